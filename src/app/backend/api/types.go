@@ -84,6 +84,9 @@ type TypeMeta struct {
 type ListMeta struct {
 	// Total number of items on the list. Used for pagination.
 	TotalItems int `json:"totalItems"`
+
+	// Token of the next chunk of the list returned by the apiserver. Empty if there are no more items.
+	Continue string `json:"continue,omitempty"`
 }
 
 // NewObjectMeta returns internal endpoint name for the given service properties, e.g.,
@@ -0,0 +1,156 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apidiscovery serves cached view of the API groups and resources discovered from the apiserver.
+package apidiscovery
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/kubernetes/dashboard/src/app/backend/discoverycache"
+)
+
+// APIDiscovery is a list of API groups served by the apiserver.
+type APIDiscovery struct {
+	// Groups served by the apiserver. Legacy core group has empty name.
+	Groups []APIGroup `json:"groups"`
+
+	// Time of the last refresh of the discovery from the apiserver.
+	RefreshTime metaV1.Time `json:"refreshTime"`
+}
+
+// APIGroup is a group of resources served by the apiserver in one or more versions.
+type APIGroup struct {
+	Name             string       `json:"name"`
+	PreferredVersion string       `json:"preferredVersion"`
+	Versions         []APIVersion `json:"versions"`
+}
+
+// APIVersion is a version of the API group with the list of its resources.
+type APIVersion struct {
+	Version   string        `json:"version"`
+	Resources []APIResource `json:"resources"`
+}
+
+// APIResource describes resource of the API group version, i.e. whether it is namespaced and verbs it supports.
+// Subresources have names with '/' separator, i.e. 'deployments/scale'.
+type APIResource struct {
+	Name       string   `json:"name"`
+	Kind       string   `json:"kind"`
+	Namespaced bool     `json:"namespaced"`
+	Verbs      []string `json:"verbs"`
+	ShortNames []string `json:"shortNames,omitempty"`
+}
+
+// Get returns API discovery of the apiserver of given client. Groups and resources are served from
+// discoverycache.DefaultCache and looked up only when they are not cached or the cache expired.
+func Get(client discovery.DiscoveryInterface) (*APIDiscovery, error) {
+	return get(discoverycache.DefaultCache, client, time.Now())
+}
+
+func get(cache *discoverycache.Cache, client discovery.DiscoveryInterface, now time.Time) (*APIDiscovery, error) {
+	result, err := fetch(cache.Wrap(client), now)
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshed := cache.Refreshed(client); !refreshed.IsZero() {
+		result.RefreshTime = metaV1.NewTime(refreshed)
+	}
+
+	return result, nil
+}
+
+// ResourceFilter returns true if resource of given API group can be listed in the discovery.
+type ResourceFilter func(group string, resource APIResource) bool
+
+// Filter returns copy of the discovery with resources that pass given filter. Versions and groups without any
+// resources are removed.
+func (self *APIDiscovery) Filter(filter ResourceFilter) *APIDiscovery {
+	result := &APIDiscovery{Groups: make([]APIGroup, 0), RefreshTime: self.RefreshTime}
+	for _, group := range self.Groups {
+		apiGroup := APIGroup{Name: group.Name, PreferredVersion: group.PreferredVersion,
+			Versions: make([]APIVersion, 0)}
+		for _, version := range group.Versions {
+			resources := make([]APIResource, 0)
+			for _, resource := range version.Resources {
+				if filter(group.Name, resource) {
+					resources = append(resources, resource)
+				}
+			}
+
+			if len(resources) > 0 {
+				apiGroup.Versions = append(apiGroup.Versions, APIVersion{Version: version.Version,
+					Resources: resources})
+			}
+		}
+
+		if len(apiGroup.Versions) > 0 {
+			result.Groups = append(result.Groups, apiGroup)
+		}
+	}
+
+	return result
+}
+
+// Fetches groups and resources from the apiserver. Groups that could not be discovered, i.e. because aggregated
+// apiserver is not available, are skipped.
+func fetch(client discovery.DiscoveryInterface, now time.Time) (*APIDiscovery, error) {
+	groups, resourceLists, err := client.ServerGroupsAndResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, err
+		}
+		log.Printf("Skipping API groups that could not be discovered: %s", err)
+	}
+
+	resources := map[string][]APIResource{}
+	for _, resourceList := range resourceLists {
+		for _, resource := range resourceList.APIResources {
+			resources[resourceList.GroupVersion] = append(resources[resourceList.GroupVersion], APIResource{
+				Name:       resource.Name,
+				Kind:       resource.Kind,
+				Namespaced: resource.Namespaced,
+				Verbs:      resource.Verbs,
+				ShortNames: resource.ShortNames,
+			})
+		}
+	}
+
+	result := &APIDiscovery{Groups: make([]APIGroup, 0), RefreshTime: metaV1.NewTime(now)}
+	for _, group := range groups {
+		apiGroup := APIGroup{Name: group.Name, PreferredVersion: group.PreferredVersion.Version,
+			Versions: make([]APIVersion, 0)}
+		for _, version := range group.Versions {
+			groupVersion := schema.GroupVersion{Group: group.Name, Version: version.Version}.String()
+			versionResources, exists := resources[groupVersion]
+			if !exists {
+				continue
+			}
+
+			sort.Slice(versionResources, func(i, j int) bool { return versionResources[i].Name < versionResources[j].Name })
+			apiGroup.Versions = append(apiGroup.Versions, APIVersion{Version: version.Version,
+				Resources: versionResources})
+		}
+		result.Groups = append(result.Groups, apiGroup)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apidiscovery
+
+import (
+	"testing"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/discoverycache"
+)
+
+func newFakeDiscovery() *fake.FakeDiscovery {
+	return &fake.FakeDiscovery{Fake: &core.Fake{Resources: []*metaV1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metaV1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "nodes", Kind: "Node", Namespaced: false, Verbs: []string{"get"}},
+		}},
+		{GroupVersion: "stable.example.com/v1", APIResources: []metaV1.APIResource{
+			{Name: "crontabs", Kind: "CronTab", Namespaced: true, Verbs: []string{"get", "create"},
+				ShortNames: []string{"ct"}},
+		}},
+	}}}
+}
+
+func TestGet(t *testing.T) {
+	client := newFakeDiscovery()
+	cache := discoverycache.NewCache(func() time.Duration { return time.Minute })
+	now := time.Now()
+
+	result, err := get(cache, client, now)
+	if err != nil {
+		t.Fatalf("Expected discovery to be fetched, but got error: %s", err)
+	}
+
+	if len(result.Groups) != 2 {
+		t.Fatalf("Expected 2 groups, but got %d", len(result.Groups))
+	}
+
+	groups := map[string]APIGroup{}
+	for _, group := range result.Groups {
+		groups[group.Name] = group
+	}
+
+	coreGroup := groups[""]
+	if coreGroup.PreferredVersion != "v1" || len(coreGroup.Versions) != 1 {
+		t.Fatalf("Expected core group with v1 version, but got %#v", coreGroup)
+	}
+
+	if resources := coreGroup.Versions[0].Resources; len(resources) != 2 || resources[0].Name != "nodes" ||
+		resources[0].Namespaced || !resources[1].Namespaced {
+		t.Errorf("Expected sorted nodes and pods resources, but got %#v", resources)
+	}
+
+	crontabGroup := groups["stable.example.com"]
+	if len(crontabGroup.Versions) != 1 || crontabGroup.Versions[0].Resources[0].Kind != "CronTab" ||
+		crontabGroup.Versions[0].Resources[0].ShortNames[0] != "ct" {
+		t.Errorf("Expected CronTab resource in stable.example.com group, but got %#v", crontabGroup)
+	}
+
+	if !result.RefreshTime.Time.Equal(now) {
+		t.Errorf("Expected refresh time %s of discovery that is not cached, but got %s", now, result.RefreshTime)
+	}
+}
+
+func TestAPIDiscoveryFilter(t *testing.T) {
+	result, err := Get(newFakeDiscovery())
+	if err != nil {
+		t.Fatalf("Expected discovery to be fetched, but got error: %s", err)
+	}
+
+	filtered := result.Filter(func(group string, resource APIResource) bool {
+		return resource.Namespaced && len(group) == 0
+	})
+
+	if len(filtered.Groups) != 1 || len(filtered.Groups[0].Versions[0].Resources) != 1 ||
+		filtered.Groups[0].Versions[0].Resources[0].Name != "pods" {
+		t.Errorf("Expected only pods in core group, but got %#v", filtered.Groups)
+	}
+
+	if len(result.Groups) != 2 {
+		t.Errorf("Expected discovery not to be modified, but got %#v", result.Groups)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apidiscovery
+
+import (
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// DiscoveryHandler manages endpoint serving cached API discovery.
+type DiscoveryHandler struct {
+	cManager clientapi.ClientManager
+	// Removes resources that are not accessible through dashboard from the discovery.
+	filter ResourceFilter
+}
+
+// Install creates new endpoint for API discovery.
+func (self *DiscoveryHandler) Install(ws *restful.WebService) {
+	ws.Route(
+		ws.GET("/discovery").
+			To(self.handleGet).
+			Writes(APIDiscovery{}))
+}
+
+func (self *DiscoveryHandler) handleGet(request *restful.Request, response *restful.Response) {
+	k8sClient, err := self.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := Get(k8sClient.Discovery())
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result.Filter(self.filter))
+}
+
+// NewDiscoveryHandler creates DiscoveryHandler.
+func NewDiscoveryHandler(cManager clientapi.ClientManager, filter ResourceFilter) DiscoveryHandler {
+	return DiscoveryHandler{cManager: cManager, filter: filter}
+}
@@ -14,7 +14,11 @@
 
 package args
 
-import "net"
+import (
+	"fmt"
+	"net"
+	"strings"
+)
 
 var builder = &holderBuilder{holder: Holder}
 
@@ -36,18 +40,282 @@ func (self *holderBuilder) SetPort(port int) *holderBuilder {
 	return self
 }
 
+// SetUnixSocket 'unix-socket' argument of Dashboard binary.
+func (self *holderBuilder) SetUnixSocket(unixSocket string) *holderBuilder {
+	self.holder.unixSocket = unixSocket
+	return self
+}
+
+// SetUnixSocketMode 'unix-socket-mode' argument of Dashboard binary.
+func (self *holderBuilder) SetUnixSocketMode(unixSocketMode string) *holderBuilder {
+	self.holder.unixSocketMode = unixSocketMode
+	return self
+}
+
+// SetBasePath 'base-path' argument of Dashboard binary.
+func (self *holderBuilder) SetBasePath(basePath string) *holderBuilder {
+	self.holder.basePath = basePath
+	return self
+}
+
+// SetAPIPrefixes 'api-prefix' argument of Dashboard binary.
+func (self *holderBuilder) SetAPIPrefixes(apiPrefixes []string) *holderBuilder {
+	self.holder.apiPrefixes = apiPrefixes
+	return self
+}
+
+// SetStaticContentDir 'static-content-dir' argument of Dashboard binary.
+func (self *holderBuilder) SetStaticContentDir(staticContentDir string) *holderBuilder {
+	self.holder.staticContentDir = staticContentDir
+	return self
+}
+
 // SetTokenTTL 'token-ttl' argument of Dashboard binary.
 func (self *holderBuilder) SetTokenTTL(ttl int) *holderBuilder {
 	self.holder.tokenTTL = ttl
 	return self
 }
 
+// SetTokenTTLBasic 'token-ttl-basic' argument of Dashboard binary.
+func (self *holderBuilder) SetTokenTTLBasic(tokenTTLBasic int) *holderBuilder {
+	self.holder.tokenTTLBasic = tokenTTLBasic
+	return self
+}
+
+// SetTokenTTLToken 'token-ttl-token' argument of Dashboard binary.
+func (self *holderBuilder) SetTokenTTLToken(tokenTTLToken int) *holderBuilder {
+	self.holder.tokenTTLToken = tokenTTLToken
+	return self
+}
+
+// SetRequiredTokenClaims 'required-token-claims' argument of Dashboard binary.
+func (self *holderBuilder) SetRequiredTokenClaims(requiredTokenClaims []string) *holderBuilder {
+	self.holder.requiredTokenClaims = requiredTokenClaims
+	return self
+}
+
+// SetEnableTokenRefresh 'enable-token-refresh' argument of Dashboard binary.
+func (self *holderBuilder) SetEnableTokenRefresh(enableTokenRefresh bool) *holderBuilder {
+	self.holder.enableTokenRefresh = enableTokenRefresh
+	return self
+}
+
+// SetMaxSessionLifetime 'max-session-lifetime' argument of Dashboard binary.
+func (self *holderBuilder) SetMaxSessionLifetime(maxSessionLifetime int) *holderBuilder {
+	self.holder.maxSessionLifetime = maxSessionLifetime
+	return self
+}
+
+// SetTokenSigningAlg 'token-signing-alg' argument of Dashboard binary.
+func (self *holderBuilder) SetTokenSigningAlg(tokenSigningAlg string) *holderBuilder {
+	self.holder.tokenSigningAlg = tokenSigningAlg
+	return self
+}
+
+// SetCookieSameSite 'cookie-samesite' argument of Dashboard binary.
+func (self *holderBuilder) SetCookieSameSite(cookieSameSite string) *holderBuilder {
+	self.holder.cookieSameSite = cookieSameSite
+	return self
+}
+
+// SetCookieSecure 'cookie-secure' argument of Dashboard binary.
+func (self *holderBuilder) SetCookieSecure(cookieSecure bool) *holderBuilder {
+	self.holder.cookieSecure = cookieSecure
+	return self
+}
+
+// SetCookieDomain 'cookie-domain' argument of Dashboard binary.
+func (self *holderBuilder) SetCookieDomain(cookieDomain string) *holderBuilder {
+	self.holder.cookieDomain = cookieDomain
+	return self
+}
+
+// SetEnableCSRF 'enable-csrf' argument of Dashboard binary.
+func (self *holderBuilder) SetEnableCSRF(enableCSRF bool) *holderBuilder {
+	self.holder.enableCSRF = enableCSRF
+	return self
+}
+
+// SetSessionIdleTimeout 'session-idle-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetSessionIdleTimeout(sessionIdleTimeout int) *holderBuilder {
+	self.holder.sessionIdleTimeout = sessionIdleTimeout
+	return self
+}
+
+// SetSessionWarningLeadTime 'session-warning-lead-time' argument of Dashboard binary.
+func (self *holderBuilder) SetSessionWarningLeadTime(sessionWarningLeadTime int) *holderBuilder {
+	self.holder.sessionWarningLeadTime = sessionWarningLeadTime
+	return self
+}
+
 // SetMetricClientCheckPeriod 'metric-client-check-period' argument of Dashboard binary.
 func (self *holderBuilder) SetMetricClientCheckPeriod(period int) *holderBuilder {
 	self.holder.metricClientCheckPeriod = period
 	return self
 }
 
+// SetResyncPeriod 'resync-period' argument of Dashboard binary.
+func (self *holderBuilder) SetResyncPeriod(resyncPeriod int) *holderBuilder {
+	self.holder.resyncPeriod = resyncPeriod
+	return self
+}
+
+// SetDiscoveryRefreshInterval 'discovery-refresh-interval' argument of Dashboard binary.
+func (self *holderBuilder) SetDiscoveryRefreshInterval(discoveryRefreshInterval int) *holderBuilder {
+	self.holder.discoveryRefreshInterval = discoveryRefreshInterval
+	return self
+}
+
+// SetShutdownTimeout 'shutdown-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetShutdownTimeout(timeout int) *holderBuilder {
+	self.holder.shutdownTimeout = timeout
+	return self
+}
+
+// SetReadinessTimeout 'readiness-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetReadinessTimeout(timeout int) *holderBuilder {
+	self.holder.readinessTimeout = timeout
+	return self
+}
+
+// SetLivenessTimeout 'liveness-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetLivenessTimeout(livenessTimeout int) *holderBuilder {
+	self.holder.livenessTimeout = livenessTimeout
+	return self
+}
+
+// SetHTTPReadTimeout 'http-read-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetHTTPReadTimeout(httpReadTimeout int) *holderBuilder {
+	self.holder.httpReadTimeout = httpReadTimeout
+	return self
+}
+
+// SetHTTPWriteTimeout 'http-write-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetHTTPWriteTimeout(httpWriteTimeout int) *holderBuilder {
+	self.holder.httpWriteTimeout = httpWriteTimeout
+	return self
+}
+
+// SetHTTPIdleTimeout 'http-idle-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetHTTPIdleTimeout(httpIdleTimeout int) *holderBuilder {
+	self.holder.httpIdleTimeout = httpIdleTimeout
+	return self
+}
+
+// SetHSTSMaxAge 'hsts-max-age' argument of Dashboard binary.
+func (self *holderBuilder) SetHSTSMaxAge(hstsMaxAge int) *holderBuilder {
+	self.holder.hstsMaxAge = hstsMaxAge
+	return self
+}
+
+// SetRequestTimeout 'request-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetRequestTimeout(requestTimeout int) *holderBuilder {
+	self.holder.requestTimeout = requestTimeout
+	return self
+}
+
+// SetRateLimitQPS 'rate-limit-qps' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitQPS(rateLimitQPS float64) *holderBuilder {
+	self.holder.rateLimitQPS = rateLimitQPS
+	return self
+}
+
+// SetRateLimitBurst 'rate-limit-burst' argument of Dashboard binary.
+func (self *holderBuilder) SetRateLimitBurst(rateLimitBurst int) *holderBuilder {
+	self.holder.rateLimitBurst = rateLimitBurst
+	return self
+}
+
+// SetMaxInflightRequests 'max-inflight-requests' argument of Dashboard binary.
+func (self *holderBuilder) SetMaxInflightRequests(maxInflightRequests int) *holderBuilder {
+	self.holder.maxInflightRequests = maxInflightRequests
+	return self
+}
+
+// SetMaxInflightStreams 'max-inflight-streams' argument of Dashboard binary.
+func (self *holderBuilder) SetMaxInflightStreams(maxInflightStreams int) *holderBuilder {
+	self.holder.maxInflightStreams = maxInflightStreams
+	return self
+}
+
+// SetMaxConnectionsPerIP 'max-connections-per-ip' argument of Dashboard binary.
+func (self *holderBuilder) SetMaxConnectionsPerIP(maxConnectionsPerIP int) *holderBuilder {
+	self.holder.maxConnectionsPerIP = maxConnectionsPerIP
+	return self
+}
+
+// SetMaxRequestBodyBytes 'max-request-body-bytes' argument of Dashboard binary.
+func (self *holderBuilder) SetMaxRequestBodyBytes(maxRequestBodyBytes int64) *holderBuilder {
+	self.holder.maxRequestBodyBytes = maxRequestBodyBytes
+	return self
+}
+
+// SetEnableCompression 'enable-compression' argument of Dashboard binary.
+func (self *holderBuilder) SetEnableCompression(enableCompression bool) *holderBuilder {
+	self.holder.enableCompression = enableCompression
+	return self
+}
+
+// SetCompressionMinBytes 'compression-min-bytes' argument of Dashboard binary.
+func (self *holderBuilder) SetCompressionMinBytes(compressionMinBytes int) *holderBuilder {
+	self.holder.compressionMinBytes = compressionMinBytes
+	return self
+}
+
+// SetMaxWatchesPerSession 'max-watches-per-session' argument of Dashboard binary.
+func (self *holderBuilder) SetMaxWatchesPerSession(maxWatchesPerSession int) *holderBuilder {
+	self.holder.maxWatchesPerSession = maxWatchesPerSession
+	return self
+}
+
+// SetEventHistoryLimit 'event-history-limit' argument of Dashboard binary.
+func (self *holderBuilder) SetEventHistoryLimit(eventHistoryLimit int) *holderBuilder {
+	self.holder.eventHistoryLimit = eventHistoryLimit
+	return self
+}
+
+// SetDrainGracePeriod 'drain-grace-period' argument of Dashboard binary.
+func (self *holderBuilder) SetDrainGracePeriod(drainGracePeriod int) *holderBuilder {
+	self.holder.drainGracePeriod = drainGracePeriod
+	return self
+}
+
+// SetDrainTimeout 'drain-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetDrainTimeout(drainTimeout int) *holderBuilder {
+	self.holder.drainTimeout = drainTimeout
+	return self
+}
+
+// SetKubeClientQPS 'kube-client-qps' argument of Dashboard binary.
+func (self *holderBuilder) SetKubeClientQPS(kubeClientQPS float32) *holderBuilder {
+	self.holder.kubeClientQPS = kubeClientQPS
+	return self
+}
+
+// SetKubeClientBurst 'kube-client-burst' argument of Dashboard binary.
+func (self *holderBuilder) SetKubeClientBurst(kubeClientBurst int) *holderBuilder {
+	self.holder.kubeClientBurst = kubeClientBurst
+	return self
+}
+
+// SetKubeClientTimeout 'kube-client-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetKubeClientTimeout(kubeClientTimeout int) *holderBuilder {
+	self.holder.kubeClientTimeout = kubeClientTimeout
+	return self
+}
+
+// SetApiServerMaxRetries 'apiserver-max-retries' argument of Dashboard binary.
+func (self *holderBuilder) SetApiServerMaxRetries(apiServerMaxRetries int) *holderBuilder {
+	self.holder.apiServerMaxRetries = apiServerMaxRetries
+	return self
+}
+
+// SetUserAgent 'user-agent' argument of Dashboard binary.
+func (self *holderBuilder) SetUserAgent(userAgent string) *holderBuilder {
+	self.holder.userAgent = userAgent
+	return self
+}
+
 // SetInsecureBindAddress 'insecure-bind-address' argument of Dashboard binary.
 func (self *holderBuilder) SetInsecureBindAddress(ip net.IP) *holderBuilder {
 	self.holder.insecureBindAddress = ip
@@ -60,6 +328,18 @@ func (self *holderBuilder) SetBindAddress(ip net.IP) *holderBuilder {
 	return self
 }
 
+// SetMetricsBindAddress 'metrics-bind-address' argument of Dashboard binary.
+func (self *holderBuilder) SetMetricsBindAddress(metricsBindAddress string) *holderBuilder {
+	self.holder.metricsBindAddress = metricsBindAddress
+	return self
+}
+
+// SetEnablePprof 'enable-pprof' argument of Dashboard binary.
+func (self *holderBuilder) SetEnablePprof(enablePprof bool) *holderBuilder {
+	self.holder.enablePprof = enablePprof
+	return self
+}
+
 // SetDefaultCertDir 'default-cert-dir' argument of Dashboard binary.
 func (self *holderBuilder) SetDefaultCertDir(certDir string) *holderBuilder {
 	self.holder.defaultCertDir = certDir
@@ -72,18 +352,90 @@ func (self *holderBuilder) SetCertFile(certFile string) *holderBuilder {
 	return self
 }
 
+// SetFrameOptions 'frame-options' argument of Dashboard binary.
+func (self *holderBuilder) SetFrameOptions(frameOptions string) *holderBuilder {
+	self.holder.frameOptions = frameOptions
+	return self
+}
+
 // SetKeyFile 'tls-key-file' argument of Dashboard binary.
 func (self *holderBuilder) SetKeyFile(keyFile string) *holderBuilder {
 	self.holder.keyFile = keyFile
 	return self
 }
 
+// SetTLSSNICerts 'tls-sni-cert' argument of Dashboard binary.
+func (self *holderBuilder) SetTLSSNICerts(tlsSNICerts []string) *holderBuilder {
+	self.holder.tlsSNICerts = tlsSNICerts
+	return self
+}
+
+// SetTLSMinVersion 'tls-min-version' argument of Dashboard binary.
+func (self *holderBuilder) SetTLSMinVersion(version string) *holderBuilder {
+	self.holder.tlsMinVersion = version
+	return self
+}
+
+// SetDisableHTTP2 'disable-http2' argument of Dashboard binary.
+func (self *holderBuilder) SetDisableHTTP2(disableHTTP2 bool) *holderBuilder {
+	self.holder.disableHTTP2 = disableHTTP2
+	return self
+}
+
+// SetTLSCipherSuites 'tls-cipher-suites' argument of Dashboard binary.
+func (self *holderBuilder) SetTLSCipherSuites(cipherSuites []string) *holderBuilder {
+	self.holder.tlsCipherSuites = cipherSuites
+	return self
+}
+
+// SetCORSAllowedOrigins 'cors-allowed-origins' argument of Dashboard binary.
+func (self *holderBuilder) SetCORSAllowedOrigins(corsAllowedOrigins []string) *holderBuilder {
+	self.holder.corsAllowedOrigins = corsAllowedOrigins
+	return self
+}
+
 // SetApiServerHost 'api-server-host' argument of Dashboard binary.
 func (self *holderBuilder) SetApiServerHost(apiServerHost string) *holderBuilder {
 	self.holder.apiServerHost = apiServerHost
 	return self
 }
 
+// SetApiServerCAFile 'apiserver-ca-file' argument of Dashboard binary.
+func (self *holderBuilder) SetApiServerCAFile(apiServerCAFile string) *holderBuilder {
+	self.holder.apiServerCAFile = apiServerCAFile
+	return self
+}
+
+// SetApiServerSkipTLSVerify 'apiserver-skip-tls-verify' argument of Dashboard binary.
+func (self *holderBuilder) SetApiServerSkipTLSVerify(apiServerSkipTLSVerify bool) *holderBuilder {
+	self.holder.apiServerSkipTLSVerify = apiServerSkipTLSVerify
+	return self
+}
+
+// SetApiServerConnectRetries 'apiserver-connect-retries' argument of Dashboard binary.
+func (self *holderBuilder) SetApiServerConnectRetries(apiServerConnectRetries int) *holderBuilder {
+	self.holder.apiServerConnectRetries = apiServerConnectRetries
+	return self
+}
+
+// SetApiServerConnectBackoff 'apiserver-connect-backoff' argument of Dashboard binary.
+func (self *holderBuilder) SetApiServerConnectBackoff(apiServerConnectBackoff int) *holderBuilder {
+	self.holder.apiServerConnectBackoff = apiServerConnectBackoff
+	return self
+}
+
+// SetApiServerProxyURL 'apiserver-proxy-url' argument of Dashboard binary.
+func (self *holderBuilder) SetApiServerProxyURL(apiServerProxyURL string) *holderBuilder {
+	self.holder.apiServerProxyURL = apiServerProxyURL
+	return self
+}
+
+// SetApiServerNoProxy 'apiserver-no-proxy' argument of Dashboard binary.
+func (self *holderBuilder) SetApiServerNoProxy(apiServerNoProxy []string) *holderBuilder {
+	self.holder.apiServerNoProxy = apiServerNoProxy
+	return self
+}
+
 // SetMetricsProvider 'metrics-provider' argument of Dashboard binary.
 func (self *holderBuilder) SetMetricsProvider(metricsProvider string) *holderBuilder {
 	self.holder.metricsProvider = metricsProvider
@@ -108,6 +460,30 @@ func (self *holderBuilder) SetKubeConfigFile(kubeConfigFile string) *holderBuild
 	return self
 }
 
+// SetTokenFile 'token-file' argument of Dashboard binary.
+func (self *holderBuilder) SetTokenFile(tokenFile string) *holderBuilder {
+	self.holder.tokenFile = tokenFile
+	return self
+}
+
+// SetServiceAccountTokenFile 'service-account-token-file' argument of Dashboard binary.
+func (self *holderBuilder) SetServiceAccountTokenFile(serviceAccountTokenFile string) *holderBuilder {
+	self.holder.serviceAccountTokenFile = serviceAccountTokenFile
+	return self
+}
+
+// SetServiceAccountCAFile 'service-account-ca-file' argument of Dashboard binary.
+func (self *holderBuilder) SetServiceAccountCAFile(serviceAccountCAFile string) *holderBuilder {
+	self.holder.serviceAccountCAFile = serviceAccountCAFile
+	return self
+}
+
+// SetDefaultContext 'default-context' argument of Dashboard binary.
+func (self *holderBuilder) SetDefaultContext(defaultContext string) *holderBuilder {
+	self.holder.defaultContext = defaultContext
+	return self
+}
+
 // SetSystemBanner 'system-banner' argument of Dashboard binary.
 func (self *holderBuilder) SetSystemBanner(systemBanner string) *holderBuilder {
 	self.holder.systemBanner = systemBanner
@@ -120,12 +496,138 @@ func (self *holderBuilder) SetSystemBannerSeverity(systemBannerSeverity string)
 	return self
 }
 
+// SetEnableGlobalBanner 'enable-global-banner' argument of Dashboard binary.
+func (self *holderBuilder) SetEnableGlobalBanner(enableGlobalBanner bool) *holderBuilder {
+	self.holder.enableGlobalBanner = enableGlobalBanner
+	return self
+}
+
+// SetEnableHelm 'enable-helm' argument of Dashboard binary.
+func (self *holderBuilder) SetEnableHelm(enableHelm bool) *holderBuilder {
+	self.holder.enableHelm = enableHelm
+	return self
+}
+
+// SetEnablePortForward 'enable-port-forward' argument of Dashboard binary.
+func (self *holderBuilder) SetEnablePortForward(enablePortForward bool) *holderBuilder {
+	self.holder.enablePortForward = enablePortForward
+	return self
+}
+
+// SetPortForwardIdleTimeout 'port-forward-idle-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetPortForwardIdleTimeout(portForwardIdleTimeout int) *holderBuilder {
+	self.holder.portForwardIdleTimeout = portForwardIdleTimeout
+	return self
+}
+
+// SetWebSocketMaxMessageBytes 'websocket-max-message-bytes' argument of Dashboard binary.
+func (self *holderBuilder) SetWebSocketMaxMessageBytes(webSocketMaxMessageBytes int) *holderBuilder {
+	self.holder.webSocketMaxMessageBytes = webSocketMaxMessageBytes
+	return self
+}
+
+// SetWebSocketPingInterval 'websocket-ping-interval' argument of Dashboard binary.
+func (self *holderBuilder) SetWebSocketPingInterval(webSocketPingInterval int) *holderBuilder {
+	self.holder.webSocketPingInterval = webSocketPingInterval
+	return self
+}
+
+// SetDefaultView 'default-view' argument of Dashboard binary.
+func (self *holderBuilder) SetDefaultView(defaultView string) *holderBuilder {
+	self.holder.defaultView = defaultView
+	return self
+}
+
+// SetLoginTitle 'login-title' argument of Dashboard binary.
+func (self *holderBuilder) SetLoginTitle(loginTitle string) *holderBuilder {
+	self.holder.loginTitle = loginTitle
+	return self
+}
+
+// SetLoginLogoURL 'login-logo-url' argument of Dashboard binary.
+func (self *holderBuilder) SetLoginLogoURL(loginLogoURL string) *holderBuilder {
+	self.holder.loginLogoURL = loginLogoURL
+	return self
+}
+
+// SetAppTitle 'app-title' argument of Dashboard binary.
+func (self *holderBuilder) SetAppTitle(appTitle string) *holderBuilder {
+	self.holder.appTitle = appTitle
+	return self
+}
+
+// SetFaviconURL 'favicon-url' argument of Dashboard binary.
+func (self *holderBuilder) SetFaviconURL(faviconURL string) *holderBuilder {
+	self.holder.faviconURL = faviconURL
+	return self
+}
+
 // SetLogLevel 'api-log-level' argument of Dashboard binary.
 func (self *holderBuilder) SetAPILogLevel(apiLogLevel string) *holderBuilder {
 	self.holder.apiLogLevel = apiLogLevel
 	return self
 }
 
+// SetLogFormat 'log-format' argument of Dashboard binary.
+func (self *holderBuilder) SetLogFormat(logFormat string) *holderBuilder {
+	self.holder.logFormat = logFormat
+	return self
+}
+
+// SetOTelEndpoint 'otel-endpoint' argument of Dashboard binary.
+func (self *holderBuilder) SetOTelEndpoint(otelEndpoint string) *holderBuilder {
+	self.holder.otelEndpoint = otelEndpoint
+	return self
+}
+
+// SetOTelSampleRate 'otel-sample-rate' argument of Dashboard binary.
+func (self *holderBuilder) SetOTelSampleRate(otelSampleRate float64) *holderBuilder {
+	self.holder.otelSampleRate = otelSampleRate
+	return self
+}
+
+// SetAuditLogPath 'audit-log-path' argument of Dashboard binary.
+func (self *holderBuilder) SetAuditLogPath(auditLogPath string) *holderBuilder {
+	self.holder.auditLogPath = auditLogPath
+	return self
+}
+
+// SetAuditLogMaxSize 'audit-log-maxsize' argument of Dashboard binary.
+func (self *holderBuilder) SetAuditLogMaxSize(auditLogMaxSize int) *holderBuilder {
+	self.holder.auditLogMaxSize = auditLogMaxSize
+	return self
+}
+
+// SetRequestIDHeader 'request-id-header' argument of Dashboard binary.
+func (self *holderBuilder) SetRequestIDHeader(requestIDHeader string) *holderBuilder {
+	self.holder.requestIDHeader = requestIDHeader
+	return self
+}
+
+// SetLogDefaultTailLines 'log-default-tail-lines' argument of Dashboard binary.
+func (self *holderBuilder) SetLogDefaultTailLines(logDefaultTailLines int) *holderBuilder {
+	self.holder.logDefaultTailLines = logDefaultTailLines
+	return self
+}
+
+// SetLogMaxBytes 'log-max-bytes' argument of Dashboard binary.
+func (self *holderBuilder) SetLogMaxBytes(logMaxBytes int) *holderBuilder {
+	self.holder.logMaxBytes = logMaxBytes
+	return self
+}
+
+// SetIgnoreDefaultContainerAnnotation 'ignore-default-container-annotation' argument of Dashboard binary.
+func (self *holderBuilder) SetIgnoreDefaultContainerAnnotation(ignoreDefaultContainerAnnotation bool) *holderBuilder {
+	self.holder.ignoreDefaultContainerAnnotation = ignoreDefaultContainerAnnotation
+	return self
+}
+
+// SetDefaultExecCommand 'default-exec-command' argument of Dashboard binary.
+func (self *holderBuilder) SetDefaultExecCommand(defaultExecCommand string) *holderBuilder {
+	self.holder.defaultExecCommand = defaultExecCommand
+	return self
+}
+
 // SetAuthenticationMode 'authentication-mode' argument of Dashboard binary.
 func (self *holderBuilder) SetAuthenticationMode(authMode []string) *holderBuilder {
 	self.holder.authenticationMode = authMode
@@ -138,6 +640,12 @@ func (self *holderBuilder) SetAutoGenerateCertificates(autoGenerateCertificates
 	return self
 }
 
+// SetAutoGenerateCertSANs 'auto-generate-cert-sans' argument of Dashboard binary.
+func (self *holderBuilder) SetAutoGenerateCertSANs(autoGenerateCertSANs []string) *holderBuilder {
+	self.holder.autoGenerateCertSANs = autoGenerateCertSANs
+	return self
+}
+
 // SetEnableInsecureLogin 'enable-insecure-login' argument of Dashboard binary.
 func (self *holderBuilder) SetEnableInsecureLogin(enableInsecureLogin bool) *holderBuilder {
 	self.holder.enableInsecureLogin = enableInsecureLogin
@@ -150,24 +658,204 @@ func (self *holderBuilder) SetDisableSettingsAuthorizer(disableSettingsAuthorize
 	return self
 }
 
+// SetSettingsNamespace 'settings-namespace' argument of Dashboard binary.
+func (self *holderBuilder) SetSettingsNamespace(settingsNamespace string) *holderBuilder {
+	self.holder.settingsNamespace = settingsNamespace
+	return self
+}
+
+// SetSettingsReadOnly 'settings-read-only' argument of Dashboard binary.
+func (self *holderBuilder) SetSettingsReadOnly(settingsReadOnly bool) *holderBuilder {
+	self.holder.settingsReadOnly = settingsReadOnly
+	return self
+}
+
 // SetEnableSkipLogin 'enable-skip-login' argument of Dashboard binary.
 func (self *holderBuilder) SetEnableSkipLogin(enableSkipLogin bool) *holderBuilder {
 	self.holder.enableSkipLogin = enableSkipLogin
 	return self
 }
 
+// SetReadOnly 'read-only' argument of Dashboard binary.
+func (self *holderBuilder) SetReadOnly(readOnly bool) *holderBuilder {
+	self.holder.readOnly = readOnly
+	return self
+}
+
+// SetEnableImpersonation 'enable-impersonation' argument of Dashboard binary.
+func (self *holderBuilder) SetEnableImpersonation(enableImpersonation bool) *holderBuilder {
+	self.holder.enableImpersonation = enableImpersonation
+	return self
+}
+
+// SetAuthenticationHeader 'authentication-header' argument of Dashboard binary.
+func (self *holderBuilder) SetAuthenticationHeader(authenticationHeader string) *holderBuilder {
+	self.holder.authenticationHeader = authenticationHeader
+	return self
+}
+
+// SetTrustedProxyCIDRs 'trusted-proxy-cidrs' argument of Dashboard binary. CIDRs are parsed once here, none of them
+// is trusted if any is invalid.
+func (self *holderBuilder) SetTrustedProxyCIDRs(trustedProxyCIDRs []string) *holderBuilder {
+	self.holder.trustedProxyCIDRs = trustedProxyCIDRs
+	self.holder.trustedProxyCIDRNets, _ = ParseCIDRs(trustedProxyCIDRs)
+	return self
+}
+
+// SetTrustedProxies 'trusted-proxies' argument of Dashboard binary. CIDRs are parsed once here, none of them is
+// trusted if any is invalid.
+func (self *holderBuilder) SetTrustedProxies(trustedProxies []string) *holderBuilder {
+	self.holder.trustedProxies = trustedProxies
+	self.holder.trustedProxyNets, _ = ParseCIDRs(trustedProxies)
+	return self
+}
+
+// SetTrustOriginalForwardedFor 'trust-original-forwarded-for' argument of Dashboard binary.
+func (self *holderBuilder) SetTrustOriginalForwardedFor(trustOriginalForwardedFor bool) *holderBuilder {
+	self.holder.trustOriginalForwardedFor = trustOriginalForwardedFor
+	return self
+}
+
+// SetNamespaceAllowlist 'namespace-allowlist' argument of Dashboard binary.
+func (self *holderBuilder) SetNamespaceAllowlist(namespaceAllowlist []string) *holderBuilder {
+	self.holder.namespaceAllowlist = namespaceAllowlist
+	return self
+}
+
+// SetNamespaceAllowlistClusterScoped 'namespace-allowlist-cluster-scoped' argument of Dashboard binary.
+func (self *holderBuilder) SetNamespaceAllowlistClusterScoped(namespaceAllowlistClusterScoped bool) *holderBuilder {
+	self.holder.namespaceAllowlistClusterScoped = namespaceAllowlistClusterScoped
+	return self
+}
+
+// SetDisableClusterScoped 'disable-cluster-scoped' argument of Dashboard binary.
+func (self *holderBuilder) SetDisableClusterScoped(disableClusterScoped bool) *holderBuilder {
+	self.holder.disableClusterScoped = disableClusterScoped
+	return self
+}
+
+// SetAllowedResources 'allowed-resources' argument of Dashboard binary.
+func (self *holderBuilder) SetAllowedResources(allowedResources []string) *holderBuilder {
+	self.holder.allowedResources = allowedResources
+	return self
+}
+
+// SetAnonymousAccess 'anonymous-access' argument of Dashboard binary.
+func (self *holderBuilder) SetAnonymousAccess(anonymousAccess bool) *holderBuilder {
+	self.holder.anonymousAccess = anonymousAccess
+	return self
+}
+
+// SetAnonymousServiceAccount 'anonymous-service-account' argument of Dashboard binary.
+func (self *holderBuilder) SetAnonymousServiceAccount(anonymousServiceAccount string) *holderBuilder {
+	self.holder.anonymousServiceAccount = anonymousServiceAccount
+	return self
+}
+
+// SetImpersonateServiceAccount 'impersonate-service-account' argument of Dashboard binary.
+func (self *holderBuilder) SetImpersonateServiceAccount(impersonateServiceAccount string) *holderBuilder {
+	self.holder.impersonateServiceAccount = impersonateServiceAccount
+	return self
+}
+
+// SetAnonymousResources 'anonymous-resources' argument of Dashboard binary.
+func (self *holderBuilder) SetAnonymousResources(anonymousResources []string) *holderBuilder {
+	self.holder.anonymousResources = anonymousResources
+	return self
+}
+
 // SetNamespace 'namespace' argument of Dashboard binary.
 func (self *holderBuilder) SetNamespace(namespace string) *holderBuilder {
 	self.holder.namespace = namespace
 	return self
 }
 
+// SetDefaultCreateNamespace 'default-create-namespace' argument of Dashboard binary.
+func (self *holderBuilder) SetDefaultCreateNamespace(defaultCreateNamespace string) *holderBuilder {
+	self.holder.defaultCreateNamespace = defaultCreateNamespace
+	return self
+}
+
+// SetDefaultDeletePropagation 'default-delete-propagation' argument of Dashboard binary.
+func (self *holderBuilder) SetDefaultDeletePropagation(defaultDeletePropagation string) *holderBuilder {
+	self.holder.defaultDeletePropagation = defaultDeletePropagation
+	return self
+}
+
+// SetDefaultTimezone 'default-timezone' argument of Dashboard binary.
+func (self *holderBuilder) SetDefaultTimezone(defaultTimezone string) *holderBuilder {
+	self.holder.defaultTimezone = defaultTimezone
+	return self
+}
+
+// SetEncryptionKeyProvider 'encryption-key-provider' argument of Dashboard binary.
+func (self *holderBuilder) SetEncryptionKeyProvider(encryptionKeyProvider string) *holderBuilder {
+	self.holder.encryptionKeyProvider = encryptionKeyProvider
+	return self
+}
+
+// SetKMSEndpoint 'kms-endpoint' argument of Dashboard binary.
+func (self *holderBuilder) SetKMSEndpoint(kmsEndpoint string) *holderBuilder {
+	self.holder.kmsEndpoint = kmsEndpoint
+	return self
+}
+
+// SetKMSTimeout 'kms-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetKMSTimeout(kmsTimeout int) *holderBuilder {
+	self.holder.kmsTimeout = kmsTimeout
+	return self
+}
+
 // SetLocaleConfig 'locale-config' argument of Dashboard binary.
 func (self *holderBuilder) SetLocaleConfig(localeConfig string) *holderBuilder {
 	self.holder.localeConfig = localeConfig
 	return self
 }
 
+// SetOIDCIssuerURL 'oidc-issuer-url' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCIssuerURL(issuerURL string) *holderBuilder {
+	self.holder.oidcIssuerURL = issuerURL
+	return self
+}
+
+// SetOIDCClientID 'oidc-client-id' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCClientID(clientID string) *holderBuilder {
+	self.holder.oidcClientID = clientID
+	return self
+}
+
+// SetOIDCClientSecret 'oidc-client-secret' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCClientSecret(clientSecret string) *holderBuilder {
+	self.holder.oidcClientSecret = clientSecret
+	return self
+}
+
+// SetOIDCScopes 'oidc-scopes' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCScopes(scopes []string) *holderBuilder {
+	self.holder.oidcScopes = scopes
+	return self
+}
+
+// SetOIDCRedirectURL 'oidc-redirect-url' argument of Dashboard binary.
+func (self *holderBuilder) SetOIDCRedirectURL(redirectURL string) *holderBuilder {
+	self.holder.oidcRedirectURL = redirectURL
+	return self
+}
+
+// ParseCIDRs parses list of CIDRs, i.e. of trusted proxies.
+func ParseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	result := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %s", cidr, err)
+		}
+		result = append(result, ipNet)
+	}
+
+	return result, nil
+}
+
 // GetHolderBuilder returns singleton instance of argument holder builder.
 func GetHolderBuilder() *holderBuilder {
 	return builder
@@ -16,6 +16,7 @@ package args
 
 import (
 	"net"
+	"strings"
 
 	"github.com/kubernetes/dashboard/src/app/backend/cert/api"
 )
@@ -25,36 +26,150 @@ var Holder = &holder{}
 // Argument holder structure. It is private to make sure that only 1 instance can be created. It holds all
 // arguments values passed to Dashboard binary.
 type holder struct {
-	insecurePort            int
-	port                    int
-	tokenTTL                int
-	metricClientCheckPeriod int
+	insecurePort             int
+	port                     int
+	unixSocket               string
+	unixSocketMode           string
+	basePath                 string
+	apiPrefixes              []string
+	staticContentDir         string
+	tokenTTL                 int
+	tokenTTLBasic            int
+	tokenTTLToken            int
+	requiredTokenClaims      []string
+	enableTokenRefresh       bool
+	maxSessionLifetime       int
+	tokenSigningAlg          string
+	cookieSameSite           string
+	cookieSecure             bool
+	cookieDomain             string
+	enableCSRF               bool
+	sessionIdleTimeout       int
+	sessionWarningLeadTime   int
+	metricClientCheckPeriod  int
+	resyncPeriod             int
+	discoveryRefreshInterval int
+	shutdownTimeout          int
+	readinessTimeout         int
+	livenessTimeout          int
+	httpReadTimeout          int
+	httpWriteTimeout         int
+	httpIdleTimeout          int
+	hstsMaxAge               int
+	requestTimeout           int
+	rateLimitQPS             float64
+	rateLimitBurst           int
+	maxInflightRequests      int
+	maxInflightStreams       int
+	maxConnectionsPerIP      int
+	maxRequestBodyBytes      int64
+	enableCompression        bool
+	compressionMinBytes      int
+	maxWatchesPerSession     int
+	eventHistoryLimit        int
+	drainGracePeriod         int
+	drainTimeout             int
+	kubeClientQPS            float32
+	kubeClientBurst          int
+	kubeClientTimeout        int
+	apiServerMaxRetries      int
+	userAgent                string
 
 	insecureBindAddress net.IP
 	bindAddress         net.IP
-
-	defaultCertDir       string
-	certFile             string
-	keyFile              string
-	apiServerHost        string
-	metricsProvider      string
-	heapsterHost         string
-	sidecarHost          string
-	kubeConfigFile       string
-	systemBanner         string
-	systemBannerSeverity string
-	apiLogLevel          string
-	namespace            string
+	metricsBindAddress  string
+	enablePprof         bool
+
+	defaultCertDir                   string
+	certFile                         string
+	frameOptions                     string
+	keyFile                          string
+	tlsSNICerts                      []string
+	tlsMinVersion                    string
+	disableHTTP2                     bool
+	apiServerHost                    string
+	apiServerCAFile                  string
+	apiServerSkipTLSVerify           bool
+	apiServerConnectRetries          int
+	apiServerConnectBackoff          int
+	apiServerProxyURL                string
+	apiServerNoProxy                 []string
+	metricsProvider                  string
+	heapsterHost                     string
+	sidecarHost                      string
+	kubeConfigFile                   string
+	tokenFile                        string
+	serviceAccountTokenFile          string
+	serviceAccountCAFile             string
+	defaultContext                   string
+	systemBanner                     string
+	systemBannerSeverity             string
+	enableGlobalBanner               bool
+	enableHelm                       bool
+	enablePortForward                bool
+	portForwardIdleTimeout           int
+	webSocketMaxMessageBytes         int
+	webSocketPingInterval            int
+	defaultView                      string
+	loginTitle                       string
+	loginLogoURL                     string
+	appTitle                         string
+	faviconURL                       string
+	apiLogLevel                      string
+	logFormat                        string
+	otelEndpoint                     string
+	otelSampleRate                   float64
+	auditLogPath                     string
+	auditLogMaxSize                  int
+	requestIDHeader                  string
+	logDefaultTailLines              int
+	logMaxBytes                      int
+	ignoreDefaultContainerAnnotation bool
+	defaultExecCommand               string
+	namespace                        string
+	defaultCreateNamespace           string
+	defaultDeletePropagation         string
+	defaultTimezone                  string
+	encryptionKeyProvider            string
+	kmsEndpoint                      string
+	kmsTimeout                       int
 
 	authenticationMode []string
+	tlsCipherSuites    []string
+	corsAllowedOrigins []string
 
 	autoGenerateCertificates  bool
+	autoGenerateCertSANs      []string
 	enableInsecureLogin       bool
 	disableSettingsAuthorizer bool
-
-	enableSkipLogin bool
+	settingsNamespace         string
+	settingsReadOnly          bool
+
+	enableSkipLogin                 bool
+	readOnly                        bool
+	enableImpersonation             bool
+	authenticationHeader            string
+	trustedProxyCIDRs               []string
+	trustedProxyCIDRNets            []*net.IPNet
+	trustedProxies                  []string
+	trustedProxyNets                []*net.IPNet
+	trustOriginalForwardedFor       bool
+	namespaceAllowlist              []string
+	namespaceAllowlistClusterScoped bool
+	disableClusterScoped            bool
+	allowedResources                []string
+	anonymousAccess                 bool
+	anonymousServiceAccount         string
+	impersonateServiceAccount       string
+	anonymousResources              []string
 
 	localeConfig string
+
+	oidcIssuerURL    string
+	oidcClientID     string
+	oidcClientSecret string
+	oidcScopes       []string
+	oidcRedirectURL  string
 }
 
 // GetInsecurePort 'insecure-port' argument of Dashboard binary.
@@ -67,16 +182,246 @@ func (self *holder) GetPort() int {
 	return self.port
 }
 
+// GetUnixSocket 'unix-socket' argument of Dashboard binary.
+func (self *holder) GetUnixSocket() string {
+	return self.unixSocket
+}
+
+// GetUnixSocketMode 'unix-socket-mode' argument of Dashboard binary.
+func (self *holder) GetUnixSocketMode() string {
+	return self.unixSocketMode
+}
+
+// GetBasePath 'base-path' argument of Dashboard binary.
+func (self *holder) GetBasePath() string {
+	return self.basePath
+}
+
+// GetAPIPrefixes 'api-prefix' argument of Dashboard binary.
+func (self *holder) GetAPIPrefixes() []string {
+	return self.apiPrefixes
+}
+
+// GetAPIPrefix returns the first 'api-prefix' argument of Dashboard binary, that is the prefix used by the frontend
+// and in URLs generated by the backend. Defaults to '/api/v1' if the argument is not set.
+func (self *holder) GetAPIPrefix() string {
+	if len(self.apiPrefixes) == 0 {
+		return "/api/v1"
+	}
+
+	return strings.TrimSuffix(self.apiPrefixes[0], "/")
+}
+
+// GetStaticContentDir 'static-content-dir' argument of Dashboard binary.
+func (self *holder) GetStaticContentDir() string {
+	return self.staticContentDir
+}
+
 // GetTokenTTL 'token-ttl' argument of Dashboard binary.
 func (self *holder) GetTokenTTL() int {
 	return self.tokenTTL
 }
 
+// GetTokenTTLBasic 'token-ttl-basic' argument of Dashboard binary.
+func (self *holder) GetTokenTTLBasic() int {
+	return self.tokenTTLBasic
+}
+
+// GetTokenTTLToken 'token-ttl-token' argument of Dashboard binary.
+func (self *holder) GetTokenTTLToken() int {
+	return self.tokenTTLToken
+}
+
+// GetRequiredTokenClaims 'required-token-claims' argument of Dashboard binary.
+func (self *holder) GetRequiredTokenClaims() []string {
+	return self.requiredTokenClaims
+}
+
+// GetEnableTokenRefresh 'enable-token-refresh' argument of Dashboard binary.
+func (self *holder) GetEnableTokenRefresh() bool {
+	return self.enableTokenRefresh
+}
+
+// GetMaxSessionLifetime 'max-session-lifetime' argument of Dashboard binary.
+func (self *holder) GetMaxSessionLifetime() int {
+	return self.maxSessionLifetime
+}
+
+// GetTokenSigningAlg 'token-signing-alg' argument of Dashboard binary.
+func (self *holder) GetTokenSigningAlg() string {
+	return self.tokenSigningAlg
+}
+
+// GetCookieSameSite 'cookie-samesite' argument of Dashboard binary.
+func (self *holder) GetCookieSameSite() string {
+	return self.cookieSameSite
+}
+
+// GetCookieSecure 'cookie-secure' argument of Dashboard binary.
+func (self *holder) GetCookieSecure() bool {
+	return self.cookieSecure
+}
+
+// GetCookieDomain 'cookie-domain' argument of Dashboard binary.
+func (self *holder) GetCookieDomain() string {
+	return self.cookieDomain
+}
+
+// GetEnableCSRF 'enable-csrf' argument of Dashboard binary.
+func (self *holder) GetEnableCSRF() bool {
+	return self.enableCSRF
+}
+
+// GetSessionIdleTimeout 'session-idle-timeout' argument of Dashboard binary.
+func (self *holder) GetSessionIdleTimeout() int {
+	return self.sessionIdleTimeout
+}
+
+// GetSessionWarningLeadTime 'session-warning-lead-time' argument of Dashboard binary.
+func (self *holder) GetSessionWarningLeadTime() int {
+	return self.sessionWarningLeadTime
+}
+
 // GetMetricClientCheckPeriod 'metric-client-check-period' argument of Dashboard binary.
 func (self *holder) GetMetricClientCheckPeriod() int {
 	return self.metricClientCheckPeriod
 }
 
+// GetResyncPeriod 'resync-period' argument of Dashboard binary.
+func (self *holder) GetResyncPeriod() int {
+	return self.resyncPeriod
+}
+
+// GetDiscoveryRefreshInterval 'discovery-refresh-interval' argument of Dashboard binary.
+func (self *holder) GetDiscoveryRefreshInterval() int {
+	return self.discoveryRefreshInterval
+}
+
+// GetShutdownTimeout 'shutdown-timeout' argument of Dashboard binary.
+func (self *holder) GetShutdownTimeout() int {
+	return self.shutdownTimeout
+}
+
+// GetReadinessTimeout 'readiness-timeout' argument of Dashboard binary.
+func (self *holder) GetReadinessTimeout() int {
+	return self.readinessTimeout
+}
+
+// GetLivenessTimeout 'liveness-timeout' argument of Dashboard binary.
+func (self *holder) GetLivenessTimeout() int {
+	return self.livenessTimeout
+}
+
+// GetHTTPReadTimeout 'http-read-timeout' argument of Dashboard binary.
+func (self *holder) GetHTTPReadTimeout() int {
+	return self.httpReadTimeout
+}
+
+// GetHTTPWriteTimeout 'http-write-timeout' argument of Dashboard binary.
+func (self *holder) GetHTTPWriteTimeout() int {
+	return self.httpWriteTimeout
+}
+
+// GetHTTPIdleTimeout 'http-idle-timeout' argument of Dashboard binary.
+func (self *holder) GetHTTPIdleTimeout() int {
+	return self.httpIdleTimeout
+}
+
+// GetHSTSMaxAge 'hsts-max-age' argument of Dashboard binary.
+func (self *holder) GetHSTSMaxAge() int {
+	return self.hstsMaxAge
+}
+
+// GetRequestTimeout 'request-timeout' argument of Dashboard binary.
+func (self *holder) GetRequestTimeout() int {
+	return self.requestTimeout
+}
+
+// GetRateLimitQPS 'rate-limit-qps' argument of Dashboard binary.
+func (self *holder) GetRateLimitQPS() float64 {
+	return self.rateLimitQPS
+}
+
+// GetRateLimitBurst 'rate-limit-burst' argument of Dashboard binary.
+func (self *holder) GetRateLimitBurst() int {
+	return self.rateLimitBurst
+}
+
+// GetMaxInflightRequests 'max-inflight-requests' argument of Dashboard binary.
+func (self *holder) GetMaxInflightRequests() int {
+	return self.maxInflightRequests
+}
+
+// GetMaxInflightStreams 'max-inflight-streams' argument of Dashboard binary.
+func (self *holder) GetMaxInflightStreams() int {
+	return self.maxInflightStreams
+}
+
+// GetMaxConnectionsPerIP 'max-connections-per-ip' argument of Dashboard binary.
+func (self *holder) GetMaxConnectionsPerIP() int {
+	return self.maxConnectionsPerIP
+}
+
+// GetMaxRequestBodyBytes 'max-request-body-bytes' argument of Dashboard binary.
+func (self *holder) GetMaxRequestBodyBytes() int64 {
+	return self.maxRequestBodyBytes
+}
+
+// GetEnableCompression 'enable-compression' argument of Dashboard binary.
+func (self *holder) GetEnableCompression() bool {
+	return self.enableCompression
+}
+
+// GetCompressionMinBytes 'compression-min-bytes' argument of Dashboard binary.
+func (self *holder) GetCompressionMinBytes() int {
+	return self.compressionMinBytes
+}
+
+// GetMaxWatchesPerSession 'max-watches-per-session' argument of Dashboard binary.
+func (self *holder) GetMaxWatchesPerSession() int {
+	return self.maxWatchesPerSession
+}
+
+// GetEventHistoryLimit 'event-history-limit' argument of Dashboard binary.
+func (self *holder) GetEventHistoryLimit() int {
+	return self.eventHistoryLimit
+}
+
+// GetDrainGracePeriod 'drain-grace-period' argument of Dashboard binary.
+func (self *holder) GetDrainGracePeriod() int {
+	return self.drainGracePeriod
+}
+
+// GetDrainTimeout 'drain-timeout' argument of Dashboard binary.
+func (self *holder) GetDrainTimeout() int {
+	return self.drainTimeout
+}
+
+// GetKubeClientQPS 'kube-client-qps' argument of Dashboard binary.
+func (self *holder) GetKubeClientQPS() float32 {
+	return self.kubeClientQPS
+}
+
+// GetKubeClientBurst 'kube-client-burst' argument of Dashboard binary.
+func (self *holder) GetKubeClientBurst() int {
+	return self.kubeClientBurst
+}
+
+// GetKubeClientTimeout 'kube-client-timeout' argument of Dashboard binary.
+func (self *holder) GetKubeClientTimeout() int {
+	return self.kubeClientTimeout
+}
+
+// GetApiServerMaxRetries 'apiserver-max-retries' argument of Dashboard binary.
+func (self *holder) GetApiServerMaxRetries() int {
+	return self.apiServerMaxRetries
+}
+
+// GetUserAgent 'user-agent' argument of Dashboard binary.
+func (self *holder) GetUserAgent() string {
+	return self.userAgent
+}
+
 // GetInsecureBindAddress 'insecure-bind-address' argument of Dashboard binary.
 func (self *holder) GetInsecureBindAddress() net.IP {
 	return self.insecureBindAddress
@@ -87,6 +432,16 @@ func (self *holder) GetBindAddress() net.IP {
 	return self.bindAddress
 }
 
+// GetMetricsBindAddress 'metrics-bind-address' argument of Dashboard binary.
+func (self *holder) GetMetricsBindAddress() string {
+	return self.metricsBindAddress
+}
+
+// GetEnablePprof 'enable-pprof' argument of Dashboard binary.
+func (self *holder) GetEnablePprof() bool {
+	return self.enablePprof
+}
+
 // GetDefaultCertDir 'default-cert-dir' argument of Dashboard binary.
 func (self *holder) GetDefaultCertDir() string {
 	return self.defaultCertDir
@@ -101,6 +456,16 @@ func (self *holder) GetCertFile() string {
 	return self.certFile
 }
 
+// GetAutoGenerateCertSANs 'auto-generate-cert-sans' argument of Dashboard binary.
+func (self *holder) GetAutoGenerateCertSANs() []string {
+	return self.autoGenerateCertSANs
+}
+
+// GetFrameOptions 'frame-options' argument of Dashboard binary.
+func (self *holder) GetFrameOptions() string {
+	return self.frameOptions
+}
+
 // GetKeyFile 'tls-key-file' argument of Dashboard binary.
 func (self *holder) GetKeyFile() string {
 	if len(self.keyFile) == 0 && self.autoGenerateCertificates {
@@ -110,11 +475,66 @@ func (self *holder) GetKeyFile() string {
 	return self.keyFile
 }
 
+// GetTLSSNICerts 'tls-sni-cert' argument of Dashboard binary.
+func (self *holder) GetTLSSNICerts() []string {
+	return self.tlsSNICerts
+}
+
+// GetTLSMinVersion 'tls-min-version' argument of Dashboard binary.
+func (self *holder) GetTLSMinVersion() string {
+	return self.tlsMinVersion
+}
+
+// GetDisableHTTP2 'disable-http2' argument of Dashboard binary.
+func (self *holder) GetDisableHTTP2() bool {
+	return self.disableHTTP2
+}
+
+// GetTLSCipherSuites 'tls-cipher-suites' argument of Dashboard binary.
+func (self *holder) GetTLSCipherSuites() []string {
+	return self.tlsCipherSuites
+}
+
+// GetCORSAllowedOrigins 'cors-allowed-origins' argument of Dashboard binary.
+func (self *holder) GetCORSAllowedOrigins() []string {
+	return self.corsAllowedOrigins
+}
+
 // GetApiServerHost 'apiserver-host' argument of Dashboard binary.
 func (self *holder) GetApiServerHost() string {
 	return self.apiServerHost
 }
 
+// GetApiServerCAFile 'apiserver-ca-file' argument of Dashboard binary.
+func (self *holder) GetApiServerCAFile() string {
+	return self.apiServerCAFile
+}
+
+// GetApiServerSkipTLSVerify 'apiserver-skip-tls-verify' argument of Dashboard binary.
+func (self *holder) GetApiServerSkipTLSVerify() bool {
+	return self.apiServerSkipTLSVerify
+}
+
+// GetApiServerConnectRetries 'apiserver-connect-retries' argument of Dashboard binary.
+func (self *holder) GetApiServerConnectRetries() int {
+	return self.apiServerConnectRetries
+}
+
+// GetApiServerConnectBackoff 'apiserver-connect-backoff' argument of Dashboard binary.
+func (self *holder) GetApiServerConnectBackoff() int {
+	return self.apiServerConnectBackoff
+}
+
+// GetApiServerProxyURL 'apiserver-proxy-url' argument of Dashboard binary.
+func (self *holder) GetApiServerProxyURL() string {
+	return self.apiServerProxyURL
+}
+
+// GetApiServerNoProxy 'apiserver-no-proxy' argument of Dashboard binary.
+func (self *holder) GetApiServerNoProxy() []string {
+	return self.apiServerNoProxy
+}
+
 // GetMetricsProvider 'metrics-provider' argument of Dashboard binary.
 func (self *holder) GetMetricsProvider() string {
 	return self.metricsProvider
@@ -135,6 +555,26 @@ func (self *holder) GetKubeConfigFile() string {
 	return self.kubeConfigFile
 }
 
+// GetTokenFile 'token-file' argument of Dashboard binary.
+func (self *holder) GetTokenFile() string {
+	return self.tokenFile
+}
+
+// GetServiceAccountTokenFile 'service-account-token-file' argument of Dashboard binary.
+func (self *holder) GetServiceAccountTokenFile() string {
+	return self.serviceAccountTokenFile
+}
+
+// GetServiceAccountCAFile 'service-account-ca-file' argument of Dashboard binary.
+func (self *holder) GetServiceAccountCAFile() string {
+	return self.serviceAccountCAFile
+}
+
+// GetDefaultContext 'default-context' argument of Dashboard binary.
+func (self *holder) GetDefaultContext() string {
+	return self.defaultContext
+}
+
 // GetSystemBanner 'system-banner' argument of Dashboard binary.
 func (self *holder) GetSystemBanner() string {
 	return self.systemBanner
@@ -145,11 +585,116 @@ func (self *holder) GetSystemBannerSeverity() string {
 	return self.systemBannerSeverity
 }
 
+// GetEnableGlobalBanner 'enable-global-banner' argument of Dashboard binary.
+func (self *holder) GetEnableGlobalBanner() bool {
+	return self.enableGlobalBanner
+}
+
+// GetEnableHelm 'enable-helm' argument of Dashboard binary.
+func (self *holder) GetEnableHelm() bool {
+	return self.enableHelm
+}
+
+// GetEnablePortForward 'enable-port-forward' argument of Dashboard binary.
+func (self *holder) GetEnablePortForward() bool {
+	return self.enablePortForward
+}
+
+// GetPortForwardIdleTimeout 'port-forward-idle-timeout' argument of Dashboard binary.
+func (self *holder) GetPortForwardIdleTimeout() int {
+	return self.portForwardIdleTimeout
+}
+
+// GetWebSocketMaxMessageBytes 'websocket-max-message-bytes' argument of Dashboard binary.
+func (self *holder) GetWebSocketMaxMessageBytes() int {
+	return self.webSocketMaxMessageBytes
+}
+
+// GetWebSocketPingInterval 'websocket-ping-interval' argument of Dashboard binary.
+func (self *holder) GetWebSocketPingInterval() int {
+	return self.webSocketPingInterval
+}
+
+// GetDefaultView 'default-view' argument of Dashboard binary.
+func (self *holder) GetDefaultView() string {
+	return self.defaultView
+}
+
+// GetLoginTitle 'login-title' argument of Dashboard binary.
+func (self *holder) GetLoginTitle() string {
+	return self.loginTitle
+}
+
+// GetLoginLogoURL 'login-logo-url' argument of Dashboard binary.
+func (self *holder) GetLoginLogoURL() string {
+	return self.loginLogoURL
+}
+
+// GetAppTitle 'app-title' argument of Dashboard binary.
+func (self *holder) GetAppTitle() string {
+	return self.appTitle
+}
+
+// GetFaviconURL 'favicon-url' argument of Dashboard binary.
+func (self *holder) GetFaviconURL() string {
+	return self.faviconURL
+}
+
 // LogLevel 'api-log-level' argument of Dashboard binary.
 func (self *holder) GetAPILogLevel() string {
 	return self.apiLogLevel
 }
 
+// GetLogFormat 'log-format' argument of Dashboard binary.
+func (self *holder) GetLogFormat() string {
+	return self.logFormat
+}
+
+// GetOTelEndpoint 'otel-endpoint' argument of Dashboard binary.
+func (self *holder) GetOTelEndpoint() string {
+	return self.otelEndpoint
+}
+
+// GetOTelSampleRate 'otel-sample-rate' argument of Dashboard binary.
+func (self *holder) GetOTelSampleRate() float64 {
+	return self.otelSampleRate
+}
+
+// GetAuditLogPath 'audit-log-path' argument of Dashboard binary.
+func (self *holder) GetAuditLogPath() string {
+	return self.auditLogPath
+}
+
+// GetAuditLogMaxSize 'audit-log-maxsize' argument of Dashboard binary.
+func (self *holder) GetAuditLogMaxSize() int {
+	return self.auditLogMaxSize
+}
+
+// GetRequestIDHeader 'request-id-header' argument of Dashboard binary.
+func (self *holder) GetRequestIDHeader() string {
+	return self.requestIDHeader
+}
+
+// GetLogDefaultTailLines 'log-default-tail-lines' argument of Dashboard binary.
+func (self *holder) GetLogDefaultTailLines() int {
+	return self.logDefaultTailLines
+}
+
+// GetLogMaxBytes 'log-max-bytes' argument of Dashboard binary.
+func (self *holder) GetLogMaxBytes() int {
+	return self.logMaxBytes
+}
+
+// GetIgnoreDefaultContainerAnnotation 'ignore-default-container-annotation' argument of Dashboard binary.
+func (self *holder) GetIgnoreDefaultContainerAnnotation() bool {
+	return self.ignoreDefaultContainerAnnotation
+}
+
+// GetDefaultExecCommand 'default-exec-command' argument of Dashboard binary.
+func (self *holder) GetDefaultExecCommand() string {
+	return self.defaultExecCommand
+}
+
 // GetAuthenticationMode 'authentication-mode' argument of Dashboard binary.
 func (self *holder) GetAuthenticationMode() []string {
 	return self.authenticationMode
@@ -170,17 +715,162 @@ func (self *holder) GetDisableSettingsAuthorizer() bool {
 	return self.disableSettingsAuthorizer
 }
 
+// GetSettingsNamespace 'settings-namespace' argument of Dashboard binary.
+func (self *holder) GetSettingsNamespace() string {
+	return self.settingsNamespace
+}
+
+// GetSettingsReadOnly 'settings-read-only' argument of Dashboard binary.
+func (self *holder) GetSettingsReadOnly() bool {
+	return self.settingsReadOnly
+}
+
 // GetEnableSkipLogin 'enable-skip-login' argument of Dashboard binary.
 func (self *holder) GetEnableSkipLogin() bool {
 	return self.enableSkipLogin
 }
 
+// GetReadOnly 'read-only' argument of Dashboard binary.
+func (self *holder) GetReadOnly() bool {
+	return self.readOnly
+}
+
+// GetEnableImpersonation 'enable-impersonation' argument of Dashboard binary.
+func (self *holder) GetEnableImpersonation() bool {
+	return self.enableImpersonation
+}
+
+// GetAuthenticationHeader 'authentication-header' argument of Dashboard binary.
+func (self *holder) GetAuthenticationHeader() string {
+	return self.authenticationHeader
+}
+
+// GetTrustedProxyCIDRs 'trusted-proxy-cidrs' argument of Dashboard binary.
+func (self *holder) GetTrustedProxyCIDRs() []string {
+	return self.trustedProxyCIDRs
+}
+
+// GetTrustedProxyCIDRNets returns parsed 'trusted-proxy-cidrs' argument of Dashboard binary.
+func (self *holder) GetTrustedProxyCIDRNets() []*net.IPNet {
+	return self.trustedProxyCIDRNets
+}
+
+// GetTrustedProxies 'trusted-proxies' argument of Dashboard binary.
+func (self *holder) GetTrustedProxies() []string {
+	return self.trustedProxies
+}
+
+// GetTrustedProxyNets returns parsed 'trusted-proxies' argument of Dashboard binary.
+func (self *holder) GetTrustedProxyNets() []*net.IPNet {
+	return self.trustedProxyNets
+}
+
+// GetTrustOriginalForwardedFor 'trust-original-forwarded-for' argument of Dashboard binary.
+func (self *holder) GetTrustOriginalForwardedFor() bool {
+	return self.trustOriginalForwardedFor
+}
+
+// GetNamespaceAllowlist 'namespace-allowlist' argument of Dashboard binary.
+func (self *holder) GetNamespaceAllowlist() []string {
+	return self.namespaceAllowlist
+}
+
+// GetNamespaceAllowlistClusterScoped 'namespace-allowlist-cluster-scoped' argument of Dashboard binary.
+func (self *holder) GetNamespaceAllowlistClusterScoped() bool {
+	return self.namespaceAllowlistClusterScoped
+}
+
+// GetDisableClusterScoped 'disable-cluster-scoped' argument of Dashboard binary.
+func (self *holder) GetDisableClusterScoped() bool {
+	return self.disableClusterScoped
+}
+
+// GetAllowedResources 'allowed-resources' argument of Dashboard binary.
+func (self *holder) GetAllowedResources() []string {
+	return self.allowedResources
+}
+
+// GetAnonymousAccess 'anonymous-access' argument of Dashboard binary.
+func (self *holder) GetAnonymousAccess() bool {
+	return self.anonymousAccess
+}
+
+// GetAnonymousServiceAccount 'anonymous-service-account' argument of Dashboard binary.
+func (self *holder) GetAnonymousServiceAccount() string {
+	return self.anonymousServiceAccount
+}
+
+// GetImpersonateServiceAccount 'impersonate-service-account' argument of Dashboard binary.
+func (self *holder) GetImpersonateServiceAccount() string {
+	return self.impersonateServiceAccount
+}
+
+// GetAnonymousResources 'anonymous-resources' argument of Dashboard binary.
+func (self *holder) GetAnonymousResources() []string {
+	return self.anonymousResources
+}
+
 // GetNamespace 'namespace' argument of Dashboard binary.
 func (self *holder) GetNamespace() string {
 	return self.namespace
 }
 
+// GetDefaultCreateNamespace 'default-create-namespace' argument of Dashboard binary.
+func (self *holder) GetDefaultCreateNamespace() string {
+	return self.defaultCreateNamespace
+}
+
+// GetDefaultDeletePropagation 'default-delete-propagation' argument of Dashboard binary.
+func (self *holder) GetDefaultDeletePropagation() string {
+	return self.defaultDeletePropagation
+}
+
+// GetDefaultTimezone 'default-timezone' argument of Dashboard binary.
+func (self *holder) GetDefaultTimezone() string {
+	return self.defaultTimezone
+}
+
+// GetEncryptionKeyProvider 'encryption-key-provider' argument of Dashboard binary.
+func (self *holder) GetEncryptionKeyProvider() string {
+	return self.encryptionKeyProvider
+}
+
+// GetKMSEndpoint 'kms-endpoint' argument of Dashboard binary.
+func (self *holder) GetKMSEndpoint() string {
+	return self.kmsEndpoint
+}
+
+// GetKMSTimeout 'kms-timeout' argument of Dashboard binary.
+func (self *holder) GetKMSTimeout() int {
+	return self.kmsTimeout
+}
+
 // GetLocaleConfig 'locale-config' argument of Dashboard binary.
 func (self *holder) GetLocaleConfig() string {
 	return self.localeConfig
 }
+
+// GetOIDCIssuerURL 'oidc-issuer-url' argument of Dashboard binary.
+func (self *holder) GetOIDCIssuerURL() string {
+	return self.oidcIssuerURL
+}
+
+// GetOIDCClientID 'oidc-client-id' argument of Dashboard binary.
+func (self *holder) GetOIDCClientID() string {
+	return self.oidcClientID
+}
+
+// GetOIDCClientSecret 'oidc-client-secret' argument of Dashboard binary.
+func (self *holder) GetOIDCClientSecret() string {
+	return self.oidcClientSecret
+}
+
+// GetOIDCScopes 'oidc-scopes' argument of Dashboard binary.
+func (self *holder) GetOIDCScopes() []string {
+	return self.oidcScopes
+}
+
+// GetOIDCRedirectURL 'oidc-redirect-url' argument of Dashboard binary.
+func (self *holder) GetOIDCRedirectURL() string {
+	return self.oidcRedirectURL
+}
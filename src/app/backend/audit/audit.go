@@ -0,0 +1,106 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+)
+
+// Stdout is the audit log path that writes events to the standard output.
+const Stdout = "-"
+
+// Event describes single mutating API call performed through dashboard.
+type Event struct {
+	// Time when the request was received in RFC3339 format.
+	Timestamp string `json:"timestamp"`
+	// ID assigned to the request, it is also attached to the API log entries.
+	RequestID string `json:"requestID,omitempty"`
+	// Name of the user that sent the request. Empty if user could not be identified.
+	User string `json:"user"`
+	// One of create, update, patch or delete.
+	Verb string `json:"verb"`
+	// Kind of the resource the request was sent for, i.e. deployment.
+	Resource string `json:"resource"`
+	// Namespace of the resource. Empty for cluster scoped resources and resources created from request body.
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the resource. Empty for resources created from request body.
+	Name string `json:"name,omitempty"`
+	// Path of the request.
+	Path string `json:"path"`
+	// Address of the client that sent the request.
+	RemoteAddr string `json:"remoteAddr"`
+	// HTTP status code of the response.
+	Status int `json:"status"`
+}
+
+var (
+	mux    sync.Mutex
+	output io.Writer
+)
+
+// Init configures audit log to write events to the file with given path or to the standard output if path is
+// Stdout. File is rotated once it grows over maxSize megabytes, rotation is disabled if maxSize is not positive.
+// Audit log is disabled if path is empty.
+func Init(path string, maxSize int) error {
+	mux.Lock()
+	defer mux.Unlock()
+
+	switch path {
+	case "":
+		output = nil
+	case Stdout:
+		output = os.Stdout
+	default:
+		file, err := newRotatingFile(path, int64(maxSize)*1024*1024)
+		if err != nil {
+			return fmt.Errorf("could not open audit log file %s: %s", path, err.Error())
+		}
+		output = file
+	}
+
+	return nil
+}
+
+// Enabled returns true if audit log was configured.
+func Enabled() bool {
+	mux.Lock()
+	defer mux.Unlock()
+	return output != nil
+}
+
+// Log writes given event as a single line JSON object. It does nothing if audit log is disabled.
+func Log(event Event) {
+	mux.Lock()
+	defer mux.Unlock()
+
+	if output == nil {
+		return
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Could not marshal audit event: %s", err.Error())
+		return
+	}
+
+	if _, err := output.Write(append(data, '\n')); err != nil {
+		log.Printf("Could not write audit event: %s", err.Error())
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer Init("", 0)
+
+	path := filepath.Join(dir, "audit.log")
+	if err := Init(path, 0); err != nil {
+		t.Fatalf("Expected audit log to be initialized, but got error: %s", err)
+	}
+
+	if !Enabled() {
+		t.Fatal("Expected audit log to be enabled.")
+	}
+
+	expected := Event{User: "alice", Verb: "delete", Resource: "pod", Namespace: "default", Name: "nginx",
+		Path: "/api/v1/_raw/pod/namespace/default/name/nginx", Status: 200}
+	Log(expected)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := Event{}
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatalf("Expected audit event to be written as JSON, but got error: %s", err)
+	}
+
+	if actual != expected {
+		t.Errorf("Expected audit event %+v, but got %+v.", expected, actual)
+	}
+
+	if err := Init("", 0); err != nil || Enabled() {
+		t.Errorf("Expected audit log to be disabled when path is empty.")
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	file, err := newRotatingFile(path, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range []string{"first\n", "second\n", "long entry\n"} {
+		if _, err := file.Write([]byte(entry)); err != nil {
+			t.Fatalf("Expected write to succeed, but got error: %s", err)
+		}
+	}
+
+	files, err := filepath.Glob(path + "*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 3 {
+		t.Fatalf("Expected audit log to be rotated twice, but got files %v.", files)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(string(data), "long entry") {
+		t.Errorf("Expected current audit log to contain the last entry, but got %q.", data)
+	}
+}
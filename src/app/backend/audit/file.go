@@ -0,0 +1,85 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Format of the timestamp appended to the name of rotated audit log files.
+const rotatedFileTimeFormat = "2006-01-02T15-04-05.000000000"
+
+// rotatingFile appends data to the file and renames it once it grows over maxSize bytes, so the next write starts a
+// new file. Rotated files are named after the original file with timestamp of the rotation appended.
+type rotatingFile struct {
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+// Write implements io.Writer interface. Callers have to synchronize writes.
+func (self *rotatingFile) Write(p []byte) (int, error) {
+	if self.maxSize > 0 && self.size > 0 && self.size+int64(len(p)) > self.maxSize {
+		if err := self.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := self.file.Write(p)
+	self.size += int64(n)
+	return n, err
+}
+
+func (self *rotatingFile) rotate() error {
+	if err := self.file.Close(); err != nil {
+		return err
+	}
+
+	rotated := fmt.Sprintf("%s.%s", self.path, time.Now().UTC().Format(rotatedFileTimeFormat))
+	if err := os.Rename(self.path, rotated); err != nil {
+		return err
+	}
+
+	return self.open()
+}
+
+func (self *rotatingFile) open() error {
+	file, err := os.OpenFile(self.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	self.file = file
+	self.size = info.Size()
+	return nil
+}
+
+func newRotatingFile(path string, maxSize int64) (*rotatingFile, error) {
+	file := &rotatingFile{path: path, maxSize: maxSize}
+	if err := file.open(); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
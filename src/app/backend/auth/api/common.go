@@ -25,7 +25,7 @@ func ToAuthenticationModes(modes []string) AuthenticationModes {
 	result := AuthenticationModes{}
 	modesMap := map[string]bool{}
 
-	for _, mode := range []AuthenticationMode{Token, Basic} {
+	for _, mode := range []AuthenticationMode{Token, Basic, OIDC, X509} {
 		modesMap[mode.String()] = true
 	}
 
@@ -55,3 +55,16 @@ func ShouldRejectRequest(url string) bool {
 
 	return false
 }
+
+// IsProtectedResource returns true if resource with given name and namespace should be filtered out from dashboard,
+// i.e. when its name is not part of the request URL. Namespace is read when the check is made, as protected resources
+// are defined before the arguments are parsed.
+func IsProtectedResource(name, namespace string) bool {
+	for _, protectedResource := range protectedResources {
+		if name == protectedResource.ResourceName && namespace == args.Holder.GetNamespace() {
+			return true
+		}
+	}
+
+	return false
+}
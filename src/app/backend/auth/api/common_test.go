@@ -27,6 +27,8 @@ func TestToAuthenticationModes(t *testing.T) {
 		{[]string{}, AuthenticationModes{}},
 		{[]string{"token"}, AuthenticationModes{Token: true}},
 		{[]string{"token", "basic", "test"}, AuthenticationModes{Token: true, Basic: true}},
+		{[]string{"token", "oidc"}, AuthenticationModes{Token: true, OIDC: true}},
+		{[]string{"x509"}, AuthenticationModes{X509: true}},
 	}
 
 	for _, c := range cases {
@@ -29,6 +29,11 @@ const (
 
 	// Expiration time (in seconds) of tokens generated by dashboard. Default: 15 min.
 	DefaultTokenTTL = 900
+
+	// Encryption key is stored in plain text in a secret.
+	EncryptionKeyProviderKubernetes = "kubernetes"
+	// Encryption key is stored in a secret wrapped by KMS plugin.
+	EncryptionKeyProviderKMS = "kms"
 )
 
 // AuthenticationModes represents auth modes supported by dashboard.
@@ -75,6 +80,8 @@ func (self AuthenticationMode) String() string {
 const (
 	Token AuthenticationMode = "token"
 	Basic AuthenticationMode = "basic"
+	OIDC  AuthenticationMode = "oidc"
+	X509  AuthenticationMode = "x509"
 )
 
 // AuthManager is used for user authentication management.
@@ -89,6 +96,20 @@ type AuthManager interface {
 	AuthenticationModes() []AuthenticationMode
 	// AuthenticationSkippable tells if the Skip button should be enabled or not
 	AuthenticationSkippable() bool
+	// OIDCAuthCodeURL returns address of the OIDC provider that user should be redirected to in order to start
+	// the authorization code flow. Given redirect URL is used by the provider to send the user back to dashboard.
+	OIDCAuthCodeURL(state, redirectURL string) (string, error)
+	// OIDCLogin exchanges authorization code for an ID token and authenticates user with it. Returned AuthResponse
+	// is the same as in case of Login.
+	OIDCLogin(code, redirectURL string) (*AuthResponse, error)
+}
+
+// OIDCClient is responsible for communication with the OpenID Connect provider during the authorization code flow.
+type OIDCClient interface {
+	// AuthCodeURL returns URL of the provider consent page that asks for permissions for the required scopes.
+	AuthCodeURL(state, redirectURL string) string
+	// Exchange converts an authorization code into an ID token.
+	Exchange(code, redirectURL string) (string, error)
 }
 
 // TokenManager is responsible for generating and decrypting tokens used for authorization. Authorization is handled
@@ -96,6 +117,8 @@ type AuthManager interface {
 type TokenManager interface {
 	// Generate secure token based on AuthInfo structure and save it tokens' payload.
 	Generate(api.AuthInfo) (string, error)
+	// GenerateForMode works the same as Generate but uses expiration time configured for given authentication mode.
+	GenerateForMode(AuthenticationMode, api.AuthInfo) (string, error)
 	// Decrypt generated token and return AuthInfo structure that will be used for K8S api client creation.
 	Decrypt(string) (*api.AuthInfo, error)
 	// Refresh returns refreshed token based on provided token. In case provided token has expired, token expiration
@@ -103,6 +126,20 @@ type TokenManager interface {
 	Refresh(string) (string, error)
 	// SetTokenTTL sets expiration time (in seconds) of generated tokens.
 	SetTokenTTL(time.Duration)
+	// SetModeTokenTTL overrides expiration time (in seconds) of tokens generated for given authentication mode.
+	SetModeTokenTTL(AuthenticationMode, time.Duration)
+	// SetMaxSessionLifetime sets time (in seconds) after the login when tokens can no longer be refreshed. Tokens
+	// generated for the session never expire after it.
+	SetMaxSessionLifetime(time.Duration)
+}
+
+// SessionGuard tracks sessions of JWE tokens independently of their expiration time, i.e. idle sessions. It is
+// consulted on token refresh, so that refreshed token continues the session instead of starting a new one.
+type SessionGuard interface {
+	// CheckRefresh returns error if session of given token can not be continued with a refreshed token.
+	CheckRefresh(token string) error
+	// Refreshed carries session of given token over to the refreshed token.
+	Refreshed(token, refreshedToken string)
 }
 
 // Authenticator represents authentication methods supported by Dashboard. Currently supported types are:
@@ -110,6 +147,7 @@ type TokenManager interface {
 //	  - Basic - Username and password based authentication. Requires that apiserver has basic auth enabled also
 //    - Kubeconfig based - Authenticates user based on kubeconfig file. Only token/basic modes are supported within
 // 		the kubeconfig file.
+//    - X509 - Client certificate and key pair signed by the CA trusted by apiserver
 type Authenticator interface {
 	// GetAuthInfo returns filled AuthInfo structure that can be used for K8S api client creation.
 	GetAuthInfo() (api.AuthInfo, error)
@@ -127,6 +165,13 @@ type LoginSpec struct {
 	// KubeConfig is the content of users' kubeconfig file. It will be parsed and auth data will be extracted.
 	// Kubeconfig can not contain any paths. All data has to be provided within the file.
 	KubeConfig string `json:"kubeconfig,omitempty"`
+	// IDToken is the OIDC ID token obtained by dashboard during the authorization code flow. It can not be provided
+	// directly by the frontend.
+	IDToken string `json:"-"`
+	// ClientCertificate is the PEM encoded client certificate for x509 authentication to the kubernetes cluster.
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	// ClientKey is the PEM encoded private key matching ClientCertificate.
+	ClientKey string `json:"clientKey,omitempty"`
 }
 
 // AuthResponse is returned from our backend as a response for login/refresh requests. It contains generated JWEToken
@@ -149,6 +194,11 @@ type LoginModesResponse struct {
 	Modes []AuthenticationMode `json:"modes"`
 }
 
+// LoginReadOnlyResponse contains a flag that tells the UI that dashboard runs in read-only mode.
+type LoginReadOnlyResponse struct {
+	ReadOnly bool `json:"readOnly"`
+}
+
 // LoginSkippableResponse contains a flag that tells the UI not to display the Skip button.
 // Note that this only hides the button, it doesn't disable unauthenticated access.
 type LoginSkippableResponse struct {
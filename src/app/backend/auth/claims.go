@@ -0,0 +1,113 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// RequiredClaim is a claim that has to be present in the token used to log in. Value is matched only if it is set.
+// Array claims, i.e. 'aud', match if any of their elements is equal to the value.
+type RequiredClaim struct {
+	Name  string
+	Value string
+}
+
+// ParseRequiredTokenClaims parses values of --required-token-claims in 'name' or 'name=value' format.
+func ParseRequiredTokenClaims(values []string) ([]RequiredClaim, error) {
+	result := make([]RequiredClaim, 0, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(strings.TrimSpace(value), "=", 2)
+		if len(parts[0]) == 0 || (len(parts) == 2 && len(parts[1]) == 0) {
+			return nil, fmt.Errorf("invalid --required-token-claims entry %q: expected 'name' or 'name=value' format",
+				value)
+		}
+
+		claim := RequiredClaim{Name: parts[0]}
+		if len(parts) == 2 {
+			claim.Value = parts[1]
+		}
+		result = append(result, claim)
+	}
+
+	return result, nil
+}
+
+// Checks that token carries all claims required with --required-token-claims. Token signature is not verified, the
+// apiserver is responsible for that. Returned error lists all missing and invalid claims.
+func validateRequiredClaims(token string) error {
+	required, _ := ParseRequiredTokenClaims(args.Holder.GetRequiredTokenClaims())
+	if len(required) == 0 {
+		return nil
+	}
+
+	claims := map[string]interface{}{}
+	parsed, err := jwt.ParseSigned(token)
+	if err == nil {
+		err = parsed.UnsafeClaimsWithoutVerification(&claims)
+	}
+	if err != nil {
+		return errors.NewUnauthorized("Token is not a JWT, it has to carry claims required by dashboard")
+	}
+
+	missing, invalid := make([]string, 0), make([]string, 0)
+	for _, claim := range required {
+		value, exists := claims[claim.Name]
+		if !exists {
+			missing = append(missing, claim.Name)
+			continue
+		}
+
+		if len(claim.Value) > 0 && !claimMatches(value, claim.Value) {
+			invalid = append(invalid, fmt.Sprintf("%s has to be %s", claim.Name, claim.Value))
+		}
+	}
+
+	if len(missing) == 0 && len(invalid) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	problems := make([]string, 0, 2)
+	if len(missing) > 0 {
+		problems = append(problems, "missing claims: "+strings.Join(missing, ", "))
+	}
+	if len(invalid) > 0 {
+		problems = append(problems, "invalid claims: "+strings.Join(invalid, ", "))
+	}
+
+	return errors.NewUnauthorized("Token was rejected, " + strings.Join(problems, "; "))
+}
+
+func claimMatches(value interface{}, expected string) bool {
+	if values, ok := value.([]interface{}); ok {
+		for _, element := range values {
+			if claimMatches(element, expected) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return fmt.Sprint(value) == expected
+}
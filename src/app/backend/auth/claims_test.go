@@ -0,0 +1,105 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+func signedToken(t *testing.T, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")},
+		nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestParseRequiredTokenClaims(t *testing.T) {
+	cases := []struct {
+		values      []string
+		expected    []RequiredClaim
+		expectedErr bool
+	}{
+		{[]string{}, []RequiredClaim{}, false},
+		{[]string{"email", "aud=dashboard", "groups=a=b"},
+			[]RequiredClaim{{"email", ""}, {"aud", "dashboard"}, {"groups", "a=b"}}, false},
+		{[]string{"=dashboard"}, nil, true},
+		{[]string{"aud="}, nil, true},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseRequiredTokenClaims(c.values)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("ParseRequiredTokenClaims(%v) returned error %v, expected error: %t", c.values, err, c.expectedErr)
+		}
+
+		if !c.expectedErr && !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("ParseRequiredTokenClaims(%v) == %v, expected %v", c.values, actual, c.expected)
+		}
+	}
+}
+
+func TestTokenAuthenticatorRequiredClaims(t *testing.T) {
+	defer args.GetHolderBuilder().SetRequiredTokenClaims([]string{})
+
+	cases := []struct {
+		info            string
+		required        []string
+		token           string
+		expectedMessage string
+	}{
+		{"Should accept any token when no claims are required", []string{}, "opaque-token", ""},
+		{"Should accept token with required claims", []string{"email", "aud=dashboard", "admin=true"},
+			signedToken(t, map[string]interface{}{"email": "a@example.com", "aud": []string{"api", "dashboard"},
+				"admin": true}), ""},
+		{"Should reject token that is not a JWT", []string{"email"}, "opaque-token", "not a JWT"},
+		{"Should list missing and invalid claims", []string{"email", "groups", "aud=dashboard"},
+			signedToken(t, map[string]interface{}{"aud": "api"}),
+			"missing claims: email, groups; invalid claims: aud has to be dashboard"},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetRequiredTokenClaims(c.required)
+		_, err := NewTokenAuthenticator(&authApi.LoginSpec{Token: c.token}).GetAuthInfo()
+
+		if len(c.expectedMessage) == 0 {
+			if err != nil {
+				t.Errorf("Test Case: %s. Expected token to be accepted, but got error: %s", c.info, err)
+			}
+			continue
+		}
+
+		if err == nil || !errors.IsUnauthorized(err) || !strings.Contains(err.Error(), c.expectedMessage) {
+			t.Errorf("Test Case: %s. Expected unauthorized error containing %q, but got %v", c.info,
+				c.expectedMessage, err)
+		}
+	}
+}
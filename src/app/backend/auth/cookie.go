@@ -0,0 +1,61 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+var cookieSameSiteModes = map[string]http.SameSite{
+	"Lax":    http.SameSiteLaxMode,
+	"Strict": http.SameSiteStrictMode,
+	"None":   http.SameSiteNoneMode,
+}
+
+// ValidateCookieAttributes checks values of the --cookie-samesite and --cookie-secure arguments. Browsers reject
+// cookies with SameSite=None attribute that are not secure.
+func ValidateCookieAttributes(sameSite string, secure bool) error {
+	mode, exists := cookieSameSiteModes[sameSite]
+	if !exists {
+		return fmt.Errorf("--cookie-samesite has to be one of 'Lax', 'Strict' or 'None', got '%s'", sameSite)
+	}
+
+	if mode == http.SameSiteNoneMode && !secure {
+		return fmt.Errorf("--cookie-secure is required when --cookie-samesite is 'None'")
+	}
+
+	return nil
+}
+
+// NewCookie returns cookie readable by the frontend with attributes configured through --cookie-domain,
+// --cookie-secure and --cookie-samesite arguments.
+func NewCookie(name, value string) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     args.Holder.GetBasePath() + "/",
+		Domain:   args.Holder.GetCookieDomain(),
+		Secure:   args.Holder.GetCookieSecure(),
+		SameSite: cookieSameSiteModes[args.Holder.GetCookieSameSite()],
+	}
+}
+
+// Returns cookie that carries given JWE token with attributes configured through arguments.
+func jweTokenCookie(token string) *http.Cookie {
+	return NewCookie(JWETokenCookieName, token)
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+func TestValidateCookieAttributes(t *testing.T) {
+	cases := []struct {
+		info        string
+		sameSite    string
+		secure      bool
+		expectedErr bool
+	}{
+		{"Should accept default attributes", "Lax", true, false},
+		{"Should accept insecure Strict cookie", "Strict", false, false},
+		{"Should accept secure None cookie", "None", true, false},
+		{"Should reject insecure None cookie", "None", false, true},
+		{"Should reject unknown SameSite mode", "lax", true, true},
+	}
+
+	for _, c := range cases {
+		err := ValidateCookieAttributes(c.sameSite, c.secure)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+}
+
+func TestJWETokenCookie(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetCookieSameSite("").SetCookieSecure(false).SetCookieDomain("")
+	}()
+	args.GetHolderBuilder().SetCookieSameSite("None").SetCookieSecure(true).SetCookieDomain("example.com")
+
+	cookie := jweTokenCookie("token")
+	if cookie.Name != JWETokenCookieName || cookie.Value != "token" || cookie.Domain != "example.com" ||
+		!cookie.Secure || cookie.SameSite != http.SameSiteNoneMode {
+		t.Errorf("Expected secure cookie with SameSite=None for example.com domain, but got %s.", cookie)
+	}
+}
@@ -15,18 +15,33 @@
 package auth
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/emicklei/go-restful/v3"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/validation"
 )
 
+const (
+	// Name of the cookie that holds OIDC state parameter between the redirect to the provider and the callback.
+	oidcStateCookieName = "oidcState"
+	// JWETokenCookieName is the name of the cookie that frontend reads generated JWE token from.
+	JWETokenCookieName = "jweToken"
+	// Path of the OIDC callback endpoint that provider redirects user back to, relative to the API prefix.
+	oidcCallbackPath = "/login/oidc/callback"
+)
+
 // AuthHandler manages all endpoints related to dashboard auth, such as login.
 type AuthHandler struct {
-	manager authApi.AuthManager
+	manager  authApi.AuthManager
+	sessions authApi.SessionGuard
 }
 
 // Install creates new endpoints for dashboard auth, such as login. It allows user to log in to dashboard using
@@ -54,6 +69,16 @@ func (self AuthHandler) Install(ws *restful.WebService) {
 		ws.GET("/login/skippable").
 			To(self.handleLoginSkippable).
 			Writes(authApi.LoginSkippableResponse{}))
+	ws.Route(
+		ws.GET("/login/readonly").
+			To(self.handleLoginReadOnly).
+			Writes(authApi.LoginReadOnlyResponse{}))
+	ws.Route(
+		ws.GET("/login/oidc").
+			To(self.handleOIDCLogin))
+	ws.Route(
+		ws.GET("/login/oidc/callback").
+			To(self.handleOIDCCallback))
 }
 
 func (self AuthHandler) handleLogin(request *restful.Request, response *restful.Response) {
@@ -79,6 +104,13 @@ func (self *AuthHandler) handleLoginStatus(request *restful.Request, response *r
 }
 
 func (self *AuthHandler) handleJWETokenRefresh(request *restful.Request, response *restful.Response) {
+	if !args.Holder.GetEnableTokenRefresh() {
+		err := errors.NewForbidden("Token refresh is disabled, log in again once the token expires")
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
+		return
+	}
+
 	tokenRefreshSpec := new(authApi.TokenRefreshSpec)
 	if err := request.ReadEntity(tokenRefreshSpec); err != nil {
 		response.AddHeader("Content-Type", "text/plain")
@@ -86,6 +118,13 @@ func (self *AuthHandler) handleJWETokenRefresh(request *restful.Request, respons
 		return
 	}
 
+	if self.sessions != nil {
+		if err := self.sessions.CheckRefresh(tokenRefreshSpec.JWEToken); err != nil {
+			errors.HandleInternalError(response, err)
+			return
+		}
+	}
+
 	refreshedJWEToken, err := self.manager.Refresh(tokenRefreshSpec.JWEToken)
 	if err != nil {
 		response.AddHeader("Content-Type", "text/plain")
@@ -93,6 +132,10 @@ func (self *AuthHandler) handleJWETokenRefresh(request *restful.Request, respons
 		return
 	}
 
+	if self.sessions != nil {
+		self.sessions.Refreshed(tokenRefreshSpec.JWEToken, refreshedJWEToken)
+	}
+
 	response.WriteHeaderAndEntity(http.StatusOK, &authApi.AuthResponse{
 		JWEToken: refreshedJWEToken,
 		Errors:   make([]error, 0),
@@ -107,7 +150,95 @@ func (self *AuthHandler) handleLoginSkippable(request *restful.Request, response
 	response.WriteHeaderAndEntity(http.StatusOK, authApi.LoginSkippableResponse{Skippable: self.manager.AuthenticationSkippable()})
 }
 
+func (self *AuthHandler) handleLoginReadOnly(request *restful.Request, response *restful.Response) {
+	response.WriteHeaderAndEntity(http.StatusOK, authApi.LoginReadOnlyResponse{ReadOnly: args.Holder.GetReadOnly()})
+}
+
+func (self *AuthHandler) handleOIDCLogin(request *restful.Request, response *restful.Response) {
+	state, err := generateOIDCState()
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	authCodeURL, err := self.manager.OIDCAuthCodeURL(state, args.Holder.GetOIDCRedirectURL())
+	if err != nil {
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
+		return
+	}
+
+	http.SetCookie(response, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    state,
+		Path:     args.Holder.GetBasePath() + args.Holder.GetAPIPrefix() + oidcCallbackPath,
+		HttpOnly: true,
+		Secure:   isOIDCStateCookieSecure(request.Request),
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(response, request.Request, authCodeURL, http.StatusFound)
+}
+
+func (self *AuthHandler) handleOIDCCallback(request *restful.Request, response *restful.Response) {
+	stateCookie, err := request.Request.Cookie(oidcStateCookieName)
+	if err != nil || stateCookie.Value != request.QueryParameter("state") {
+		err := errors.NewUnauthorized("OIDC state mismatch")
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(http.StatusUnauthorized, err.Error()+"\n")
+		return
+	}
+
+	if providerError := request.QueryParameter("error"); len(providerError) > 0 {
+		err := errors.NewUnauthorized(fmt.Sprintf("OIDC provider returned an error: %s", providerError))
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(http.StatusUnauthorized, err.Error()+"\n")
+		return
+	}
+
+	loginResponse, err := self.manager.OIDCLogin(request.QueryParameter("code"), args.Holder.GetOIDCRedirectURL())
+	if err != nil {
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(errors.HandleHTTPError(err), err.Error()+"\n")
+		return
+	}
+
+	if len(loginResponse.Errors) > 0 {
+		response.WriteHeaderAndEntity(http.StatusUnauthorized, loginResponse)
+		return
+	}
+
+	http.SetCookie(response, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Path:     args.Holder.GetBasePath() + args.Holder.GetAPIPrefix() + oidcCallbackPath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   isOIDCStateCookieSecure(request.Request),
+	})
+	http.SetCookie(response, jweTokenCookie(loginResponse.JWEToken))
+	http.Redirect(response, request.Request, args.Holder.GetBasePath()+"/", http.StatusFound)
+}
+
+// State cookie is sent only over TLS if the request arrived over TLS or the provider redirects the user back over
+// TLS, i.e. when TLS is terminated by a proxy.
+func isOIDCStateCookieSecure(request *http.Request) bool {
+	return request.TLS != nil || strings.HasPrefix(args.Holder.GetOIDCRedirectURL(), "https://")
+}
+
+func generateOIDCState() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
 // NewAuthHandler created AuthHandler instance.
 func NewAuthHandler(manager authApi.AuthManager) AuthHandler {
 	return AuthHandler{manager: manager}
 }
+
+// SetSessionGuard sets guard of the sessions that is consulted on token refresh.
+func (self *AuthHandler) SetSessionGuard(sessions authApi.SessionGuard) {
+	self.sessions = sessions
+}
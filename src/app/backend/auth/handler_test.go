@@ -15,9 +15,16 @@
 package auth
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
 func TestIntegrationHandler_Install(t *testing.T) {
@@ -29,3 +36,69 @@ func TestIntegrationHandler_Install(t *testing.T) {
 		t.Error("Failed to install routes.")
 	}
 }
+
+type refreshAuthManager struct {
+	authApi.AuthManager
+	refreshed []string
+}
+
+func (self *refreshAuthManager) Refresh(token string) (string, error) {
+	self.refreshed = append(self.refreshed, token)
+	return "refreshed-" + token, nil
+}
+
+// fakeSessionGuard allows refresh only of the active token and records carried sessions.
+type fakeSessionGuard struct {
+	carried map[string]string
+}
+
+func (self *fakeSessionGuard) CheckRefresh(token string) error {
+	if token != "active" {
+		return errors.NewUnauthorized(errors.MsgSessionIdleError)
+	}
+	return nil
+}
+
+func (self *fakeSessionGuard) Refreshed(token, refreshedToken string) {
+	self.carried[refreshedToken] = token
+}
+
+func TestAuthHandler_TokenRefreshSessionGuard(t *testing.T) {
+	args.GetHolderBuilder().SetEnableTokenRefresh(true)
+	defer args.GetHolderBuilder().SetEnableTokenRefresh(false)
+
+	manager := &refreshAuthManager{}
+	guard := &fakeSessionGuard{carried: map[string]string{}}
+	authHandler := NewAuthHandler(manager)
+	authHandler.SetSessionGuard(guard)
+	ws := new(restful.WebService).Consumes(restful.MIME_JSON).Produces(restful.MIME_JSON)
+	authHandler.Install(ws)
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info           string
+		token          string
+		expectedStatus int
+	}{
+		{"Should refresh token of active session", "active", http.StatusOK},
+		{"Should reject refresh of idle session", "idle", http.StatusUnauthorized},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodPost, "/token/refresh",
+			strings.NewReader(`{"jweToken":"`+c.token+`"}`))
+		request.Header.Set("Content-Type", restful.MIME_JSON)
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+
+	if len(manager.refreshed) != 1 || guard.carried["refreshed-active"] != "active" {
+		t.Errorf("Expected only token of active session to be refreshed and carried over, but got %v and %v.",
+			manager.refreshed, guard.carried)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Resolves identity of the user authenticated with given AuthInfo and returns AuthInfo that only impersonates this
+// user. Credentials of the user are not stored in the token, as requests to the apiserver are sent with dashboard's
+// own credentials. Username of basic authentication is not verified against apiserver identity, so it can not be used.
+func impersonationAuthInfo(client kubernetes.Interface, authInfo api.AuthInfo) (api.AuthInfo, error) {
+	switch {
+	case len(authInfo.ClientCertificateData) > 0:
+		return certificateIdentity(authInfo.ClientCertificateData)
+	case len(authInfo.Token) > 0:
+		return tokenIdentity(client, authInfo.Token)
+	}
+
+	return api.AuthInfo{}, errors.NewInvalid("Impersonation is supported only for token, oidc and x509 authentication modes.")
+}
+
+// Kubernetes uses certificate common name as the username and organizations as groups.
+func certificateIdentity(certificateData []byte) (api.AuthInfo, error) {
+	block, _ := pem.Decode(certificateData)
+	if block == nil {
+		return api.AuthInfo{}, errors.NewInvalid("Could not decode client certificate.")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return api.AuthInfo{}, errors.NewInvalid(err.Error())
+	}
+
+	if len(certificate.Subject.CommonName) == 0 {
+		return api.AuthInfo{}, errors.NewInvalid("Client certificate does not contain common name.")
+	}
+
+	return api.AuthInfo{
+		Impersonate:       certificate.Subject.CommonName,
+		ImpersonateGroups: certificate.Subject.Organization,
+	}, nil
+}
+
+// Asks apiserver about identity of the token owner. Requires dashboard to be allowed to create token reviews.
+func tokenIdentity(client kubernetes.Interface, token string) (api.AuthInfo, error) {
+	review, err := client.AuthenticationV1().TokenReviews().Create(context.TODO(), &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metaV1.CreateOptions{})
+	if err != nil {
+		return api.AuthInfo{}, err
+	}
+
+	if !review.Status.Authenticated || len(review.Status.User.Username) == 0 {
+		return api.AuthInfo{}, errors.NewUnauthorized(errors.MsgLoginUnauthorizedError)
+	}
+
+	var extra map[string][]string
+	for key, value := range review.Status.User.Extra {
+		if extra == nil {
+			extra = map[string][]string{}
+		}
+		extra[key] = value
+	}
+
+	return api.AuthInfo{
+		Impersonate:          review.Status.User.Username,
+		ImpersonateGroups:    review.Status.User.Groups,
+		ImpersonateUserExtra: extra,
+	}, nil
+}
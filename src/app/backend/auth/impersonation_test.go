@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/x509"
+	"reflect"
+	"testing"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stest "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+func TestImpersonationAuthInfo(t *testing.T) {
+	ca := newTestCA(t, 1)
+	certificate := newTestClientCertificate(t, ca, x509.ExtKeyUsageClientAuth)
+
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "tokenreviews",
+		func(action k8stest.Action) (bool, runtime.Object, error) {
+			review := action.(k8stest.CreateAction).GetObject().(*authenticationv1.TokenReview)
+			if review.Spec.Token == "valid-token" {
+				review.Status = authenticationv1.TokenReviewStatus{
+					Authenticated: true,
+					User: authenticationv1.UserInfo{Username: "test-user", Groups: []string{"test-group"},
+						Extra: map[string]authenticationv1.ExtraValue{"scopes": {"test-scope"}}},
+				}
+			}
+			return true, review, nil
+		})
+
+	cases := []struct {
+		info        string
+		authInfo    api.AuthInfo
+		expected    api.AuthInfo
+		expectedErr error
+	}{
+		{
+			"Should resolve identity of the token owner",
+			api.AuthInfo{Token: "valid-token"},
+			api.AuthInfo{Impersonate: "test-user", ImpersonateGroups: []string{"test-group"},
+				ImpersonateUserExtra: map[string][]string{"scopes": {"test-scope"}}},
+			nil,
+		},
+		{
+			"Should reject token not authenticated by apiserver",
+			api.AuthInfo{Token: "invalid-token"},
+			api.AuthInfo{},
+			errors.NewUnauthorized(errors.MsgLoginUnauthorizedError),
+		},
+		{
+			"Should use certificate subject as identity",
+			api.AuthInfo{ClientCertificateData: []byte(certificate.certPEM), ClientKeyData: []byte(certificate.keyPEM)},
+			api.AuthInfo{Impersonate: "test-user", ImpersonateGroups: []string{"test-group"}},
+			nil,
+		},
+		{
+			"Should reject basic authentication",
+			api.AuthInfo{Username: "test-user", Password: "test-password"},
+			api.AuthInfo{},
+			errors.NewInvalid("Impersonation is supported only for token, oidc and x509 authentication modes."),
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := impersonationAuthInfo(client, c.authInfo)
+		if !reflect.DeepEqual(err, c.expectedErr) {
+			t.Errorf("Test Case: %s. Expected error to be: %v, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected auth info to be: %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+}
@@ -15,8 +15,12 @@
 package jwe
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
+	"fmt"
 	"log"
 	"sync"
 
@@ -25,6 +29,7 @@ import (
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
@@ -36,6 +41,9 @@ import (
 const (
 	holderMapKeyEntry  = "priv"
 	holderMapCertEntry = "pub"
+	// Prefix of entries holding ECDSA keys, followed by the name of the signing algorithm, i.e. "priv-ES256". RSA key
+	// is kept in the entries above, so it can still be read by older versions.
+	holderMapECKeyEntryPrefix = "priv-"
 )
 
 // KeyHolder is responsible for generating, storing and synchronizing encryption key used for token
@@ -43,27 +51,51 @@ const (
 type KeyHolder interface {
 	// Returns encrypter instance that can be used to encrypt data.
 	Encrypter() jose.Encrypter
+	// Returns signer instance that can be used to sign data with configured signing algorithm.
+	Signer() jose.Signer
 	// Returns encryption key that can be used to decrypt data.
 	Key() *rsa.PrivateKey
+	// Returns key used with given signing algorithm or nil if there is none. Data signed with it is encrypted with the
+	// same key, so it can be used both to decrypt data and to verify its signature.
+	SigningKey(algorithm jose.SignatureAlgorithm) crypto.Signer
 	// Forces refresh of encryption key synchronized with kubernetes resource (secret).
 	Refresh()
+	// Check verifies that encryption key can be loaded from kubernetes resource (secret) using given client. Returns
+	// error describing the problem otherwise.
+	Check(ctx context.Context, client kubernetes.Interface) error
 }
 
 // Implements KeyHolder interface
 type rsaKeyHolder struct {
 	// 256-byte random RSA key pair. Synced with a key saved in a secret.
-	key          *rsa.PrivateKey
+	key *rsa.PrivateKey
+	// ECDSA keys used with ES256 and ES384 signing algorithms. Synced with keys saved in a secret, so keys of the
+	// previously configured algorithm are kept.
+	ecKeys map[jose.SignatureAlgorithm]*ecdsa.PrivateKey
+	// Algorithm used to sign data. Key management algorithm used to encrypt it depends on the type of its key.
+	algorithm    jose.SignatureAlgorithm
 	synchronizer syncApi.Synchronizer
-	mux          sync.Mutex
+	// Optional wrapper used to encrypt private key stored in a secret.
+	wrapper KeyWrapper
+	mux     sync.Mutex
 }
 
-// Encrypter implements key holder interface. See KeyHolder for more information.
+// Encrypter implements key holder interface. See KeyHolder for more information. Encrypted data is expected to be
+// signed, algorithm of its key is set as key ID.
 // Used encryption algorithms:
 //    - Content encryption: AES-GCM (256)
-//    - Key management: RSA-OAEP-SHA256
+//    - Key management: RSA-OAEP-SHA256 for RS256 signing algorithm, ECDH-ES with AES key wrap (256) otherwise
 func (self *rsaKeyHolder) Encrypter() jose.Encrypter {
-	publicKey := &self.Key().PublicKey
-	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: publicKey}, nil)
+	recipient := jose.Recipient{Algorithm: jose.RSA_OAEP_256, KeyID: string(self.algorithm)}
+	switch key := self.SigningKey(self.algorithm).(type) {
+	case *rsa.PrivateKey:
+		recipient.Key = &key.PublicKey
+	case *ecdsa.PrivateKey:
+		recipient.Algorithm = jose.ECDH_ES_A256KW
+		recipient.Key = &key.PublicKey
+	}
+
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, recipient, (&jose.EncrypterOptions{}).WithContentType("JWT"))
 	if err != nil {
 		panic(err)
 	}
@@ -71,6 +103,17 @@ func (self *rsaKeyHolder) Encrypter() jose.Encrypter {
 	return encrypter
 }
 
+// Signer implements key holder interface. See KeyHolder for more information.
+func (self *rsaKeyHolder) Signer() jose.Signer {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: self.algorithm, Key: self.SigningKey(self.algorithm)},
+		nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return signer
+}
+
 // Key implements key holder interface. See KeyHolder for more information.
 func (self *rsaKeyHolder) Key() *rsa.PrivateKey {
 	self.mux.Lock()
@@ -78,19 +121,58 @@ func (self *rsaKeyHolder) Key() *rsa.PrivateKey {
 	return self.key
 }
 
+// SigningKey implements key holder interface. See KeyHolder for more information.
+func (self *rsaKeyHolder) SigningKey(algorithm jose.SignatureAlgorithm) crypto.Signer {
+	if algorithm == jose.RS256 {
+		return self.Key()
+	}
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	if key, exists := self.ecKeys[algorithm]; exists {
+		return key
+	}
+
+	return nil
+}
+
 // Refresh implements key holder interface. See KeyHolder for more information.
 func (self *rsaKeyHolder) Refresh() {
 	self.synchronizer.Refresh()
 	self.update(self.synchronizer.Get())
 }
 
+// Check implements key holder interface. See KeyHolder for more information.
+func (self *rsaKeyHolder) Check(ctx context.Context, client kubernetes.Interface) error {
+	namespace := args.Holder.GetNamespace()
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, authApi.EncryptionKeyHolderName, metaV1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("could not read secret %s in namespace %s: %s", authApi.EncryptionKeyHolderName,
+			namespace, err.Error())
+	}
+
+	if _, err := self.parseEncryptionKeyHolder(secret); err != nil {
+		return fmt.Errorf("could not load encryption key from secret %s in namespace %s: %s",
+			authApi.EncryptionKeyHolderName, namespace, err.Error())
+	}
+
+	return nil
+}
+
 // Handler function executed by synchronizer used to store encryption key. It is called whenever watched object
 // is created or updated.
 func (self *rsaKeyHolder) update(obj runtime.Object) {
 	secret := obj.(*v1.Secret)
-	priv, err := ParseRSAKey(string(secret.Data[holderMapKeyEntry]), string(secret.Data[holderMapCertEntry]))
+	priv, err := self.parseEncryptionKeyHolder(secret)
+	var ecKeys map[jose.SignatureAlgorithm]*ecdsa.PrivateKey
+	if err == nil {
+		ecKeys, err = self.parseSigningKeys(secret)
+	}
+
 	if err != nil {
-		// Secret was probably tampered with. Update it based on local key.
+		// Secret was probably tampered with or key used to wrap it was rotated in KMS. Update it based on local key.
+		// Tokens encrypted with the key stored in a secret can not be decrypted anymore and users have to log in again.
+		log.Printf("Failed to read encryption key from synchronized secret, updating it with local key: %s", err)
 		err := self.synchronizer.Update(self.getEncryptionKeyHolder())
 		if err != nil {
 			panic(err)
@@ -100,8 +182,23 @@ func (self *rsaKeyHolder) update(obj runtime.Object) {
 	}
 
 	self.mux.Lock()
-	defer self.mux.Unlock()
 	self.key = priv
+	// Key of configured algorithm is not stored yet, i.e. algorithm was changed. Keep local one and store it.
+	_, missing := ecdsaCurves[self.algorithm]
+	if _, exists := ecKeys[self.algorithm]; missing && !exists {
+		ecKeys[self.algorithm] = self.ecKeys[self.algorithm]
+	} else {
+		missing = false
+	}
+	self.ecKeys = ecKeys
+	self.mux.Unlock()
+
+	if missing {
+		log.Printf("Storing %s signing key in a secret", self.algorithm)
+		if err := self.synchronizer.Update(self.getEncryptionKeyHolder()); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // Handler function executed by synchronizer used to store encryption key. It is called whenever watched object
@@ -136,18 +233,85 @@ func (self *rsaKeyHolder) init() {
 	}
 }
 
+func (self *rsaKeyHolder) parseEncryptionKeyHolder(secret *v1.Secret) (*rsa.PrivateKey, error) {
+	priv, err := self.unwrap(secret.Data[holderMapKeyEntry])
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseRSAKey(string(priv), string(secret.Data[holderMapCertEntry]))
+}
+
+// Parses ECDSA keys of all supported signing algorithms stored in a secret.
+func (self *rsaKeyHolder) parseSigningKeys(secret *v1.Secret) (map[jose.SignatureAlgorithm]*ecdsa.PrivateKey, error) {
+	keys := map[jose.SignatureAlgorithm]*ecdsa.PrivateKey{}
+	for algorithm, curve := range ecdsaCurves {
+		data, exists := secret.Data[holderMapECKeyEntryPrefix+string(algorithm)]
+		if !exists {
+			continue
+		}
+
+		priv, err := self.unwrap(data)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := ParseECKey(string(priv))
+		if err != nil {
+			return nil, err
+		}
+
+		if key.Curve != curve {
+			return nil, fmt.Errorf("%s signing key does not use %s curve", algorithm, curve.Params().Name)
+		}
+
+		keys[algorithm] = key
+	}
+
+	return keys, nil
+}
+
+func (self *rsaKeyHolder) unwrap(data []byte) ([]byte, error) {
+	if self.wrapper == nil {
+		return data, nil
+	}
+
+	return self.wrapper.Unwrap(data)
+}
+
+func (self *rsaKeyHolder) wrapOrDie(data []byte) []byte {
+	if self.wrapper == nil {
+		return data
+	}
+
+	wrapped, err := self.wrapper.Wrap(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return wrapped
+}
+
 func (self *rsaKeyHolder) getEncryptionKeyHolder() runtime.Object {
 	priv, pub := ExportRSAKeyOrDie(self.Key())
+	data := map[string][]byte{
+		holderMapKeyEntry:  self.wrapOrDie([]byte(priv)),
+		holderMapCertEntry: []byte(pub),
+	}
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	for algorithm, key := range self.ecKeys {
+		data[holderMapECKeyEntryPrefix+string(algorithm)] = self.wrapOrDie([]byte(ExportECKeyOrDie(key)))
+	}
+
 	return &v1.Secret{
 		ObjectMeta: metaV1.ObjectMeta{
 			Namespace: args.Holder.GetNamespace(),
 			Name:      authApi.EncryptionKeyHolderName,
 		},
 
-		Data: map[string][]byte{
-			holderMapKeyEntry:  []byte(priv),
-			holderMapCertEntry: []byte(pub),
-		},
+		Data: data,
 	}
 }
 
@@ -163,12 +327,44 @@ func (self *rsaKeyHolder) initEncryptionKey() {
 	}
 
 	self.key = privateKey
+	self.ecKeys = map[jose.SignatureAlgorithm]*ecdsa.PrivateKey{}
+	if curve, exists := ecdsaCurves[self.algorithm]; exists {
+		ecKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+
+		self.ecKeys[self.algorithm] = ecKey
+	}
+}
+
+// Returns configured token signing algorithm. RS256 is used by default.
+func signingAlgorithm() jose.SignatureAlgorithm {
+	if algorithm := args.Holder.GetTokenSigningAlg(); len(algorithm) > 0 {
+		return jose.SignatureAlgorithm(algorithm)
+	}
+
+	return jose.RS256
 }
 
 // NewRSAKeyHolder creates new KeyHolder instance.
 func NewRSAKeyHolder(synchronizer syncApi.Synchronizer) KeyHolder {
 	holder := &rsaKeyHolder{
 		synchronizer: synchronizer,
+		algorithm:    signingAlgorithm(),
+	}
+
+	holder.init()
+	return holder
+}
+
+// NewWrappedRSAKeyHolder creates new KeyHolder instance that stores private key wrapped with given wrapper, i.e.
+// using KMS.
+func NewWrappedRSAKeyHolder(synchronizer syncApi.Synchronizer, wrapper KeyWrapper) KeyHolder {
+	holder := &rsaKeyHolder{
+		synchronizer: synchronizer,
+		wrapper:      wrapper,
+		algorithm:    signingAlgorithm(),
 	}
 
 	holder.init()
@@ -15,10 +15,14 @@
 package jwe
 
 import (
+	"context"
 	"testing"
 
-	"github.com/kubernetes/dashboard/src/app/backend/sync"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
 )
 
 func getKeyHolder() KeyHolder {
@@ -47,3 +51,30 @@ func TestRsaKeyHolder_Key(t *testing.T) {
 		t.Fatalf("Key(): Expected key not to be nil")
 	}
 }
+
+func TestRsaKeyHolder_Check(t *testing.T) {
+	c := fake.NewSimpleClientset()
+	holder := NewRSAKeyHolder(sync.NewSynchronizerManager(c).Secret("", authApi.EncryptionKeyHolderName))
+	if err := holder.Check(context.TODO(), c); err != nil {
+		t.Fatalf("Check(): Expected encryption key to be loaded, but got error: %s", err)
+	}
+
+	secret, _ := c.CoreV1().Secrets("").Get(context.TODO(), authApi.EncryptionKeyHolderName, metaV1.GetOptions{})
+	secret.Data[holderMapKeyEntry] = []byte("invalid")
+	if _, err := c.CoreV1().Secrets("").Update(context.TODO(), secret, metaV1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := holder.Check(context.TODO(), c); err == nil {
+		t.Error("Check(): Expected error for invalid encryption key")
+	}
+
+	if err := c.CoreV1().Secrets("").Delete(context.TODO(), authApi.EncryptionKeyHolderName,
+		metaV1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := holder.Check(context.TODO(), c); err == nil {
+		t.Error("Check(): Expected error for missing encryption key secret")
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	kmsapi "k8s.io/apiserver/pkg/storage/value/encrypt/envelope/v1beta1"
+)
+
+const (
+	// Version of the KMS plugin API supported by dashboard.
+	kmsAPIVersion = "v1beta1"
+	// Only unix domain sockets are supported by KMS plugins.
+	kmsEndpointScheme = "unix://"
+)
+
+// KeyWrapper is responsible for wrapping encryption key before it is stored in a secret and unwrapping it when it
+// is read back, so the key is never stored in plain text.
+type KeyWrapper interface {
+	// Wrap encrypts given encryption key.
+	Wrap(key []byte) ([]byte, error)
+	// Unwrap decrypts encryption key wrapped with Wrap.
+	Unwrap(wrapped []byte) ([]byte, error)
+}
+
+// Implements KeyWrapper interface using Kubernetes KMS plugin gRPC API.
+type kmsKeyWrapper struct {
+	client  kmsapi.KeyManagementServiceClient
+	timeout time.Duration
+}
+
+// Wrap implements KeyWrapper interface. See KeyWrapper for more information.
+func (self *kmsKeyWrapper) Wrap(key []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), self.timeout)
+	defer cancel()
+
+	response, err := self.client.Encrypt(ctx, &kmsapi.EncryptRequest{Version: kmsAPIVersion, Plain: key})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap encryption key with KMS: %s", err)
+	}
+
+	return response.Cipher, nil
+}
+
+// Unwrap implements KeyWrapper interface. See KeyWrapper for more information.
+func (self *kmsKeyWrapper) Unwrap(wrapped []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), self.timeout)
+	defer cancel()
+
+	response, err := self.client.Decrypt(ctx, &kmsapi.DecryptRequest{Version: kmsAPIVersion, Cipher: wrapped})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap encryption key with KMS: %s", err)
+	}
+
+	return response.Plain, nil
+}
+
+// NewKMSKeyWrapper connects to the KMS plugin listening on given unix socket endpoint, i.e.
+// 'unix:///var/run/kms.sock', and verifies that it supports the v1beta1 API.
+func NewKMSKeyWrapper(endpoint string, timeout time.Duration) (KeyWrapper, error) {
+	if !strings.HasPrefix(endpoint, kmsEndpointScheme) {
+		return nil, fmt.Errorf("KMS endpoint %s has to start with %s", endpoint, kmsEndpointScheme)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, strings.TrimPrefix(endpoint, kmsEndpointScheme), grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to KMS plugin at %s: %s", endpoint, err)
+	}
+
+	client := kmsapi.NewKeyManagementServiceClient(conn)
+	response, err := client.Version(ctx, &kmsapi.VersionRequest{Version: kmsAPIVersion})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to get version of KMS plugin at %s: %s", endpoint, err)
+	}
+
+	if response.Version != kmsAPIVersion {
+		conn.Close()
+		return nil, fmt.Errorf("KMS plugin at %s supports API %s, expected %s", endpoint, response.Version,
+			kmsAPIVersion)
+	}
+
+	return &kmsKeyWrapper{client: client, timeout: timeout}, nil
+}
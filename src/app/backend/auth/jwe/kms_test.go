@@ -0,0 +1,128 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmsapi "k8s.io/apiserver/pkg/storage/value/encrypt/envelope/v1beta1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
+)
+
+// Fake KMS plugin that "encrypts" data by reversing and prefixing it with the current key version.
+type fakeKMSPlugin struct {
+	version string
+}
+
+func (self *fakeKMSPlugin) Version(ctx context.Context, req *kmsapi.VersionRequest) (*kmsapi.VersionResponse, error) {
+	return &kmsapi.VersionResponse{Version: self.version, RuntimeName: "fake", RuntimeVersion: "0.1.0"}, nil
+}
+
+func (self *fakeKMSPlugin) Decrypt(ctx context.Context, req *kmsapi.DecryptRequest) (*kmsapi.DecryptResponse, error) {
+	if !bytes.HasPrefix(req.Cipher, []byte("k1:")) {
+		return nil, errors.New("unknown key")
+	}
+
+	return &kmsapi.DecryptResponse{Plain: reverse(bytes.TrimPrefix(req.Cipher, []byte("k1:")))}, nil
+}
+
+func (self *fakeKMSPlugin) Encrypt(ctx context.Context, req *kmsapi.EncryptRequest) (*kmsapi.EncryptResponse, error) {
+	return &kmsapi.EncryptResponse{Cipher: append([]byte("k1:"), reverse(req.Plain)...)}, nil
+}
+
+func reverse(data []byte) []byte {
+	result := make([]byte, len(data))
+	for i, b := range data {
+		result[len(data)-1-i] = b
+	}
+	return result
+}
+
+func startFakeKMSPlugin(t *testing.T, version string) string {
+	socket := filepath.Join(t.TempDir(), "kms.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := grpc.NewServer()
+	kmsapi.RegisterKeyManagementServiceServer(server, &fakeKMSPlugin{version: version})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return "unix://" + socket
+}
+
+func TestNewKMSKeyWrapper(t *testing.T) {
+	cases := []struct {
+		info        string
+		endpoint    string
+		expectedErr bool
+	}{
+		{"Endpoint has to be a unix socket", "localhost:8080", true},
+		{"Plugin with unsupported API should be rejected", startFakeKMSPlugin(t, "v2"), true},
+		{"Plugin with v1beta1 API should be accepted", startFakeKMSPlugin(t, kmsAPIVersion), false},
+	}
+
+	for _, c := range cases {
+		_, err := NewKMSKeyWrapper(c.endpoint, time.Second)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v", c.info, c.expectedErr, err)
+		}
+	}
+}
+
+func TestWrappedRSAKeyHolder(t *testing.T) {
+	wrapper, err := NewKMSKeyWrapper(startFakeKMSPlugin(t, kmsAPIVersion), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := fake.NewSimpleClientset()
+	holder := NewWrappedRSAKeyHolder(sync.NewSynchronizerManager(c).Secret("", authApi.EncryptionKeyHolderName),
+		wrapper)
+
+	secret, err := c.CoreV1().Secrets("").Get(context.TODO(), authApi.EncryptionKeyHolderName, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(secret.Data[holderMapKeyEntry], []byte("k1:")) {
+		t.Fatalf("Expected private key to be stored wrapped, but got %s", secret.Data[holderMapKeyEntry])
+	}
+
+	key, err := holder.(*rsaKeyHolder).parseEncryptionKeyHolder(secret)
+	if err != nil || !key.Equal(holder.Key()) {
+		t.Fatalf("Expected stored key to be unwrapped to the key of the holder, but got error: %v", err)
+	}
+
+	// Key wrapped with a key no longer known to KMS can not be read anymore.
+	rotated := &v1.Secret{Data: map[string][]byte{holderMapKeyEntry: []byte("k0:key")}}
+	if _, err := holder.(*rsaKeyHolder).parseEncryptionKeyHolder(rotated); err == nil {
+		t.Fatal("Expected key wrapped with unknown KMS key not to be unwrapped")
+	}
+}
@@ -27,8 +27,10 @@ import (
 
 // Implements TokenManager interface
 type jweTokenManager struct {
-	keyHolder KeyHolder
-	tokenTTL  time.Duration
+	keyHolder          KeyHolder
+	tokenTTL           time.Duration
+	modeTokenTTLs      map[authApi.AuthenticationMode]time.Duration
+	maxSessionLifetime time.Duration
 }
 
 // AdditionalAuthData contains information required to validate token. It is integrity protected.
@@ -46,17 +48,44 @@ const (
 	IAT Claim = "iat"
 	// EXP claim is part of token AAD header. It represents token expiration time.
 	EXP Claim = "exp"
+	// AUTH claim is part of token AAD header. It represents time of the login that the token and all tokens refreshed
+	// from it were issued for.
+	AUTH Claim = "auth_time"
 )
 
 // Generate and encrypt JWE token based on provided AuthInfo structure. AuthInfo will be embedded in a token payload and
 // encrypted with autogenerated signing key.
 func (self *jweTokenManager) Generate(authInfo api.AuthInfo) (string, error) {
+	return self.generate(authInfo, self.tokenTTL, time.Now())
+}
+
+// GenerateForMode implements token manager interface. See TokenManager for more information.
+func (self *jweTokenManager) GenerateForMode(mode authApi.AuthenticationMode, authInfo api.AuthInfo) (string, error) {
+	ttl, exists := self.modeTokenTTLs[mode]
+	if !exists {
+		ttl = self.tokenTTL
+	}
+
+	return self.generate(authInfo, ttl, time.Now())
+}
+
+func (self *jweTokenManager) generate(authInfo api.AuthInfo, ttl time.Duration, authTime time.Time) (string, error) {
 	marshalledAuthInfo, err := json.Marshal(authInfo)
 	if err != nil {
 		return "", err
 	}
 
-	jweObject, err := self.getEncrypter().EncryptWithAuthData(marshalledAuthInfo, self.generateAAD())
+	jwsObject, err := self.keyHolder.Signer().Sign(marshalledAuthInfo)
+	if err != nil {
+		return "", err
+	}
+
+	signedAuthInfo, err := jwsObject.CompactSerialize()
+	if err != nil {
+		return "", err
+	}
+
+	jweObject, err := self.getEncrypter().EncryptWithAuthData([]byte(signedAuthInfo), self.generateAAD(ttl, authTime))
 	if err != nil {
 		return "", err
 	}
@@ -71,13 +100,7 @@ func (self *jweTokenManager) Decrypt(jweToken string) (*api.AuthInfo, error) {
 		return nil, err
 	}
 
-	decrypted, err := jweTokenObject.Decrypt(self.keyHolder.Key())
-	if err == jose.ErrCryptoFailure {
-		// Force key refresh and try to decrypt again
-		self.keyHolder.Refresh()
-		decrypted, err = jweTokenObject.Decrypt(self.keyHolder.Key())
-	}
-
+	decrypted, err := self.decrypt(jweTokenObject)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +121,18 @@ func (self *jweTokenManager) Refresh(jweToken string) (string, error) {
 		return "", err
 	}
 
-	decrypted, err := jweTokenObject.Decrypt(self.keyHolder.Key())
+	aad, err := self.getAAD(jweTokenObject)
+	if err != nil {
+		return "", err
+	}
+
+	// Session can not be extended past its maximum lifetime, user has to log in again.
+	authTime := self.getAuthTime(aad)
+	if self.maxSessionLifetime > 0 && !time.Now().Before(authTime.Add(self.maxSessionLifetime)) {
+		return "", errors.NewTokenExpired(errors.MsgTokenExpiredError)
+	}
+
+	decrypted, err := self.decrypt(jweTokenObject)
 	if err != nil {
 		return "", err
 	}
@@ -109,7 +143,8 @@ func (self *jweTokenManager) Refresh(jweToken string) (string, error) {
 		return "", errors.NewInvalid("Token refresh error. Could not unmarshal token payload.")
 	}
 
-	return self.Generate(*authInfo)
+	// Refreshed token keeps TTL it was issued with, even if it differs from the current default one.
+	return self.generate(*authInfo, self.getTTL(aad), authTime)
 }
 
 // SetTokenTTL implements token manager interface. See TokenManager for more information.
@@ -121,10 +156,69 @@ func (self *jweTokenManager) SetTokenTTL(ttl time.Duration) {
 	self.tokenTTL = ttl * time.Second
 }
 
+// SetModeTokenTTL implements token manager interface. See TokenManager for more information.
+func (self *jweTokenManager) SetModeTokenTTL(mode authApi.AuthenticationMode, ttl time.Duration) {
+	if ttl < 0 {
+		ttl = 0
+	}
+
+	self.modeTokenTTLs[mode] = ttl * time.Second
+}
+
+// SetMaxSessionLifetime implements token manager interface. See TokenManager for more information.
+func (self *jweTokenManager) SetMaxSessionLifetime(lifetime time.Duration) {
+	if lifetime < 0 {
+		lifetime = 0
+	}
+
+	self.maxSessionLifetime = lifetime * time.Second
+}
+
 func (self *jweTokenManager) getEncrypter() jose.Encrypter {
 	return self.keyHolder.Encrypter()
 }
 
+// Decrypts token with the key of the algorithm it was signed with and verifies its signature. Tokens issued before
+// signing was introduced are not signed and do not contain key ID, they are encrypted with RSA key.
+func (self *jweTokenManager) decrypt(jwe *jose.JSONWebEncryption) ([]byte, error) {
+	algorithm := jose.RS256
+	if len(jwe.Header.KeyID) > 0 {
+		algorithm = jose.SignatureAlgorithm(jwe.Header.KeyID)
+	}
+
+	decrypted, err := self.decryptWithKey(jwe, algorithm)
+	if err == jose.ErrCryptoFailure || err == jose.ErrUnsupportedKeyType {
+		// Force key refresh and try to decrypt again
+		self.keyHolder.Refresh()
+		decrypted, err = self.decryptWithKey(jwe, algorithm)
+	}
+
+	if err != nil || jwe.Header.ExtraHeaders[jose.HeaderContentType] != "JWT" {
+		return decrypted, err
+	}
+
+	jws, err := jose.ParseSigned(string(decrypted))
+	if err != nil {
+		return nil, errors.NewInvalid("Token validation error. Could not parse signed payload.")
+	}
+
+	if len(jws.Signatures) != 1 || jws.Signatures[0].Header.Algorithm != string(algorithm) {
+		return nil, errors.NewInvalid("Token validation error. Payload is not signed with the key of the token.")
+	}
+
+	return jws.Verify(&jose.JSONWebKey{Key: self.keyHolder.SigningKey(algorithm).Public()})
+}
+
+func (self *jweTokenManager) decryptWithKey(jwe *jose.JSONWebEncryption, algorithm jose.SignatureAlgorithm) (
+	[]byte, error) {
+	key := self.keyHolder.SigningKey(algorithm)
+	if key == nil {
+		return nil, jose.ErrUnsupportedKeyType
+	}
+
+	return jwe.Decrypt(key)
+}
+
 // Parses and validates provided token to check if it hasn't been manipulated with.
 func (self *jweTokenManager) validate(jweToken string) (*jose.JSONWebEncryption, error) {
 	jwe, err := jose.ParseEncrypted(jweToken)
@@ -132,21 +226,57 @@ func (self *jweTokenManager) validate(jweToken string) (*jose.JSONWebEncryption,
 		return nil, err
 	}
 
-	if self.tokenTTL > 0 {
-		aad := AdditionalAuthData{}
-		err = json.Unmarshal(jwe.GetAuthData(), &aad)
-		if err != nil {
-			return nil, errors.NewInvalid("Token validation error. Could not unmarshal AAD.")
-		}
+	aad, err := self.getAAD(jwe)
+	if err != nil {
+		return nil, err
+	}
 
-		if self.isExpired(aad[IAT], aad[EXP]) {
-			return nil, errors.NewTokenExpired(errors.MsgTokenExpiredError)
-		}
+	// Tokens generated with TTL set to 0 do not contain expiration time and never expire.
+	if _, exists := aad[EXP]; exists && self.isExpired(aad[IAT], aad[EXP]) {
+		return nil, errors.NewTokenExpired(errors.MsgTokenExpiredError)
 	}
 
 	return jwe, nil
 }
 
+func (self *jweTokenManager) getAAD(jwe *jose.JSONWebEncryption) (AdditionalAuthData, error) {
+	aad := AdditionalAuthData{}
+	if err := json.Unmarshal(jwe.GetAuthData(), &aad); err != nil {
+		return nil, errors.NewInvalid("Token validation error. Could not unmarshal AAD.")
+	}
+
+	return aad, nil
+}
+
+// Returns TTL that token was issued with based on its AAD. Tokens without expiration time have TTL set to 0.
+func (self *jweTokenManager) getTTL(aad AdditionalAuthData) time.Duration {
+	iat, err := time.Parse(timeFormat, aad[IAT])
+	if err != nil {
+		return self.tokenTTL
+	}
+
+	exp, err := time.Parse(timeFormat, aad[EXP])
+	if err != nil {
+		return 0
+	}
+
+	return exp.Sub(iat)
+}
+
+// Returns time of the login that the token was issued for based on its AAD. Tokens generated before the time was
+// added to AAD use their issue time.
+func (self *jweTokenManager) getAuthTime(aad AdditionalAuthData) time.Time {
+	if authTime, err := time.Parse(timeFormat, aad[AUTH]); err == nil {
+		return authTime
+	}
+
+	if iat, err := time.Parse(timeFormat, aad[IAT]); err == nil {
+		return iat
+	}
+
+	return time.Now()
+}
+
 // Returns true if token has expired. In case time could not be parsed it might mean that token was tampered with and
 // token will be marked as expired. This will force user to log in again.
 func (self *jweTokenManager) isExpired(iatStr, expStr string) bool {
@@ -164,14 +294,47 @@ func (self *jweTokenManager) isExpired(iatStr, expStr string) bool {
 	return iat.Add(age).After(exp)
 }
 
-func (self *jweTokenManager) generateAAD() []byte {
+// TokenExpiry returns expiration time of the token based on its AAD or zero time if token never expires. Token is not
+// decrypted, so it has to be validated by the token manager before its expiration time can be trusted.
+func TokenExpiry(jweToken string) (time.Time, error) {
+	jwe, err := jose.ParseEncrypted(jweToken)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	aad := AdditionalAuthData{}
+	if err := json.Unmarshal(jwe.GetAuthData(), &aad); err != nil {
+		return time.Time{}, errors.NewInvalid("Token validation error. Could not unmarshal AAD.")
+	}
+
+	if _, exists := aad[EXP]; !exists {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(timeFormat, aad[EXP])
+}
+
+func (self *jweTokenManager) generateAAD(ttl time.Duration, authTime time.Time) []byte {
 	now := time.Now()
 	aad := AdditionalAuthData{
-		IAT: now.Format(timeFormat),
+		IAT:  now.Format(timeFormat),
+		AUTH: authTime.Format(timeFormat),
 	}
 
-	if self.tokenTTL > 0 {
-		aad[EXP] = now.Add(self.tokenTTL).Format(timeFormat)
+	// Tokens do not outlive maximum lifetime of the session, even if they would never expire otherwise.
+	exp := time.Time{}
+	if ttl > 0 {
+		exp = now.Add(ttl)
+	}
+
+	if self.maxSessionLifetime > 0 {
+		if sessionEnd := authTime.Add(self.maxSessionLifetime); exp.IsZero() || sessionEnd.Before(exp) {
+			exp = sessionEnd
+		}
+	}
+
+	if !exp.IsZero() {
+		aad[EXP] = exp.Format(timeFormat)
 	}
 
 	rawAAD, _ := json.Marshal(aad)
@@ -180,6 +343,10 @@ func (self *jweTokenManager) generateAAD() []byte {
 
 // Creates and returns default JWE token manager instance.
 func NewJWETokenManager(holder KeyHolder) authApi.TokenManager {
-	manager := &jweTokenManager{keyHolder: holder, tokenTTL: authApi.DefaultTokenTTL * time.Second}
+	manager := &jweTokenManager{
+		keyHolder:     holder,
+		tokenTTL:      authApi.DefaultTokenTTL * time.Second,
+		modeTokenTTLs: map[authApi.AuthenticationMode]time.Duration{},
+	}
 	return manager
 }
@@ -19,9 +19,12 @@ import (
 	"testing"
 	"time"
 
+	jose "gopkg.in/square/go-jose.v2"
+	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/sync"
@@ -154,3 +157,234 @@ func TestJweTokenManager_Refresh(t *testing.T) {
 		}
 	}
 }
+
+func getTokenTTL(t *testing.T, token string) time.Duration {
+	jwe, err := jose.ParseEncrypted(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aad := AdditionalAuthData{}
+	if err := json.Unmarshal(jwe.GetAuthData(), &aad); err != nil {
+		t.Fatal(err)
+	}
+
+	return (&jweTokenManager{}).getTTL(aad)
+}
+
+func TestJweTokenManager_GenerateForMode(t *testing.T) {
+	tokenManager := getTokenManager()
+	tokenManager.SetTokenTTL(60)
+	tokenManager.SetModeTokenTTL(authApi.Token, 3600)
+	tokenManager.SetModeTokenTTL(authApi.Basic, 0)
+
+	cases := []struct {
+		info     string
+		mode     authApi.AuthenticationMode
+		expected time.Duration
+	}{
+		{"Should use mode specific TTL", authApi.Token, time.Hour},
+		{"Should not expire when mode specific TTL is 0", authApi.Basic, 0},
+		{"Should use default TTL when mode has no override", authApi.OIDC, time.Minute},
+	}
+
+	for _, c := range cases {
+		token, err := tokenManager.GenerateForMode(c.mode, api.AuthInfo{Token: "test-token"})
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+
+		if actual := getTokenTTL(t, token); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected token TTL %s, but got %s.", c.info, c.expected, actual)
+		}
+
+		refreshedToken, err := tokenManager.Refresh(token)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected refresh error: %v", c.info, err)
+		}
+
+		if actual := getTokenTTL(t, refreshedToken); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected refreshed token TTL %s, but got %s.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestJweTokenManager_SigningAlgorithm(t *testing.T) {
+	defer args.GetHolderBuilder().SetTokenSigningAlg("")
+
+	cases := []struct {
+		info                 string
+		previousAlgorithm    jose.SignatureAlgorithm
+		algorithm            jose.SignatureAlgorithm
+		expectedKeyAlgorithm string
+	}{
+		{"Should sign token using RS256", jose.RS256, jose.RS256, string(jose.RSA_OAEP_256)},
+		{"Should sign token using ES256", jose.ES256, jose.ES256, string(jose.ECDH_ES_A256KW)},
+		{"Should sign token using ES384", jose.ES384, jose.ES384, string(jose.ECDH_ES_A256KW)},
+		{"Should accept token signed with RS256 after switching to ES256", jose.RS256, jose.ES256,
+			string(jose.RSA_OAEP_256)},
+		{"Should accept token signed with ES384 after switching to RS256", jose.ES384, jose.RS256,
+			string(jose.ECDH_ES_A256KW)},
+	}
+
+	for _, c := range cases {
+		synchronizer := sync.NewSynchronizerManager(fake.NewSimpleClientset()).Secret("", authApi.EncryptionKeyHolderName)
+		args.GetHolderBuilder().SetTokenSigningAlg(string(c.previousAlgorithm))
+		token, err := NewJWETokenManager(NewRSAKeyHolder(synchronizer)).Generate(api.AuthInfo{Token: "test-token"})
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+
+		args.GetHolderBuilder().SetTokenSigningAlg(string(c.algorithm))
+		tokenManager := NewJWETokenManager(NewRSAKeyHolder(synchronizer))
+		authInfo, err := tokenManager.Decrypt(token)
+		if err != nil || authInfo.Token != "test-token" {
+			t.Errorf("Test Case: %s. Expected token to be decrypted, but got error: %v", c.info, err)
+		}
+
+		jwe, _ := jose.ParseEncrypted(token)
+		if jwe.Header.Algorithm != c.expectedKeyAlgorithm || jwe.Header.KeyID != string(c.previousAlgorithm) {
+			t.Errorf("Test Case: %s. Expected token encrypted using %s with %s key, but got %s with %s key.", c.info,
+				c.expectedKeyAlgorithm, c.previousAlgorithm, jwe.Header.Algorithm, jwe.Header.KeyID)
+		}
+
+		refreshedToken, err := tokenManager.Refresh(token)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected refresh error: %v", c.info, err)
+		}
+
+		if jwe, _ := jose.ParseEncrypted(refreshedToken); jwe.Header.KeyID != string(c.algorithm) {
+			t.Errorf("Test Case: %s. Expected refreshed token signed with %s, but got %s.", c.info, c.algorithm,
+				jwe.Header.KeyID)
+		}
+	}
+}
+
+func TestJweTokenManager_DecryptUnsigned(t *testing.T) {
+	holder := NewRSAKeyHolder(sync.NewSynchronizerManager(fake.NewSimpleClientset()).Secret("", ""))
+	tokenManager := NewJWETokenManager(holder)
+	payload := []byte(`{"token":"test-token"}`)
+
+	cases := []struct {
+		info        string
+		options     *jose.EncrypterOptions
+		expectedErr bool
+	}{
+		{"Should accept unsigned token issued by older version", nil, false},
+		{"Should reject token without signature", (&jose.EncrypterOptions{}).WithContentType("JWT"), true},
+	}
+
+	for _, c := range cases {
+		encrypter, err := jose.NewEncrypter(jose.A256GCM,
+			jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: &holder.Key().PublicKey}, c.options)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		jweObject, err := encrypter.EncryptWithAuthData(payload, (&jweTokenManager{}).generateAAD(0, time.Now()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authInfo, err := tokenManager.Decrypt(jweObject.FullSerialize())
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if err == nil && authInfo.Token != "test-token" {
+			t.Errorf("Test Case: %s. Expected token to be decrypted, but got %v.", c.info, authInfo)
+		}
+	}
+}
+
+func TestTokenExpiry(t *testing.T) {
+	tokenManager := getTokenManager()
+	tokenManager.SetModeTokenTTL(authApi.Token, 3600)
+	tokenManager.SetModeTokenTTL(authApi.Basic, 0)
+
+	cases := []struct {
+		info           string
+		mode           authApi.AuthenticationMode
+		expectedExpiry bool
+	}{
+		{"Should return expiration time of expiring token", authApi.Token, true},
+		{"Should return zero time if token never expires", authApi.Basic, false},
+	}
+
+	for _, c := range cases {
+		before := time.Now().Truncate(time.Second)
+		token, err := tokenManager.GenerateForMode(c.mode, api.AuthInfo{Token: "test-token"})
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+
+		expiry, err := TokenExpiry(token)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+
+		if expiry.IsZero() == c.expectedExpiry {
+			t.Errorf("Test Case: %s. Expected expiry to be set: %t, but got %s.", c.info, c.expectedExpiry, expiry)
+		}
+
+		if c.expectedExpiry && (expiry.Before(before.Add(time.Hour)) || expiry.After(time.Now().Add(time.Hour))) {
+			t.Errorf("Test Case: %s. Expected expiry in an hour, but got %s.", c.info, expiry)
+		}
+	}
+
+	if _, err := TokenExpiry("invalid"); err == nil {
+		t.Error("Expected error for invalid token.")
+	}
+}
+
+func TestJweTokenManager_MaxSessionLifetime(t *testing.T) {
+	tokenManager := getTokenManager().(*jweTokenManager)
+	tokenManager.SetTokenTTL(900)
+	now := time.Now()
+
+	cases := []struct {
+		info           string
+		authTime       time.Time
+		expectedErr    bool
+		expectedExpiry time.Time
+	}{
+		{"Should refresh token within session lifetime", now.Add(-10 * time.Minute), false, now.Add(15 * time.Minute)},
+		{"Should cap expiry of refreshed token at session lifetime", now.Add(-55 * time.Minute), false,
+			now.Add(5 * time.Minute)},
+		{"Should reject refresh after session lifetime", now.Add(-2 * time.Hour), true, time.Time{}},
+	}
+
+	for _, c := range cases {
+		// Tokens are issued before the lifetime is limited, so they are not capped on their own.
+		tokenManager.SetMaxSessionLifetime(0)
+		token, err := tokenManager.generate(api.AuthInfo{Token: "test-token"}, tokenManager.tokenTTL, c.authTime)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+
+		tokenManager.SetMaxSessionLifetime(3600)
+		refreshedToken, err := tokenManager.Refresh(token)
+		if (err != nil) != c.expectedErr {
+			t.Fatalf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		expiry, err := TokenExpiry(refreshedToken)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+
+		if diff := expiry.Sub(c.expectedExpiry); diff < -time.Second || diff > time.Second {
+			t.Errorf("Test Case: %s. Expected expiry %s, but got %s.", c.info, c.expectedExpiry, expiry)
+		}
+	}
+
+	tokenManager.SetTokenTTL(0)
+	token, _ := tokenManager.Generate(api.AuthInfo{Token: "test-token"})
+	if expiry, _ := TokenExpiry(token); expiry.IsZero() || expiry.After(time.Now().Add(time.Hour)) {
+		t.Errorf("Expected token that never expires to be capped at session lifetime, but got expiry %s.", expiry)
+	}
+}
@@ -15,13 +15,39 @@
 package jwe
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
+
+	jose "gopkg.in/square/go-jose.v2"
 
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
+// SupportedSigningAlgorithms lists algorithms that can be used to sign tokens.
+var SupportedSigningAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.ES256, jose.ES384}
+
+// Curves of ECDSA keys used with supported signing algorithms.
+var ecdsaCurves = map[jose.SignatureAlgorithm]elliptic.Curve{
+	jose.ES256: elliptic.P256(),
+	jose.ES384: elliptic.P384(),
+}
+
+// ValidateSigningAlgorithm returns error if given token signing algorithm is not supported.
+func ValidateSigningAlgorithm(algorithm string) error {
+	for _, supported := range SupportedSigningAlgorithms {
+		if string(supported) == algorithm {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported token signing algorithm %s, supported algorithms are %v", algorithm,
+		SupportedSigningAlgorithms)
+}
+
 // Credits to David W. https://stackoverflow.com/a/44688503
 
 // ExportRSAKeyOrDie exports rsa key object to a private/public strings. In case of fail panic is called.
@@ -82,3 +108,24 @@ func ParseRSAKey(privStr, pubStr string) (*rsa.PrivateKey, error) {
 	priv.PublicKey = *pub
 	return priv, nil
 }
+
+// ExportECKeyOrDie exports ecdsa key object to a private key string. Public key is a part of it. In case of fail panic
+// is called.
+func ExportECKeyOrDie(privKey *ecdsa.PrivateKey) string {
+	privkeyBytes, err := x509.MarshalECPrivateKey(privKey)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privkeyBytes}))
+}
+
+// ParseECKey parses private key string and returns ecdsa key object or error.
+func ParseECKey(privStr string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privStr))
+	if block == nil {
+		return nil, errors.NewInvalid("Failed to parse PEM block containing the key")
+	}
+
+	return x509.ParseECPrivateKey(block.Bytes)
+}
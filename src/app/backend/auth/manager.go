@@ -15,11 +15,16 @@
 package auth
 
 import (
+	"crypto/x509"
+	"log"
+
 	"k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
 )
 
 // Implements AuthManager interface
@@ -28,6 +33,9 @@ type authManager struct {
 	clientManager           clientapi.ClientManager
 	authenticationModes     authApi.AuthenticationModes
 	authenticationSkippable bool
+	oidcClient              authApi.OIDCClient
+	apiserverCertPool       *x509.CertPool
+	settingsManager         settingsApi.SettingsManager
 }
 
 // Login implements auth manager. See AuthManager interface for more information.
@@ -48,7 +56,15 @@ func (self authManager) Login(spec *authApi.LoginSpec) (*authApi.AuthResponse, e
 		return &authApi.AuthResponse{Errors: nonCriticalErrors}, criticalError
 	}
 
-	token, err := self.tokenManager.Generate(authInfo)
+	mode := loginMode(spec, authInfo)
+	if args.Holder.GetEnableImpersonation() {
+		authInfo, err = impersonationAuthInfo(self.clientManager.InsecureClient(), authInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token, err := self.tokenManager.GenerateForMode(mode, authInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -62,44 +78,144 @@ func (self authManager) Refresh(jweToken string) (string, error) {
 }
 
 func (self authManager) AuthenticationModes() []authApi.AuthenticationMode {
-	return self.authenticationModes.Array()
+	return self.enabledAuthenticationModes().Array()
 }
 
 func (self authManager) AuthenticationSkippable() bool {
 	return self.authenticationSkippable
 }
 
+// OIDCAuthCodeURL implements auth manager. See AuthManager interface for more information.
+func (self authManager) OIDCAuthCodeURL(state, redirectURL string) (string, error) {
+	if err := self.checkOIDCEnabled(); err != nil {
+		return "", err
+	}
+
+	return self.oidcClient.AuthCodeURL(state, redirectURL), nil
+}
+
+// OIDCLogin implements auth manager. See AuthManager interface for more information.
+func (self authManager) OIDCLogin(code, redirectURL string) (*authApi.AuthResponse, error) {
+	if err := self.checkOIDCEnabled(); err != nil {
+		return nil, err
+	}
+
+	idToken, err := self.oidcClient.Exchange(code, redirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.Login(&authApi.LoginSpec{IDToken: idToken})
+}
+
+func (self authManager) checkOIDCEnabled() error {
+	if !self.enabledAuthenticationModes().IsEnabled(authApi.OIDC) || self.oidcClient == nil {
+		return errors.NewInvalid("OIDC authentication is disabled. Check --authentication-mode argument for more information.")
+	}
+
+	return nil
+}
+
 // Returns authenticator based on provided LoginSpec.
 func (self authManager) getAuthenticator(spec *authApi.LoginSpec) (authApi.Authenticator, error) {
-	if len(self.authenticationModes) == 0 {
+	authenticationModes := self.enabledAuthenticationModes()
+	if len(authenticationModes) == 0 {
 		return nil, errors.NewInvalid("All authentication options disabled. Check --authentication-modes argument for more information.")
 	}
 
 	switch {
-	case len(spec.Token) > 0 && self.authenticationModes.IsEnabled(authApi.Token):
+	case len(spec.Token) > 0 && authenticationModes.IsEnabled(authApi.Token):
 		return NewTokenAuthenticator(spec), nil
-	case len(spec.Username) > 0 && len(spec.Password) > 0 && self.authenticationModes.IsEnabled(authApi.Basic):
+	case len(spec.Username) > 0 && len(spec.Password) > 0 && authenticationModes.IsEnabled(authApi.Basic):
 		return NewBasicAuthenticator(spec), nil
+	case len(spec.IDToken) > 0 && authenticationModes.IsEnabled(authApi.OIDC):
+		return NewOIDCAuthenticator(spec), nil
+	case len(spec.ClientCertificate) > 0 && len(spec.ClientKey) > 0 && authenticationModes.IsEnabled(authApi.X509):
+		return NewX509Authenticator(spec, self.apiserverCertPool), nil
 	case len(spec.KubeConfig) > 0:
-		return NewKubeConfigAuthenticator(spec, self.authenticationModes), nil
+		return NewKubeConfigAuthenticator(spec, authenticationModes), nil
 	}
 
 	return nil, errors.NewInvalid("Not enough data to create authenticator.")
 }
 
+// Returns authentication modes set in the settings config map or modes passed with --authentication-mode if settings
+// do not override them. Modes that require configuration provided on startup, i.e. OIDC client, can not be enabled
+// through settings if it is missing. Settings that would disable all modes are rejected, so it is always possible to
+// log in.
+func (self authManager) enabledAuthenticationModes() authApi.AuthenticationModes {
+	if self.settingsManager == nil {
+		return self.authenticationModes
+	}
+
+	modes := self.settingsManager.GetAuthenticationModes(self.clientManager.InsecureClient())
+	if modes == nil {
+		return self.authenticationModes
+	}
+
+	result := authApi.AuthenticationModes{}
+	for mode := range authApi.ToAuthenticationModes(modes) {
+		if self.isAuthenticationModeConfigured(mode) {
+			result.Add(mode)
+		}
+	}
+
+	if len(result) == 0 {
+		log.Printf("Rejecting authentication modes %v from settings as none of them can be enabled, using "+
+			"--authentication-mode instead", modes)
+		return self.authenticationModes
+	}
+
+	return result
+}
+
+// Returns true if configuration required by given authentication mode was provided on startup.
+func (self authManager) isAuthenticationModeConfigured(mode authApi.AuthenticationMode) bool {
+	switch mode {
+	case authApi.OIDC:
+		return self.oidcClient != nil
+	case authApi.X509:
+		return self.apiserverCertPool != nil
+	}
+
+	return true
+}
+
+// Returns authentication mode that was used to log in. Credentials extracted from kubeconfig file are treated as if
+// they were provided directly.
+func loginMode(spec *authApi.LoginSpec, authInfo api.AuthInfo) authApi.AuthenticationMode {
+	switch {
+	case len(spec.IDToken) > 0:
+		return authApi.OIDC
+	case len(authInfo.ClientCertificateData) > 0:
+		return authApi.X509
+	case len(authInfo.Token) > 0:
+		return authApi.Token
+	}
+
+	return authApi.Basic
+}
+
 // Checks if user data extracted from provided AuthInfo structure is valid and user is correctly authenticated
 // by K8S apiserver.
 func (self authManager) healthCheck(authInfo api.AuthInfo) error {
 	return self.clientManager.HasAccess(authInfo)
 }
 
-// NewAuthManager creates auth manager.
+// NewAuthManager creates auth manager. OIDC client is required only if OIDC authentication mode is enabled and
+// apiserver cert pool only if X509 authentication mode is enabled. Settings manager is optional, if it is given
+// authentication modes can be overridden in the settings config map.
 func NewAuthManager(clientManager clientapi.ClientManager, tokenManager authApi.TokenManager,
-	authenticationModes authApi.AuthenticationModes, authenticationSkippable bool) authApi.AuthManager {
+	authenticationModes authApi.AuthenticationModes, authenticationSkippable bool,
+	oidcClient authApi.OIDCClient, apiserverCertPool *x509.CertPool,
+	settingsManager settingsApi.SettingsManager) authApi.AuthManager {
 	return &authManager{
 		tokenManager:            tokenManager,
 		clientManager:           clientManager,
 		authenticationModes:     authenticationModes,
 		authenticationSkippable: authenticationSkippable,
+		oidcClient:              oidcClient,
+		apiserverCertPool:       apiserverCertPool,
+		settingsManager:         settingsManager,
 	}
 }
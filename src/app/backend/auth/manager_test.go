@@ -25,6 +25,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/client"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
 
 	pluginclientset "github.com/kubernetes/dashboard/src/app/backend/plugin/client/clientset/versioned"
 	v1 "k8s.io/api/authorization/v1"
@@ -60,6 +61,10 @@ func (self *fakeClientManager) InsecureClient() kubernetes.Interface {
 	return nil
 }
 
+func (self *fakeClientManager) InsecureConfig() *rest.Config {
+	return nil
+}
+
 func (self *fakeClientManager) InsecureAPIExtensionsClient() apiextensionsclientset.Interface {
 	return nil
 }
@@ -68,12 +73,24 @@ func (self *fakeClientManager) InsecurePluginClient() pluginclientset.Interface
 	return nil
 }
 
+func (self *fakeClientManager) Identity(req *restful.Request) string {
+	return ""
+}
+
+func (self *fakeClientManager) VerifyCredentials(req *restful.Request) error {
+	return nil
+}
+
 func (self *fakeClientManager) SetTokenManager(manager authApi.TokenManager) {}
 
 func (self *fakeClientManager) Config(req *restful.Request) (*rest.Config, error) {
 	return nil, nil
 }
 
+func (self *fakeClientManager) StreamingConfig(req *restful.Request) (*rest.Config, error) {
+	return nil, nil
+}
+
 func (self *fakeClientManager) ClientCmdConfig(req *restful.Request) (clientcmd.ClientConfig, error) {
 	return clientcmd.NewDefaultClientConfig(api.Config{}, &clientcmd.ConfigOverrides{}), nil
 }
@@ -105,6 +122,14 @@ func (self *fakeTokenManager) Refresh(string) (string, error) {
 
 func (self *fakeTokenManager) SetTokenTTL(time.Duration) {}
 
+func (self *fakeTokenManager) SetModeTokenTTL(authApi.AuthenticationMode, time.Duration) {}
+
+func (self *fakeTokenManager) SetMaxSessionLifetime(time.Duration) {}
+
+func (self *fakeTokenManager) GenerateForMode(mode authApi.AuthenticationMode, authInfo api.AuthInfo) (string, error) {
+	return self.Generate(authInfo)
+}
+
 func (self *fakeTokenManager) Generate(authInfo api.AuthInfo) (string, error) {
 	return self.GeneratedToken, self.Error
 }
@@ -156,7 +181,7 @@ func TestAuthManager_Login(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		authManager := NewAuthManager(c.cManager, c.tManager, authApi.AuthenticationModes{authApi.Token: true}, true)
+		authManager := NewAuthManager(c.cManager, c.tManager, authApi.AuthenticationModes{authApi.Token: true}, true, nil, nil, nil)
 		response, err := authManager.Login(c.spec)
 
 		if !areErrorsEqual(err, c.expectedErr) {
@@ -183,7 +208,7 @@ func TestAuthManager_AuthenticationModes(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		authManager := NewAuthManager(cManager, tManager, c.modes, true)
+		authManager := NewAuthManager(cManager, tManager, c.modes, true, nil, nil, nil)
 		got := authManager.AuthenticationModes()
 
 		if !reflect.DeepEqual(got, c.expected) {
@@ -192,16 +217,144 @@ func TestAuthManager_AuthenticationModes(t *testing.T) {
 	}
 }
 
+type fakeSettingsManager struct {
+	settingsApi.SettingsManager
+	authenticationModes []string
+}
+
+func (self *fakeSettingsManager) GetAuthenticationModes(client kubernetes.Interface) []string {
+	return self.authenticationModes
+}
+
+func TestAuthManager_AuthenticationModesFromSettings(t *testing.T) {
+	cManager := &fakeClientManager{}
+	tManager := &fakeTokenManager{}
+	flagModes := authApi.AuthenticationModes{authApi.Token: true, authApi.Basic: true}
+	cases := []struct {
+		info          string
+		settingsModes []string
+		oidcClient    authApi.OIDCClient
+		expected      authApi.AuthenticationModes
+	}{
+		{"Should use flag modes if settings do not override them", nil, nil, flagModes},
+		{"Should use modes from settings", []string{"token"}, nil, authApi.AuthenticationModes{authApi.Token: true}},
+		{"Should enable OIDC from settings if OIDC client is configured", []string{"token", "oidc"},
+			&fakeOIDCClient{}, authApi.AuthenticationModes{authApi.Token: true, authApi.OIDC: true}},
+		{"Should skip OIDC from settings if OIDC client is not configured", []string{"token", "oidc"}, nil,
+			authApi.AuthenticationModes{authApi.Token: true}},
+		{"Should reject empty modes from settings", []string{}, nil, flagModes},
+		{"Should reject settings without any valid mode", []string{"unknown", "x509"}, nil, flagModes},
+	}
+
+	for _, c := range cases {
+		authManager := NewAuthManager(cManager, tManager, flagModes, true, c.oidcClient, nil,
+			&fakeSettingsManager{authenticationModes: c.settingsModes})
+		got := authApi.AuthenticationModes{}
+		for _, mode := range authManager.AuthenticationModes() {
+			got.Add(mode)
+		}
+
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("Test Case: %s. Expected %v, but got %v.", c.info, c.expected, got)
+		}
+	}
+}
+
 func TestAuthManager_AuthenticationSkippable(t *testing.T) {
 	cManager := &fakeClientManager{}
 	tManager := &fakeTokenManager{}
 	cModes := authApi.AuthenticationModes{}
 
 	for _, flag := range []bool{true, false} {
-		authManager := NewAuthManager(cManager, tManager, cModes, flag)
+		authManager := NewAuthManager(cManager, tManager, cModes, flag, nil, nil, nil)
 		got := authManager.AuthenticationSkippable()
 		if got != flag {
 			t.Errorf("Expected %v, but got %v.", flag, got)
 		}
 	}
 }
+
+type fakeOIDCClient struct {
+	IDToken string
+	Error   error
+}
+
+func (self *fakeOIDCClient) AuthCodeURL(state, redirectURL string) string {
+	return "https://issuer/auth?state=" + state
+}
+
+func (self *fakeOIDCClient) Exchange(code, redirectURL string) (string, error) {
+	return self.IDToken, self.Error
+}
+
+func TestAuthManager_OIDCLogin(t *testing.T) {
+	cManager := &fakeClientManager{}
+	tManager := &fakeTokenManager{GeneratedToken: "jwe-token"}
+
+	cases := []struct {
+		info        string
+		modes       authApi.AuthenticationModes
+		oidcClient  authApi.OIDCClient
+		expected    *authApi.AuthResponse
+		expectedErr error
+	}{
+		{
+			"OIDC login should fail when OIDC mode is disabled",
+			authApi.AuthenticationModes{authApi.Token: true},
+			&fakeOIDCClient{IDToken: "id-token"},
+			nil,
+			errors.NewInvalid("OIDC authentication is disabled. Check --authentication-mode argument for more information."),
+		}, {
+			"OIDC login should fail when code exchange fails",
+			authApi.AuthenticationModes{authApi.OIDC: true},
+			&fakeOIDCClient{Error: errors.NewUnauthorized("Unauthorized")},
+			nil,
+			errors.NewUnauthorized("Unauthorized"),
+		}, {
+			"OIDC login should generate token based on ID token",
+			authApi.AuthenticationModes{authApi.OIDC: true},
+			&fakeOIDCClient{IDToken: "id-token"},
+			&authApi.AuthResponse{JWEToken: "jwe-token", Errors: make([]error, 0)},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		authManager := NewAuthManager(cManager, tManager, c.modes, true, c.oidcClient, nil, nil)
+		response, err := authManager.OIDCLogin("code", "https://dashboard/callback")
+
+		if !areErrorsEqual(err, c.expectedErr) {
+			t.Errorf("Test Case: %s. Expected error to be: %v, but got %v.",
+				c.info, c.expectedErr, err)
+		}
+
+		if !reflect.DeepEqual(response, c.expected) {
+			t.Errorf("Test Case: %s. Expected response to be: %v, but got %v.",
+				c.info, c.expected, response)
+		}
+	}
+}
+
+func TestLoginMode(t *testing.T) {
+	cases := []struct {
+		info     string
+		spec     *authApi.LoginSpec
+		authInfo api.AuthInfo
+		expected authApi.AuthenticationMode
+	}{
+		{"Token login", &authApi.LoginSpec{Token: "test"}, api.AuthInfo{Token: "test"}, authApi.Token},
+		{"Basic login", &authApi.LoginSpec{Username: "user", Password: "pass"},
+			api.AuthInfo{Username: "user", Password: "pass"}, authApi.Basic},
+		{"OIDC login", &authApi.LoginSpec{IDToken: "test"}, api.AuthInfo{Token: "test"}, authApi.OIDC},
+		{"X509 login", &authApi.LoginSpec{ClientCertificate: "cert", ClientKey: "key"},
+			api.AuthInfo{ClientCertificateData: []byte("cert"), ClientKeyData: []byte("key")}, authApi.X509},
+		{"Kubeconfig login with token", &authApi.LoginSpec{KubeConfig: "config"}, api.AuthInfo{Token: "test"},
+			authApi.Token},
+	}
+
+	for _, c := range cases {
+		if actual := loginMode(c.spec, c.authInfo); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected mode %s, but got %s.", c.info, c.expected, actual)
+		}
+	}
+}
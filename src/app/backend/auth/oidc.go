@@ -0,0 +1,214 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+const (
+	// Path appended to the issuer URL to get the OpenID Connect provider configuration.
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	// Scope that has to be always requested in order to receive an ID token.
+	oidcScope = "openid"
+	// Name of the token response field that contains an ID token.
+	oidcIDTokenField = "id_token"
+	// Timeout of the requests sent to the OpenID Connect provider.
+	oidcRequestTimeout = 30 * time.Second
+)
+
+// Client used for all requests sent to the OpenID Connect provider, so that slow provider can not block the startup
+// nor the login forever.
+var oidcHTTPClient = &http.Client{Timeout: oidcRequestTimeout}
+
+// oidcProviderConfig is a subset of the OpenID Connect discovery document required by the authorization code flow.
+type oidcProviderConfig struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+}
+
+// Implements OIDCClient interface
+type oidcClient struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	endpoint     oauth2.Endpoint
+}
+
+// AuthCodeURL implements OIDCClient interface. See OIDCClient for more information.
+func (self *oidcClient) AuthCodeURL(state, redirectURL string) string {
+	return self.config(redirectURL).AuthCodeURL(state)
+}
+
+// Exchange implements OIDCClient interface. See OIDCClient for more information. ID token is received directly from
+// the token endpoint, so its signature is verified by the apiserver, but it is rejected early if it was not issued
+// by the discovered issuer for this client.
+func (self *oidcClient) Exchange(code, redirectURL string) (string, error) {
+	ctx := context.WithValue(context.TODO(), oauth2.HTTPClient, oidcHTTPClient)
+	token, err := self.config(redirectURL).Exchange(ctx, code)
+	if err != nil {
+		return "", err
+	}
+
+	idToken, ok := token.Extra(oidcIDTokenField).(string)
+	if !ok || len(idToken) == 0 {
+		return "", errors.NewUnauthorized("OIDC provider did not return an ID token")
+	}
+
+	if err := self.validateIDToken(idToken); err != nil {
+		return "", err
+	}
+
+	return idToken, nil
+}
+
+// Checks issuer, audience and expiration time of the ID token.
+func (self *oidcClient) validateIDToken(idToken string) error {
+	parsed, err := jwt.ParseSigned(idToken)
+	if err != nil {
+		return errors.NewUnauthorized(fmt.Sprintf("OIDC provider returned invalid ID token: %s", err))
+	}
+
+	claims := jwt.Claims{}
+	if err := parsed.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return errors.NewUnauthorized(fmt.Sprintf("OIDC provider returned invalid ID token: %s", err))
+	}
+
+	expected := jwt.Expected{Issuer: self.issuer, Audience: jwt.Audience{self.clientID}, Time: time.Now()}
+	if err := claims.Validate(expected); err != nil {
+		return errors.NewUnauthorized(fmt.Sprintf("OIDC provider returned invalid ID token: %s", err))
+	}
+
+	return nil
+}
+
+func (self *oidcClient) config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     self.clientID,
+		ClientSecret: self.clientSecret,
+		Endpoint:     self.endpoint,
+		RedirectURL:  redirectURL,
+		Scopes:       self.scopes,
+	}
+}
+
+// Implements Authenticator interface
+type oidcAuthenticator struct {
+	idToken string
+}
+
+// GetAuthInfo implements Authenticator interface. See Authenticator for more information. ID token is used
+// as a bearer token, so apiserver has to be configured with the same OIDC issuer to accept it.
+func (self oidcAuthenticator) GetAuthInfo() (api.AuthInfo, error) {
+	return api.AuthInfo{
+		Token: self.idToken,
+	}, nil
+}
+
+// NewOIDCAuthenticator returns Authenticator based on LoginSpec.
+func NewOIDCAuthenticator(spec *authApi.LoginSpec) authApi.Authenticator {
+	return &oidcAuthenticator{
+		idToken: spec.IDToken,
+	}
+}
+
+// NewOIDCClient creates OIDC client based on the provider configuration discovered from given issuer URL.
+func NewOIDCClient(issuerURL, clientID, clientSecret string, scopes []string) (authApi.OIDCClient, error) {
+	providerConfig, err := discoverOIDCProvider(issuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oidcClient{
+		issuer:       providerConfig.Issuer,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		scopes:       withOIDCScope(scopes),
+		endpoint: oauth2.Endpoint{
+			AuthURL:  providerConfig.AuthorizationEndpoint,
+			TokenURL: providerConfig.TokenEndpoint,
+		},
+	}, nil
+}
+
+// Issuer of the discovered configuration has to match given issuer URL, trailing slash is ignored.
+func discoverOIDCProvider(issuerURL string) (*oidcProviderConfig, error) {
+	response, err := oidcHTTPClient.Get(strings.TrimSuffix(issuerURL, "/") + oidcDiscoveryPath)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not discover OIDC provider configuration, status code: %d", response.StatusCode)
+	}
+
+	providerConfig := new(oidcProviderConfig)
+	if err := json.NewDecoder(response.Body).Decode(providerConfig); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(providerConfig.Issuer, "/") != strings.TrimSuffix(issuerURL, "/") {
+		return nil, fmt.Errorf("OIDC provider configuration of %s belongs to a different issuer: %s", issuerURL,
+			providerConfig.Issuer)
+	}
+
+	if len(providerConfig.AuthorizationEndpoint) == 0 || len(providerConfig.TokenEndpoint) == 0 {
+		return nil, fmt.Errorf("OIDC provider configuration of %s is missing authorization or token endpoint", issuerURL)
+	}
+
+	return providerConfig, nil
+}
+
+// ValidateOIDCRedirectURL checks that redirect URL of the OIDC callback is an absolute HTTP or HTTPS URL. It is never
+// derived from the request, so that the host header can not change where the provider sends the authorization code.
+func ValidateOIDCRedirectURL(redirectURL string) error {
+	if len(redirectURL) == 0 {
+		return fmt.Errorf("--oidc-redirect-url is required when 'oidc' authentication mode is enabled")
+	}
+
+	parsed, err := url.Parse(redirectURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || len(parsed.Host) == 0 {
+		return fmt.Errorf("--oidc-redirect-url has to be an absolute HTTP or HTTPS URL, got %q", redirectURL)
+	}
+
+	return nil
+}
+
+// Makes sure that 'openid' scope is always requested, as otherwise provider will not return an ID token.
+func withOIDCScope(scopes []string) []string {
+	for _, scope := range scopes {
+		if scope == oidcScope {
+			return scopes
+		}
+	}
+
+	return append([]string{oidcScope}, scopes...)
+}
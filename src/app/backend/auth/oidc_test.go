@@ -0,0 +1,130 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func TestDiscoverOIDCProvider(t *testing.T) {
+	var issuer string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/valid" + oidcDiscoveryPath:
+			fmt.Fprintf(w, `{"issuer":"%s/valid","authorization_endpoint":"%s/auth","token_endpoint":"%s/token"}`,
+				issuer, issuer, issuer)
+		case "/other" + oidcDiscoveryPath:
+			fmt.Fprintf(w, `{"issuer":"%s/valid","authorization_endpoint":"%s/auth","token_endpoint":"%s/token"}`,
+				issuer, issuer, issuer)
+		case "/incomplete" + oidcDiscoveryPath:
+			fmt.Fprintf(w, `{"issuer":"%s/incomplete"}`, issuer)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	issuer = server.URL
+
+	cases := []struct {
+		info        string
+		path        string
+		expectedErr bool
+	}{
+		{"Should discover configuration of the issuer", "/valid", false},
+		{"Should ignore trailing slash of the issuer URL", "/valid/", false},
+		{"Should reject configuration of a different issuer", "/other", true},
+		{"Should reject configuration without endpoints", "/incomplete", true},
+		{"Should reject missing configuration", "/missing", true},
+	}
+
+	for _, c := range cases {
+		config, err := discoverOIDCProvider(server.URL + c.path)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error %t, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if err == nil && config.TokenEndpoint != server.URL+"/token" {
+			t.Errorf("Test Case: %s. Expected token endpoint %s/token, but got %s.", c.info, server.URL,
+				config.TokenEndpoint)
+		}
+	}
+}
+
+func TestOIDCClient_ValidateIDToken(t *testing.T) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &oidcClient{issuer: "https://issuer", clientID: "dashboard"}
+	expiry := jwt.NewNumericDate(time.Now().Add(time.Hour))
+
+	cases := []struct {
+		info        string
+		claims      jwt.Claims
+		expectedErr bool
+	}{
+		{"Should accept token of the issuer for the client",
+			jwt.Claims{Issuer: "https://issuer", Audience: jwt.Audience{"dashboard"}, Expiry: expiry}, false},
+		{"Should accept token with multiple audiences",
+			jwt.Claims{Issuer: "https://issuer", Audience: jwt.Audience{"other", "dashboard"}, Expiry: expiry}, false},
+		{"Should reject token of other issuer",
+			jwt.Claims{Issuer: "https://other", Audience: jwt.Audience{"dashboard"}, Expiry: expiry}, true},
+		{"Should reject token for other client",
+			jwt.Claims{Issuer: "https://issuer", Audience: jwt.Audience{"other"}, Expiry: expiry}, true},
+		{"Should reject expired token", jwt.Claims{Issuer: "https://issuer", Audience: jwt.Audience{"dashboard"},
+			Expiry: jwt.NewNumericDate(time.Now().Add(-time.Hour))}, true},
+	}
+
+	for _, c := range cases {
+		idToken, err := jwt.Signed(signer).Claims(c.claims).CompactSerialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := client.validateIDToken(idToken); (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error %t, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+
+	if err := client.validateIDToken("invalid"); err == nil {
+		t.Error("Expected malformed ID token to be rejected.")
+	}
+}
+
+func TestValidateOIDCRedirectURL(t *testing.T) {
+	cases := []struct {
+		redirectURL string
+		expectedErr bool
+	}{
+		{"https://dashboard.example.com/api/v1/login/oidc/callback", false},
+		{"http://localhost:9090/api/v1/login/oidc/callback", false},
+		{"", true},
+		{"/api/v1/login/oidc/callback", true},
+		{"ftp://dashboard.example.com/callback", true},
+	}
+
+	for _, c := range cases {
+		if err := ValidateOIDCRedirectURL(c.redirectURL); (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %q. Expected error %t, but got %v.", c.redirectURL, c.expectedErr, err)
+		}
+	}
+}
@@ -26,6 +26,10 @@ type tokenAuthenticator struct {
 
 // GetAuthInfo implements Authenticator interface. See Authenticator for more information.
 func (self tokenAuthenticator) GetAuthInfo() (api.AuthInfo, error) {
+	if err := validateRequiredClaims(self.token); err != nil {
+		return api.AuthInfo{}, err
+	}
+
 	return api.AuthInfo{
 		Token: self.token,
 	}, nil
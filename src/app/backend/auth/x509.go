@@ -0,0 +1,114 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Implements Authenticator interface
+type x509Authenticator struct {
+	certificate string
+	key         string
+	roots       *x509.CertPool
+}
+
+// GetAuthInfo implements Authenticator interface. See Authenticator for more information. Certificate chain is
+// verified against given CA pool before it is handed over to the apiserver. Certificate and key are stored in the
+// generated JWE token as they are, so the session is bounded only by the token TTL and not by the certificate expiry.
+func (self *x509Authenticator) GetAuthInfo() (api.AuthInfo, error) {
+	keyPair, err := tls.X509KeyPair([]byte(self.certificate), []byte(self.key))
+	if err != nil {
+		return api.AuthInfo{}, errors.NewInvalid(fmt.Sprintf("Invalid client certificate or key: %s", err.Error()))
+	}
+
+	if err = self.verify(keyPair); err != nil {
+		return api.AuthInfo{}, errors.NewUnauthorized(fmt.Sprintf("Client certificate verification failed: %s", err.Error()))
+	}
+
+	return api.AuthInfo{
+		ClientCertificateData: []byte(self.certificate),
+		ClientKeyData:         []byte(self.key),
+	}, nil
+}
+
+func (self *x509Authenticator) verify(keyPair tls.Certificate) error {
+	if self.roots == nil {
+		return fmt.Errorf("apiserver CA is unknown")
+	}
+
+	certificates := make([]*x509.Certificate, 0, len(keyPair.Certificate))
+	for _, raw := range keyPair.Certificate {
+		certificate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         self.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+// NewX509Authenticator returns Authenticator based on LoginSpec. Given CA pool is used to verify client certificate.
+func NewX509Authenticator(spec *authApi.LoginSpec, roots *x509.CertPool) authApi.Authenticator {
+	return &x509Authenticator{
+		certificate: spec.ClientCertificate,
+		key:         spec.ClientKey,
+		roots:       roots,
+	}
+}
+
+// NewAPIServerCertPool returns pool with the CA certificates of the apiserver described by given config. Error is
+// returned if the config does not contain any CA.
+func NewAPIServerCertPool(config *rest.Config) (*x509.CertPool, error) {
+	caData := config.TLSClientConfig.CAData
+	if len(caData) == 0 && len(config.TLSClientConfig.CAFile) > 0 {
+		data, err := ioutil.ReadFile(config.TLSClientConfig.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		caData = data
+	}
+
+	if len(caData) == 0 {
+		return nil, fmt.Errorf("CA of apiserver %s could not be determined", config.Host)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caData) {
+		return nil, fmt.Errorf("CA of apiserver %s does not contain any valid PEM encoded certificate", config.Host)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPEM     string
+	keyPEM      string
+}
+
+func newTestCertificate(t *testing.T, template *x509.Certificate, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.certificate, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPEM:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:      string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+func newTestCA(t *testing.T, serial int64) *testCertificate {
+	return newTestCertificate(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil)
+}
+
+func newTestClientCertificate(t *testing.T, ca *testCertificate, usage x509.ExtKeyUsage) *testCertificate {
+	return newTestCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(100),
+		Subject:      pkix.Name{CommonName: "test-user", Organization: []string{"test-group"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}, ca)
+}
+
+func TestX509Authenticator_GetAuthInfo(t *testing.T) {
+	ca := newTestCA(t, 1)
+	otherCA := newTestCA(t, 2)
+	client := newTestClientCertificate(t, ca, x509.ExtKeyUsageClientAuth)
+	server := newTestClientCertificate(t, ca, x509.ExtKeyUsageServerAuth)
+	untrusted := newTestClientCertificate(t, otherCA, x509.ExtKeyUsageClientAuth)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.certificate)
+
+	cases := []struct {
+		info        string
+		spec        *authApi.LoginSpec
+		roots       *x509.CertPool
+		expectedErr bool
+	}{
+		{"Certificate signed by apiserver CA should be accepted",
+			&authApi.LoginSpec{ClientCertificate: client.certPEM, ClientKey: client.keyPEM}, roots, false},
+		{"Certificate signed by other CA should be rejected",
+			&authApi.LoginSpec{ClientCertificate: untrusted.certPEM, ClientKey: untrusted.keyPEM}, roots, true},
+		{"Certificate without client auth usage should be rejected",
+			&authApi.LoginSpec{ClientCertificate: server.certPEM, ClientKey: server.keyPEM}, roots, true},
+		{"Certificate with not matching key should be rejected",
+			&authApi.LoginSpec{ClientCertificate: client.certPEM, ClientKey: untrusted.keyPEM}, roots, true},
+		{"Malformed certificate should be rejected",
+			&authApi.LoginSpec{ClientCertificate: "test", ClientKey: "test"}, roots, true},
+		{"Certificate should be rejected if apiserver CA is unknown",
+			&authApi.LoginSpec{ClientCertificate: client.certPEM, ClientKey: client.keyPEM}, nil, true},
+	}
+
+	for _, c := range cases {
+		authInfo, err := NewX509Authenticator(c.spec, c.roots).GetAuthInfo()
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+			continue
+		}
+
+		if err == nil && (string(authInfo.ClientCertificateData) != c.spec.ClientCertificate ||
+			string(authInfo.ClientKeyData) != c.spec.ClientKey) {
+			t.Errorf("Test Case: %s. Expected auth info to contain client certificate and key.", c.info)
+		}
+	}
+}
+
+func TestNewAPIServerCertPool(t *testing.T) {
+	ca := newTestCA(t, 1)
+
+	cases := []struct {
+		info        string
+		config      *rest.Config
+		expectedErr bool
+	}{
+		{"CA data should be used",
+			&rest.Config{TLSClientConfig: rest.TLSClientConfig{CAData: []byte(ca.certPEM)}}, false},
+		{"Missing CA should return an error", &rest.Config{}, true},
+		{"Invalid CA data should return an error",
+			&rest.Config{TLSClientConfig: rest.TLSClientConfig{CAData: []byte("test")}}, true},
+		{"Not existing CA file should return an error",
+			&rest.Config{TLSClientConfig: rest.TLSClientConfig{CAFile: "/not/existing/ca.crt"}}, true},
+	}
+
+	for _, c := range cases {
+		_, err := NewAPIServerCertPool(c.config)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+}
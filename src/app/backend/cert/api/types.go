@@ -14,7 +14,10 @@
 
 package api
 
-import "crypto/tls"
+import (
+	"crypto/tls"
+	"net"
+)
 
 const (
 	// DashboardCertName is the certificate file names that will be generated by Dashboard
@@ -30,6 +33,16 @@ type Manager interface {
 	GetCertificates() (tls.Certificate, error)
 }
 
+// Reloader is responsible for serving certificate loaded from files and reloading it at runtime whenever the files
+// change, i.e. when certificates are rotated.
+type Reloader interface {
+	// GetCertificate returns currently served certificate. It can be used as tls.Config GetCertificate callback.
+	GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
+	// Watch starts watching certificate files in the background. Certificate is swapped only if the new key pair
+	// is valid, otherwise previously loaded certificate is served.
+	Watch() error
+}
+
 // Creator is responsible for preparing and generating certificates.
 type Creator interface {
 	// GenerateKey generates certificate key
@@ -44,4 +57,6 @@ type Creator interface {
 	GetKeyFileName() string
 	// GetCertFileName returns certificate file name
 	GetCertFileName() string
+	// GetSubjectAltNames returns DNS names and IP addresses that should be embedded in the generated certificate
+	GetSubjectAltNames() (dnsNames []string, ipAddresses []net.IP)
 }
@@ -26,6 +26,7 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"sort"
 	"time"
 
 	certapi "github.com/kubernetes/dashboard/src/app/backend/cert/api"
@@ -38,6 +39,7 @@ type ecdsaCreator struct {
 	keyFile  string
 	certFile string
 	curve    elliptic.Curve
+	sans     []string
 }
 
 // GenerateKey implements certificate Creator interface. See Creator for more information.
@@ -65,15 +67,14 @@ func (self *ecdsaCreator) GenerateCertificate(key interface{}) []byte {
 		NotBefore:    notBefore,
 	}
 
+	template.DNSNames, template.IPAddresses = self.GetSubjectAltNames()
 	if len(pod.Name) > 0 && len(pod.Namespace) > 0 {
 		podDomainName := pod.Name + "." + pod.Namespace
 		template.Subject = pkix.Name{CommonName: podDomainName}
 		template.Issuer = pkix.Name{CommonName: podDomainName}
-		template.DNSNames = []string{podDomainName}
-	}
-
-	if len(pod.Status.PodIP) > 0 {
-		template.IPAddresses = []net.IP{net.ParseIP(pod.Status.PodIP)}
+	} else if len(template.DNSNames) > 0 {
+		template.Subject = pkix.Name{CommonName: template.DNSNames[0]}
+		template.Issuer = pkix.Name{CommonName: template.DNSNames[0]}
 	}
 
 	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &ecdsaKey.PublicKey, ecdsaKey)
@@ -98,6 +99,47 @@ func (self *ecdsaCreator) StoreCertificates(path string, key interface{}, certBy
 	}
 }
 
+// GetSubjectAltNames implements certificate Creator interface. See Creator for more information.
+func (self *ecdsaCreator) GetSubjectAltNames() ([]string, []net.IP) {
+	pod := self.getDashboardPod()
+	dnsNames := make([]string, 0)
+	ipAddresses := make([]net.IP, 0)
+	seen := map[string]bool{}
+
+	add := func(name string) {
+		if len(name) == 0 || seen[name] {
+			return
+		}
+		seen[name] = true
+
+		if ip := net.ParseIP(name); ip != nil {
+			ipAddresses = append(ipAddresses, ip)
+		} else {
+			dnsNames = append(dnsNames, name)
+		}
+	}
+
+	if len(pod.Name) > 0 && len(pod.Namespace) > 0 {
+		add(pod.Name + "." + pod.Namespace)
+	}
+
+	if serviceName := os.Getenv("SERVICE_NAME"); len(serviceName) > 0 {
+		add(serviceName)
+		if len(pod.Namespace) > 0 {
+			add(serviceName + "." + pod.Namespace)
+			add(serviceName + "." + pod.Namespace + ".svc")
+		}
+	}
+
+	add(pod.Status.PodIP)
+	for _, san := range self.sans {
+		add(san)
+	}
+
+	sort.Strings(dnsNames)
+	return dnsNames, ipAddresses
+}
+
 func (self *ecdsaCreator) KeyCertPEMBytes(key interface{}, certBytes []byte) ([]byte, []byte, error) {
 	marshaledKey, err := x509.MarshalECPrivateKey(self.getKey(key))
 	if err != nil {
@@ -164,12 +206,14 @@ func (self *ecdsaCreator) init() {
 	}
 }
 
-// NewECDSACreator creates ECDSACreator instance.
-func NewECDSACreator(keyFile, certFile string, curve elliptic.Curve) certapi.Creator {
+// NewECDSACreator creates ECDSACreator instance. Additional DNS names and IP addresses from sans are embedded in
+// the generated certificate next to the names discovered from the environment.
+func NewECDSACreator(keyFile, certFile string, curve elliptic.Curve, sans []string) certapi.Creator {
 	creator := &ecdsaCreator{
 		curve:    curve,
 		keyFile:  keyFile,
 		certFile: certFile,
+		sans:     sans,
 	}
 
 	creator.init()
@@ -16,6 +16,9 @@ package ecdsa_test
 
 import (
 	"crypto/elliptic"
+	"net"
+	"os"
+	"reflect"
 	"testing"
 
 	"github.com/kubernetes/dashboard/src/app/backend/cert/ecdsa"
@@ -24,7 +27,7 @@ import (
 func TestNewECDSACreator(t *testing.T) {
 	keyFile := "cert.key"
 	certFile := "cert.crt"
-	creator := ecdsa.NewECDSACreator(keyFile, certFile, elliptic.P256())
+	creator := ecdsa.NewECDSACreator(keyFile, certFile, elliptic.P256(), nil)
 
 	if creator == nil {
 		t.Fatal("Expected creator not to be nil.")
@@ -34,7 +37,7 @@ func TestNewECDSACreator(t *testing.T) {
 func TestEcdsaCreator_GetCertFileName(t *testing.T) {
 	keyFile := "cert.key"
 	certFile := "cert.crt"
-	creator := ecdsa.NewECDSACreator(keyFile, certFile, elliptic.P256())
+	creator := ecdsa.NewECDSACreator(keyFile, certFile, elliptic.P256(), nil)
 
 	if creator.GetCertFileName() != certFile {
 		t.Fatalf("Expected cert file name to equal %s but got %s.", certFile, creator.GetCertFileName())
@@ -44,9 +47,36 @@ func TestEcdsaCreator_GetCertFileName(t *testing.T) {
 func TestEcdsaCreator_GetKeyFileName(t *testing.T) {
 	keyFile := "cert.key"
 	certFile := "cert.crt"
-	creator := ecdsa.NewECDSACreator(keyFile, certFile, elliptic.P256())
+	creator := ecdsa.NewECDSACreator(keyFile, certFile, elliptic.P256(), nil)
 
 	if creator.GetKeyFileName() != keyFile {
 		t.Fatalf("Expected cert key file name to equal %s but got %s.", keyFile, creator.GetKeyFileName())
 	}
 }
+
+func TestEcdsaCreator_GetSubjectAltNames(t *testing.T) {
+	os.Setenv("POD_NAME", "dashboard")
+	os.Setenv("POD_NAMESPACE", "kube-system")
+	os.Setenv("POD_IP", "10.0.0.1")
+	os.Setenv("SERVICE_NAME", "kubernetes-dashboard")
+	defer func() {
+		for _, name := range []string{"POD_NAME", "POD_NAMESPACE", "POD_IP", "SERVICE_NAME"} {
+			os.Unsetenv(name)
+		}
+	}()
+
+	creator := ecdsa.NewECDSACreator("cert.key", "cert.crt", elliptic.P256(),
+		[]string{"dashboard.example.com", "192.168.0.1", "10.0.0.1"})
+	dnsNames, ipAddresses := creator.GetSubjectAltNames()
+
+	expectedDNSNames := []string{"dashboard.example.com", "dashboard.kube-system", "kubernetes-dashboard",
+		"kubernetes-dashboard.kube-system", "kubernetes-dashboard.kube-system.svc"}
+	if !reflect.DeepEqual(dnsNames, expectedDNSNames) {
+		t.Errorf("Expected DNS names %v, but got %v.", expectedDNSNames, dnsNames)
+	}
+
+	expectedIPs := []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("192.168.0.1")}
+	if !reflect.DeepEqual(ipAddresses, expectedIPs) {
+		t.Errorf("Expected IP addresses %v, but got %v.", expectedIPs, ipAddresses)
+	}
+}
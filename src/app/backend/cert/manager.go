@@ -16,7 +16,9 @@ package cert
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"log"
+	"net"
 	"os"
 
 	certapi "github.com/kubernetes/dashboard/src/app/backend/cert/api"
@@ -31,11 +33,16 @@ type Manager struct {
 // GetCertificates implements Manager interface. See Manager for more information.
 func (self *Manager) GetCertificates() (tls.Certificate, error) {
 	if self.keyFileExists() && self.certFileExists() {
-		log.Println("Certificates already exist. Returning.")
-		return tls.LoadX509KeyPair(
+		certificate, err := tls.LoadX509KeyPair(
 			self.path(self.creator.GetCertFileName()),
 			self.path(self.creator.GetKeyFileName()),
 		)
+		if err != nil || self.matchesSubjectAltNames(certificate) {
+			log.Println("Certificates already exist. Returning.")
+			return certificate, err
+		}
+
+		log.Println("Subject alternative names of existing certificates changed. Regenerating.")
 	}
 
 	key := self.creator.GenerateKey()
@@ -48,6 +55,52 @@ func (self *Manager) GetCertificates() (tls.Certificate, error) {
 	return tls.X509KeyPair(certPEM, keyPEM)
 }
 
+// Checks if certificate contains exactly the subject alternative names that creator would embed in the new one.
+func (self *Manager) matchesSubjectAltNames(certificate tls.Certificate) bool {
+	if len(certificate.Certificate) == 0 {
+		return false
+	}
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return false
+	}
+
+	dnsNames, ipAddresses := self.creator.GetSubjectAltNames()
+	return sameNames(leaf.DNSNames, dnsNames) && sameIPs(leaf.IPAddresses, ipAddresses)
+}
+
+func sameNames(actual, expected []string) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+
+	names := make(map[string]bool, len(actual))
+	for _, name := range actual {
+		names[name] = true
+	}
+
+	for _, name := range expected {
+		if !names[name] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameIPs(actual, expected []net.IP) bool {
+	toNames := func(ips []net.IP) []string {
+		names := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			names = append(names, ip.String())
+		}
+		return names
+	}
+
+	return sameNames(toNames(actual), toNames(expected))
+}
+
 func (self *Manager) keyFileExists() bool {
 	return self.exists(self.path(self.creator.GetKeyFileName()))
 }
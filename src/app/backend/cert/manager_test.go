@@ -0,0 +1,73 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"crypto/elliptic"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/cert/ecdsa"
+)
+
+func TestManager_GetCertificates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stored := ecdsa.NewECDSACreator("tls.key", "tls.crt", elliptic.P256(), []string{"dashboard.example.com"})
+	key := stored.GenerateKey()
+	stored.StoreCertificates(dir, key, stored.GenerateCertificate(key))
+
+	cases := []struct {
+		info             string
+		sans             []string
+		expectedDNSNames []string
+		expectedIPs      []string
+	}{
+		{"Existing certificate should be returned if SANs did not change", []string{"dashboard.example.com"},
+			[]string{"dashboard.example.com"}, nil},
+		{"Certificate should be regenerated if SANs changed", []string{"dashboard.example.com", "10.0.0.1"},
+			[]string{"dashboard.example.com"}, []string{"10.0.0.1"}},
+	}
+
+	for _, c := range cases {
+		creator := ecdsa.NewECDSACreator("tls.key", "tls.crt", elliptic.P256(), c.sans)
+		certificate, err := NewCertManager(creator, dir).GetCertificates()
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %s", c.info, err)
+		}
+
+		leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %s", c.info, err)
+		}
+
+		var ips []string
+		for _, ip := range leaf.IPAddresses {
+			ips = append(ips, ip.String())
+		}
+
+		if !reflect.DeepEqual(leaf.DNSNames, c.expectedDNSNames) || !reflect.DeepEqual(ips, c.expectedIPs) {
+			t.Errorf("Test Case: %s. Expected SANs %v %v, but got %v %v", c.info, c.expectedDNSNames, c.expectedIPs,
+				leaf.DNSNames, ips)
+		}
+	}
+}
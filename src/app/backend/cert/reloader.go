@@ -0,0 +1,139 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"crypto/tls"
+	"log"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	certapi "github.com/kubernetes/dashboard/src/app/backend/cert/api"
+)
+
+// Reloader is used to implement cert/api/types.Reloader interface. See Reloader for more information.
+type Reloader struct {
+	certFile string
+	keyFile  string
+
+	mutex sync.RWMutex
+	cert  *tls.Certificate
+}
+
+// GetCertificate implements Reloader interface. See Reloader for more information.
+func (self *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	self.mutex.RLock()
+	defer self.mutex.RUnlock()
+	return self.cert, nil
+}
+
+// Watch implements Reloader interface. See Reloader for more information.
+func (self *Reloader) Watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	// Directories are watched instead of files, because mounted secrets are updated by swapping symlinks and
+	// in such case watch on the file itself is lost.
+	for _, dir := range self.dirs() {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return err
+		}
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+					continue
+				}
+
+				if err := self.reload(); err != nil {
+					log.Printf("Could not reload certificates, serving previous ones. Reason: %s", err)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+
+				log.Printf("Error while watching certificate files: %s", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Loads key pair from the files and swaps it with currently served one only if it is valid.
+func (self *Reloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(self.certFile, self.keyFile)
+	if err != nil {
+		return err
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	if self.cert != nil && certificatesEqual(self.cert, &cert) {
+		return nil
+	}
+
+	self.cert = &cert
+	log.Printf("Successfully reloaded certificates from %s and %s", self.certFile, self.keyFile)
+	return nil
+}
+
+func (self *Reloader) dirs() []string {
+	certDir := filepath.Dir(self.certFile)
+	keyDir := filepath.Dir(self.keyFile)
+	if certDir == keyDir {
+		return []string{certDir}
+	}
+
+	return []string{certDir, keyDir}
+}
+
+func certificatesEqual(a, b *tls.Certificate) bool {
+	if len(a.Certificate) != len(b.Certificate) {
+		return false
+	}
+
+	for i := range a.Certificate {
+		if string(a.Certificate[i]) != string(b.Certificate[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewCertReloader creates Reloader object and loads initial key pair from given files.
+func NewCertReloader(certFile, keyFile string) (certapi.Reloader, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reloader{certFile: certFile, keyFile: keyFile, cert: &cert}, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"crypto/elliptic"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/cert/ecdsa"
+)
+
+func storeCertificates(t *testing.T, dir string) {
+	creator := ecdsa.NewECDSACreator("tls.key", "tls.crt", elliptic.P256(), nil)
+	key := creator.GenerateKey()
+	creator.StoreCertificates(dir, key, creator.GenerateCertificate(key))
+}
+
+func TestReloader_reload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	storeCertificates(t, dir)
+
+	certReloader, err := NewCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("Expected reloader to be created, but got error: %s", err)
+	}
+
+	reloader := certReloader.(*Reloader)
+	initial, _ := reloader.GetCertificate(nil)
+
+	if err := ioutil.WriteFile(certFile, []byte("invalid"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reloader.reload(); err == nil {
+		t.Error("Expected reload of invalid key pair to fail.")
+	}
+
+	if got, _ := reloader.GetCertificate(nil); got != initial {
+		t.Error("Expected previous certificate to be served after failed reload.")
+	}
+
+	storeCertificates(t, dir)
+	if err := reloader.reload(); err != nil {
+		t.Fatalf("Expected reload to succeed, but got error: %s", err)
+	}
+
+	if got, _ := reloader.GetCertificate(nil); got == initial || certificatesEqual(got, initial) {
+		t.Error("Expected new certificate to be served after successful reload.")
+	}
+}
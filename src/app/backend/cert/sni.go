@@ -0,0 +1,113 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"crypto/tls"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	certapi "github.com/kubernetes/dashboard/src/app/backend/cert/api"
+)
+
+// SNICertificate is a key pair served to clients that request given host name with SNI.
+type SNICertificate struct {
+	Host     string
+	CertFile string
+	KeyFile  string
+}
+
+// ParseSNICertificates parses values of --tls-sni-cert in 'host:certfile:keyfile' format. Host can be a wildcard,
+// i.e. '*.example.com'. Relative file paths are resolved against given directory.
+func ParseSNICertificates(values []string, dir string) ([]SNICertificate, error) {
+	result := make([]SNICertificate, 0, len(values))
+	hosts := map[string]bool{}
+	for _, value := range values {
+		parts := strings.SplitN(value, ":", 3)
+		if len(parts) != 3 || len(parts[0]) == 0 || len(parts[1]) == 0 || len(parts[2]) == 0 {
+			return nil, fmt.Errorf("invalid --tls-sni-cert %q: expected 'host:certfile:keyfile' format", value)
+		}
+
+		host := strings.ToLower(parts[0])
+		if hosts[host] {
+			return nil, fmt.Errorf("invalid --tls-sni-cert %q: certificate for host %s is already set", value, host)
+		}
+		hosts[host] = true
+
+		result = append(result, SNICertificate{Host: host, CertFile: resolvePath(dir, parts[1]),
+			KeyFile: resolvePath(dir, parts[2])})
+	}
+
+	return result, nil
+}
+
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
+
+// SNISelector serves certificate matching the host name requested with SNI. Certificates of exact host names take
+// precedence over wildcard ones. Fallback is used for clients requesting other hosts or not using SNI.
+type SNISelector struct {
+	reloaders map[string]certapi.Reloader
+	fallback  func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+}
+
+// GetCertificate can be used as tls.Config GetCertificate callback. Returns nil certificate if there is no match and
+// no fallback, so that certificates from tls.Config Certificates are used.
+func (self *SNISelector) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if hello != nil && len(hello.ServerName) > 0 {
+		host := strings.ToLower(strings.TrimSuffix(hello.ServerName, "."))
+		if reloader, exists := self.reloaders[host]; exists {
+			return reloader.GetCertificate(hello)
+		}
+
+		if i := strings.Index(host, "."); i > 0 {
+			if reloader, exists := self.reloaders["*"+host[i:]]; exists {
+				return reloader.GetCertificate(hello)
+			}
+		}
+	}
+
+	if self.fallback == nil {
+		return nil, nil
+	}
+
+	return self.fallback(hello)
+}
+
+// NewSNISelector loads and starts watching key pairs of given certificates. Error is returned if any of the key
+// pairs is not valid.
+func NewSNISelector(certificates []SNICertificate,
+	fallback func(*tls.ClientHelloInfo) (*tls.Certificate, error)) (*SNISelector, error) {
+	reloaders := make(map[string]certapi.Reloader, len(certificates))
+	for _, certificate := range certificates {
+		reloader, err := NewCertReloader(certificate.CertFile, certificate.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load certificate for host %s: %s", certificate.Host, err.Error())
+		}
+
+		if err := reloader.Watch(); err != nil {
+			return nil, err
+		}
+		reloaders[certificate.Host] = reloader
+	}
+
+	return &SNISelector{reloaders: reloaders, fallback: fallback}, nil
+}
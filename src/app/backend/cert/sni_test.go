@@ -0,0 +1,139 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	certapi "github.com/kubernetes/dashboard/src/app/backend/cert/api"
+)
+
+func TestParseSNICertificates(t *testing.T) {
+	cases := []struct {
+		values      []string
+		expected    []SNICertificate
+		expectedErr bool
+	}{
+		{[]string{}, []SNICertificate{}, false},
+		{
+			[]string{"Dashboard.example.com:tls.crt:tls.key", "*.internal:/etc/internal.crt:/etc/internal.key"},
+			[]SNICertificate{
+				{"dashboard.example.com", "/certs/tls.crt", "/certs/tls.key"},
+				{"*.internal", "/etc/internal.crt", "/etc/internal.key"},
+			},
+			false,
+		},
+		{[]string{"example.com:tls.crt"}, nil, true},
+		{[]string{":tls.crt:tls.key"}, nil, true},
+		{[]string{"example.com:tls.crt:"}, nil, true},
+		{[]string{"example.com:a.crt:a.key", "EXAMPLE.com:b.crt:b.key"}, nil, true},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseSNICertificates(c.values, "/certs")
+		if (err != nil) != c.expectedErr {
+			t.Errorf("ParseSNICertificates(%v) returned error %v, expected error: %t", c.values, err, c.expectedErr)
+		}
+
+		if !c.expectedErr && !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("ParseSNICertificates(%v) == %v, expected %v", c.values, actual, c.expected)
+		}
+	}
+}
+
+func TestSNISelector_GetCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-sni-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	reloaders := map[string]certapi.Reloader{}
+	for _, host := range []string{"dashboard.example.com", "*.example.com", "fallback"} {
+		hostDir := filepath.Join(dir, host)
+		if err := os.Mkdir(hostDir, 0700); err != nil {
+			t.Fatal(err)
+		}
+		storeCertificates(t, hostDir)
+
+		reloader, err := NewCertReloader(filepath.Join(hostDir, "tls.crt"), filepath.Join(hostDir, "tls.key"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		reloaders[host] = reloader
+	}
+
+	certificates := map[string]*tls.Certificate{}
+	for host, reloader := range reloaders {
+		certificates[host], _ = reloader.GetCertificate(nil)
+	}
+
+	cases := []struct {
+		serverName  string
+		withDefault bool
+		expected    *tls.Certificate
+	}{
+		{"dashboard.example.com", true, certificates["dashboard.example.com"]},
+		{"DASHBOARD.example.com.", true, certificates["dashboard.example.com"]},
+		{"other.example.com", true, certificates["*.example.com"]},
+		{"nested.other.example.com", true, certificates["fallback"]},
+		{"example.com", true, certificates["fallback"]},
+		{"", true, certificates["fallback"]},
+		{"unknown.org", false, nil},
+	}
+
+	for _, c := range cases {
+		selector := &SNISelector{reloaders: map[string]certapi.Reloader{
+			"dashboard.example.com": reloaders["dashboard.example.com"],
+			"*.example.com":         reloaders["*.example.com"],
+		}}
+		if c.withDefault {
+			selector.fallback = reloaders["fallback"].GetCertificate
+		}
+
+		actual, err := selector.GetCertificate(&tls.ClientHelloInfo{ServerName: c.serverName})
+		if err != nil {
+			t.Fatalf("Expected certificate to be selected, but got error: %s", err)
+		}
+
+		if actual != c.expected {
+			t.Errorf("GetCertificate() for server name %q with default certificate: %t returned unexpected certificate",
+				c.serverName, c.withDefault)
+		}
+	}
+}
+
+func TestNewSNISelectorInvalidKeyPair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-sni-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	storeCertificates(t, dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "invalid.key"), []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	certificates := []SNICertificate{{"example.com", filepath.Join(dir, "tls.crt"), filepath.Join(dir, "invalid.key")}}
+	if _, err := NewSNISelector(certificates, nil); err == nil {
+		t.Error("Expected invalid key pair to be rejected.")
+	}
+}
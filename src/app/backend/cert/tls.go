@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+)
+
+// Maps TLS versions that can be passed to Dashboard binary to their crypto/tls representation.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// GetTLSVersion returns crypto/tls representation of given TLS version, i.e. '1.2'. Error is returned in case
+// version is not supported.
+func GetTLSVersion(version string) (uint16, error) {
+	if result, exists := tlsVersions[version]; exists {
+		return result, nil
+	}
+
+	return 0, fmt.Errorf("unsupported TLS version %q, supported versions are 1.0, 1.1, 1.2 and 1.3", version)
+}
+
+// GetTLSCipherSuites returns IDs of cipher suites with given IANA names, i.e.
+// 'TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256'. Only cipher suites without known security issues are supported. Empty
+// list is returned for no names, so that crypto/tls default cipher suites are used.
+func GetTLSCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	supported := map[string]uint16{}
+	for _, suite := range tls.CipherSuites() {
+		supported[suite.Name] = suite.ID
+	}
+
+	result := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, exists := supported[name]
+		if !exists {
+			return nil, fmt.Errorf("unsupported TLS cipher suite %q, supported cipher suites are: %s", name,
+				strings.Join(supportedCipherSuiteNames(), ", "))
+		}
+
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+func supportedCipherSuiteNames() []string {
+	result := []string{}
+	for _, suite := range tls.CipherSuites() {
+		result = append(result, suite.Name)
+	}
+
+	return result
+}
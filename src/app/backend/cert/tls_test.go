@@ -0,0 +1,72 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"crypto/tls"
+	"reflect"
+	"testing"
+)
+
+func TestGetTLSVersion(t *testing.T) {
+	cases := []struct {
+		version     string
+		expected    uint16
+		expectedErr bool
+	}{
+		{"1.2", tls.VersionTLS12, false},
+		{"1.3", tls.VersionTLS13, false},
+		{"1.5", 0, true},
+		{"", 0, true},
+	}
+
+	for _, c := range cases {
+		got, err := GetTLSVersion(c.version)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("GetTLSVersion(%s): expected error %v, but got %v", c.version, c.expectedErr, err)
+		}
+
+		if got != c.expected {
+			t.Errorf("GetTLSVersion(%s): expected %v, but got %v", c.version, c.expected, got)
+		}
+	}
+}
+
+func TestGetTLSCipherSuites(t *testing.T) {
+	cases := []struct {
+		names       []string
+		expected    []uint16
+		expectedErr bool
+	}{
+		{nil, nil, false},
+		{
+			[]string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"},
+			[]uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384},
+			false,
+		},
+		{[]string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_UNKNOWN"}, nil, true},
+	}
+
+	for _, c := range cases {
+		got, err := GetTLSCipherSuites(c.names)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("GetTLSCipherSuites(%v): expected error %v, but got %v", c.names, c.expectedErr, err)
+		}
+
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("GetTLSCipherSuites(%v): expected %v, but got %v", c.names, c.expected, got)
+		}
+	}
+}
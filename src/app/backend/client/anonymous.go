@@ -0,0 +1,86 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Name of the request attribute set for requests that are served with credentials of the anonymous service account.
+const anonymousAccessAttribute = "anonymousAccess"
+
+// Requested lifetime of the anonymous service account token.
+const anonymousTokenExpirationSeconds = 3600
+
+// AllowAnonymousAccess marks request without auth information, so it is served with credentials of the service
+// account passed with --anonymous-service-account.
+func AllowAnonymousAccess(req *restful.Request) {
+	req.SetAttribute(anonymousAccessAttribute, true)
+}
+
+// Checks if request was marked with AllowAnonymousAccess.
+func isAnonymousAccessAllowed(req *restful.Request) bool {
+	allowed, _ := req.Attribute(anonymousAccessAttribute).(bool)
+	return allowed
+}
+
+// anonymousToken provides token of the service account used for anonymous access. Token is requested with
+// credentials of dashboard and renewed once 80% of its lifetime has passed.
+type anonymousToken struct {
+	mux       sync.Mutex
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	token     string
+	renewAt   time.Time
+}
+
+// Token returns current token. Previously requested token is returned if it could not be renewed.
+func (self *anonymousToken) Token() string {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	now := time.Now()
+	if len(self.token) > 0 && now.Before(self.renewAt) {
+		return self.token
+	}
+
+	expirationSeconds := int64(anonymousTokenExpirationSeconds)
+	request, err := self.client.CoreV1().ServiceAccounts(self.namespace).CreateToken(context.TODO(), self.name,
+		&authenticationv1.TokenRequest{Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds}},
+		metaV1.CreateOptions{})
+	if err != nil {
+		log.Printf("Could not request token of the anonymous service account %s/%s: %s", self.namespace, self.name,
+			err.Error())
+		return self.token
+	}
+
+	lifetime := request.Status.ExpirationTimestamp.Sub(now)
+	self.token = request.Status.Token
+	self.renewAt = now.Add(lifetime * 4 / 5)
+	return self.token
+}
+
+func newAnonymousToken(client kubernetes.Interface, namespace, name string) *anonymousToken {
+	return &anonymousToken{client: client, namespace: namespace, name: name}
+}
@@ -0,0 +1,55 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestAnonymousTokenRenewal(t *testing.T) {
+	requests := 0
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "serviceaccounts",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			requests++
+			return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{
+				Token:               "token",
+				ExpirationTimestamp: metaV1.NewTime(time.Now().Add(time.Hour)),
+			}}, nil
+		})
+
+	token := newAnonymousToken(client, "kube-system", "viewer")
+	if actual := token.Token(); actual != "token" {
+		t.Errorf("Expected token %s, but got %s", "token", actual)
+	}
+
+	token.Token()
+	if requests != 1 {
+		t.Errorf("Expected token to be requested once, but it was requested %d times", requests)
+	}
+
+	token.renewAt = time.Now()
+	token.Token()
+	if requests != 2 {
+		t.Errorf("Expected token to be renewed, but it was requested %d times", requests)
+	}
+}
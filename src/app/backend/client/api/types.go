@@ -15,9 +15,12 @@
 package api
 
 import (
+	"io"
+
 	"github.com/emicklei/go-restful/v3"
 	v1 "k8s.io/api/authorization/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -41,14 +44,18 @@ const (
 type ClientManager interface {
 	Client(req *restful.Request) (kubernetes.Interface, error)
 	InsecureClient() kubernetes.Interface
+	InsecureConfig() *rest.Config
 	APIExtensionsClient(req *restful.Request) (apiextensionsclientset.Interface, error)
 	PluginClient(req *restful.Request) (pluginclientset.Interface, error)
 	InsecureAPIExtensionsClient() apiextensionsclientset.Interface
 	InsecurePluginClient() pluginclientset.Interface
 	CanI(req *restful.Request, ssar *v1.SelfSubjectAccessReview) bool
 	Config(req *restful.Request) (*rest.Config, error)
+	StreamingConfig(req *restful.Request) (*rest.Config, error)
 	ClientCmdConfig(req *restful.Request) (clientcmd.ClientConfig, error)
 	CSRFKey() string
+	Identity(req *restful.Request) string
+	VerifyCredentials(req *restful.Request) error
 	HasAccess(authInfo api.AuthInfo) error
 	VerberClient(req *restful.Request, config *rest.Config) (ResourceVerber, error)
 	SetTokenManager(manager authApi.TokenManager)
@@ -57,9 +64,12 @@ type ClientManager interface {
 // ResourceVerber is responsible for performing generic CRUD operations on all supported resources.
 type ResourceVerber interface {
 	Put(kind string, namespaceSet bool, namespace string, name string,
-		object *runtime.Unknown) error
+		object *runtime.Unknown, dryRun []string) (*runtime.Unknown, error)
 	Get(kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error)
-	Delete(kind string, namespaceSet bool, namespace string, name string) error
+	List(kind string, namespace string, opts metaV1.ListOptions) (runtime.Object, error)
+	Delete(kind string, namespaceSet bool, namespace string, name string,
+		propagationPolicy metaV1.DeletionPropagation) error
+	Watch(kind string, namespace string, resourceVersion string) (io.ReadCloser, error)
 }
 
 // CanIResponse is used to as response to check whether or not user is allowed to access given endpoint.
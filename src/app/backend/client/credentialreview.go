@@ -0,0 +1,105 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Time for which credentials accepted by the apiserver are not reviewed again.
+const credentialReviewTTL = 30 * time.Second
+
+// credentialReviews verifies credentials of the users with the apiserver when they are not sent to it, i.e. when all
+// requests impersonate the service account passed with --impersonate-service-account. Only accepted credentials are
+// cached, so that invalid ones can not fill the cache.
+type credentialReviews struct {
+	mux       sync.Mutex
+	accepted  map[string]time.Time
+	lastSweep time.Time
+}
+
+// Verify checks that the apiserver accepts credentials of given config. It makes a self subject access review, which
+// every authenticated user is allowed to create, and fails with unauthorized error if the credentials are invalid.
+func (self *credentialReviews) Verify(authInfo *api.AuthInfo, cfg *rest.Config) error {
+	return self.verify(credentialReviewKey(authInfo), cfg, time.Now())
+}
+
+func (self *credentialReviews) verify(key string, cfg *rest.Config, now time.Time) error {
+	if self.isAccepted(key, now) {
+		return nil
+	}
+
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.AuthorizationV1().SelfSubjectAccessReviews().Create(context.TODO(),
+		&authorizationv1.SelfSubjectAccessReview{Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			NonResourceAttributes: &authorizationv1.NonResourceAttributes{Path: "/api", Verb: "get"},
+		}}, metaV1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	self.accept(key, now)
+	return nil
+}
+
+func (self *credentialReviews) isAccepted(key string, now time.Time) bool {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	expires, exists := self.accepted[key]
+	return exists && now.Before(expires)
+}
+
+func (self *credentialReviews) accept(key string, now time.Time) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	// Removes expired entries, so that credentials of sessions that ended are not kept in memory
+	if now.Sub(self.lastSweep) >= credentialReviewTTL {
+		for key, expires := range self.accepted {
+			if !now.Before(expires) {
+				delete(self.accepted, key)
+			}
+		}
+		self.lastSweep = now
+	}
+
+	self.accepted[key] = now.Add(credentialReviewTTL)
+}
+
+func newCredentialReviews() *credentialReviews {
+	return &credentialReviews{accepted: map[string]time.Time{}}
+}
+
+// Returns hash of the credentials, so that they are not kept in memory in plain text.
+func credentialReviewKey(authInfo *api.AuthInfo) string {
+	hash := sha256.Sum256([]byte(authInfo.Token + "\n" + authInfo.Username + "\n" + authInfo.Password + "\n" +
+		string(authInfo.ClientCertificateData)))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Identity returns name of the user that sent the request based on credentials attached to it. Token signatures are
+// not verified, apiserver is responsible for that, so the name should only be used for informational purposes,
+// i.e. audit log. Returns empty string if request does not contain credentials or user could not be identified.
+func (self *clientManager) Identity(req *restful.Request) string {
+	if req == nil || !self.containsAuthInfo(req) {
+		return ""
+	}
+
+	authInfo, err := self.extractAuthInfo(req)
+	if err != nil {
+		return ""
+	}
+
+	return authInfoIdentity(authInfo)
+}
+
+// CredentialsKey returns hash of credentials sent with the request or empty string if request is not authenticated.
+// Credentials are hashed so they are not kept in memory, the key can be used to identify session of the user.
+func CredentialsKey(request *http.Request) string {
+	credentials := ProxyAuthToken(request)
+	if len(credentials) == 0 {
+		credentials = request.Header.Get("Authorization")
+	}
+
+	if len(credentials) == 0 {
+		credentials = request.Header.Get(JWETokenHeader)
+	}
+
+	if len(credentials) == 0 {
+		return ""
+	}
+
+	return credentialsHash(credentials)
+}
+
+// JWETokenKey returns the same key as CredentialsKey of the request that sends given JWE token in JWETokenHeader,
+// so that session of the token can be found when it is not sent in the headers, i.e. on token refresh.
+func JWETokenKey(token string) string {
+	return credentialsHash(token)
+}
+
+func credentialsHash(credentials string) string {
+	hash := sha256.Sum256([]byte(credentials))
+	return hex.EncodeToString(hash[:])
+}
+
+// Returns impersonated user, common name of the client certificate, subject of the JWT bearer token or username of
+// the basic authentication, whichever is found first.
+func authInfoIdentity(authInfo *api.AuthInfo) string {
+	switch {
+	case len(authInfo.Impersonate) > 0:
+		return authInfo.Impersonate
+	case len(authInfo.ClientCertificateData) > 0:
+		return certificateCommonName(authInfo.ClientCertificateData)
+	case len(authInfo.Token) > 0:
+		return tokenSubject(authInfo.Token)
+	}
+
+	return authInfo.Username
+}
+
+func certificateCommonName(certificateData []byte) string {
+	block, _ := pem.Decode(certificateData)
+	if block == nil {
+		return ""
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return ""
+	}
+
+	return certificate.Subject.CommonName
+}
+
+func tokenSubject(token string) string {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return ""
+	}
+
+	claims := jwt.Claims{}
+	if err := parsed.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return ""
+	}
+
+	return claims.Subject
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestAuthInfoIdentity(t *testing.T) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("secret")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{Subject: "system:serviceaccount:default:admin"}).CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info     string
+		authInfo *api.AuthInfo
+		expected string
+	}{
+		{"Should return subject of the token", &api.AuthInfo{Token: token}, "system:serviceaccount:default:admin"},
+		{"Should prefer impersonated user", &api.AuthInfo{Token: token, Impersonate: "alice"}, "alice"},
+		{"Should return username of basic authentication", &api.AuthInfo{Username: "bob", Password: "pass"}, "bob"},
+		{"Should return empty identity for opaque token", &api.AuthInfo{Token: "opaque"}, ""},
+		{"Should return empty identity for invalid certificate", &api.AuthInfo{ClientCertificateData: []byte("invalid")},
+			""},
+	}
+
+	for _, c := range cases {
+		if actual := authInfoIdentity(c.authInfo); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected identity %q, but got %q.", c.info, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"k8s.io/client-go/rest"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+const (
+	// Standard location of the service account token mounted into the pod.
+	DefaultServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// Standard location of the CA bundle of the apiserver mounted into the pod.
+	DefaultServiceAccountCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+// ValidateServiceAccountFiles checks that service account token and CA files passed with
+// --service-account-token-file and --service-account-ca-file can be read. Empty paths are skipped.
+func ValidateServiceAccountFiles(tokenFile, caFile string) error {
+	if len(tokenFile) > 0 {
+		if _, err := ReadTokenFile(tokenFile); err != nil {
+			return fmt.Errorf("could not read service account token file: %s", err.Error())
+		}
+	}
+
+	if len(caFile) > 0 {
+		if _, err := certutil.NewPool(caFile); err != nil {
+			return fmt.Errorf("could not read service account CA file: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Returns in-cluster config that reads service account token and CA from given files. Standard locations are used
+// for empty paths. In case both are empty automatic in-cluster discovery of the client library is used.
+func inClusterConfig(tokenFile, caFile string) (*rest.Config, error) {
+	if len(tokenFile) == 0 && len(caFile) == 0 {
+		return rest.InClusterConfig()
+	}
+
+	if len(tokenFile) == 0 {
+		tokenFile = DefaultServiceAccountTokenFile
+	}
+
+	if len(caFile) == 0 {
+		caFile = DefaultServiceAccountCAFile
+	}
+
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if len(host) == 0 || len(port) == 0 {
+		return nil, rest.ErrNotInCluster
+	}
+
+	token, err := ReadTokenFile(tokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := certutil.NewPool(caFile); err != nil {
+		return nil, fmt.Errorf("expected to load root CA config from %s, but got err: %v", caFile, err)
+	}
+
+	return &rest.Config{
+		Host:            "https://" + net.JoinHostPort(host, port),
+		TLSClientConfig: rest.TLSClientConfig{CAFile: caFile},
+		BearerToken:     token,
+		BearerTokenFile: tokenFile,
+	}, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func TestInClusterConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-service-account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca, _, err := certutil.GenerateSelfSignedCertKey("localhost", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenFile, caFile := filepath.Join(dir, "token"), filepath.Join(dir, "ca.crt")
+	if err := ioutil.WriteFile(tokenFile, []byte("token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(caFile, ca, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Setenv("KUBERNETES_SERVICE_HOST", os.Getenv("KUBERNETES_SERVICE_HOST"))
+	defer os.Setenv("KUBERNETES_SERVICE_PORT", os.Getenv("KUBERNETES_SERVICE_PORT"))
+	os.Setenv("KUBERNETES_SERVICE_HOST", "10.0.0.1")
+	os.Setenv("KUBERNETES_SERVICE_PORT", "443")
+
+	cfg, err := inClusterConfig(tokenFile, caFile)
+	if err != nil {
+		t.Fatalf("Expected in-cluster config to be created, but got error: %s", err)
+	}
+
+	if cfg.Host != "https://10.0.0.1:443" || cfg.BearerToken != "token" || cfg.BearerTokenFile != tokenFile ||
+		cfg.TLSClientConfig.CAFile != caFile {
+		t.Errorf("Expected in-cluster config to use %s and %s, but got %#v", tokenFile, caFile, cfg)
+	}
+
+	if _, err := inClusterConfig(filepath.Join(dir, "missing"), caFile); err == nil {
+		t.Error("Expected error for missing token file.")
+	}
+
+	if _, err := inClusterConfig(tokenFile, tokenFile); err == nil {
+		t.Error("Expected error for invalid CA file.")
+	}
+
+	os.Setenv("KUBERNETES_SERVICE_HOST", "")
+	if _, err := inClusterConfig(tokenFile, caFile); err == nil {
+		t.Error("Expected error when not running in cluster.")
+	}
+}
+
+func TestValidateServiceAccountFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-service-account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca, _, err := certutil.GenerateSelfSignedCertKey("localhost", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenFile, caFile := filepath.Join(dir, "token"), filepath.Join(dir, "ca.crt")
+	if err := ioutil.WriteFile(tokenFile, []byte("token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(caFile, ca, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info        string
+		tokenFile   string
+		caFile      string
+		expectedErr bool
+	}{
+		{"Should skip empty paths", "", "", false},
+		{"Should accept readable files", tokenFile, caFile, false},
+		{"Should reject missing token file", filepath.Join(dir, "missing"), caFile, true},
+		{"Should reject missing CA file", tokenFile, filepath.Join(dir, "missing"), true},
+		{"Should reject invalid CA file", tokenFile, tokenFile, true},
+	}
+
+	for _, c := range cases {
+		if err := ValidateServiceAccountFiles(c.tokenFile, c.caFile); (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+}
@@ -17,7 +17,10 @@ package client
 import (
 	"context"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 	v1 "k8s.io/api/authorization/v1"
@@ -36,6 +39,7 @@ import (
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/client/csrf"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/tracing"
 )
 
 // Dashboard UI default values for client configs.
@@ -52,6 +56,8 @@ const (
 	DefaultCmdConfigName = "kubernetes"
 	// Header name that contains token used for authorization. See TokenManager for more information.
 	JWETokenHeader = "jweToken"
+	// Cookie name that contains name of the kubeconfig context selected for the session.
+	KubeContextCookieName = "kubeContext"
 	// Default http header for user-agent
 	DefaultUserAgent = "dashboard"
 	//Impersonation Extra header
@@ -70,6 +76,9 @@ type clientManager struct {
 	kubeConfigPath string
 	// Address of apiserver host in format 'protocol://address:port'
 	apiserverHost string
+	// Name of the kubeconfig context used if session did not select any other. If empty current
+	// context of the kubeconfig file is used.
+	defaultContext string
 	// Initialized on clientManager creation and used if kubeconfigPath and apiserverHost are
 	// empty
 	inClusterConfig *rest.Config
@@ -88,6 +97,21 @@ type clientManager struct {
 	// to service account used by dashboard or kubeconfig file if it was passed during dashboard
 	// init.
 	insecureConfig *rest.Config
+	// Selects proxy used to connect to the apiserver. Initialized from --apiserver-proxy-url and
+	// --apiserver-no-proxy, nil if apiserver is reached directly.
+	proxy func(*http.Request) (*url.URL, error)
+	// Provides token passed with --token-file. It is used by all requests that do not contain any other auth
+	// information, nil if token file is not set.
+	tokenFile *tokenFile
+	// Provides token of the service account passed with --anonymous-service-account. It is used by requests marked
+	// with AllowAnonymousAccess, nil if anonymous access is disabled.
+	anonymousToken *anonymousToken
+	// Identity of the service account passed with --impersonate-service-account. All requests are served with
+	// credentials of dashboard impersonating it, nil if it was not set.
+	impersonatedServiceAccount *rest.ImpersonationConfig
+	// Verifies credentials of the users when they are not sent to the apiserver, i.e. when they impersonate the
+	// service account passed with --impersonate-service-account, or when requests are rate limited by user.
+	credentialReviews *credentialReviews
 }
 
 // Client returns a kubernetes client. In case dashboard login is enabled and option to skip
@@ -102,6 +126,10 @@ func (self *clientManager) Client(req *restful.Request) (kubernetes.Interface, e
 		return self.secureClient(req)
 	}
 
+	if !self.isDefaultContext(req) {
+		return self.contextClient(req)
+	}
+
 	return self.InsecureClient(), nil
 }
 
@@ -117,6 +145,10 @@ func (self *clientManager) APIExtensionsClient(req *restful.Request) (apiextensi
 		return self.secureAPIExtensionsClient(req)
 	}
 
+	if !self.isDefaultContext(req) {
+		return self.contextAPIExtensionsClient(req)
+	}
+
 	return self.InsecureAPIExtensionsClient(), nil
 }
 
@@ -132,6 +164,10 @@ func (self *clientManager) PluginClient(req *restful.Request) (pluginclientset.I
 		return self.securePluginClient(req)
 	}
 
+	if !self.isDefaultContext(req) {
+		return self.contextPluginClient(req)
+	}
+
 	return self.InsecurePluginClient(), nil
 }
 
@@ -147,9 +183,27 @@ func (self *clientManager) Config(req *restful.Request) (*rest.Config, error) {
 		return self.secureConfig(req)
 	}
 
+	if !self.isDefaultContext(req) {
+		return self.contextConfig(req)
+	}
+
 	return self.InsecureConfig(), nil
 }
 
+// StreamingConfig returns the same config as Config, but without timeout of the apiserver requests set with
+// --kube-client-timeout. It should be used for long running requests, i.e. watches, log streams and exec into
+// containers.
+func (self *clientManager) StreamingConfig(req *restful.Request) (*rest.Config, error) {
+	cfg, err := self.Config(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg = rest.CopyConfig(cfg)
+	cfg.Timeout = 0
+	return cfg, nil
+}
+
 // InsecureClient returns kubernetes client that was created without providing auth info. It uses
 // permissions granted to service account used by dashboard or kubeconfig file if it was passed
 // during dashboard init.
@@ -208,7 +262,7 @@ func (self *clientManager) ClientCmdConfig(req *restful.Request) (clientcmd.Clie
 		return nil, err
 	}
 
-	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath)
+	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath, self.kubeContext(req))
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +278,7 @@ func (self *clientManager) CSRFKey() string {
 // HasAccess configures K8S api client with provided auth info and executes a basic check against apiserver to see
 // if it is valid.
 func (self *clientManager) HasAccess(authInfo api.AuthInfo) error {
-	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath)
+	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath, self.defaultContext)
 	if err != nil {
 		return err
 	}
@@ -244,19 +298,20 @@ func (self *clientManager) HasAccess(authInfo api.AuthInfo) error {
 	return err
 }
 
-// VerberClient returns new verber client based on authentication information extracted from request
+// VerberClient returns new verber client that uses given config. It should be the config of the request, either
+// returned by Config or StreamingConfig.
 func (self *clientManager) VerberClient(req *restful.Request, config *rest.Config) (clientapi.ResourceVerber, error) {
-	k8sClient, err := self.Client(req)
+	k8sClient, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
-	apiextensionsclient, err := self.APIExtensionsClient(req)
+	apiextensionsclient, err := apiextensionsclientset.NewForConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
-	pluginsclient, err := self.PluginClient(req)
+	pluginsclient, err := pluginclientset.NewForConfig(config)
 	if err != nil {
 		return nil, err
 	}
@@ -286,22 +341,58 @@ func (self *clientManager) SetTokenManager(manager authApi.TokenManager) {
 	self.tokenManager = manager
 }
 
-// Initializes config with default values
+// Initializes config with default values. QPS and burst configured with --kube-client-qps and --kube-client-burst
+// are used if they are set, otherwise client side throttling is effectively disabled. Proxy configured with
+// --apiserver-proxy-url is used to connect to the apiserver if it is set. User agent can be overridden with
+// --user-agent. Requests are traced if --otel-endpoint is set. Every request fails after --kube-client-timeout if it is
+// set. Idempotent requests that failed with transient errors are retried up to --apiserver-max-retries times.
 func (self *clientManager) initConfig(cfg *rest.Config) {
 	cfg.QPS = DefaultQPS
+	if qps := args.Holder.GetKubeClientQPS(); qps > 0 {
+		cfg.QPS = qps
+	}
+
 	cfg.Burst = DefaultBurst
+	if burst := args.Holder.GetKubeClientBurst(); burst > 0 {
+		cfg.Burst = burst
+	}
+
+	cfg.Timeout = time.Duration(args.Holder.GetKubeClientTimeout()) * time.Second
 	cfg.ContentType = DefaultContentType
 	cfg.UserAgent = DefaultUserAgent + "/" + Version
+	if userAgent := args.Holder.GetUserAgent(); len(userAgent) > 0 {
+		cfg.UserAgent = userAgent
+	}
+
+	if self.proxy != nil {
+		cfg.Proxy = self.proxy
+	}
+
+	if tracing.Enabled() {
+		cfg.Wrap(tracing.Transport)
+	}
+
+	configureRetries(cfg, args.Holder.GetApiServerMaxRetries())
 }
 
 // Returns rest Config based on provided apiserverHost and kubeConfigPath flags. If both are
-// empty then in-cluster config will be used and if it is nil the error is returned.
-func (self *clientManager) buildConfigFromFlags(apiserverHost, kubeConfigPath string) (
+// empty then in-cluster config will be used and if it is nil the error is returned. Context name
+// selects kubeconfig context, if it is empty default context is used. CA and TLS verification
+// flags of the apiserver are not applied to in-cluster config.
+func (self *clientManager) buildConfigFromFlags(apiserverHost, kubeConfigPath, contextName string) (
 	*rest.Config, error) {
+	if len(contextName) == 0 {
+		contextName = self.defaultContext
+	}
+
 	if len(kubeConfigPath) > 0 || len(apiserverHost) > 0 {
 		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 			&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeConfigPath},
-			&clientcmd.ConfigOverrides{ClusterInfo: api.Cluster{Server: apiserverHost}}).ClientConfig()
+			&clientcmd.ConfigOverrides{ClusterInfo: api.Cluster{
+				Server:                apiserverHost,
+				CertificateAuthority:  args.Holder.GetApiServerCAFile(),
+				InsecureSkipTLSVerify: args.Holder.GetApiServerSkipTLSVerify(),
+			}, CurrentContext: contextName}).ClientConfig()
 	}
 
 	if self.isRunningInCluster() {
@@ -339,6 +430,11 @@ func (self *clientManager) extractAuthInfo(req *restful.Request) (*api.AuthInfo,
 	impersonationHeader := req.HeaderParameter("Impersonate-User")
 	jweToken := req.HeaderParameter(JWETokenHeader)
 
+	// Token injected by the trusted authenticating proxy is more important than any other credentials
+	if proxyToken := ProxyAuthToken(req.Request); len(proxyToken) > 0 {
+		return &api.AuthInfo{Token: proxyToken}, nil
+	}
+
 	// Authorization header will be more important than our token
 	token := self.extractTokenFromHeader(authHeader)
 	if len(token) > 0 {
@@ -373,6 +469,19 @@ func (self *clientManager) extractAuthInfo(req *restful.Request) (*api.AuthInfo,
 		return self.tokenManager.Decrypt(jweToken)
 	}
 
+	// Token from --token-file is used only if request does not contain any other auth information
+	if self.tokenFile != nil {
+		if token := self.tokenFile.Token(); len(token) > 0 {
+			return &api.AuthInfo{Token: token}, nil
+		}
+	}
+
+	if self.anonymousToken != nil && isAnonymousAccessAllowed(req) {
+		if token := self.anonymousToken.Token(); len(token) > 0 {
+			return &api.AuthInfo{Token: token}, nil
+		}
+	}
+
 	return nil, errors.NewUnauthorized(errors.MsgLoginUnauthorizedError)
 }
 
@@ -381,7 +490,8 @@ func (self *clientManager) containsAuthInfo(req *restful.Request) bool {
 	authHeader := req.HeaderParameter("Authorization")
 	jweToken := req.HeaderParameter(JWETokenHeader)
 
-	return len(authHeader) > 0 || len(jweToken) > 0
+	return len(authHeader) > 0 || len(jweToken) > 0 || len(ProxyAuthToken(req.Request)) > 0 || self.tokenFile != nil ||
+		isAnonymousAccessAllowed(req)
 }
 
 func (self *clientManager) extractTokenFromHeader(authHeader string) string {
@@ -399,6 +509,26 @@ func (self *clientManager) isLoginEnabled(req *restful.Request) bool {
 // Secure mode means that every request to Dashboard has to be authenticated and privileges
 // of Dashboard SA can not be used.
 func (self *clientManager) isSecureModeEnabled(req *restful.Request) bool {
+	// Privileges of Dashboard SA are never used when all requests impersonate the fixed service account
+	if self.impersonatedServiceAccount != nil {
+		return true
+	}
+
+	// User authenticated by the trusted proxy can never use privileges of Dashboard SA
+	if len(ProxyAuthToken(req.Request)) > 0 {
+		return true
+	}
+
+	// Privileges of Dashboard SA are never used in single-identity mode
+	if self.tokenFile != nil {
+		return true
+	}
+
+	// Anonymous requests can only use privileges of the anonymous service account
+	if isAnonymousAccessAllowed(req) {
+		return true
+	}
+
 	if self.isLoginEnabled(req) && !args.Holder.GetEnableSkipLogin() {
 		return true
 	}
@@ -406,6 +536,66 @@ func (self *clientManager) isSecureModeEnabled(req *restful.Request) bool {
 	return self.isLoginEnabled(req) && args.Holder.GetEnableSkipLogin() && self.containsAuthInfo(req)
 }
 
+// Returns name of the kubeconfig context selected for the session or empty string if none was selected.
+func (self *clientManager) kubeContext(req *restful.Request) string {
+	if len(self.kubeConfigPath) == 0 {
+		return ""
+	}
+
+	cookie, err := req.Request.Cookie(KubeContextCookieName)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// Returns true if session uses the same kubeconfig context as insecure clients.
+func (self *clientManager) isDefaultContext(req *restful.Request) bool {
+	contextName := self.kubeContext(req)
+	return len(contextName) == 0 || contextName == self.defaultContext
+}
+
+// Returns config that uses kubeconfig context selected for the session and privileges defined
+// for this context in the kubeconfig file.
+func (self *clientManager) contextConfig(req *restful.Request) (*rest.Config, error) {
+	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath, self.kubeContext(req))
+	if err != nil {
+		return nil, err
+	}
+
+	self.initConfig(cfg)
+	bindRequestContext(cfg, req.Request)
+	return cfg, nil
+}
+
+func (self *clientManager) contextClient(req *restful.Request) (kubernetes.Interface, error) {
+	cfg, err := self.contextConfig(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(cfg)
+}
+
+func (self *clientManager) contextAPIExtensionsClient(req *restful.Request) (apiextensionsclientset.Interface, error) {
+	cfg, err := self.contextConfig(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiextensionsclientset.NewForConfig(cfg)
+}
+
+func (self *clientManager) contextPluginClient(req *restful.Request) (pluginclientset.Interface, error) {
+	cfg, err := self.contextConfig(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return pluginclientset.NewForConfig(cfg)
+}
+
 func (self *clientManager) secureClient(req *restful.Request) (kubernetes.Interface, error) {
 	cfg, err := self.secureConfig(req)
 	if err != nil {
@@ -449,6 +639,17 @@ func (self *clientManager) securePluginClient(req *restful.Request) (pluginclien
 }
 
 func (self *clientManager) secureConfig(req *restful.Request) (*rest.Config, error) {
+	if self.impersonatedServiceAccount != nil {
+		return self.serviceAccountConfig(req)
+	}
+
+	if self.isImpersonationEnabled(req) {
+		cfg, err := self.impersonatedConfig(req)
+		if cfg != nil || err != nil {
+			return cfg, err
+		}
+	}
+
 	cmdConfig, err := self.ClientCmdConfig(req)
 	if err != nil {
 		return nil, err
@@ -460,17 +661,170 @@ func (self *clientManager) secureConfig(req *restful.Request) (*rest.Config, err
 	}
 
 	self.initConfig(cfg)
+	bindRequestContext(cfg, req.Request)
 	return cfg, nil
 }
 
+// Impersonation is used only for requests authenticated with JWE token, as identity of the user is verified during
+// login and stored in the token. Requests with bearer token in the Authorization header use this token directly, so
+// impersonation headers sent by the user can not be used to gain permissions of dashboard.
+func (self *clientManager) isImpersonationEnabled(req *restful.Request) bool {
+	return args.Holder.GetEnableImpersonation() &&
+		len(self.extractTokenFromHeader(req.HeaderParameter("Authorization"))) == 0 &&
+		len(ProxyAuthToken(req.Request)) == 0 && !isAnonymousAccessAllowed(req)
+}
+
+// Returns config that uses dashboard credentials to impersonate user identified by JWE token from the request. Returns
+// nil if the token does not contain user identity, i.e. it was generated before impersonation was enabled.
+func (self *clientManager) impersonatedConfig(req *restful.Request) (*rest.Config, error) {
+	jweToken := req.HeaderParameter(JWETokenHeader)
+	if self.tokenManager == nil || len(jweToken) == 0 {
+		return nil, errors.NewUnauthorized(errors.MsgLoginUnauthorizedError)
+	}
+
+	authInfo, err := self.tokenManager.Decrypt(jweToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(authInfo.Impersonate) == 0 {
+		return nil, nil
+	}
+
+	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath, self.kubeContext(req))
+	if err != nil {
+		return nil, err
+	}
+
+	// In-cluster config is shared between requests
+	cfg = rest.CopyConfig(cfg)
+	cfg.Impersonate = rest.ImpersonationConfig{
+		UserName: authInfo.Impersonate,
+		Groups:   authInfo.ImpersonateGroups,
+		Extra:    authInfo.ImpersonateUserExtra,
+	}
+	self.initConfig(cfg)
+	bindRequestContext(cfg, req.Request)
+	return cfg, nil
+}
+
+// Returns config that uses dashboard credentials to impersonate the service account passed with
+// --impersonate-service-account. Credentials of the user are not sent with the requests, so they are verified with
+// the apiserver first. Only requests marked with AllowAnonymousAccess are served without credentials.
+func (self *clientManager) serviceAccountConfig(req *restful.Request) (*rest.Config, error) {
+	if !isAnonymousAccessAllowed(req) {
+		if err := self.VerifyCredentials(req); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath, self.kubeContext(req))
+	if err != nil {
+		return nil, err
+	}
+
+	// In-cluster config is shared between requests
+	cfg = rest.CopyConfig(cfg)
+	cfg.Impersonate = *self.impersonatedServiceAccount
+	self.initConfig(cfg)
+	bindRequestContext(cfg, req.Request)
+	return cfg, nil
+}
+
+// VerifyCredentials checks that the apiserver accepts credentials of the request. Accepted credentials are not
+// reviewed again for a short time.
+func (self *clientManager) VerifyCredentials(req *restful.Request) error {
+	authInfo, err := self.extractAuthInfo(req)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath, self.kubeContext(req))
+	if err != nil {
+		return err
+	}
+
+	cfg, err = self.buildCmdConfig(authInfo, cfg).ClientConfig()
+	if err != nil {
+		return err
+	}
+
+	self.initConfig(cfg)
+	bindRequestContext(cfg, req.Request)
+	return self.credentialReviews.Verify(authInfo, cfg)
+}
+
 // Initializes client manager
 func (self *clientManager) init() {
+	self.credentialReviews = newCredentialReviews()
+	self.initProxy()
+	self.initTokenFile()
 	self.initInClusterConfig()
 	self.initInsecureClients()
+	self.initImpersonatedServiceAccount()
+	self.initAnonymousToken()
 	self.initCSRFKey()
 }
 
-// Initializes in-cluster config if apiserverHost and kubeConfigPath were not provided.
+// Initializes proxy used to connect to the apiserver. Invalid proxy configuration is reported during
+// dashboard startup, so it is only logged here.
+func (self *clientManager) initProxy() {
+	proxy, err := ApiServerProxy(args.Holder.GetApiServerProxyURL(), args.Holder.GetApiServerNoProxy())
+	if err != nil {
+		log.Printf("Could not init apiserver proxy: %s", err.Error())
+		return
+	}
+
+	if proxy != nil {
+		log.Print("Using proxy to connect to apiserver")
+	}
+	self.proxy = proxy
+}
+
+// Initializes token file if it was passed with --token-file. Dashboard runs in single-identity mode then.
+func (self *clientManager) initTokenFile() {
+	if path := args.Holder.GetTokenFile(); len(path) > 0 {
+		log.Printf("Using token from %s for requests without auth information", path)
+		self.tokenFile = newTokenFile(path)
+	}
+}
+
+// Initializes identity of the service account passed with --impersonate-service-account.
+func (self *clientManager) initImpersonatedServiceAccount() {
+	serviceAccount := args.Holder.GetImpersonateServiceAccount()
+	if len(serviceAccount) == 0 {
+		return
+	}
+
+	impersonation, err := ParseImpersonatedServiceAccount(serviceAccount)
+	if err != nil {
+		log.Printf("Could not init service account impersonation: %s", err.Error())
+		return
+	}
+
+	log.Printf("Impersonating %s in all requests", impersonation.UserName)
+	self.impersonatedServiceAccount = impersonation
+}
+
+// Initializes token of the anonymous service account if anonymous access was enabled with --anonymous-access.
+// Anonymous requests impersonate the service account passed with --impersonate-service-account if it was set.
+func (self *clientManager) initAnonymousToken() {
+	if !args.Holder.GetAnonymousAccess() || self.impersonatedServiceAccount != nil {
+		return
+	}
+
+	namespace, name, err := ParseServiceAccount(args.Holder.GetAnonymousServiceAccount())
+	if err != nil {
+		log.Printf("Could not init anonymous access: %s", err.Error())
+		return
+	}
+
+	log.Printf("Using service account %s:%s for anonymous requests", namespace, name)
+	self.anonymousToken = newAnonymousToken(self.insecureClient, namespace, name)
+}
+
+// Initializes in-cluster config if apiserverHost and kubeConfigPath were not provided. Standard locations of service
+// account token and CA can be overridden with --service-account-token-file and --service-account-ca-file.
 func (self *clientManager) initInClusterConfig() {
 	if len(self.apiserverHost) > 0 || len(self.kubeConfigPath) > 0 {
 		log.Print("Skipping in-cluster config")
@@ -478,7 +832,7 @@ func (self *clientManager) initInClusterConfig() {
 	}
 
 	log.Print("Using in-cluster config to connect to apiserver")
-	cfg, err := rest.InClusterConfig()
+	cfg, err := inClusterConfig(args.Holder.GetServiceAccountTokenFile(), args.Holder.GetServiceAccountCAFile())
 	if err != nil {
 		log.Printf("Could not init in cluster config: %s", err.Error())
 		return
@@ -526,7 +880,7 @@ func (self *clientManager) initInsecureClients() {
 }
 
 func (self *clientManager) initInsecureConfig() {
-	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath)
+	cfg, err := self.buildConfigFromFlags(self.apiserverHost, self.kubeConfigPath, self.defaultContext)
 	if err != nil {
 		panic(err)
 	}
@@ -546,6 +900,7 @@ func NewClientManager(kubeConfigPath, apiserverHost string) clientapi.ClientMana
 	result := &clientManager{
 		kubeConfigPath: kubeConfigPath,
 		apiserverHost:  apiserverHost,
+		defaultContext: args.Holder.GetDefaultContext(),
 	}
 
 	result.init()
@@ -16,13 +16,22 @@ package client
 
 import (
 	"crypto/tls"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
 	restful "github.com/emicklei/go-restful/v3"
 	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
 )
 
 func TestNewClientManager(t *testing.T) {
@@ -236,6 +245,74 @@ func TestConfig(t *testing.T) {
 	}
 }
 
+func TestConfigUserAgent(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetUserAgent("")
+	}()
+
+	cases := []struct {
+		userAgent string
+		expected  string
+	}{
+		{"", DefaultUserAgent + "/" + Version},
+		{"dashboard-prod", "dashboard-prod"},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetUserAgent(c.userAgent)
+		manager := NewClientManager("", "https://localhost:8080")
+		cfg, err := manager.Config(&restful.Request{Request: &http.Request{Header: http.Header{}}})
+		if err != nil {
+			t.Fatalf("Config(): Expected config to be created but error was thrown: %s", err.Error())
+		}
+
+		if cfg.UserAgent != c.expected {
+			t.Errorf("Config() with --user-agent '%s': Expected user agent to be %s but got %s",
+				c.userAgent, c.expected, cfg.UserAgent)
+		}
+	}
+}
+
+func TestConfigTimeout(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetKubeClientTimeout(0)
+	}()
+
+	cases := []struct {
+		timeout  int
+		expected time.Duration
+	}{
+		{0, 0},
+		{30, 30 * time.Second},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetKubeClientTimeout(c.timeout)
+		manager := NewClientManager("", "https://localhost:8080")
+		req := &restful.Request{Request: &http.Request{Header: http.Header{}}}
+
+		cfg, err := manager.Config(req)
+		if err != nil {
+			t.Fatalf("Config(): Expected config to be created but error was thrown: %s", err.Error())
+		}
+
+		if cfg.Timeout != c.expected {
+			t.Errorf("Config() with --kube-client-timeout %d: Expected timeout to be %s but got %s",
+				c.timeout, c.expected, cfg.Timeout)
+		}
+
+		streamingCfg, err := manager.StreamingConfig(req)
+		if err != nil {
+			t.Fatalf("StreamingConfig(): Expected config to be created but error was thrown: %s", err.Error())
+		}
+
+		if streamingCfg.Timeout != 0 {
+			t.Errorf("StreamingConfig() with --kube-client-timeout %d: Expected no timeout but got %s",
+				c.timeout, streamingCfg.Timeout)
+		}
+	}
+}
+
 func TestClientCmdConfig(t *testing.T) {
 	args.GetHolderBuilder().SetEnableSkipLogin(true)
 	cases := []struct {
@@ -591,3 +668,225 @@ func TestImpersonationExtrasClient(t *testing.T) {
 		}
 	}
 }
+
+func TestEnableImpersonationConfig(t *testing.T) {
+	args.GetHolderBuilder().SetEnableSkipLogin(false).SetEnableImpersonation(true)
+	defer args.GetHolderBuilder().SetEnableImpersonation(false)
+
+	tokenManager := jwe.NewJWETokenManager(jwe.NewRSAKeyHolder(
+		sync.NewSynchronizerManager(fake.NewSimpleClientset()).Secret("", "")))
+	jweToken, err := tokenManager.Generate(api.AuthInfo{Impersonate: "test-user", ImpersonateGroups: []string{"test-group"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info                      string
+		header                    http.Header
+		expectedToken             string
+		expectedImpersonationUser string
+	}{
+		{
+			"Should impersonate user identified by JWE token",
+			http.Header{http.CanonicalHeaderKey(JWETokenHeader): {jweToken}},
+			"",
+			"test-user",
+		},
+		{
+			"Should use bearer token from Authorization header",
+			http.Header{"Authorization": {"Bearer test-token"}, http.CanonicalHeaderKey(JWETokenHeader): {jweToken}},
+			"test-token",
+			"",
+		},
+	}
+
+	for _, c := range cases {
+		manager := NewClientManager("", "https://localhost:8080")
+		manager.SetTokenManager(tokenManager)
+		cfg, err := manager.Config(&restful.Request{Request: &http.Request{Header: c.header, TLS: &tls.ConnectionState{}}})
+		if err != nil {
+			t.Fatalf("Test Case: %s. Expected config to be created but error was thrown: %s", c.info, err)
+		}
+
+		if cfg.BearerToken != c.expectedToken {
+			t.Errorf("Test Case: %s. Expected token to be %q but got %q", c.info, c.expectedToken, cfg.BearerToken)
+		}
+
+		if cfg.Impersonate.UserName != c.expectedImpersonationUser {
+			t.Errorf("Test Case: %s. Expected impersonated user to be %q but got %q", c.info,
+				c.expectedImpersonationUser, cfg.Impersonate.UserName)
+		}
+	}
+}
+
+func TestProxyAuthTokenConfig(t *testing.T) {
+	args.GetHolderBuilder().SetEnableSkipLogin(false).SetAuthenticationHeader("X-Forwarded-Access-Token").
+		SetTrustedProxyCIDRs([]string{"10.0.0.0/8", "fd00::/8"})
+	defer func() {
+		args.GetHolderBuilder().SetAuthenticationHeader("").SetTrustedProxyCIDRs([]string{})
+	}()
+
+	cases := []struct {
+		info          string
+		remoteAddr    string
+		header        http.Header
+		expectedToken string
+	}{
+		{
+			"Should use token set by trusted proxy",
+			"10.1.2.3:43210",
+			http.Header{"X-Forwarded-Access-Token": {"proxy-token"}},
+			"proxy-token",
+		},
+		{
+			"Should strip bearer prefix from token set by trusted IPv6 proxy",
+			"[fd00::1]:43210",
+			http.Header{"X-Forwarded-Access-Token": {"Bearer proxy-token"}},
+			"proxy-token",
+		},
+		{
+			"Should prefer token set by trusted proxy over Authorization header",
+			"10.1.2.3:43210",
+			http.Header{"X-Forwarded-Access-Token": {"proxy-token"}, "Authorization": {"Bearer test-token"}},
+			"proxy-token",
+		},
+		{
+			"Should ignore token from untrusted address",
+			"192.168.0.1:43210",
+			http.Header{"X-Forwarded-Access-Token": {"proxy-token"}, "Authorization": {"Bearer test-token"}},
+			"test-token",
+		},
+		{
+			"Should ignore forwarded address of untrusted client",
+			"192.168.0.1:43210",
+			http.Header{"X-Forwarded-Access-Token": {"proxy-token"}, "X-Forwarded-For": {"10.1.2.3"},
+				"Authorization": {"Bearer test-token"}},
+			"test-token",
+		},
+	}
+
+	for _, c := range cases {
+		manager := NewClientManager("", "https://localhost:8080")
+		cfg, err := manager.Config(&restful.Request{Request: &http.Request{Header: c.header, RemoteAddr: c.remoteAddr,
+			TLS: &tls.ConnectionState{}}})
+		if err != nil {
+			t.Fatalf("Test Case: %s. Expected config to be created but error was thrown: %s", c.info, err)
+		}
+
+		if cfg.BearerToken != c.expectedToken {
+			t.Errorf("Test Case: %s. Expected token to be %q but got %q", c.info, c.expectedToken, cfg.BearerToken)
+		}
+	}
+}
+
+func TestApiServerTLSConfig(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetApiServerCAFile("").SetApiServerSkipTLSVerify(false)
+	}()
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	if err := ioutil.WriteFile(caFile, []byte("ca"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info               string
+		caFile             string
+		skipTLSVerify      bool
+		expectedCAFile     string
+		expectedSkipVerify bool
+	}{
+		{"Should use system CAs by default", "", false, "", false},
+		{"Should use custom CA file", caFile, false, caFile, false},
+		{"Should skip TLS verification", "", true, "", true},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetApiServerCAFile(c.caFile).SetApiServerSkipTLSVerify(c.skipTLSVerify)
+		manager := &clientManager{apiserverHost: "https://localhost:8080"}
+		cfg, err := manager.buildConfigFromFlags(manager.apiserverHost, "", "")
+		if err != nil {
+			t.Fatalf("Test Case: %s. Expected config to be created but error was thrown: %s", c.info, err)
+		}
+
+		if cfg.TLSClientConfig.CAFile != c.expectedCAFile {
+			t.Errorf("Test Case: %s. Expected CA file %q, but got %q", c.info, c.expectedCAFile,
+				cfg.TLSClientConfig.CAFile)
+		}
+
+		if cfg.TLSClientConfig.Insecure != c.expectedSkipVerify {
+			t.Errorf("Test Case: %s. Expected insecure %t, but got %t", c.info, c.expectedSkipVerify,
+				cfg.TLSClientConfig.Insecure)
+		}
+	}
+}
+
+func TestImpersonateServiceAccountConfig(t *testing.T) {
+	reviews := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Unauthorized","code":401}`))
+			return
+		}
+
+		reviews++
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"kind":"SelfSubjectAccessReview","apiVersion":"authorization.k8s.io/v1",` +
+			`"status":{"allowed":true}}`))
+	}))
+	defer server.Close()
+
+	args.GetHolderBuilder().SetEnableSkipLogin(false).SetImpersonateServiceAccount("kube-public:viewer").
+		SetApiServerSkipTLSVerify(true)
+	defer args.GetHolderBuilder().SetApiServerSkipTLSVerify(false)
+	defer args.GetHolderBuilder().SetImpersonateServiceAccount("")
+
+	cases := []struct {
+		info          string
+		header        http.Header
+		anonymous     bool
+		expectedError bool
+	}{
+		{"Should impersonate service account instead of using credentials of the user",
+			http.Header{"Authorization": {"Bearer test-token"}}, false, false},
+		{"Should not review credentials of the user again",
+			http.Header{"Authorization": {"Bearer test-token"}}, false, false},
+		{"Should reject bogus bearer token", http.Header{"Authorization": {"Bearer bogus"}}, false, true},
+		{"Should impersonate service account in anonymous requests", http.Header{}, true, false},
+		{"Should require credentials in requests that do not allow anonymous access", http.Header{}, false, true},
+	}
+
+	manager := NewClientManager("", server.URL)
+	for _, c := range cases {
+		req := restful.NewRequest(&http.Request{Header: c.header, TLS: &tls.ConnectionState{}})
+		if c.anonymous {
+			AllowAnonymousAccess(req)
+		}
+
+		cfg, err := manager.Config(req)
+		if (err != nil) != c.expectedError {
+			t.Fatalf("Test Case: %s. Expected error: %t, but got %v", c.info, c.expectedError, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if len(cfg.BearerToken) > 0 {
+			t.Errorf("Test Case: %s. Expected credentials of the user not to be used but got token %q", c.info,
+				cfg.BearerToken)
+		}
+
+		expectedGroups := []string{"system:serviceaccounts", "system:serviceaccounts:kube-public", "system:authenticated"}
+		if cfg.Impersonate.UserName != "system:serviceaccount:kube-public:viewer" ||
+			!reflect.DeepEqual(cfg.Impersonate.Groups, expectedGroups) {
+			t.Errorf("Test Case: %s. Expected service account to be impersonated but got %v", c.info, cfg.Impersonate)
+		}
+	}
+
+	if reviews != 1 {
+		t.Errorf("Expected credentials of the user to be reviewed once, but got %d reviews", reviews)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Schemes of the proxy that can be used to connect to the apiserver.
+var apiServerProxySchemes = map[string]bool{"http": true, "https": true, "socks5": true}
+
+// ApiServerProxy returns function selecting proxy for requests sent to the apiserver based on proxyURL. Hosts
+// matching any of the noProxy entries are reached directly. Returns nil if proxyURL is empty and error if it is
+// not a valid proxy address.
+func ApiServerProxy(proxyURL string, noProxy []string) (func(*http.Request) (*url.URL, error), error) {
+	if len(proxyURL) == 0 {
+		return nil, nil
+	}
+
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --apiserver-proxy-url %s: %s", proxyURL, err.Error())
+	}
+
+	if !apiServerProxySchemes[parsed.Scheme] || len(parsed.Host) == 0 {
+		return nil, fmt.Errorf("invalid --apiserver-proxy-url %s: has to be in format "+
+			"'protocol://address:port' with one of http, https or socks5 protocols", proxyURL)
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    strings.Join(noProxy, ","),
+	}).ProxyFunc()
+
+	return func(request *http.Request) (*url.URL, error) {
+		return proxyFunc(request.URL)
+	}, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestApiServerProxy(t *testing.T) {
+	cases := []struct {
+		info          string
+		proxyURL      string
+		noProxy       []string
+		requestURL    string
+		expectedProxy string
+		expectedErr   bool
+	}{
+		{"Should not use proxy if it is not set", "", nil, "https://apiserver:6443", "", false},
+		{"Should use HTTP proxy", "http://proxy:3128", nil, "https://apiserver:6443", "http://proxy:3128", false},
+		{"Should use SOCKS5 proxy", "socks5://proxy:1080", nil, "https://apiserver:6443", "socks5://proxy:1080",
+			false},
+		{"Should use proxy for insecure apiserver", "http://proxy:3128", nil, "http://apiserver:8080",
+			"http://proxy:3128", false},
+		{"Should not use proxy for host from no proxy list", "http://proxy:3128", []string{"other", "apiserver"},
+			"https://apiserver:6443", "", false},
+		{"Should not use proxy for domain from no proxy list", "http://proxy:3128", []string{".cluster.local"},
+			"https://kubernetes.default.svc.cluster.local", "", false},
+		{"Should not use proxy for CIDR from no proxy list", "http://proxy:3128", []string{"10.0.0.0/8"},
+			"https://10.96.0.1:443", "", false},
+		{"Should reject unsupported scheme", "ftp://proxy:21", nil, "", "", true},
+		{"Should reject proxy without host", "http://", nil, "", "", true},
+		{"Should reject proxy without scheme", "proxy:3128", nil, "", "", true},
+	}
+
+	for _, c := range cases {
+		proxy, err := ApiServerProxy(c.proxyURL, c.noProxy)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+			continue
+		}
+
+		if err != nil || len(c.proxyURL) == 0 {
+			if proxy != nil {
+				t.Errorf("Test Case: %s. Expected proxy function to be nil.", c.info)
+			}
+			continue
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, c.requestURL, nil)
+		actual, err := proxy(request)
+		if err != nil {
+			t.Errorf("Test Case: %s. Expected no error, but got %s.", c.info, err)
+			continue
+		}
+
+		actualProxy := ""
+		if actual != nil {
+			actualProxy = actual.String()
+		}
+
+		if actualProxy != c.expectedProxy {
+			t.Errorf("Test Case: %s. Expected proxy %s, but got %s.", c.info, c.expectedProxy, actualProxy)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+// ProxyAuthToken returns token from the header configured with --authentication-header. Header is honored only if
+// request was sent directly by the proxy from one of --trusted-proxy-cidrs, so it can not be spoofed by users.
+// Returns empty string if header is not configured, not present or request did not come from a trusted proxy.
+func ProxyAuthToken(req *http.Request) string {
+	header := args.Holder.GetAuthenticationHeader()
+	if len(header) == 0 {
+		return ""
+	}
+
+	token := strings.TrimSpace(req.Header.Get(header))
+	if len(token) == 0 || !isTrustedProxy(req.RemoteAddr) {
+		return ""
+	}
+
+	return strings.TrimPrefix(token, "Bearer ")
+}
+
+// Checks if remote address of the connection belongs to one of the trusted proxy CIDRs. Forwarding headers are not
+// taken into account on purpose.
+func isTrustedProxy(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range args.Holder.GetTrustedProxyCIDRNets() {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
+)
+
+// requestContextRoundTripper binds requests sent to the API server without cancellable context, i.e. with
+// context.TODO(), to the context of the dashboard request, so they are cancelled together with it.
+type requestContextRoundTripper struct {
+	ctx      context.Context
+	delegate http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper interface.
+func (self *requestContextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Done() == nil {
+		req = req.WithContext(self.ctx)
+	}
+
+	return self.delegate.RoundTrip(req)
+}
+
+// requestIDRoundTripper sets ID of the dashboard request on requests sent to the API server, so that they can be
+// correlated with logs of dashboard.
+type requestIDRoundTripper struct {
+	header    string
+	requestID string
+	delegate  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper interface.
+func (self *requestIDRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(req.Header.Get(self.header)) == 0 {
+		req = req.Clone(req.Context())
+		req.Header.Set(self.header, self.requestID)
+	}
+
+	return self.delegate.RoundTrip(req)
+}
+
+// Makes clients created from given config cancel their requests once deadline of the dashboard request, set by
+// --request-timeout, passes. Trace of the dashboard request is continued by requests sent to the API server and
+// its ID is sent in --request-id-header. Configs shared between requests must not be passed here.
+func bindRequestContext(cfg *rest.Config, req *http.Request) {
+	if req == nil {
+		return
+	}
+
+	ctx := req.Context()
+	if requestID := logging.RequestID(ctx); len(requestID) > 0 {
+		header := args.Holder.GetRequestIDHeader()
+		cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &requestIDRoundTripper{header: header, requestID: requestID, delegate: rt}
+		})
+	}
+
+	if _, ok := ctx.Deadline(); !ok && !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &requestContextRoundTripper{ctx: ctx, delegate: rt}
+	})
+}
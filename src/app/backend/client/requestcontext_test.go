@@ -0,0 +1,65 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
+)
+
+func TestBindRequestContextRequestID(t *testing.T) {
+	defer args.GetHolderBuilder().SetRequestIDHeader("")
+	args.GetHolderBuilder().SetRequestIDHeader("X-Request-ID")
+
+	received := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("X-Request-ID")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"major":"1","minor":"21"}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		info      string
+		requestID string
+	}{
+		{"Should forward ID of the request", "abc-123"},
+		{"Should not set header without ID of the request", ""},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pod", nil)
+		if len(c.requestID) > 0 {
+			req = req.WithContext(logging.WithRequestID(req.Context(), c.requestID))
+		}
+
+		cfg := &rest.Config{Host: server.URL}
+		bindRequestContext(cfg, req)
+		if _, err := kubernetes.NewForConfigOrDie(cfg).Discovery().ServerVersion(); err != nil {
+			t.Fatalf("Test Case: %s. Expected no error, but got %s.", c.info, err)
+		}
+
+		if received != c.requestID {
+			t.Errorf("Test Case: %s. Expected request ID %q, but got %q.", c.info, c.requestID, received)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+)
+
+// Status codes of the API server responses that are retried with --apiserver-max-retries.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusServiceUnavailable:  true,
+}
+
+// Delay before the first retry. It is doubled with every next attempt.
+var retryInitialBackoff = 200 * time.Millisecond
+
+// Maximum delay between retries, longer Retry-After sent by the API server is capped to it.
+const retryMaxBackoff = 10 * time.Second
+
+// retryRoundTripper retries idempotent requests sent to the API server that failed with retryable status code or
+// transient network error. Mutating requests and connection upgrades, i.e. exec into containers, are never retried.
+type retryRoundTripper struct {
+	maxRetries int
+	delegate   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper interface.
+func (self *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isRetryable(req) {
+		return self.delegate.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := self.delegate.RoundTrip(req)
+		if attempt >= self.maxRetries || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := retryDelay(resp, attempt)
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// Checks if request can be safely sent again. Only requests that do not modify resources and have no body are retried.
+func isRetryable(req *http.Request) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+
+	return req.Body == nil && len(req.Header.Get("Upgrade")) == 0
+}
+
+// Checks if request failed with retryable status code or transient network error. Requests cancelled by the
+// dashboard are not retried.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return true
+		}
+
+		return utilnet.IsConnectionReset(err) || utilnet.IsConnectionRefused(err) || utilnet.IsProbableEOF(err)
+	}
+
+	return retryableStatusCodes[resp.StatusCode]
+}
+
+// Returns time to wait before the next attempt. Retry-After sent by the API server is honored, otherwise delay grows
+// exponentially with every attempt.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	delay := retryInitialBackoff
+	for i := 0; i < attempt && delay < retryMaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if resp != nil {
+		if retryAfter := resp.Header.Get("Retry-After"); len(retryAfter) > 0 {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+				delay = time.Duration(seconds) * time.Second
+			} else if date, err := http.ParseTime(retryAfter); err == nil {
+				delay = time.Until(date)
+			}
+		}
+	}
+
+	if delay < 0 {
+		return 0
+	}
+
+	if delay > retryMaxBackoff {
+		return retryMaxBackoff
+	}
+
+	return delay
+}
+
+// Makes clients created from given config retry idempotent requests up to maxRetries times.
+func configureRetries(cfg *rest.Config, maxRetries int) {
+	if maxRetries <= 0 {
+		return
+	}
+
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &retryRoundTripper{maxRetries: maxRetries, delegate: rt}
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryRoundTripper(t *testing.T) {
+	defer func(backoff time.Duration) {
+		retryInitialBackoff = backoff
+	}(retryInitialBackoff)
+	retryInitialBackoff = time.Millisecond
+
+	cases := []struct {
+		info             string
+		method           string
+		failures         int32
+		status           int
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int32
+	}{
+		{"Should retry GET on 503", http.MethodGet, 2, http.StatusServiceUnavailable, 3, http.StatusOK, 3},
+		{"Should retry GET on 429", http.MethodGet, 1, http.StatusTooManyRequests, 3, http.StatusOK, 2},
+		{"Should retry GET on 500", http.MethodGet, 1, http.StatusInternalServerError, 3, http.StatusOK, 2},
+		{"Should give up after max retries", http.MethodGet, 5, http.StatusServiceUnavailable, 2,
+			http.StatusServiceUnavailable, 3},
+		{"Should not retry other status codes", http.MethodGet, 1, http.StatusNotFound, 3, http.StatusNotFound, 1},
+		{"Should not retry POST", http.MethodPost, 1, http.StatusServiceUnavailable, 3,
+			http.StatusServiceUnavailable, 1},
+	}
+
+	for _, c := range cases {
+		attempts := int32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) <= c.failures {
+				w.WriteHeader(c.status)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req, _ := http.NewRequest(c.method, server.URL, nil)
+		if c.method == http.MethodPost {
+			req, _ = http.NewRequest(c.method, server.URL, strings.NewReader("{}"))
+		}
+
+		rt := &retryRoundTripper{maxRetries: c.maxRetries, delegate: http.DefaultTransport}
+		resp, err := rt.RoundTrip(req)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %v", c.info, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != c.expectedStatus || attempts != c.expectedAttempts {
+			t.Errorf("Test Case: %s. Expected status %d after %d attempts, but got %d after %d attempts.", c.info,
+				c.expectedStatus, c.expectedAttempts, resp.StatusCode, attempts)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	defer func(backoff time.Duration) {
+		retryInitialBackoff = backoff
+	}(retryInitialBackoff)
+	retryInitialBackoff = 100 * time.Millisecond
+
+	cases := []struct {
+		info       string
+		retryAfter string
+		attempt    int
+		expected   time.Duration
+	}{
+		{"Should use initial backoff", "", 0, 100 * time.Millisecond},
+		{"Should double backoff with every attempt", "", 2, 400 * time.Millisecond},
+		{"Should cap backoff", "", 30, retryMaxBackoff},
+		{"Should honor Retry-After in seconds", "3", 0, 3 * time.Second},
+		{"Should cap Retry-After", "120", 0, retryMaxBackoff},
+		{"Should ignore invalid Retry-After", "soon", 1, 200 * time.Millisecond},
+	}
+
+	for _, c := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		if len(c.retryAfter) > 0 {
+			resp.Header.Set("Retry-After", c.retryAfter)
+		}
+
+		if actual := retryDelay(resp, c.attempt); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected delay %s, but got %s.", c.info, c.expected, actual)
+		}
+	}
+}
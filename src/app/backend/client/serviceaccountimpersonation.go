@@ -0,0 +1,59 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/rest"
+)
+
+// ParseServiceAccount parses service account given in 'namespace:name' format, the same as in the
+// 'system:serviceaccount:namespace:name' username of the service account.
+func ParseServiceAccount(serviceAccount string) (namespace, name string, err error) {
+	parts := strings.Split(serviceAccount, ":")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("invalid service account %q, it should be in 'namespace:name' format",
+			serviceAccount)
+	}
+
+	namespace, name = parts[0], parts[1]
+	if msgs := validation.IsDNS1123Label(namespace); len(msgs) > 0 {
+		return "", "", fmt.Errorf("invalid namespace %q of service account: %s", namespace, strings.Join(msgs, ", "))
+	}
+
+	if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+		return "", "", fmt.Errorf("invalid name %q of service account: %s", name, strings.Join(msgs, ", "))
+	}
+
+	return namespace, name, nil
+}
+
+// ParseImpersonatedServiceAccount parses service account in 'namespace:name' format and returns impersonation config
+// of its identity, the same that the apiserver assigns to requests authenticated with the service account token.
+func ParseImpersonatedServiceAccount(serviceAccount string) (*rest.ImpersonationConfig, error) {
+	namespace, name, err := ParseServiceAccount(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rest.ImpersonationConfig{
+		UserName: serviceaccount.MakeUsername(namespace, name),
+		Groups:   append(serviceaccount.MakeGroupNames(namespace), "system:authenticated"),
+	}, nil
+}
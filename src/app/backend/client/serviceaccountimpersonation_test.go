@@ -0,0 +1,70 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import "testing"
+
+func TestParseServiceAccount(t *testing.T) {
+	cases := []struct {
+		serviceAccount    string
+		expectedNamespace string
+		expectedName      string
+		expectedErr       bool
+	}{
+		{"kube-system:viewer", "kube-system", "viewer", false},
+		{"viewer", "", "", true},
+		{"kube-system/viewer", "", "", true},
+		{"kube-system:", "", "", true},
+		{"a:b:c", "", "", true},
+		{"Kube_System:viewer", "", "", true},
+	}
+
+	for _, c := range cases {
+		namespace, name, err := ParseServiceAccount(c.serviceAccount)
+		if (err != nil) != c.expectedErr || namespace != c.expectedNamespace || name != c.expectedName {
+			t.Errorf("ParseServiceAccount(%q) == (%s, %s, %v), expected (%s, %s) and error: %t", c.serviceAccount,
+				namespace, name, err, c.expectedNamespace, c.expectedName, c.expectedErr)
+		}
+	}
+}
+
+func TestParseImpersonatedServiceAccount(t *testing.T) {
+	cases := []struct {
+		serviceAccount string
+		expectedUser   string
+		expectedError  bool
+	}{
+		{"kube-public:viewer", "system:serviceaccount:kube-public:viewer", false},
+		{"kube-public/viewer", "", true},
+		{"kube-public:", "", true},
+		{"kube-public:viewer:extra", "", true},
+		{"Kube_Public:viewer", "", true},
+		{"kube-public:Viewer", "", true},
+	}
+
+	for _, c := range cases {
+		impersonation, err := ParseImpersonatedServiceAccount(c.serviceAccount)
+		if (err != nil) != c.expectedError {
+			t.Errorf("ParseImpersonatedServiceAccount(%s) returned error %v, expected error: %t", c.serviceAccount,
+				err, c.expectedError)
+			continue
+		}
+
+		if err == nil && impersonation.UserName != c.expectedUser {
+			t.Errorf("ParseImpersonatedServiceAccount(%s) == %s, expected %s", c.serviceAccount,
+				impersonation.UserName, c.expectedUser)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenFile provides bearer token read from the file passed with --token-file. File is read again once its
+// modification time changes, so the token can be rotated without restart.
+type tokenFile struct {
+	mux     sync.Mutex
+	path    string
+	token   string
+	modTime time.Time
+}
+
+// Token returns current token. Previously read token is returned if the file could not be read.
+func (self *tokenFile) Token() string {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	info, err := os.Stat(self.path)
+	if err != nil {
+		log.Printf("Could not check token file %s: %s", self.path, err.Error())
+		return self.token
+	}
+
+	if info.ModTime().Equal(self.modTime) {
+		return self.token
+	}
+
+	token, err := ReadTokenFile(self.path)
+	if err != nil {
+		log.Printf("Could not reload token file: %s", err.Error())
+		return self.token
+	}
+
+	log.Printf("Reloaded token from %s", self.path)
+	self.token = token
+	self.modTime = info.ModTime()
+	return self.token
+}
+
+// ReadTokenFile returns bearer token stored in the file with given path. Surrounding whitespace is removed. Returns
+// error if the file could not be read or does not contain a token.
+func ReadTokenFile(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(data))
+	if len(token) == 0 {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+func newTokenFile(path string) *tokenFile {
+	return &tokenFile{path: path}
+}
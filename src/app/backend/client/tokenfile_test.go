@@ -0,0 +1,114 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+func TestReadTokenFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		content     string
+		expected    string
+		expectedErr bool
+	}{
+		{"token\n", "token", false},
+		{"  \n", "", true},
+	}
+
+	for _, c := range cases {
+		path := filepath.Join(dir, "token")
+		if err := ioutil.WriteFile(path, []byte(c.content), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		actual, err := ReadTokenFile(path)
+		if (err != nil) != c.expectedErr || actual != c.expected {
+			t.Errorf("ReadTokenFile() with content %q == (%s, %v), expected %s and error: %t", c.content, actual, err,
+				c.expected, c.expectedErr)
+		}
+	}
+
+	if _, err := ReadTokenFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("Expected error for missing token file.")
+	}
+}
+
+func TestTokenFileReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer args.GetHolderBuilder().SetTokenFile("")
+
+	path := filepath.Join(dir, "token")
+	write := func(token string, modTime time.Time) {
+		if err := ioutil.WriteFile(path, []byte(token), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	write("first", now)
+	args.GetHolderBuilder().SetTokenFile(path)
+	manager := NewClientManager("", "http://localhost:8080").(*clientManager)
+	request := restful.NewRequest(&http.Request{Header: http.Header{}})
+
+	if !manager.isSecureModeEnabled(request) {
+		t.Error("Expected secure mode to be enabled when token file is used.")
+	}
+
+	for _, c := range []struct {
+		token    string
+		modTime  time.Time
+		expected string
+	}{
+		{"", now, "first"},
+		{"second", now.Add(time.Minute), "second"},
+		{"", now.Add(2 * time.Minute), "second"},
+	} {
+		if len(c.token) > 0 || !c.modTime.Equal(now) {
+			write(c.token, c.modTime)
+		}
+
+		authInfo, err := manager.extractAuthInfo(request)
+		if err != nil {
+			t.Fatalf("Expected auth info to be extracted, but got error: %s", err)
+		}
+
+		if authInfo.Token != c.expected {
+			t.Errorf("Expected token %s, but got %s.", c.expected, authInfo.Token)
+		}
+	}
+}
@@ -17,6 +17,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
@@ -25,6 +26,7 @@ import (
 	restclient "k8s.io/client-go/rest"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/customresourcedefinition"
@@ -82,6 +84,24 @@ func (verber *resourceVerber) getRESTClientByType(clientType api.ClientType) RES
 }
 
 func (verber *resourceVerber) getResourceSpecFromKind(kind string, namespaceSet bool) (client RESTClient, resourceSpec api.APIMapping, err error) {
+	client, resourceSpec, err = verber.getResourceSpec(kind)
+	if err != nil {
+		return
+	}
+
+	if namespaceSet != resourceSpec.Namespaced {
+		if namespaceSet {
+			err = errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource kind: %s", kind))
+			return
+		}
+		err = errors.NewInvalid(fmt.Sprintf("Set no namespace for namespaced resource kind: %s", kind))
+		return
+	}
+
+	return
+}
+
+func (verber *resourceVerber) getResourceSpec(kind string) (client RESTClient, resourceSpec api.APIMapping, err error) {
 	resourceSpec, ok := api.KindToAPIMapping[kind]
 	if !ok {
 		var crdInfo crdInfo
@@ -103,15 +123,6 @@ func (verber *resourceVerber) getResourceSpecFromKind(kind string, namespaceSet
 		}
 	}
 
-	if namespaceSet != resourceSpec.Namespaced {
-		if namespaceSet {
-			err = errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource kind: %s", kind))
-			return
-		}
-		err = errors.NewInvalid(fmt.Sprintf("Set no namespace for namespaced resource kind: %s", kind))
-		return
-	}
-
 	if client == nil {
 		client = verber.getRESTClientByType(resourceSpec.ClientType)
 	}
@@ -159,6 +170,57 @@ func (verber *resourceVerber) getCRDGroupAndVersion(kind string) (info crdInfo,
 	return
 }
 
+// Watch opens a watch of resources of given kind starting from given resource version. Namespaced resources are
+// watched in all namespaces if namespace is empty. Watch events are returned as a stream of JSON objects in the
+// format returned by the apiserver. Closing the stream stops the watch.
+func (verber *resourceVerber) Watch(kind string, namespace string, resourceVersion string) (io.ReadCloser, error) {
+	client, resourceSpec, err := verber.getResourceSpec(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(namespace) > 0 && !resourceSpec.Namespaced {
+		return nil, errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource kind: %s", kind))
+	}
+
+	req := client.Get().Resource(resourceSpec.Resource).Param("watch", "true").
+		SetHeader("Accept", "application/json")
+
+	if len(namespace) > 0 {
+		req.Namespace(namespace)
+	}
+
+	if len(resourceVersion) > 0 {
+		req.Param("resourceVersion", resourceVersion)
+	}
+
+	return req.Stream(context.TODO())
+}
+
+// List lists resources of given kind matching given options. Namespaced resources are listed in all namespaces if
+// namespace is empty.
+func (verber *resourceVerber) List(kind string, namespace string, opts v1.ListOptions) (runtime.Object, error) {
+	client, resourceSpec, err := verber.getResourceSpec(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(namespace) > 0 && !resourceSpec.Namespaced {
+		return nil, errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource kind: %s", kind))
+	}
+
+	result := &runtime.Unknown{}
+	req := client.Get().Resource(resourceSpec.Resource).VersionedParams(&opts, v1.ParameterCodec).
+		SetHeader("Accept", "application/json")
+
+	if len(namespace) > 0 {
+		req.Namespace(namespace)
+	}
+
+	err = req.Do(context.TODO()).Into(result)
+	return result, err
+}
+
 // RESTClient is an interface for REST operations used in this file.
 type RESTClient interface {
 	Delete() *restclient.Request
@@ -172,20 +234,24 @@ func NewResourceVerber(client, extensionsClient, appsClient, batchClient, betaBa
 		batchClient, betaBatchClient, autoscalingClient, storageClient, rbacClient, networkingClient, apiExtensionsClient, pluginsClient, config}
 }
 
-// Delete deletes the resource of the given kind in the given namespace with the given name.
-func (verber *resourceVerber) Delete(kind string, namespaceSet bool, namespace string, name string) error {
+// Delete deletes the resource of the given kind in the given namespace with the given name. Dependents are deleted
+// according to the propagation policy, or the one set with --default-delete-propagation if it is empty.
+func (verber *resourceVerber) Delete(kind string, namespaceSet bool, namespace string, name string,
+	propagationPolicy v1.DeletionPropagation) error {
 	client, resourceSpec, err := verber.getResourceSpecFromKind(kind, namespaceSet)
 	if err != nil {
 		return err
 	}
 
-	// Do cascade delete by default, as this is what users typically expect.
-	defaultPropagationPolicy := v1.DeletePropagationForeground
-	defaultDeleteOptions := &v1.DeleteOptions{
-		PropagationPolicy: &defaultPropagationPolicy,
+	if len(propagationPolicy) == 0 {
+		propagationPolicy = v1.DeletionPropagation(args.Holder.GetDefaultDeletePropagation())
+	}
+	deleteOptions := &v1.DeleteOptions{}
+	if len(propagationPolicy) > 0 {
+		deleteOptions.PropagationPolicy = &propagationPolicy
 	}
 
-	req := client.Delete().Resource(resourceSpec.Resource).Name(name).Body(defaultDeleteOptions)
+	req := client.Delete().Resource(resourceSpec.Resource).Name(name).Body(deleteOptions)
 
 	if resourceSpec.Namespaced {
 		req.Namespace(namespace)
@@ -194,26 +260,34 @@ func (verber *resourceVerber) Delete(kind string, namespaceSet bool, namespace s
 	return req.Do(context.TODO()).Error()
 }
 
-// Put puts new resource version of the given kind in the given namespace with the given name.
+// Put puts new resource version of the given kind in the given namespace with the given name and returns the object
+// stored by the apiserver. Changes are validated but not persisted if dry run is requested.
 func (verber *resourceVerber) Put(kind string, namespaceSet bool, namespace string, name string,
-	object *runtime.Unknown) error {
+	object *runtime.Unknown, dryRun []string) (*runtime.Unknown, error) {
 
 	client, resourceSpec, err := verber.getResourceSpecFromKind(kind, namespaceSet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req := client.Put().
 		Resource(resourceSpec.Resource).
 		Name(name).
 		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
 		Body([]byte(object.Raw))
 
 	if resourceSpec.Namespaced {
 		req.Namespace(namespace)
 	}
 
-	return req.Do(context.TODO()).Error()
+	for _, value := range dryRun {
+		req.Param("dryRun", value)
+	}
+
+	result := &runtime.Unknown{}
+	err = req.Do(context.TODO()).Into(result)
+	return result, err
 }
 
 // Get gets the resource of the given kind in the given namespace with the given name.
@@ -15,6 +15,8 @@
 package client
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -28,6 +30,7 @@ import (
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/rest/fake"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
@@ -40,10 +43,12 @@ func (f clientFunc) Do(req *http.Request) (*http.Response, error) {
 type FakeRESTClient struct {
 	response *http.Response
 	err      error
+	request  *http.Request
 }
 
 func NewFakeClientFunc(c *FakeRESTClient) clientFunc {
 	return clientFunc(func(req *http.Request) (*http.Response, error) {
+		c.request = req
 		return c.response, c.err
 	})
 }
@@ -65,7 +70,11 @@ func (c *FakeRESTClient) Delete() *restclient.Request {
 }
 
 func (c *FakeRESTClient) Put() *restclient.Request {
-	return restclient.NewRequestWithClient(&url.URL{Path: "/api/v1/"}, "", restclient.ClientContentConfig{}, fake.CreateHTTPClient(NewFakeClientFunc(c))).Verb("PUT")
+	groupVersion := schema.GroupVersion{Version: "v1"}
+	return restclient.NewRequestWithClient(&url.URL{Path: "/api/v1/"}, "", restclient.ClientContentConfig{
+		ContentType: "application/json",
+		Negotiator:  runtime.NewClientNegotiator(scheme.Codecs.WithoutConversion(), groupVersion),
+	}, fake.CreateHTTPClient(NewFakeClientFunc(c))).Verb("PUT")
 }
 
 func (c *FakeRESTClient) Get() *restclient.Request {
@@ -85,19 +94,19 @@ func TestDeleteShouldPropagateErrorsAndChooseClient(t *testing.T) {
 		appsClient:       &FakeRESTClient{err: errors.NewInvalid("err from apps")},
 	}
 
-	err := verber.Delete("replicaset", true, "bar", "baz")
+	err := verber.Delete("replicaset", true, "bar", "baz", "")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Delete /api/v1/namespaces/bar/replicasets/baz: err from apps") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
 	}
 
-	err = verber.Delete("service", true, "bar", "baz")
+	err = verber.Delete("service", true, "bar", "baz", "")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Delete /api/v1/namespaces/bar/services/baz: err") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
 	}
 
-	err = verber.Delete("statefulset", true, "bar", "baz")
+	err = verber.Delete("statefulset", true, "bar", "baz", "")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Delete /api/v1/namespaces/bar/statefulsets/baz: err from apps") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
@@ -136,7 +145,7 @@ func TestDeleteShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
 		apiExtensionsClient: &FakeRESTClient{err: errors.NewNotFound("err")},
 	}
 
-	err := verber.Delete("foo", true, "bar", "baz")
+	err := verber.Delete("foo", true, "bar", "baz", "")
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/customresourcedefinitions/foo: err") {
 		t.Fatalf("Expected error on verber delete but got %#v", err.Error())
@@ -162,13 +171,77 @@ func TestPutShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
 		apiExtensionsClient: &FakeRESTClient{err: errors.NewNotFound("err")},
 	}
 
-	err := verber.Put("foo", false, "", "baz", nil)
+	_, err := verber.Put("foo", false, "", "baz", nil, nil)
 
 	if !reflect.DeepEqual(normalize(err.Error()), "Get /api/v1/customresourcedefinitions/foo: err") {
 		t.Fatalf("Expected error on verber put but got %#v", err.Error())
 	}
 }
 
+func TestPutShouldPassDryRunAndReturnObject(t *testing.T) {
+	body := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"baz","namespace":"bar"}}`
+	fakeClient := &FakeRESTClient{response: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}}
+	verber := resourceVerber{client: fakeClient}
+
+	result, err := verber.Put("service", true, "bar", "baz", &runtime.Unknown{Raw: []byte(body)},
+		[]string{metaV1.DryRunAll})
+	if err != nil {
+		t.Fatalf("Expected no error on verber put but got %#v", err)
+	}
+
+	if dryRun := fakeClient.request.URL.Query().Get("dryRun"); dryRun != metaV1.DryRunAll {
+		t.Errorf("Expected dryRun query parameter to be %s but got %s", metaV1.DryRunAll, dryRun)
+	}
+
+	if string(result.Raw) != body {
+		t.Errorf("Expected object returned by the apiserver to be %s but got %s", body, result.Raw)
+	}
+}
+
+func TestDeleteShouldSetPropagationPolicy(t *testing.T) {
+	defer args.GetHolderBuilder().SetDefaultDeletePropagation("")
+	args.GetHolderBuilder().SetDefaultDeletePropagation(string(metaV1.DeletePropagationBackground))
+
+	cases := []struct {
+		propagationPolicy metaV1.DeletionPropagation
+		expected          metaV1.DeletionPropagation
+	}{
+		{"", metaV1.DeletePropagationBackground},
+		{metaV1.DeletePropagationOrphan, metaV1.DeletePropagationOrphan},
+	}
+
+	for _, c := range cases {
+		fakeClient := &FakeRESTClient{response: &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader("{}")),
+		}}
+		verber := resourceVerber{client: fakeClient}
+
+		if err := verber.Delete("service", true, "bar", "baz", c.propagationPolicy); err != nil {
+			t.Fatalf("Expected no error on verber delete but got %#v", err)
+		}
+
+		body, err := ioutil.ReadAll(fakeClient.request.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		options := metaV1.DeleteOptions{}
+		if err := json.Unmarshal(body, &options); err != nil {
+			t.Fatal(err)
+		}
+
+		if options.PropagationPolicy == nil || *options.PropagationPolicy != c.expected {
+			t.Errorf("Expected propagation policy %s for %q but got %s", c.expected, c.propagationPolicy, body)
+		}
+	}
+}
+
 func TestGetShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
@@ -182,7 +255,7 @@ func TestGetShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 func TestPutShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Put("service", false, "", "baz", nil)
+	_, err := verber.Put("service", false, "", "baz", nil, nil)
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set no namespace for namespaced resource kind: service")) {
 		t.Fatalf("Expected error on verber put but got %#v", err)
@@ -192,7 +265,7 @@ func TestPutShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 func TestDeleteShouldRespectNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Delete("service", false, "", "baz")
+	err := verber.Delete("service", false, "", "baz", "")
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set no namespace for namespaced resource kind: service")) {
 		t.Fatalf("Expected error on verber delete but got %#v", err)
@@ -212,7 +285,7 @@ func TestGetShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 func TestPutShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Put("namespace", true, "bar", "baz", nil)
+	_, err := verber.Put("namespace", true, "bar", "baz", nil, nil)
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
 		t.Fatalf("Expected error on verber put but got %#v", err)
@@ -222,9 +295,49 @@ func TestPutShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 func TestDeleteShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 	verber := resourceVerber{client: &FakeRESTClient{}}
 
-	err := verber.Delete("namespace", true, "bar", "baz")
+	err := verber.Delete("namespace", true, "bar", "baz", "")
 
 	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
 		t.Fatalf("Expected error on verber delete but got %#v", err)
 	}
 }
+
+func TestWatch(t *testing.T) {
+	cases := []struct {
+		kind            string
+		namespace       string
+		resourceVersion string
+		expectedURL     string
+	}{
+		{"pod", "default", "123", "/api/v1/namespaces/default/pods?resourceVersion=123&watch=true"},
+		{"pod", "", "", "/api/v1/pods?watch=true"},
+		{"node", "", "", "/api/v1/nodes?watch=true"},
+	}
+
+	for _, c := range cases {
+		client := &FakeRESTClient{response: &http.Response{StatusCode: http.StatusOK,
+			Body: ioutil.NopCloser(strings.NewReader(`{"type":"ADDED","object":{}}`))}}
+		verber := resourceVerber{client: client}
+
+		stream, err := verber.Watch(c.kind, c.namespace, c.resourceVersion)
+		if err != nil {
+			t.Fatalf("Watch(%s, %s, %s): unexpected error %s", c.kind, c.namespace, c.resourceVersion, err)
+		}
+		stream.Close()
+
+		if actual := client.request.URL.RequestURI(); actual != c.expectedURL {
+			t.Errorf("Watch(%s, %s, %s): expected URL %s, but got %s", c.kind, c.namespace, c.resourceVersion,
+				c.expectedURL, actual)
+		}
+	}
+}
+
+func TestWatchShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
+	verber := resourceVerber{client: &FakeRESTClient{}}
+
+	_, err := verber.Watch("namespace", "bar", "")
+
+	if !reflect.DeepEqual(err, errors.NewInvalid("Set namespace for not-namespaced resource kind: namespace")) {
+		t.Fatalf("Expected error on verber watch but got %#v", err)
+	}
+}
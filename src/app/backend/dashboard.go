@@ -15,20 +15,29 @@
 package main
 
 import (
+	"context"
 	"crypto/elliptic"
 	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"net/http/pprof"
 	"os"
+	"strconv"
+	"strings"
 	"time"
+	// Timezone database is embedded so that --default-timezone can be validated in images without one.
+	_ "time/tzdata"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
 	"github.com/kubernetes/dashboard/src/app/backend/auth"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
@@ -39,41 +48,157 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/handler"
 	"github.com/kubernetes/dashboard/src/app/backend/integration"
 	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	"github.com/kubernetes/dashboard/src/app/backend/kubecontext"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
 	"github.com/kubernetes/dashboard/src/app/backend/settings"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
 	"github.com/kubernetes/dashboard/src/app/backend/sync"
 	"github.com/kubernetes/dashboard/src/app/backend/systembanner"
+	systembannerApi "github.com/kubernetes/dashboard/src/app/backend/systembanner/api"
+	"github.com/kubernetes/dashboard/src/app/backend/tracing"
 )
 
 var (
-	argInsecurePort              = pflag.Int("insecure-port", 9090, "port to listen to for incoming HTTP requests")
-	argPort                      = pflag.Int("port", 8443, "secure port to listen to for incoming HTTPS requests")
-	argInsecureBindAddress       = pflag.IP("insecure-bind-address", net.IPv4(127, 0, 0, 1), "IP address on which to serve the --insecure-port, set to 127.0.0.1 for all interfaces")
-	argBindAddress               = pflag.IP("bind-address", net.IPv4(0, 0, 0, 0), "IP address on which to serve the --port, set to 0.0.0.0 for all interfaces")
-	argDefaultCertDir            = pflag.String("default-cert-dir", "/certs", "directory path containing files from --tls-cert-file and --tls-key-file, used also when auto-generating certificates flag is set")
-	argCertFile                  = pflag.String("tls-cert-file", "", "file containing the default x509 certificate for HTTPS")
-	argKeyFile                   = pflag.String("tls-key-file", "", "file containing the default x509 private key matching --tls-cert-file")
-	argApiserverHost             = pflag.String("apiserver-host", "", "address of the Kubernetes API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for local discovery attempt")
-	argMetricsProvider           = pflag.String("metrics-provider", "sidecar", "select provider type for metrics, 'none' will not check metrics")
-	argHeapsterHost              = pflag.String("heapster-host", "", "address of the Heapster API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for service proxy usage")
-	argSidecarHost               = pflag.String("sidecar-host", "", "address of the Sidecar API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for service proxy usage")
-	argKubeConfigFile            = pflag.String("kubeconfig", "", "path to kubeconfig file with authorization and master location information")
-	argTokenTTL                  = pflag.Int("token-ttl", authApi.DefaultTokenTTL, "expiration time in seconds of JWE tokens generated by dashboard, set to 0 to avoid expiration")
-	argAuthenticationMode        = pflag.StringSlice("authentication-mode", []string{authApi.Token.String()}, "enabled authentication options, supports 'token' and 'basic' that should only be used if Kubernetes API server has --authorization-mode=ABAC and --basic-auth-file flags set")
-	argMetricClientCheckPeriod   = pflag.Int("metric-client-check-period", 30, "time interval between separate metric client health checks in seconds")
-	argAutoGenerateCertificates  = pflag.Bool("auto-generate-certificates", false, "enables automatic certificates generation used to serve HTTPS")
-	argEnableInsecureLogin       = pflag.Bool("enable-insecure-login", false, "enables login view when the app is not served over HTTPS")
-	argEnableSkip                = pflag.Bool("enable-skip-login", false, "enables skip button on the login page")
-	argSystemBanner              = pflag.String("system-banner", "", "system banner message displayed in the app if non-empty, it accepts simple HTML")
-	argSystemBannerSeverity      = pflag.String("system-banner-severity", "INFO", "severity of system banner, should be one of 'INFO', 'WARNING' or 'ERROR'")
-	argAPILogLevel               = pflag.String("api-log-level", "INFO", "level of API request logging, should be one of 'NONE', 'INFO' or 'DEBUG'")
-	argDisableSettingsAuthorizer = pflag.Bool("disable-settings-authorizer", false, "disables settings page user authorizer so anyone can access settings page")
-	argNamespace                 = pflag.String("namespace", getEnv("POD_NAMESPACE", "kube-system"), "if non-default namespace is used encryption key will be created in the specified namespace")
-	localeConfig                 = pflag.String("locale-config", "./locale_conf.json", "path to file containing the locale configuration")
+	argInsecurePort                     = pflag.Int("insecure-port", 9090, "port to listen to for incoming HTTP requests, set to 0 to disable plain HTTP listener")
+	argPort                             = pflag.Int("port", 8443, "secure port to listen to for incoming HTTPS requests")
+	argUnixSocket                       = pflag.String("unix-socket", "", "path of the unix socket to listen to for incoming HTTP requests in addition to the TCP ports, if --port and --insecure-port are set to 0 it is the only listener")
+	argUnixSocketMode                   = pflag.String("unix-socket-mode", "0660", "file permissions of the unix socket in octal format")
+	argBasePath                         = pflag.String("base-path", "", "path prefix under which dashboard is served, i.e. '/dashboard', useful when running behind a reverse proxy")
+	argAPIPrefixes                      = pflag.StringArray("api-prefix", []string{handler.DefaultAPIPrefix}, "path prefix of the REST API, relative to --base-path, can be repeated to serve the API under multiple prefixes at the same time, the first one is used by the frontend")
+	argStaticContentDir                 = pflag.String("static-content-dir", "", "directory with frontend assets that take precedence over the bundled ones, it has the same layout as the bundled assets directory, i.e. 'en/index.html', files missing in it are served from the bundle")
+	argInsecureBindAddress              = pflag.IP("insecure-bind-address", net.IPv4(127, 0, 0, 1), "IP address on which to serve the --insecure-port, set to 127.0.0.1 for all interfaces")
+	argBindAddress                      = pflag.IP("bind-address", net.IPv4(0, 0, 0, 0), "IP address on which to serve the --port, set to 0.0.0.0 for all IPv4 interfaces or to :: for all IPv4 and IPv6 interfaces")
+	argMetricsBindAddress               = pflag.String("metrics-bind-address", "", "address in host:port format on which to serve Prometheus metrics separately from the API, if empty metrics are served on the dashboard port")
+	argEnablePprof                      = pflag.Bool("enable-pprof", false, "serves pprof profiles under /debug/pprof/ on the --metrics-bind-address, they are never served on the dashboard ports")
+	argDefaultCertDir                   = pflag.String("default-cert-dir", "/certs", "directory path containing files from --tls-cert-file and --tls-key-file, used also when auto-generating certificates flag is set")
+	argCertFile                         = pflag.String("tls-cert-file", "", "file containing the default x509 certificate for HTTPS, it is reloaded automatically together with --tls-key-file whenever any of them changes")
+	argKeyFile                          = pflag.String("tls-key-file", "", "file containing the default x509 private key matching --tls-cert-file")
+	argTLSSNICerts                      = pflag.StringArray("tls-sni-cert", []string{}, "key pair served to clients requesting given host name with SNI in 'host:certfile:keyfile' format, i.e. '*.example.com:example.crt:example.key', can be repeated, relative paths are resolved against --default-cert-dir")
+	argTLSMinVersion                    = pflag.String("tls-min-version", "1.2", "minimum TLS version accepted by the HTTPS server, should be one of '1.0', '1.1', '1.2' or '1.3'")
+	argDisableHTTP2                     = pflag.Bool("disable-http2", false, "when true, only HTTP/1.1 is negotiated with ALPN on the HTTPS port")
+	argTLSCipherSuites                  = pflag.StringSlice("tls-cipher-suites", []string{}, "comma-separated list of IANA names of cipher suites accepted by the HTTPS server, i.e. 'TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256', leave it empty to use Go defaults")
+	argHSTSMaxAge                       = pflag.Int("hsts-max-age", 31536000, "max-age in seconds of the Strict-Transport-Security header set on responses of the HTTPS server, set to 0 to disable HSTS")
+	argFrameOptions                     = pflag.String("frame-options", handler.FrameOptionsDeny, "value of the X-Frame-Options header set on responses of the HTTPS server, should be one of 'DENY' or 'SAMEORIGIN'")
+	argApiserverHost                    = pflag.String("apiserver-host", "", "address of the Kubernetes API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for local discovery attempt")
+	argApiserverCAFile                  = pflag.String("apiserver-ca-file", "", "file containing CA bundle used to verify certificate of the --apiserver-host or of the server from --kubeconfig, it is not used for in-cluster config")
+	argApiserverSkipTLSVerify           = pflag.Bool("apiserver-skip-tls-verify", false, "disables verification of the --apiserver-host certificate, it should be used only in development environments")
+	argApiserverConnectRetries          = pflag.Int("apiserver-connect-retries", 5, "number of times the initial connection to the apiserver is retried before dashboard exits, listeners respond with 503 in the meantime")
+	argApiserverConnectBackoff          = pflag.Int("apiserver-connect-backoff", 2, "initial wait time in seconds between retries of the initial connection to the apiserver, it is doubled after every attempt up to 30 seconds")
+	argApiserverProxyURL                = pflag.String("apiserver-proxy-url", "", "address of the HTTP or SOCKS5 proxy used to connect to the apiserver in format 'protocol://address:port', e.g., 'http://proxy:3128' or 'socks5://proxy:1080'")
+	argApiserverNoProxy                 = pflag.StringSlice("apiserver-no-proxy", []string{}, "comma separated list of hosts, domains, IP addresses or CIDR ranges that are reached without --apiserver-proxy-url")
+	argMetricsProvider                  = pflag.String("metrics-provider", "sidecar", "select provider type for metrics, 'none' will not check metrics")
+	argHeapsterHost                     = pflag.String("heapster-host", "", "address of the Heapster API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for service proxy usage")
+	argSidecarHost                      = pflag.String("sidecar-host", "", "address of the Sidecar API server to connect to in the format of protocol://address:port, leave it empty if the binary runs inside cluster for service proxy usage")
+	argKubeConfigFile                   = pflag.String("kubeconfig", "", "path to kubeconfig file with authorization and master location information, 'env:VARNAME' to read it from the environment variable or '-' to read it from stdin")
+	argTokenFile                        = pflag.String("token-file", "", "path to the file with bearer token used for all requests without auth information, login page is disabled and every user has privileges of the token, the file is read again when it changes")
+	argServiceAccountTokenFile          = pflag.String("service-account-token-file", "", "path to the service account token used by in-cluster config, leave it empty to use the standard location")
+	argServiceAccountCAFile             = pflag.String("service-account-ca-file", "", "path to the CA bundle of the apiserver used by in-cluster config, leave it empty to use the standard location")
+	argDefaultContext                   = pflag.String("default-context", "", "name of the --kubeconfig context used by default, leave it empty to use current context of the kubeconfig file, other contexts can be selected per session")
+	argTokenTTL                         = pflag.Int("token-ttl", authApi.DefaultTokenTTL, "expiration time in seconds of JWE tokens generated by dashboard, set to 0 to avoid expiration")
+	argTokenTTLBasic                    = pflag.Int("token-ttl-basic", -1, "expiration time in seconds of JWE tokens generated for basic authentication mode, set to 0 to avoid expiration, defaults to --token-ttl")
+	argTokenTTLToken                    = pflag.Int("token-ttl-token", -1, "expiration time in seconds of JWE tokens generated for token authentication mode, set to 0 to avoid expiration, defaults to --token-ttl")
+	argRequiredTokenClaims              = pflag.StringSlice("required-token-claims", []string{}, "comma-separated list of claims that tokens used to log in have to carry in 'name' or 'name=value' format, i.e. 'email,aud=dashboard', leave it empty to accept all tokens")
+	argEnableTokenRefresh               = pflag.Bool("enable-token-refresh", true, "allows to extend sessions by refreshing JWE tokens before they expire, each refreshed token gets a new expiration time from --token-ttl")
+	argMaxSessionLifetime               = pflag.Int("max-session-lifetime", 0, "time in seconds after the login when tokens can no longer be refreshed and user has to log in again, set to 0 to allow refreshing sessions forever")
+	argTokenSigningAlg                  = pflag.String("token-signing-alg", "RS256", "algorithm used to sign JWE tokens generated by dashboard, one of 'RS256', 'ES256' or 'ES384'. Encryption key of the token matches the type of the signing key")
+	argCookieSameSite                   = pflag.String("cookie-samesite", "Lax", "SameSite attribute of the cookie that carries the JWE token, one of 'Lax', 'Strict' or 'None', 'None' requires --cookie-secure")
+	argCookieSecure                     = pflag.Bool("cookie-secure", true, "sets Secure attribute of the cookie that carries the JWE token so it is sent only over HTTPS")
+	argCookieDomain                     = pflag.String("cookie-domain", "", "Domain attribute of the cookie that carries the JWE token, leave it empty to restrict the cookie to the host dashboard is accessed through")
+	argEnableCSRF                       = pflag.Bool("enable-csrf", true, "requires double-submit CSRF token issued in XSRF-TOKEN cookie to be sent back in X-XSRF-TOKEN header of all mutating API requests")
+	argSessionIdleTimeout               = pflag.Int("session-idle-timeout", 0, "time in seconds after which session without any user activity is rejected and user has to log in again, independently of --token-ttl, set to 0 to disable")
+	argSessionWarningLeadTime           = pflag.Int("session-warning-lead-time", 60, "time in seconds before the session expires, either due to --token-ttl or --session-idle-timeout, when user is warned over WebSocket and can extend the session, set to 0 to disable")
+	argAuthenticationMode               = pflag.StringSlice("authentication-mode", []string{authApi.Token.String()}, "enabled authentication options, supports 'token', 'oidc', 'x509' and 'basic' that should only be used if Kubernetes API server has --authorization-mode=ABAC and --basic-auth-file flags set")
+	argCORSAllowedOrigins               = pflag.StringSlice("cors-allowed-origins", []string{}, "comma-separated list of origins allowed to send cross-origin requests to the API, i.e. 'https://portal.example.com' or 'https://*.example.com', leave it empty to disable CORS")
+	argShutdownTimeout                  = pflag.Int("shutdown-timeout", 30, "time in seconds that open connections have to finish their requests after SIGTERM or SIGINT is received, remaining connections are forcibly closed afterwards")
+	argReadinessTimeout                 = pflag.Int("readiness-timeout", 5, "time in seconds after which readiness checks of the apiserver connection and the encryption key fail")
+	argLivenessTimeout                  = pflag.Int("liveness-timeout", 5, "time in seconds after which liveness check fails if requests are not dispatched by the router, i.e. when handlers are stuck")
+	argHTTPReadTimeout                  = pflag.Int("http-read-timeout", 30, "maximum time in seconds for reading the entire request, set to 0 to disable")
+	argHTTPWriteTimeout                 = pflag.Int("http-write-timeout", 0, "maximum time in seconds before timing out writes of the response, set to 0 to disable, streaming routes such as exec are always exempted")
+	argHTTPIdleTimeout                  = pflag.Int("http-idle-timeout", 120, "maximum time in seconds to wait for the next request on keep-alive connections, set to 0 to disable")
+	argRequestTimeout                   = pflag.Int("request-timeout", 60, "time in seconds after which API requests are cancelled and 504 status code is returned, streaming requests are not affected, set to 0 to disable")
+	argRateLimitQPS                     = pflag.Float64("rate-limit-qps", 0, "number of API requests per second allowed for a single user, requests over the limit are rejected with 429 status code, set to 0 to disable rate limiting")
+	argRateLimitBurst                   = pflag.Int("rate-limit-burst", 50, "maximum burst of API requests allowed for a single user over --rate-limit-qps")
+	argMaxInflightRequests              = pflag.Int("max-inflight-requests", 0, "maximum number of API requests handled at the same time, requests over the limit are rejected with 503 status code, set to 0 to disable")
+	argMaxInflightStreams               = pflag.Int("max-inflight-streams", 0, "maximum number of streaming API connections, i.e. watches, log downloads and exec into containers, handled at the same time, set to 0 to disable")
+	argMaxConnectionsPerIP              = pflag.Int("max-connections-per-ip", 0, "maximum number of connections, including WebSocket and SockJS sessions, handled at the same time for a single client IP, connections over the limit are rejected with 429 status code, set to 0 to disable")
+	argMaxRequestBodyBytes              = pflag.Int64("max-request-body-bytes", 3*1024*1024, "maximum size in bytes of API request body, larger requests are rejected with 413 status code, deploy from file accepts 4 times larger body, set to 0 to disable")
+	argEnableCompression                = pflag.Bool("enable-compression", true, "enables gzip and deflate compression of API responses negotiated with Accept-Encoding header, streaming responses are never compressed")
+	argCompressionMinBytes              = pflag.Int("compression-min-bytes", 1024, "minimum size in bytes of API response body that is compressed when --enable-compression is set")
+	argMaxWatchesPerSession             = pflag.Int("max-watches-per-session", 10, "maximum number of resource watches streamed over WebSocket that a single user can have open at the same time, set to 0 to disable the limit")
+	argEventHistoryLimit                = pflag.Int("event-history-limit", 20, "number of past events of a resource that are replayed when its event stream is opened")
+	argDrainGracePeriod                 = pflag.Int("drain-grace-period", -1, "termination grace period in seconds of pods evicted while draining a node, negative value uses grace period of the pod")
+	argDrainTimeout                     = pflag.Int("drain-timeout", 300, "time in seconds after which draining a node fails if its pods were not evicted, set to 0 to wait until they are evicted")
+	argKubeClientQPS                    = pflag.Float32("kube-client-qps", 50, "maximum number of requests per second that a single Kubernetes API client can send to the API server, set to 0 to disable client side throttling")
+	argKubeClientBurst                  = pflag.Int("kube-client-burst", 100, "maximum burst of requests that a single Kubernetes API client can send to the API server over --kube-client-qps, set to 0 to disable client side throttling")
+	argKubeClientTimeout                = pflag.Int("kube-client-timeout", 0, "time in seconds after which a single request to the API server fails, streaming requests, i.e. watches, log downloads and exec into containers, are not affected, set to 0 to disable")
+	argApiServerMaxRetries              = pflag.Int("apiserver-max-retries", 0, "maximum number of retries of GET requests to the API server that failed with 429, 500 or 503 status code or transient network error, Retry-After header is honored, set to 0 to disable")
+	argUserAgent                        = pflag.String("user-agent", client.DefaultUserAgent+"/"+client.Version, "user agent of the requests sent to the API server, it can be used to match dashboard in audit policy and API priority and fairness rules")
+	argMetricClientCheckPeriod          = pflag.Int("metric-client-check-period", 30, "time interval between separate metric client health checks in seconds")
+	argResyncPeriod                     = pflag.Int("resync-period", 30, "time interval in seconds between resynchronizations of config maps, i.e. global banner and locale config map, with the apiserver, secrets are resynchronized 10 times less often, set to 0 to disable periodic resync and only load objects on startup and on demand")
+	argDiscoveryRefreshInterval         = pflag.Int("discovery-refresh-interval", 300, "time interval in seconds after which cached API groups and resources looked up from the apiserver, i.e. to find resource of the kind or to serve API discovery, are refreshed, set to 0 to look them up on every request")
+	argAutoGenerateCertificates         = pflag.Bool("auto-generate-certificates", false, "enables automatic certificates generation used to serve HTTPS")
+	argAutoGenerateCertSANs             = pflag.StringSlice("auto-generate-cert-sans", []string{}, "comma separated list of additional DNS names and IP addresses embedded in the auto-generated certificate, pod IP and name of the service from SERVICE_NAME env variable are included automatically")
+	argEnableInsecureLogin              = pflag.Bool("enable-insecure-login", false, "enables login view when the app is not served over HTTPS")
+	argEnableSkip                       = pflag.Bool("enable-skip-login", false, "enables skip button on the login page")
+	argReadOnly                         = pflag.Bool("read-only", false, "rejects all API requests that could modify resources, exec into containers is also disabled")
+	argEnableImpersonation              = pflag.Bool("enable-impersonation", false, "makes dashboard use its own credentials to impersonate the logged in user, instead of using credentials of the user, can not be used together with basic authentication mode")
+	argAuthenticationHeader             = pflag.String("authentication-header", "", "name of the header, i.e. 'X-Forwarded-Access-Token', containing bearer token of the user set by the authenticating reverse proxy, it is honored only for requests coming from --trusted-proxy-cidrs and login view is skipped for them")
+	argTrustedProxyCIDRs                = pflag.StringSlice("trusted-proxy-cidrs", []string{}, "comma-separated list of CIDRs of the reverse proxies allowed to set --authentication-header, i.e. '10.0.0.0/8'")
+	argTrustedProxies                   = pflag.StringSlice("trusted-proxies", []string{}, "comma-separated list of CIDRs of the reverse proxies, i.e. ingress controller pods, whose X-Forwarded-For and X-Real-IP headers are used to determine client address for logging and rate limiting")
+	argTrustOriginalForwardedFor        = pflag.Bool("trust-original-forwarded-for", false, "use X-Original-Forwarded-For header of --trusted-proxies to determine client address, enable only if the header is set by a trusted load balancer in front of them as ingress-nginx passes through the header sent by the client")
+	argSystemBanner                     = pflag.String("system-banner", "", "system banner message displayed in the app if non-empty, it accepts simple HTML")
+	argSystemBannerSeverity             = pflag.String("system-banner-severity", "INFO", "severity of system banner, should be one of 'INFO', 'WARNING' or 'ERROR'")
+	argEnableGlobalBanner               = pflag.Bool("enable-global-banner", false, "enables global banner read from the kubernetes-dashboard-global-banner config map in --namespace, it can be changed at runtime and takes precedence over --system-banner")
+	argEnableHelm                       = pflag.Bool("enable-helm", false, "enables read-only views of Helm releases, which are read from the secrets and config maps used by Helm to store them")
+	argEnablePortForward                = pflag.Bool("enable-port-forward", false, "enables forwarding ports of pods to the browser over WebSocket, it is disabled in read-only mode")
+	argPortForwardIdleTimeout           = pflag.Int("port-forward-idle-timeout", 300, "time in seconds after which port forward that did not send any data is closed, 0 means that idle port forwards are never closed")
+	argWebSocketMaxMessageBytes         = pflag.Int("websocket-max-message-bytes", 0, "maximum size in bytes of a message received over WebSocket endpoints, connections sending larger messages are closed, 0 means no limit")
+	argWebSocketPingInterval            = pflag.Int("websocket-ping-interval", 25, "time in seconds between heartbeats sent over WebSocket endpoints to keep proxies from closing idle connections")
+	argDefaultView                      = pflag.String("default-view", handler.DefaultView, "view users land on after opening dashboard or logging in, i.e. 'workloads' or 'overview?namespace=kube-system'")
+	argLoginTitle                       = pflag.String("login-title", "", "product name displayed on the login page, leave it empty to display 'Kubernetes Dashboard'")
+	argLoginLogoURL                     = pflag.String("login-logo-url", "", "absolute http or https URL or base64 encoded image data URI of the logo displayed on the login page")
+	argAppTitle                         = pflag.String("app-title", "", "title of the browser tab, leave it empty to display 'Kubernetes Dashboard'")
+	argFaviconURL                       = pflag.String("favicon-url", "", "absolute http or https URL or base64 encoded image data URI of the favicon, leave it empty to use the default one")
+	argAPILogLevel                      = pflag.String("api-log-level", "INFO", "level of API request logging, should be one of 'NONE', 'INFO' or 'DEBUG'")
+	argLogFormat                        = pflag.String("log-format", logging.FormatText, "format of the logs, should be one of 'text' or 'json'")
+	argOTelEndpoint                     = pflag.String("otel-endpoint", "", "URL of the OTLP HTTP collector that traces of API requests are exported to, if empty tracing is disabled")
+	argOTelSampleRate                   = pflag.Float64("otel-sample-rate", 1, "ratio of API requests between 0 and 1 that are traced, if the request is not already part of a sampled trace")
+	argAuditLogPath                     = pflag.String("audit-log-path", "", "path of the file that audit events of API requests modifying resources are written to, '-' writes them to the standard output, audit log is disabled if it is not set")
+	argAuditLogMaxSize                  = pflag.Int("audit-log-maxsize", 0, "maximum size in megabytes of the audit log file before it is rotated, set to 0 to disable rotation")
+	argRequestIDHeader                  = pflag.String("request-id-header", "X-Request-ID", "name of the header with ID of the request that is written to JSON API request logs, echoed back in the response and sent with requests to the apiserver, ID is generated if the header is missing")
+	argLogDefaultTailLines              = pflag.Int("log-default-tail-lines", 5000, "number of the newest container log lines loaded from the apiserver by default, older lines can be requested from the logs view")
+	argLogMaxBytes                      = pflag.Int("log-max-bytes", 500000, "maximum number of bytes of container logs loaded from the apiserver at once, the oldest lines are dropped if the limit is exceeded")
+	argIgnoreDefaultContainerAnnotation = pflag.Bool("ignore-default-container-annotation", false, "always select the first container of the pod for logs and exec by default, instead of the one set with the kubectl.kubernetes.io/default-container annotation")
+	argDefaultExecCommand               = pflag.String("default-exec-command", "", "command started in exec terminals that do not select one, i.e. '/bin/ash -l', leave it empty to try '/bin/bash', '/bin/sh', 'powershell' and 'cmd' one by one")
+	argDisableSettingsAuthorizer        = pflag.Bool("disable-settings-authorizer", false, "disables settings page user authorizer so anyone can access settings page")
+	argSettingsNamespace                = pflag.String("settings-namespace", "", "namespace of the settings config map, if it is not set --namespace is used")
+	argSettingsReadOnly                 = pflag.Bool("settings-read-only", false, "rejects all changes of the settings made through dashboard, so they can only be managed by editing the settings config map")
+	argNamespace                        = pflag.String("namespace", getEnv("POD_NAMESPACE", "kube-system"), "if non-default namespace is used encryption key will be created in the specified namespace")
+	argDefaultCreateNamespace           = pflag.String("default-create-namespace", "default", "namespace that namespaced objects created without one are created in")
+	argDefaultDeletePropagation         = pflag.String("default-delete-propagation", string(metaV1.DeletePropagationBackground), "propagation policy of delete requests that do not specify one, should be one of 'Foreground', 'Background' or 'Orphan'")
+	argDefaultTimezone                  = pflag.String("default-timezone", "UTC", "IANA name of the timezone, i.e. 'Europe/Berlin', that timestamps are displayed in by default and that timestamps of downloaded log files are converted to")
+	argEncryptionKeyProvider            = pflag.String("encryption-key-provider", authApi.EncryptionKeyProviderKubernetes, "provider of the encryption key stored in a secret in --namespace, one of 'kubernetes' storing it in plain text or 'kms' wrapping it with KMS plugin from --kms-endpoint")
+	argKMSEndpoint                      = pflag.String("kms-endpoint", "", "unix socket endpoint of the KMS plugin implementing v1beta1 API used to wrap the encryption key, i.e. unix:///var/run/kms.sock")
+	argKMSTimeout                       = pflag.Int("kms-timeout", 3, "timeout in seconds of requests sent to the KMS plugin")
+	argNamespaceAllowlist               = pflag.StringSlice("namespace-allowlist", []string{}, "comma-separated list of namespaces that can be accessed through dashboard regardless of user permissions, leave it empty to allow all namespaces")
+	argNamespaceAllowlistCluster        = pflag.Bool("namespace-allowlist-cluster-scoped", true, "allows access to cluster-scoped resources, i.e. nodes and persistent volumes, when --namespace-allowlist is set")
+	argDisableClusterScoped             = pflag.Bool("disable-cluster-scoped", false, "rejects requests for cluster-scoped resources, i.e. nodes, persistent volumes and cluster roles, and hides them in the UI regardless of user permissions")
+	argAllowedResources                 = pflag.StringSlice("allowed-resources", []string{}, "comma-separated list of 'group/resource' entries, i.e. 'apps/deployments,pods', that can be accessed through dashboard regardless of user permissions, leave it empty to allow all resources")
+	argAnonymousAccess                  = pflag.Bool("anonymous-access", false, "serve read-only requests without auth information for resources set with --anonymous-resources using credentials of the service account set with --anonymous-service-account")
+	argAnonymousServiceAccount          = pflag.String("anonymous-service-account", "", "service account in 'namespace:name' format whose credentials are used for anonymous access")
+	argImpersonateServiceAccount        = pflag.String("impersonate-service-account", "", "service account in 'namespace:name' format that dashboard impersonates with its own credentials in all requests, regardless of the logged in user")
+	argAnonymousResources               = pflag.StringSlice("anonymous-resources", []string{}, "comma-separated list of 'group/resource' entries, i.e. 'apps/deployments,pods', that can be viewed without logging in when anonymous access is enabled")
+	localeConfig                        = pflag.String("locale-config", handler.DefaultLocaleConfig, "comma separated list of paths to files containing the locale configuration merged in order or config map key in 'configmap://namespace/name/key' format")
+	argOIDCIssuerURL                    = pflag.String("oidc-issuer-url", "", "URL of the OpenID Connect provider used by the 'oidc' authentication mode, Kubernetes API server has to trust the same issuer")
+	argOIDCClientID                     = pflag.String("oidc-client-id", "", "client ID registered in the OpenID Connect provider for the 'oidc' authentication mode")
+	argOIDCClientSecret                 = pflag.String("oidc-client-secret", "", "client secret registered in the OpenID Connect provider for the 'oidc' authentication mode")
+	argOIDCScopes                       = pflag.StringSlice("oidc-scopes", []string{"openid", "email"}, "scopes requested from the OpenID Connect provider, 'openid' scope is always requested")
+	argOIDCRedirectURL                  = pflag.String("oidc-redirect-url", "", "external URL of the OIDC callback endpoint registered in the OpenID Connect provider, i.e. 'https://dashboard.example.com/api/v1/login/oidc/callback'")
 )
 
 func main() {
-	// Set logging output to standard console out
-	log.SetOutput(os.Stdout)
+	// Set logging output to standard error, the same as logs of the Kubernetes client libraries
+	log.SetOutput(os.Stderr)
 
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 	pflag.Parse()
@@ -82,33 +207,462 @@ func main() {
 	// Initializes dashboard arguments holder so we can read them in other packages
 	initArgHolder()
 
+	if err := logging.Init(args.Holder.GetLogFormat(), os.Stderr); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	shutdownTracing, err := tracing.Init(args.Holder.GetOTelEndpoint(), args.Holder.GetOTelSampleRate(), client.Version)
+	if err != nil {
+		handleFatalInvalidArgError(err)
+	}
+	if tracing.Enabled() {
+		log.Printf("Exporting traces of API requests to %s", args.Holder.GetOTelEndpoint())
+	}
+
+	if args.Holder.GetAuditLogMaxSize() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--audit-log-maxsize can not be negative"))
+	}
+
+	if err := audit.Init(args.Holder.GetAuditLogPath(), args.Holder.GetAuditLogMaxSize()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	tlsMinVersion, err := cert.GetTLSVersion(args.Holder.GetTLSMinVersion())
+	if err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	tlsCipherSuites, err := cert.GetTLSCipherSuites(args.Holder.GetTLSCipherSuites())
+	if err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	sniCertificates, err := cert.ParseSNICertificates(args.Holder.GetTLSSNICerts(), args.Holder.GetDefaultCertDir())
+	if err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if args.Holder.GetRateLimitQPS() > 0 && args.Holder.GetRateLimitBurst() < 1 {
+		handleFatalInvalidArgError(fmt.Errorf("--rate-limit-burst has to be greater than 0 when rate limiting is enabled"))
+	}
+
+	if _, err := args.ParseCIDRs(args.Holder.GetTrustedProxyCIDRs()); err != nil {
+		handleFatalInvalidArgError(fmt.Errorf("--trusted-proxy-cidrs: %s", err))
+	}
+
+	if _, err := args.ParseCIDRs(args.Holder.GetTrustedProxies()); err != nil {
+		handleFatalInvalidArgError(fmt.Errorf("--trusted-proxies: %s", err))
+	}
+
+	if len(args.Holder.GetAuthenticationHeader()) > 0 && len(args.Holder.GetTrustedProxyCIDRs()) == 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--trusted-proxy-cidrs is required when --authentication-header is set"))
+	}
+
+	if err := auth.ValidateCookieAttributes(args.Holder.GetCookieSameSite(), args.Holder.GetCookieSecure()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if args.Holder.GetLogDefaultTailLines() < 1 || args.Holder.GetLogMaxBytes() < 1 {
+		handleFatalInvalidArgError(fmt.Errorf("--log-default-tail-lines and --log-max-bytes have to be greater than 0"))
+	}
+
+	if pflag.CommandLine.Changed("user-agent") && len(strings.TrimSpace(args.Holder.GetUserAgent())) == 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--user-agent can not be empty"))
+	}
+
+	if len(args.Holder.GetDefaultCreateNamespace()) == 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--default-create-namespace can not be empty"))
+	}
+
+	switch metaV1.DeletionPropagation(args.Holder.GetDefaultDeletePropagation()) {
+	case metaV1.DeletePropagationForeground, metaV1.DeletePropagationBackground, metaV1.DeletePropagationOrphan:
+	default:
+		handleFatalInvalidArgError(fmt.Errorf("--default-delete-propagation has to be one of '%s', '%s' or '%s'",
+			metaV1.DeletePropagationForeground, metaV1.DeletePropagationBackground, metaV1.DeletePropagationOrphan))
+	}
+
+	if _, err := time.LoadLocation(args.Holder.GetDefaultTimezone()); err != nil {
+		handleFatalInvalidArgError(fmt.Errorf("--default-timezone has to be a name from the IANA timezone database: %s", err))
+	}
+
+	if args.Holder.GetLivenessTimeout() < 1 {
+		handleFatalInvalidArgError(fmt.Errorf("--liveness-timeout has to be greater than 0"))
+	}
+
+	if err := jwe.ValidateSigningAlgorithm(args.Holder.GetTokenSigningAlg()); err != nil {
+		handleFatalInvalidArgError(fmt.Errorf("--token-signing-alg: %s", err))
+	}
+
+	if args.Holder.GetMaxInflightRequests() < 0 || args.Holder.GetMaxInflightStreams() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--max-inflight-requests and --max-inflight-streams can not be negative"))
+	}
+
+	if args.Holder.GetMaxConnectionsPerIP() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--max-connections-per-ip can not be negative"))
+	}
+
+	if args.Holder.GetKubeClientQPS() < 0 || args.Holder.GetKubeClientBurst() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--kube-client-qps and --kube-client-burst can not be negative"))
+	}
+
+	if args.Holder.GetKubeClientTimeout() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--kube-client-timeout can not be negative"))
+	}
+
+	if args.Holder.GetApiServerMaxRetries() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--apiserver-max-retries can not be negative"))
+	}
+
+	if args.Holder.GetDrainTimeout() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--drain-timeout can not be negative"))
+	}
+
+	if args.Holder.GetEventHistoryLimit() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--event-history-limit can not be negative"))
+	}
+
+	if args.Holder.GetPortForwardIdleTimeout() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--port-forward-idle-timeout can not be negative"))
+	}
+
+	if args.Holder.GetWebSocketMaxMessageBytes() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--websocket-max-message-bytes can not be negative"))
+	}
+
+	if args.Holder.GetWebSocketPingInterval() < 1 {
+		handleFatalInvalidArgError(fmt.Errorf("--websocket-ping-interval has to be greater than 0"))
+	}
+
+	switch args.Holder.GetEncryptionKeyProvider() {
+	case authApi.EncryptionKeyProviderKubernetes:
+	case authApi.EncryptionKeyProviderKMS:
+		if len(args.Holder.GetKMSEndpoint()) == 0 || args.Holder.GetKMSTimeout() <= 0 {
+			handleFatalInvalidArgError(fmt.Errorf("--kms-endpoint and positive --kms-timeout are required when --encryption-key-provider is '%s'",
+				authApi.EncryptionKeyProviderKMS))
+		}
+	default:
+		handleFatalInvalidArgError(fmt.Errorf("--encryption-key-provider has to be one of '%s' or '%s'",
+			authApi.EncryptionKeyProviderKubernetes, authApi.EncryptionKeyProviderKMS))
+	}
+
+	if args.Holder.GetApiServerConnectRetries() < 0 || args.Holder.GetApiServerConnectBackoff() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--apiserver-connect-retries and --apiserver-connect-backoff can not be negative"))
+	}
+
+	if _, err := client.ApiServerProxy(args.Holder.GetApiServerProxyURL(), args.Holder.GetApiServerNoProxy()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if _, err := handler.ParseAllowedResources(args.Holder.GetAllowedResources()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if len(args.Holder.GetImpersonateServiceAccount()) > 0 {
+		if _, err := client.ParseImpersonatedServiceAccount(args.Holder.GetImpersonateServiceAccount()); err != nil {
+			handleFatalInvalidArgError(fmt.Errorf("--impersonate-service-account: %s", err))
+		}
+
+		if args.Holder.GetEnableImpersonation() {
+			handleFatalInvalidArgError(fmt.Errorf("--impersonate-service-account can not be used together with --enable-impersonation"))
+		}
+	}
+
+	if args.Holder.GetAnonymousAccess() {
+		// Anonymous requests impersonate the fixed service account if it was set
+		if len(args.Holder.GetImpersonateServiceAccount()) == 0 {
+			if _, _, err := client.ParseServiceAccount(args.Holder.GetAnonymousServiceAccount()); err != nil {
+				handleFatalInvalidArgError(fmt.Errorf("--anonymous-service-account: %s", err))
+			}
+		}
+
+		if resources, err := handler.ParseAllowedResources(args.Holder.GetAnonymousResources()); err != nil {
+			handleFatalInvalidArgError(fmt.Errorf("--anonymous-resources: %s", err))
+		} else if resources == nil {
+			handleFatalInvalidArgError(fmt.Errorf("--anonymous-resources is required when --anonymous-access is enabled"))
+		}
+	}
+
+	if _, _, err := handler.ParseDefaultView(args.Holder.GetDefaultView()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if _, err := auth.ParseRequiredTokenClaims(args.Holder.GetRequiredTokenClaims()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if err := handler.ValidateLoginLogoURL(args.Holder.GetLoginLogoURL()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if err := handler.ValidateFaviconURL(args.Holder.GetFaviconURL()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if len(args.Holder.GetTokenFile()) > 0 {
+		if _, err := client.ReadTokenFile(args.Holder.GetTokenFile()); err != nil {
+			handleFatalInvalidArgError(fmt.Errorf("could not read --token-file: %s", err.Error()))
+		}
+		log.Print("Dashboard runs in single-identity mode, every user that can reach it has privileges of the token " +
+			"from --token-file")
+	}
+
+	if args.Holder.GetMaxRequestBodyBytes() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--max-request-body-bytes can not be negative"))
+	}
+
+	if args.Holder.GetCompressionMinBytes() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--compression-min-bytes can not be negative"))
+	}
+
+	if args.Holder.GetResyncPeriod() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--resync-period can not be negative"))
+	}
+
+	if args.Holder.GetDiscoveryRefreshInterval() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--discovery-refresh-interval can not be negative"))
+	}
+
+	if args.Holder.GetMaxSessionLifetime() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--max-session-lifetime can not be negative"))
+	}
+
+	if args.Holder.GetSessionIdleTimeout() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--session-idle-timeout can not be negative"))
+	}
+
+	if args.Holder.GetSessionWarningLeadTime() < 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--session-warning-lead-time can not be negative"))
+	}
+
+	if frameOptions := args.Holder.GetFrameOptions(); frameOptions != handler.FrameOptionsDeny &&
+		frameOptions != handler.FrameOptionsSameOrigin {
+		handleFatalInvalidArgError(fmt.Errorf("--frame-options has to be one of '%s' or '%s'",
+			handler.FrameOptionsDeny, handler.FrameOptionsSameOrigin))
+	}
+
+	if err := client.ValidateServiceAccountFiles(args.Holder.GetServiceAccountTokenFile(),
+		args.Holder.GetServiceAccountCAFile()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if len(args.Holder.GetApiServerCAFile()) > 0 && args.Holder.GetApiServerSkipTLSVerify() {
+		handleFatalInvalidArgError(fmt.Errorf("--apiserver-ca-file can not be used together with --apiserver-skip-tls-verify"))
+	}
+
+	if args.Holder.GetApiServerSkipTLSVerify() {
+		log.Print("WARNING: --apiserver-skip-tls-verify is enabled, certificate of the apiserver is not verified " +
+			"and connection is vulnerable to man-in-the-middle attacks. Do not use it in production!")
+	}
+
+	if args.Holder.GetEnablePprof() && len(args.Holder.GetMetricsBindAddress()) == 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--metrics-bind-address is required when --enable-pprof is set"))
+	}
+
+	if args.Holder.GetEnableImpersonation() &&
+		authApi.ToAuthenticationModes(args.Holder.GetAuthenticationMode()).IsEnabled(authApi.Basic) {
+		handleFatalInvalidArgError(fmt.Errorf("--enable-impersonation can not be used with basic authentication mode, as username is not verified against apiserver identity"))
+	}
+
+	// Requests authenticated with the token file carry no identity of the user that could be impersonated
+	if args.Holder.GetEnableImpersonation() && len(args.Holder.GetTokenFile()) > 0 {
+		handleFatalInvalidArgError(fmt.Errorf("--enable-impersonation can not be used together with --token-file"))
+	}
+
+	if dir := args.Holder.GetStaticContentDir(); len(dir) > 0 {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			handleFatalInvalidArgError(fmt.Errorf("--static-content-dir %s is not a directory", dir))
+		}
+		log.Printf("Serving frontend assets from %s with fallback to bundled assets", dir)
+	}
+
+	if basePath := args.Holder.GetBasePath(); len(basePath) > 0 && !strings.HasPrefix(basePath, "/") {
+		handleFatalInvalidArgError(fmt.Errorf("--base-path has to start with '/'"))
+	}
+
+	if err := handler.ValidateAPIPrefixes(args.Holder.GetAPIPrefixes()); err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	unixSocketMode, err := strconv.ParseUint(args.Holder.GetUnixSocketMode(), 8, 32)
+	if err != nil {
+		handleFatalInvalidArgError(fmt.Errorf("--unix-socket-mode has to be a valid octal file mode: %s", err))
+	}
+
+	// Unix socket can be the only listener, in that case bind addresses and ports are ignored.
+	unixSocketOnly := len(args.Holder.GetUnixSocket()) > 0 && args.Holder.GetInsecurePort() == 0 &&
+		args.Holder.GetPort() == 0
+	if args.Holder.GetInsecurePort() == 0 && args.Holder.GetPort() == 0 && !unixSocketOnly {
+		handleFatalInvalidArgError(fmt.Errorf("--insecure-port and --port can not be both set to 0"))
+	}
+
 	if args.Holder.GetApiServerHost() != "" {
 		log.Printf("Using apiserver-host location: %s", args.Holder.GetApiServerHost())
 	}
 	if args.Holder.GetKubeConfigFile() != "" {
-		log.Printf("Using kubeconfig file: %s", args.Holder.GetKubeConfigFile())
+		log.Printf("Using kubeconfig: %s", args.Holder.GetKubeConfigFile())
+	}
+	kubeConfigFile, removeKubeConfigFile, err := resolveKubeConfig(args.Holder.GetKubeConfigFile(), os.Stdin)
+	if err != nil {
+		handleFatalInvalidArgError(err)
 	}
+	defer removeKubeConfigFile()
+	args.GetHolderBuilder().SetKubeConfigFile(kubeConfigFile)
 	if args.Holder.GetNamespace() != "" {
 		log.Printf("Using namespace: %s", args.Holder.GetNamespace())
 	}
 
+	var servingCerts []tls.Certificate
+	var getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+	if args.Holder.GetAutoGenerateCertificates() {
+		log.Println("Auto-generating certificates")
+		certCreator := ecdsa.NewECDSACreator(args.Holder.GetKeyFile(), args.Holder.GetCertFile(), elliptic.P256(),
+			args.Holder.GetAutoGenerateCertSANs())
+		certManager := cert.NewCertManager(certCreator, args.Holder.GetDefaultCertDir())
+		servingCert, err := certManager.GetCertificates()
+		if err != nil {
+			handleFatalInitServingCertError(err)
+		}
+		servingCerts = []tls.Certificate{servingCert}
+	} else if args.Holder.GetCertFile() != "" && args.Holder.GetKeyFile() != "" {
+		certFilePath := args.Holder.GetDefaultCertDir() + string(os.PathSeparator) + args.Holder.GetCertFile()
+		keyFilePath := args.Holder.GetDefaultCertDir() + string(os.PathSeparator) + args.Holder.GetKeyFile()
+		certReloader, err := cert.NewCertReloader(certFilePath, keyFilePath)
+		if err != nil {
+			handleFatalInitServingCertError(err)
+		}
+		if err := certReloader.Watch(); err != nil {
+			handleFatalInitServingCertError(err)
+		}
+		getCertificate = certReloader.GetCertificate
+	}
+
+	if len(sniCertificates) > 0 {
+		sniSelector, err := cert.NewSNISelector(sniCertificates, getCertificate)
+		if err != nil {
+			handleFatalInitServingCertError(err)
+		}
+		log.Printf("Serving %d additional certificates selected with SNI", len(sniCertificates))
+		getCertificate = sniSelector.GetCertificate
+	}
+
+	// Listeners are started before the initial connection to the apiserver is established. Until then all requests,
+	// including readiness checks, are answered by the startup handler.
+	startupHandler := handler.NewStartupHandler()
+	rootHandler := handler.MakeBasePathHandler(handler.MakeAPIPrefixHandler(
+		handler.MakeConnectionLimitHandler(startupHandler, args.Holder.GetMaxConnectionsPerIP()),
+		args.Holder.GetAPIPrefixes()), args.Holder.GetBasePath(), "/readyz", "/livez", "/metrics")
+	servers := []*http.Server{}
+	connections := &connectionCounter{}
+	readTimeout := time.Duration(args.Holder.GetHTTPReadTimeout()) * time.Second
+	writeTimeout := time.Duration(args.Holder.GetHTTPWriteTimeout()) * time.Second
+	idleTimeout := time.Duration(args.Holder.GetHTTPIdleTimeout()) * time.Second
+
+	// Listen for http or https
+	secure := servingCerts != nil || getCertificate != nil
+	if unixSocketOnly {
+		log.Print("TCP ports are disabled, only the unix socket is active")
+	} else {
+		if !secure && args.Holder.GetInsecurePort() == 0 {
+			handleFatalInvalidArgError(fmt.Errorf("--insecure-port is set to 0, but no certificates were provided to serve HTTPS"))
+		}
+
+		if secure {
+			secureAddr := serverAddress(args.Holder.GetBindAddress(), args.Holder.GetPort())
+			log.Printf("Serving securely on HTTPS address: %s", secureAddr)
+			secureHandler := handler.MakeSecurityHeadersHandler(rootHandler, args.Holder.GetHSTSMaxAge(),
+				args.Holder.GetFrameOptions())
+			server := &http.Server{
+				Addr:         secureAddr,
+				Handler:      secureHandler,
+				ConnState:    connections.Track,
+				ConnContext:  handler.ConnContext,
+				ReadTimeout:  readTimeout,
+				WriteTimeout: writeTimeout,
+				IdleTimeout:  idleTimeout,
+				TLSConfig: &tls.Config{
+					Certificates:   servingCerts,
+					GetCertificate: getCertificate,
+					MinVersion:     tlsMinVersion,
+					CipherSuites:   tlsCipherSuites,
+				},
+			}
+			if args.Holder.GetDisableHTTP2() {
+				log.Print("HTTP/2 is disabled on the HTTPS port")
+				disableHTTP2(server)
+			}
+			servers = append(servers, server)
+			serve(func() error { return server.ListenAndServeTLS("", "") })
+		} else {
+			addr := serverAddress(args.Holder.GetInsecureBindAddress(), args.Holder.GetInsecurePort())
+			log.Printf("Serving insecurely on HTTP address: %s", addr)
+			server := &http.Server{
+				Addr:         addr,
+				Handler:      rootHandler,
+				ConnState:    connections.Track,
+				ConnContext:  handler.ConnContext,
+				ReadTimeout:  readTimeout,
+				WriteTimeout: writeTimeout,
+				IdleTimeout:  idleTimeout,
+			}
+			servers = append(servers, server)
+			serve(server.ListenAndServe)
+		}
+
+		if args.Holder.GetInsecurePort() == 0 {
+			log.Print("Insecure HTTP port is disabled, only the secure port is active")
+		}
+	}
+
+	if unixSocket := args.Holder.GetUnixSocket(); len(unixSocket) > 0 {
+		listener, err := listenUnixSocket(unixSocket, os.FileMode(unixSocketMode))
+		if err != nil {
+			log.Fatalf("Could not listen on unix socket %s: %s", unixSocket, err)
+		}
+
+		log.Printf("Serving insecurely on unix socket: %s", unixSocket)
+		server := &http.Server{
+			Addr:         unixSocket,
+			Handler:      rootHandler,
+			ConnState:    connections.Track,
+			ConnContext:  handler.ConnContext,
+			ReadTimeout:  readTimeout,
+			WriteTimeout: writeTimeout,
+			IdleTimeout:  idleTimeout,
+		}
+		servers = append(servers, server)
+		serve(func() error { return server.Serve(listener) })
+	}
+
 	clientManager := client.NewClientManager(args.Holder.GetKubeConfigFile(), args.Holder.GetApiServerHost())
-	versionInfo, err := clientManager.InsecureClient().Discovery().ServerVersion()
+	versionInfo, err := connectToAPIServer(clientManager.InsecureClient(), args.Holder.GetApiServerConnectRetries(),
+		time.Duration(args.Holder.GetApiServerConnectBackoff())*time.Second)
 	if err != nil {
 		handleFatalInitError(err)
 	}
 
 	log.Printf("Successful initial request to the apiserver, version: %s", versionInfo.String())
 
-	// Init auth manager
-	authManager := initAuthManager(clientManager)
-
 	// Init settings manager
 	settingsManager := settings.NewSettingsManager()
+	settingsSynchronizer := sync.NewSynchronizerManager(clientManager.InsecureClient()).ConfigMap(settings.Namespace(),
+		settingsApi.SettingsConfigMapName)
+	sync.Overwatch.RegisterSynchronizer(settingsSynchronizer, sync.AlwaysRestart)
+	settingsManager.EnableDefaultSortSync(settingsSynchronizer)
+
+	// Init auth manager
+	authManager, keyHolder := initAuthManager(clientManager, settingsManager)
 
 	// Init system banner manager
 	systemBannerManager := systembanner.NewSystemBannerManager(args.Holder.GetSystemBanner(),
 		args.Holder.GetSystemBannerSeverity())
+	if args.Holder.GetEnableGlobalBanner() {
+		synchronizer := sync.NewSynchronizerManager(clientManager.InsecureClient()).ConfigMap(args.Holder.GetNamespace(),
+			systembannerApi.GlobalBannerConfigMapName)
+		sync.Overwatch.RegisterSynchronizer(synchronizer, sync.AlwaysRestart)
+		log.Printf("Loading global banner from config map %s in namespace %s",
+			systembannerApi.GlobalBannerConfigMapName, args.Holder.GetNamespace())
+		systemBannerManager.EnableGlobalBanner(synchronizer)
+	}
 
 	// Init integrations
 	integrationManager := integration.NewIntegrationManager(clientManager)
@@ -134,60 +688,104 @@ func main() {
 		clientManager,
 		authManager,
 		settingsManager,
-		systemBannerManager)
+		systemBannerManager,
+		kubecontext.NewContextManager(args.Holder.GetKubeConfigFile(), args.Holder.GetDefaultContext()))
 	if err != nil {
 		handleFatalInitError(err)
 	}
 
-	var servingCerts []tls.Certificate
-	if args.Holder.GetAutoGenerateCertificates() {
-		log.Println("Auto-generating certificates")
-		certCreator := ecdsa.NewECDSACreator(args.Holder.GetKeyFile(), args.Holder.GetCertFile(), elliptic.P256())
-		certManager := cert.NewCertManager(certCreator, args.Holder.GetDefaultCertDir())
-		servingCert, err := certManager.GetCertificates()
-		if err != nil {
-			handleFatalInitServingCertError(err)
-		}
-		servingCerts = []tls.Certificate{servingCert}
-	} else if args.Holder.GetCertFile() != "" && args.Holder.GetKeyFile() != "" {
-		certFilePath := args.Holder.GetDefaultCertDir() + string(os.PathSeparator) + args.Holder.GetCertFile()
-		keyFilePath := args.Holder.GetDefaultCertDir() + string(os.PathSeparator) + args.Holder.GetKeyFile()
-		servingCert, err := tls.LoadX509KeyPair(certFilePath, keyFilePath)
-		if err != nil {
-			handleFatalInitServingCertError(err)
+	// Run a HTTP server that serves static public files from './public' and handles API calls. Default serve mux is
+	// not used, as net/http/pprof registers its handlers there.
+	mux := http.NewServeMux()
+	mux.Handle("/", handler.MakeGzipHandler(initLocaleHandler(clientManager)))
+	inFlightLimiter := handler.NewInFlightLimiter(args.Holder.GetMaxInflightRequests(),
+		args.Holder.GetMaxInflightStreams())
+	mux.Handle("/api/", tracing.Handler(inFlightLimiter.Handler(handler.MakeCORSHandler(handler.MakeTimeoutHandler(
+		apiHandler, time.Duration(args.Holder.GetRequestTimeout())*time.Second), args.Holder.GetCORSAllowedOrigins())),
+		"api"))
+	mux.Handle("/config", handler.AppHandler(handler.ConfigHandler))
+	if args.Holder.GetReadOnly() {
+		log.Print("Read-only mode is enabled, modifying resources, exec into containers and port forwarding are disabled")
+	} else {
+		mux.Handle("/api/sockjs/", inFlightLimiter.Handler(handler.MakeStreamingHandler(
+			handler.CreateAttachHandler("/api/sockjs"))))
+		if args.Holder.GetEnablePortForward() {
+			mux.Handle("/api/portforward/", inFlightLimiter.Handler(handler.MakeStreamingHandler(
+				handler.CreatePortForwardHandler("/api/portforward"))))
 		}
-		servingCerts = []tls.Certificate{servingCert}
 	}
+	mux.Handle("/api/watch/", inFlightLimiter.Handler(handler.MakeStreamingHandler(
+		handler.CreateWatchHandler("/api/watch"))))
+	if args.Holder.GetSessionWarningLeadTime() > 0 {
+		mux.Handle("/api/session/", inFlightLimiter.Handler(handler.MakeStreamingHandler(
+			handler.CreateSessionWarningHandler("/api/session"))))
+	}
+	if len(args.Holder.GetMetricsBindAddress()) == 0 {
+		mux.Handle("/metrics", promhttp.Handler())
+	}
+	mux.Handle("/readyz", handler.CreateHealthHandler(time.Duration(args.Holder.GetReadinessTimeout())*time.Second).
+		AddCheck("apiserver", handler.APIServerHealthCheck(clientManager.InsecureClient())).
+		AddCheck("encryption-key", handler.EncryptionKeyHealthCheck(keyHolder, clientManager.InsecureClient())))
+	mux.Handle("/livez", handler.CreateHealthHandler(time.Duration(args.Holder.GetLivenessTimeout())*time.Second).
+		AddCheck("router", handler.RouterHealthCheck(mux, handler.PingPath)))
 
-	// Run a HTTP server that serves static public files from './public' and handles API calls.
-	http.Handle("/", handler.MakeGzipHandler(handler.CreateLocaleHandler()))
-	http.Handle("/api/", apiHandler)
-	http.Handle("/config", handler.AppHandler(handler.ConfigHandler))
-	http.Handle("/api/sockjs/", handler.CreateAttachHandler("/api/sockjs"))
-	http.Handle("/metrics", promhttp.Handler())
+	startupHandler.SetHandler(mux)
 
-	// Listen for http or https
-	if servingCerts != nil {
-		log.Printf("Serving securely on HTTPS port: %d", args.Holder.GetPort())
-		secureAddr := fmt.Sprintf("%s:%d", args.Holder.GetBindAddress(), args.Holder.GetPort())
+	if metricsAddr := args.Holder.GetMetricsBindAddress(); len(metricsAddr) > 0 {
+		log.Printf("Serving metrics on HTTP address: %s", metricsAddr)
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		if args.Holder.GetEnablePprof() {
+			log.Print("Serving pprof profiles on the metrics address under /debug/pprof/")
+			metricsMux.HandleFunc("/debug/pprof/", pprof.Index)
+			metricsMux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+			metricsMux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+			metricsMux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+			metricsMux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+		}
 		server := &http.Server{
-			Addr:    secureAddr,
-			Handler: http.DefaultServeMux,
-			TLSConfig: &tls.Config{
-				Certificates: servingCerts,
-				MinVersion:   tls.VersionTLS12,
-			},
+			Addr:         metricsAddr,
+			Handler:      metricsMux,
+			ConnState:    connections.Track,
+			ReadTimeout:  readTimeout,
+			WriteTimeout: writeTimeout,
+			IdleTimeout:  idleTimeout,
 		}
-		go func() { log.Fatal(server.ListenAndServeTLS("", "")) }()
-	} else {
-		log.Printf("Serving insecurely on HTTP port: %d", args.Holder.GetInsecurePort())
-		addr := fmt.Sprintf("%s:%d", args.Holder.GetInsecureBindAddress(), args.Holder.GetInsecurePort())
-		go func() { log.Fatal(http.ListenAndServe(addr, nil)) }()
+		servers = append(servers, server)
+		serve(server.ListenAndServe)
+	}
+
+	waitForShutdown(servers, connections, time.Duration(args.Holder.GetShutdownTimeout())*time.Second)
+
+	// Flush spans that were not exported yet.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(args.Holder.GetShutdownTimeout())*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("Error while shutting down tracing: %s", err)
+	}
+}
+
+// Creates locale handler that loads the localization configuration either from file or from config map. Config map is
+// synchronized using the dashboard's own client so it can be read before any user logs in.
+func initLocaleHandler(clientManager clientapi.ClientManager) *handler.LocaleHandler {
+	ref, err := handler.ParseLocaleConfigMapRef(args.Holder.GetLocaleConfig())
+	if err != nil {
+		handleFatalInvalidArgError(err)
+	}
+
+	if ref == nil {
+		return handler.CreateLocaleHandler()
 	}
-	select {}
+
+	synchronizer := sync.NewSynchronizerManager(clientManager.InsecureClient()).ConfigMap(ref.Namespace, ref.Name)
+	sync.Overwatch.RegisterSynchronizer(synchronizer, sync.AlwaysRestart)
+	log.Printf("Loading localization configuration from key %s of config map %s in namespace %s", ref.Key, ref.Name,
+		ref.Namespace)
+	return handler.CreateConfigMapLocaleHandler(synchronizer, ref.Key)
 }
 
-func initAuthManager(clientManager clientapi.ClientManager) authApi.AuthManager {
+func initAuthManager(clientManager clientapi.ClientManager,
+	settingsManager settingsApi.SettingsManager) (authApi.AuthManager, jwe.KeyHolder) {
 	insecureClient := clientManager.InsecureClient()
 
 	// Init default encryption key synchronizer
@@ -198,13 +796,41 @@ func initAuthManager(clientManager clientapi.ClientManager) authApi.AuthManager
 	sync.Overwatch.RegisterSynchronizer(keySynchronizer, sync.AlwaysRestart)
 
 	// Init encryption key holder and token manager
-	keyHolder := jwe.NewRSAKeyHolder(keySynchronizer)
+	var keyHolder jwe.KeyHolder
+	if args.Holder.GetEncryptionKeyProvider() == authApi.EncryptionKeyProviderKMS {
+		keyWrapper, err := jwe.NewKMSKeyWrapper(args.Holder.GetKMSEndpoint(),
+			time.Duration(args.Holder.GetKMSTimeout())*time.Second)
+		if err != nil {
+			handleFatalInitError(err)
+		}
+
+		log.Printf("Using KMS plugin at %s to wrap encryption key", args.Holder.GetKMSEndpoint())
+		keyHolder = jwe.NewWrappedRSAKeyHolder(keySynchronizer, keyWrapper)
+	} else {
+		keyHolder = jwe.NewRSAKeyHolder(keySynchronizer)
+	}
+
 	tokenManager := jwe.NewJWETokenManager(keyHolder)
 	tokenTTL := time.Duration(args.Holder.GetTokenTTL())
 	if tokenTTL != authApi.DefaultTokenTTL {
 		tokenManager.SetTokenTTL(tokenTTL)
 	}
 
+	// Negative values mean that mode uses --token-ttl
+	modeTokenTTLs := map[authApi.AuthenticationMode]int{
+		authApi.Basic: args.Holder.GetTokenTTLBasic(),
+		authApi.Token: args.Holder.GetTokenTTLToken(),
+	}
+	for mode, ttl := range modeTokenTTLs {
+		if ttl >= 0 {
+			tokenManager.SetModeTokenTTL(mode, time.Duration(ttl))
+		}
+	}
+
+	if maxSessionLifetime := args.Holder.GetMaxSessionLifetime(); maxSessionLifetime > 0 {
+		tokenManager.SetMaxSessionLifetime(time.Duration(maxSessionLifetime))
+	}
+
 	// Set token manager for client manager.
 	clientManager.SetTokenManager(tokenManager)
 	authModes := authApi.ToAuthenticationModes(args.Holder.GetAuthenticationMode())
@@ -215,35 +841,176 @@ func initAuthManager(clientManager clientapi.ClientManager) authApi.AuthManager
 	// UI logic dictates this should be the inverse of the cli option
 	authenticationSkippable := args.Holder.GetEnableSkipLogin()
 
-	return auth.NewAuthManager(clientManager, tokenManager, authModes, authenticationSkippable)
+	var oidcClient authApi.OIDCClient
+	if authModes.IsEnabled(authApi.OIDC) {
+		if len(args.Holder.GetOIDCIssuerURL()) == 0 {
+			handleFatalInvalidArgError(fmt.Errorf("--oidc-issuer-url is required when 'oidc' authentication mode is enabled"))
+		}
+
+		if err := auth.ValidateOIDCRedirectURL(args.Holder.GetOIDCRedirectURL()); err != nil {
+			handleFatalInvalidArgError(err)
+		}
+
+		client, err := auth.NewOIDCClient(args.Holder.GetOIDCIssuerURL(), args.Holder.GetOIDCClientID(),
+			args.Holder.GetOIDCClientSecret(), args.Holder.GetOIDCScopes())
+		if err != nil {
+			handleFatalInvalidArgError(err)
+		}
+		oidcClient = client
+	}
+
+	// Client certificates are verified against apiserver CA. Certificate expiry is not checked after the login,
+	// sessions are bounded only by --token-ttl.
+	var apiserverCertPool *x509.CertPool
+	if authModes.IsEnabled(authApi.X509) {
+		pool, err := auth.NewAPIServerCertPool(clientManager.InsecureConfig())
+		if err != nil {
+			handleFatalInvalidArgError(fmt.Errorf("'x509' authentication mode requires apiserver CA: %s", err))
+		}
+		apiserverCertPool = pool
+	}
+
+	return auth.NewAuthManager(clientManager, tokenManager, authModes, authenticationSkippable, oidcClient,
+		apiserverCertPool, settingsManager), keyHolder
 }
 
 func initArgHolder() {
 	builder := args.GetHolderBuilder()
 	builder.SetInsecurePort(*argInsecurePort)
 	builder.SetPort(*argPort)
+	builder.SetUnixSocket(*argUnixSocket)
+	builder.SetUnixSocketMode(*argUnixSocketMode)
+	builder.SetBasePath(strings.TrimSuffix(*argBasePath, "/"))
+	builder.SetAPIPrefixes(*argAPIPrefixes)
+	builder.SetStaticContentDir(*argStaticContentDir)
 	builder.SetTokenTTL(*argTokenTTL)
+	builder.SetTokenTTLBasic(*argTokenTTLBasic)
+	builder.SetTokenTTLToken(*argTokenTTLToken)
+	builder.SetRequiredTokenClaims(*argRequiredTokenClaims)
+	builder.SetEnableTokenRefresh(*argEnableTokenRefresh)
+	builder.SetMaxSessionLifetime(*argMaxSessionLifetime)
+	builder.SetTokenSigningAlg(*argTokenSigningAlg)
+	builder.SetCookieSameSite(*argCookieSameSite)
+	builder.SetCookieSecure(*argCookieSecure)
+	builder.SetCookieDomain(*argCookieDomain)
+	builder.SetEnableCSRF(*argEnableCSRF)
+	builder.SetSessionIdleTimeout(*argSessionIdleTimeout)
+	builder.SetSessionWarningLeadTime(*argSessionWarningLeadTime)
 	builder.SetMetricClientCheckPeriod(*argMetricClientCheckPeriod)
+	builder.SetResyncPeriod(*argResyncPeriod)
+	builder.SetDiscoveryRefreshInterval(*argDiscoveryRefreshInterval)
+	builder.SetShutdownTimeout(*argShutdownTimeout)
+	builder.SetReadinessTimeout(*argReadinessTimeout)
+	builder.SetLivenessTimeout(*argLivenessTimeout)
+	builder.SetHTTPReadTimeout(*argHTTPReadTimeout)
+	builder.SetHTTPWriteTimeout(*argHTTPWriteTimeout)
+	builder.SetHTTPIdleTimeout(*argHTTPIdleTimeout)
+	builder.SetRequestTimeout(*argRequestTimeout)
+	builder.SetRateLimitQPS(*argRateLimitQPS)
+	builder.SetRateLimitBurst(*argRateLimitBurst)
+	builder.SetMaxInflightRequests(*argMaxInflightRequests)
+	builder.SetMaxInflightStreams(*argMaxInflightStreams)
+	builder.SetMaxConnectionsPerIP(*argMaxConnectionsPerIP)
+	builder.SetMaxRequestBodyBytes(*argMaxRequestBodyBytes)
+	builder.SetEnableCompression(*argEnableCompression)
+	builder.SetCompressionMinBytes(*argCompressionMinBytes)
+	builder.SetMaxWatchesPerSession(*argMaxWatchesPerSession)
+	builder.SetEventHistoryLimit(*argEventHistoryLimit)
+	builder.SetDrainGracePeriod(*argDrainGracePeriod)
+	builder.SetDrainTimeout(*argDrainTimeout)
+	builder.SetKubeClientQPS(*argKubeClientQPS)
+	builder.SetKubeClientBurst(*argKubeClientBurst)
+	builder.SetKubeClientTimeout(*argKubeClientTimeout)
+	builder.SetApiServerMaxRetries(*argApiServerMaxRetries)
+	builder.SetUserAgent(*argUserAgent)
 	builder.SetInsecureBindAddress(*argInsecureBindAddress)
 	builder.SetBindAddress(*argBindAddress)
+	builder.SetMetricsBindAddress(*argMetricsBindAddress)
+	builder.SetEnablePprof(*argEnablePprof)
 	builder.SetDefaultCertDir(*argDefaultCertDir)
 	builder.SetCertFile(*argCertFile)
 	builder.SetKeyFile(*argKeyFile)
+	builder.SetTLSSNICerts(*argTLSSNICerts)
+	builder.SetTLSMinVersion(*argTLSMinVersion)
+	builder.SetDisableHTTP2(*argDisableHTTP2)
+	builder.SetTLSCipherSuites(*argTLSCipherSuites)
+	builder.SetHSTSMaxAge(*argHSTSMaxAge)
+	builder.SetFrameOptions(*argFrameOptions)
+	builder.SetCORSAllowedOrigins(*argCORSAllowedOrigins)
 	builder.SetApiServerHost(*argApiserverHost)
+	builder.SetApiServerCAFile(*argApiserverCAFile)
+	builder.SetApiServerSkipTLSVerify(*argApiserverSkipTLSVerify)
+	builder.SetApiServerConnectRetries(*argApiserverConnectRetries)
+	builder.SetApiServerConnectBackoff(*argApiserverConnectBackoff)
+	builder.SetApiServerProxyURL(*argApiserverProxyURL)
+	builder.SetApiServerNoProxy(*argApiserverNoProxy)
 	builder.SetMetricsProvider(*argMetricsProvider)
 	builder.SetHeapsterHost(*argHeapsterHost)
 	builder.SetSidecarHost(*argSidecarHost)
 	builder.SetKubeConfigFile(*argKubeConfigFile)
+	builder.SetTokenFile(*argTokenFile)
+	builder.SetServiceAccountTokenFile(*argServiceAccountTokenFile)
+	builder.SetServiceAccountCAFile(*argServiceAccountCAFile)
+	builder.SetDefaultContext(*argDefaultContext)
 	builder.SetSystemBanner(*argSystemBanner)
 	builder.SetSystemBannerSeverity(*argSystemBannerSeverity)
+	builder.SetEnableGlobalBanner(*argEnableGlobalBanner)
+	builder.SetEnableHelm(*argEnableHelm)
+	builder.SetEnablePortForward(*argEnablePortForward)
+	builder.SetPortForwardIdleTimeout(*argPortForwardIdleTimeout)
+	builder.SetWebSocketMaxMessageBytes(*argWebSocketMaxMessageBytes)
+	builder.SetWebSocketPingInterval(*argWebSocketPingInterval)
+	builder.SetDefaultView(*argDefaultView)
+	builder.SetLoginTitle(*argLoginTitle)
+	builder.SetLoginLogoURL(*argLoginLogoURL)
+	builder.SetAppTitle(*argAppTitle)
+	builder.SetFaviconURL(*argFaviconURL)
 	builder.SetAPILogLevel(*argAPILogLevel)
+	builder.SetLogFormat(*argLogFormat)
+	builder.SetOTelEndpoint(*argOTelEndpoint)
+	builder.SetOTelSampleRate(*argOTelSampleRate)
+	builder.SetAuditLogPath(*argAuditLogPath)
+	builder.SetAuditLogMaxSize(*argAuditLogMaxSize)
+	builder.SetRequestIDHeader(*argRequestIDHeader)
+	builder.SetLogDefaultTailLines(*argLogDefaultTailLines)
+	builder.SetLogMaxBytes(*argLogMaxBytes)
+	builder.SetIgnoreDefaultContainerAnnotation(*argIgnoreDefaultContainerAnnotation)
+	builder.SetDefaultExecCommand(*argDefaultExecCommand)
 	builder.SetAuthenticationMode(*argAuthenticationMode)
 	builder.SetAutoGenerateCertificates(*argAutoGenerateCertificates)
+	builder.SetAutoGenerateCertSANs(*argAutoGenerateCertSANs)
 	builder.SetEnableInsecureLogin(*argEnableInsecureLogin)
 	builder.SetDisableSettingsAuthorizer(*argDisableSettingsAuthorizer)
+	builder.SetSettingsNamespace(*argSettingsNamespace)
+	builder.SetSettingsReadOnly(*argSettingsReadOnly)
 	builder.SetEnableSkipLogin(*argEnableSkip)
+	builder.SetReadOnly(*argReadOnly)
+	builder.SetEnableImpersonation(*argEnableImpersonation)
+	builder.SetAuthenticationHeader(*argAuthenticationHeader)
+	builder.SetTrustedProxyCIDRs(*argTrustedProxyCIDRs)
+	builder.SetTrustedProxies(*argTrustedProxies)
+	builder.SetTrustOriginalForwardedFor(*argTrustOriginalForwardedFor)
 	builder.SetNamespace(*argNamespace)
+	builder.SetDefaultCreateNamespace(*argDefaultCreateNamespace)
+	builder.SetDefaultDeletePropagation(*argDefaultDeletePropagation)
+	builder.SetDefaultTimezone(*argDefaultTimezone)
+	builder.SetEncryptionKeyProvider(*argEncryptionKeyProvider)
+	builder.SetKMSEndpoint(*argKMSEndpoint)
+	builder.SetKMSTimeout(*argKMSTimeout)
+	builder.SetNamespaceAllowlist(*argNamespaceAllowlist)
+	builder.SetNamespaceAllowlistClusterScoped(*argNamespaceAllowlistCluster)
+	builder.SetDisableClusterScoped(*argDisableClusterScoped)
+	builder.SetAllowedResources(*argAllowedResources)
+	builder.SetAnonymousAccess(*argAnonymousAccess)
+	builder.SetAnonymousServiceAccount(*argAnonymousServiceAccount)
+	builder.SetImpersonateServiceAccount(*argImpersonateServiceAccount)
+	builder.SetAnonymousResources(*argAnonymousResources)
 	builder.SetLocaleConfig(*localeConfig)
+	builder.SetOIDCIssuerURL(*argOIDCIssuerURL)
+	builder.SetOIDCClientID(*argOIDCClientID)
+	builder.SetOIDCClientSecret(*argOIDCClientSecret)
+	builder.SetOIDCScopes(*argOIDCScopes)
+	builder.SetOIDCRedirectURL(*argOIDCRedirectURL)
 }
 
 /**
@@ -266,6 +1033,13 @@ func handleFatalInitServingCertError(err error) {
 	log.Fatalf("Error while loading dashboard server certificates. Reason: %s", err)
 }
 
+/**
+ * Handles fatal init errors caused by invalid or inconsistent arguments passed to Dashboard binary.
+ */
+func handleFatalInvalidArgError(err error) {
+	log.Fatalf("Invalid arguments passed to Dashboard. Reason: %s", err)
+}
+
 /**
 * Lookup the environment variable provided and set to default value if variable isn't found
  */
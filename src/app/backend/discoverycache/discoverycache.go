@@ -0,0 +1,181 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package discoverycache caches API groups and resources looked up from the apiserver, i.e. to find resource of the
+// kind or preferred version of the group or to serve API discovery, so that they are not discovered on every request.
+package discoverycache
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+// Key of the cached API group list, API resource lists are cached under their group version.
+const groupsKey = "/groups"
+
+type cacheEntry struct {
+	value     interface{}
+	refreshed time.Time
+	expires   time.Time
+}
+
+// Cache keeps discovery results of every apiserver for --discovery-refresh-interval. Results are shared between
+// users, as discovery is not restricted by permissions of the user. Failed lookups are not cached.
+type Cache struct {
+	mux     sync.Mutex
+	ttl     func() time.Duration
+	entries map[string]*cacheEntry
+}
+
+// Wrap returns discovery client that serves API groups and resources from the cache and looks them up with given
+// client only when they are not cached or the cache expired. Other calls are passed to given client.
+func (self *Cache) Wrap(client discovery.DiscoveryInterface) discovery.DiscoveryInterface {
+	restClient := client.RESTClient()
+	if restClient == nil || self.ttl() <= 0 {
+		return client
+	}
+
+	return &cachedDiscovery{DiscoveryInterface: client, cache: self, key: restClient.Get().URL().Host}
+}
+
+// Refreshed returns time when API groups served by the apiserver of given client were looked up, or zero time if
+// they are not cached.
+func (self *Cache) Refreshed(client discovery.DiscoveryInterface) time.Time {
+	restClient := client.RESTClient()
+	if restClient == nil {
+		return time.Time{}
+	}
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	if entry, exists := self.entries[restClient.Get().URL().Host+groupsKey]; exists {
+		return entry.refreshed
+	}
+
+	return time.Time{}
+}
+
+// Invalidate removes all cached results, so that they are looked up again on the next request.
+func (self *Cache) Invalidate() {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	self.entries = map[string]*cacheEntry{}
+}
+
+func (self *Cache) get(key string, now time.Time) (interface{}, bool) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	entry, exists := self.entries[key]
+	if !exists || now.After(entry.expires) {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+func (self *Cache) set(key string, value interface{}, now time.Time) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	for entryKey, entry := range self.entries {
+		if now.After(entry.expires) {
+			delete(self.entries, entryKey)
+		}
+	}
+	self.entries[key] = &cacheEntry{value: value, refreshed: now, expires: now.Add(self.ttl())}
+}
+
+type cachedDiscovery struct {
+	discovery.DiscoveryInterface
+	cache *Cache
+	key   string
+}
+
+// ServerGroups returns cached API groups served by the apiserver.
+func (self *cachedDiscovery) ServerGroups() (*metaV1.APIGroupList, error) {
+	key := self.key + groupsKey
+	if value, exists := self.cache.get(key, time.Now()); exists {
+		return value.(*metaV1.APIGroupList), nil
+	}
+
+	result, err := self.DiscoveryInterface.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	self.cache.set(key, result, time.Now())
+	return result, nil
+}
+
+// ServerGroupsAndResources returns cached API groups and resources of all their versions. Only the group versions
+// that are not cached are looked up.
+func (self *cachedDiscovery) ServerGroupsAndResources() ([]*metaV1.APIGroup, []*metaV1.APIResourceList, error) {
+	return discovery.ServerGroupsAndResources(self)
+}
+
+// ServerResourcesForGroupVersion returns cached API resources of given group version.
+func (self *cachedDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metaV1.APIResourceList, error) {
+	key := self.key + "/" + groupVersion
+	if value, exists := self.cache.get(key, time.Now()); exists {
+		return value.(*metaV1.APIResourceList), nil
+	}
+
+	result, err := self.DiscoveryInterface.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	self.cache.set(key, result, time.Now())
+	return result, nil
+}
+
+// NewCache creates Cache keeping results for the duration returned by given function.
+func NewCache(ttl func() time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: map[string]*cacheEntry{}}
+}
+
+// DefaultCache is the cache of discovery results used by the whole dashboard. Its TTL is set with
+// --discovery-refresh-interval.
+var DefaultCache = NewCache(func() time.Duration {
+	return time.Duration(args.Holder.GetDiscoveryRefreshInterval()) * time.Second
+})
+
+// Wrap returns discovery client backed by DefaultCache. See Cache.Wrap for more information.
+func Wrap(client discovery.DiscoveryInterface) discovery.DiscoveryInterface {
+	return DefaultCache.Wrap(client)
+}
+
+// IsCacheBust returns true if the client asked for fresh results with 'Cache-Control: no-cache' header, i.e. right
+// after it created a custom resource definition.
+func IsCacheBust(request *http.Request) bool {
+	for _, value := range request.Header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(directive), "no-cache") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
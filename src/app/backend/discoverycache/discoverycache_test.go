@@ -0,0 +1,147 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverycache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+// Returns fake apiserver serving discovery of apps/v1 group and counter of requests it received.
+func newFakeServer() (*httptest.Server, *int32) {
+	requests := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"kind":"APIVersions","versions":["v1"]}`))
+		case "/apis":
+			w.Write([]byte(`{"kind":"APIGroupList","groups":[{"name":"apps","versions":[{"groupVersion":"apps/v1",` +
+				`"version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}]}`))
+		case "/apis/apps/v1":
+			w.Write([]byte(`{"kind":"APIResourceList","groupVersion":"apps/v1","resources":[{"name":"deployments",` +
+				`"kind":"Deployment","namespaced":true,"verbs":["get"]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server, &requests
+}
+
+func TestCacheWrap(t *testing.T) {
+	server, requests := newFakeServer()
+	defer server.Close()
+
+	ttl := time.Minute
+	cache := NewCache(func() time.Duration { return ttl })
+	client := discovery.NewDiscoveryClientForConfigOrDie(&rest.Config{Host: server.URL})
+
+	for i := 0; i < 2; i++ {
+		groups, err := cache.Wrap(client).ServerGroups()
+		if err != nil || len(groups.Groups) != 2 {
+			t.Fatalf("Expected core and apps groups, but got %v and error %v", groups, err)
+		}
+
+		resources, err := cache.Wrap(client).ServerResourcesForGroupVersion("apps/v1")
+		if err != nil || len(resources.APIResources) != 1 {
+			t.Fatalf("Expected deployments resource, but got %v and error %v", resources, err)
+		}
+	}
+
+	// Groups are discovered with 2 requests and resources of the group version with 1.
+	if actual := atomic.LoadInt32(requests); actual != 3 {
+		t.Errorf("Expected cached results to be reused, but apiserver received %d requests", actual)
+	}
+
+	if _, err := cache.Wrap(client).ServerResourcesForGroupVersion("unknown/v1"); err == nil {
+		t.Error("Expected lookup of unknown group version to fail")
+	}
+	cache.Wrap(client).ServerResourcesForGroupVersion("unknown/v1")
+	if actual := atomic.LoadInt32(requests); actual != 5 {
+		t.Errorf("Expected failed lookups not to be cached, but apiserver received %d requests", actual)
+	}
+
+	cache.Invalidate()
+	cache.Wrap(client).ServerGroups()
+	if actual := atomic.LoadInt32(requests); actual != 7 {
+		t.Errorf("Expected groups to be discovered again after invalidation, but apiserver received %d requests", actual)
+	}
+
+	ttl = 0
+	cache.Wrap(client).ServerGroups()
+	if actual := atomic.LoadInt32(requests); actual != 9 {
+		t.Errorf("Expected cache to be disabled with TTL 0, but apiserver received %d requests", actual)
+	}
+}
+
+func TestCacheServerGroupsAndResources(t *testing.T) {
+	server, requests := newFakeServer()
+	defer server.Close()
+
+	cache := NewCache(func() time.Duration { return time.Minute })
+	client := discovery.NewDiscoveryClientForConfigOrDie(&rest.Config{Host: server.URL})
+
+	if refreshed := cache.Refreshed(client); !refreshed.IsZero() {
+		t.Errorf("Expected zero refresh time before groups are cached, but got %s", refreshed)
+	}
+
+	for i := 0; i < 2; i++ {
+		groups, resources, err := cache.Wrap(client).ServerGroupsAndResources()
+		if err != nil || len(groups) != 2 || len(resources) != 2 {
+			t.Fatalf("Expected core and apps groups with their resources, but got %v, %v and error %v", groups,
+				resources, err)
+		}
+	}
+
+	// Groups are discovered with 2 requests and resources of every group version with 1.
+	if actual := atomic.LoadInt32(requests); actual != 4 {
+		t.Errorf("Expected cached results to be reused, but apiserver received %d requests", actual)
+	}
+
+	if refreshed := cache.Refreshed(client); refreshed.IsZero() {
+		t.Error("Expected refresh time of cached groups")
+	}
+}
+
+func TestIsCacheBust(t *testing.T) {
+	cases := []struct {
+		cacheControl []string
+		expected     bool
+	}{
+		{nil, false},
+		{[]string{"no-cache"}, true},
+		{[]string{"max-age=0, No-Cache"}, true},
+		{[]string{"no-store"}, false},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/pod", nil)
+		for _, value := range c.cacheControl {
+			request.Header.Add("Cache-Control", value)
+		}
+
+		if actual := IsCacheBust(request); actual != c.expected {
+			t.Errorf("IsCacheBust() with Cache-Control %v == %t, expected %t", c.cacheControl, actual, c.expected)
+		}
+	}
+}
@@ -64,6 +64,20 @@ func NewInvalid(reason string) *errors.StatusError {
 	}
 }
 
+// NewForbidden return a statusError
+// which is an error intended for consumption by a REST API server; it can also be
+// reconstructed by clients from a REST response. Public to allow easy type switches.
+func NewForbidden(reason string) *errors.StatusError {
+	return &errors.StatusError{
+		ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusForbidden,
+			Reason:  metav1.StatusReasonForbidden,
+			Message: reason,
+		},
+	}
+}
+
 // NewNotFound return a statusError
 // which is an error intended for consumption by a REST API server; it can also be
 // reconstructed by clients from a REST response. Public to allow easy type switches.
@@ -93,6 +107,20 @@ func NewInternal(reason string) *errors.StatusError {
 	}}
 }
 
+// NewServiceUnavailable return a statusError
+// which is an error intended for consumption by a REST API server; it can also be
+// reconstructed by clients from a REST response. Public to allow easy type switches.
+func NewServiceUnavailable(reason string) *errors.StatusError {
+	return &errors.StatusError{
+		ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusServiceUnavailable,
+			Reason:  metav1.StatusReasonServiceUnavailable,
+			Message: reason,
+		},
+	}
+}
+
 // NewUnexpectedObject return a statusError
 // which is an error intended for consumption by a REST API server; it can also be
 // reconstructed by clients from a REST response. Public to allow easy type switches.
@@ -151,6 +179,9 @@ func NewGenericResponse(code int, serverMessage string) *errors.StatusError {
 	case http.StatusGatewayTimeout:
 		reason = metav1.StatusReasonTimeout
 		message = "the server was unable to return a response in the time allotted, but may still be processing the request"
+	case http.StatusRequestEntityTooLarge:
+		reason = metav1.StatusReasonRequestEntityTooLarge
+		message = "the server has rejected the request because its body is too large"
 	case http.StatusTooManyRequests:
 		reason = metav1.StatusReasonTooManyRequests
 		message = "the server has received too many requests and has asked us to try again later"
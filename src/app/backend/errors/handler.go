@@ -15,6 +15,7 @@
 package errors
 
 import (
+	stderrors "errors"
 	"log"
 	"net/http"
 
@@ -127,6 +128,8 @@ func HandleInternalError(response *restful.Response, err error) {
 	statusError, ok := err.(*errors.StatusError)
 	if ok && statusError.Status().Code > 0 {
 		statusCode = int(statusError.Status().Code)
+	} else if IsRequestBodyTooLargeError(err) {
+		statusCode = http.StatusRequestEntityTooLarge
 	}
 	response.AddHeader("Content-Type", "text/plain")
 	response.WriteErrorString(statusCode, err.Error()+"\n")
@@ -140,5 +143,17 @@ func HandleHTTPError(err error) int {
 	if err.Error() == MsgTokenExpiredError || err.Error() == MsgLoginUnauthorizedError || err.Error() == MsgEncryptionKeyChanged {
 		return http.StatusUnauthorized
 	}
+	if IsRequestBodyTooLargeError(err) {
+		return http.StatusRequestEntityTooLarge
+	}
 	return http.StatusInternalServerError
 }
+
+// ErrRequestBodyTooLarge is returned while reading request body that exceeded maximum allowed size.
+var ErrRequestBodyTooLarge = stderrors.New("http: request body too large")
+
+// IsRequestBodyTooLargeError returns true if given error occurred while reading request body that exceeded
+// maximum allowed size.
+func IsRequestBodyTooLargeError(err error) bool {
+	return stderrors.Is(err, ErrRequestBodyTooLarge)
+}
@@ -15,6 +15,7 @@
 package errors_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -50,6 +51,10 @@ func TestHandleHTTPError(t *testing.T) {
 			errors.NewInvalid(errors.MsgEncryptionKeyChanged),
 			401,
 		},
+		{
+			fmt.Errorf("could not read body: %w", errors.ErrRequestBodyTooLarge),
+			413,
+		},
 	}
 	for _, c := range cases {
 		actual := errors.HandleHTTPError(c.err)
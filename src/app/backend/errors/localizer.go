@@ -28,6 +28,7 @@ const (
 	MsgEncryptionKeyChanged            = "MSG_ENCRYPTION_KEY_CHANGED"
 	MsgDashboardExclusiveResourceError = "MSG_DASHBOARD_EXCLUSIVE_RESOURCE_ERROR"
 	MsgTokenExpiredError               = "MSG_TOKEN_EXPIRED_ERROR"
+	MsgSessionIdleError                = "MSG_SESSION_IDLE_ERROR"
 )
 
 // This file contains all errors that should be kept in sync with:
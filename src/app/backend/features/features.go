@@ -0,0 +1,156 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package features evaluates coarse feature flags of the user based on permissions granted to the user, so that the
+// frontend can hide controls the user is not allowed to use.
+package features
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/authorization/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+// Time after which result of the access review is evaluated again.
+const cacheTTL = 30 * time.Second
+
+// Feature is a coarse feature of dashboard that is enabled if the user is allowed to perform given action. Actions
+// on namespaced resources are reviewed in the namespace the flags are requested for, or in all namespaces.
+type Feature struct {
+	Name       string
+	Attributes v1.ResourceAttributes
+	Namespaced bool
+}
+
+// Features are the feature flags returned to the frontend.
+var Features = []Feature{
+	{Name: "nodeMaintenance", Attributes: v1.ResourceAttributes{Verb: "patch", Resource: "nodes"}},
+	{Name: "podEviction", Attributes: v1.ResourceAttributes{Verb: "create", Resource: "pods",
+		Subresource: "eviction"}, Namespaced: true},
+	{Name: "podExec", Attributes: v1.ResourceAttributes{Verb: "create", Resource: "pods", Subresource: "exec"},
+		Namespaced: true},
+	{Name: "podPortForward", Attributes: v1.ResourceAttributes{Verb: "create", Resource: "pods",
+		Subresource: "portforward"}, Namespaced: true},
+	{Name: "namespaceDelete", Attributes: v1.ResourceAttributes{Verb: "delete", Resource: "namespaces"}},
+}
+
+// FeatureFlags tells which features are enabled for the user.
+type FeatureFlags struct {
+	Features map[string]bool `json:"features"`
+}
+
+type cacheEntry struct {
+	allowed bool
+	expires time.Time
+}
+
+// Cache keeps results of the access reviews of every session for cacheTTL, so that the apiserver is not asked on
+// every request.
+type Cache struct {
+	mux       sync.Mutex
+	entries   map[string]*cacheEntry
+	lastSweep time.Time
+}
+
+// Evaluate returns feature flags of the session with given key in given namespace, empty namespace means all
+// namespaces. Reviews are made with given client of the user. Features are disabled if the review could not be
+// performed and, as all of them modify resources or interact with containers, in read-only mode. Results are not
+// cached if key is empty.
+func (self *Cache) Evaluate(key, namespace string, client kubernetes.Interface) FeatureFlags {
+	return self.evaluate(key, namespace, client, time.Now())
+}
+
+func (self *Cache) evaluate(key, namespace string, client kubernetes.Interface, now time.Time) FeatureFlags {
+	result := FeatureFlags{Features: make(map[string]bool, len(Features))}
+	for _, feature := range Features {
+		result.Features[feature.Name] = !args.Holder.GetReadOnly() && self.allowed(key, namespace, feature, client, now)
+	}
+
+	return result
+}
+
+func (self *Cache) allowed(key, namespace string, feature Feature, client kubernetes.Interface, now time.Time) bool {
+	attributes := feature.Attributes
+	entryKey := key + "/" + feature.Name
+	if feature.Namespaced {
+		attributes.Namespace = namespace
+		entryKey += "/" + namespace
+	}
+
+	if len(key) > 0 {
+		if allowed, exists := self.get(entryKey, now); exists {
+			return allowed
+		}
+	}
+
+	response, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(context.TODO(),
+		&v1.SelfSubjectAccessReview{Spec: v1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes}},
+		metaV1.CreateOptions{})
+	if err != nil {
+		log.Printf("Disabling feature %s, could not review access: %s", feature.Name, err)
+		return false
+	}
+
+	if len(key) > 0 {
+		self.set(entryKey, response.Status.Allowed, now)
+	}
+
+	return response.Status.Allowed
+}
+
+func (self *Cache) get(key string, now time.Time) (bool, bool) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	entry, exists := self.entries[key]
+	if !exists || now.After(entry.expires) {
+		return false, false
+	}
+
+	return entry.allowed, true
+}
+
+func (self *Cache) set(key string, allowed bool, now time.Time) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	self.sweep(now)
+	self.entries[key] = &cacheEntry{allowed: allowed, expires: now.Add(cacheTTL)}
+}
+
+// Removes expired entries, so that results of sessions that ended are not kept in memory.
+func (self *Cache) sweep(now time.Time) {
+	if now.Sub(self.lastSweep) < cacheTTL {
+		return
+	}
+
+	for key, entry := range self.entries {
+		if now.After(entry.expires) {
+			delete(self.entries, key)
+		}
+	}
+	self.lastSweep = now
+}
+
+// NewCache creates Cache.
+func NewCache() *Cache {
+	return &Cache{entries: map[string]*cacheEntry{}, lastSweep: time.Now()}
+}
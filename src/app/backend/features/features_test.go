@@ -0,0 +1,147 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+// Returns client that allows only patching of nodes and counts access reviews it was asked for. Reviews fail if
+// fail is set.
+func newFakeClient(reviews *int, fail *bool) *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews",
+		func(action core.Action) (bool, runtime.Object, error) {
+			*reviews++
+			if *fail {
+				return true, nil, errors.New("apiserver unavailable")
+			}
+
+			review := action.(core.CreateAction).GetObject().(*v1.SelfSubjectAccessReview)
+			attributes := review.Spec.ResourceAttributes
+			review.Status.Allowed = attributes.Resource == "nodes" && attributes.Verb == "patch" ||
+				attributes.Subresource == "exec" && attributes.Namespace == "foo"
+			return true, review, nil
+		})
+	return client
+}
+
+func TestCacheEvaluate(t *testing.T) {
+	reviews, fail := 0, false
+	client := newFakeClient(&reviews, &fail)
+	cache := NewCache()
+	now := time.Now()
+
+	result := cache.evaluate("session", "", client, now)
+	if !result.Features["nodeMaintenance"] || result.Features["podExec"] || result.Features["namespaceDelete"] {
+		t.Fatalf("Expected only nodeMaintenance feature to be enabled, but got %v", result.Features)
+	}
+
+	if reviews != len(Features) {
+		t.Fatalf("Expected %d access reviews, but got %d", len(Features), reviews)
+	}
+
+	cache.evaluate("session", "", client, now.Add(cacheTTL/2))
+	if reviews != len(Features) {
+		t.Errorf("Expected cached results to be used, but got %d access reviews", reviews)
+	}
+
+	cache.evaluate("other", "", client, now.Add(cacheTTL/2))
+	if reviews != 2*len(Features) {
+		t.Errorf("Expected results of other session to be reviewed, but got %d access reviews", reviews)
+	}
+
+	cache.evaluate("session", "", client, now.Add(2*cacheTTL))
+	if reviews != 3*len(Features) {
+		t.Errorf("Expected expired results to be reviewed again, but got %d access reviews", reviews)
+	}
+
+	cache.evaluate("", "", client, now)
+	cache.evaluate("", "", client, now)
+	if reviews != 5*len(Features) {
+		t.Errorf("Expected results of unauthenticated requests not to be cached, but got %d access reviews", reviews)
+	}
+}
+
+func TestCacheEvaluateFailClosed(t *testing.T) {
+	reviews, fail := 0, true
+	client := newFakeClient(&reviews, &fail)
+	cache := NewCache()
+	now := time.Now()
+
+	result := cache.evaluate("session", "", client, now)
+	for name, enabled := range result.Features {
+		if enabled {
+			t.Errorf("Expected feature %s to be disabled when access review fails", name)
+		}
+	}
+
+	fail = false
+	result = cache.evaluate("session", "", client, now)
+	if !result.Features["nodeMaintenance"] {
+		t.Error("Expected failed access review not to be cached")
+	}
+}
+
+func TestCacheEvaluateNamespace(t *testing.T) {
+	reviews, fail := 0, false
+	client := newFakeClient(&reviews, &fail)
+	cache := NewCache()
+	now := time.Now()
+
+	if result := cache.evaluate("session", "foo", client, now); !result.Features["podExec"] ||
+		!result.Features["nodeMaintenance"] {
+		t.Errorf("Expected podExec feature to be enabled in the namespace, but got %v", result.Features)
+	}
+
+	if result := cache.evaluate("session", "bar", client, now); result.Features["podExec"] {
+		t.Errorf("Expected podExec feature to be evaluated in other namespace, but got %v", result.Features)
+	}
+
+	namespaced := 0
+	for _, feature := range Features {
+		if feature.Namespaced {
+			namespaced++
+		}
+	}
+
+	if reviews != len(Features)+namespaced {
+		t.Errorf("Expected only namespaced features to be reviewed again, but got %d access reviews", reviews)
+	}
+}
+
+func TestCacheEvaluateReadOnly(t *testing.T) {
+	args.GetHolderBuilder().SetReadOnly(true)
+	defer args.GetHolderBuilder().SetReadOnly(false)
+
+	reviews, fail := 0, false
+	client := newFakeClient(&reviews, &fail)
+
+	result := NewCache().evaluate("session", "foo", client, time.Now())
+	for name, enabled := range result.Features {
+		if enabled {
+			t.Errorf("Expected feature %s to be disabled in read-only mode", name)
+		}
+	}
+}
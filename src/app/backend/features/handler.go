@@ -0,0 +1,78 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// FeatureHandler manages endpoint serving feature flags of the user.
+type FeatureHandler struct {
+	cache    *Cache
+	cManager clientapi.ClientManager
+}
+
+// Install creates new endpoint for feature flags. Flags of namespaced actions are evaluated in the namespace passed
+// in 'namespace' query parameter, or in all namespaces if it is not set.
+func (self *FeatureHandler) Install(ws *restful.WebService) {
+	ws.Route(
+		ws.GET("/me/features").
+			To(self.handleGet).
+			Param(ws.QueryParameter("namespace", "namespace of the namespaced actions")).
+			Writes(FeatureFlags{}))
+}
+
+func (self *FeatureHandler) handleGet(request *restful.Request, response *restful.Response) {
+	k8sClient, err := self.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.QueryParameter("namespace")
+	if len(namespace) > 0 && len(validation.IsDNS1123Label(namespace)) > 0 {
+		errors.HandleInternalError(response, errors.NewBadRequest("invalid namespace "+namespace))
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, self.cache.Evaluate(sessionKey(request), namespace, k8sClient))
+}
+
+// Returns key of the session that sent the request. Permissions may differ between kubeconfig contexts, so they are
+// cached separately. Empty if request is not authenticated.
+func sessionKey(request *restful.Request) string {
+	key := client.CredentialsKey(request.Request)
+	if len(key) == 0 {
+		return ""
+	}
+
+	if cookie, err := request.Request.Cookie(client.KubeContextCookieName); err == nil {
+		key += "/" + cookie.Value
+	}
+
+	return key
+}
+
+// NewFeatureHandler creates FeatureHandler.
+func NewFeatureHandler(cache *Cache, cManager clientapi.ClientManager) FeatureHandler {
+	return FeatureHandler{cache: cache, cManager: cManager}
+}
@@ -0,0 +1,246 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/apidiscovery"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// API groups of resources served by clients of given type.
+var clientTypeGroups = map[api.ClientType]string{
+	api.ClientTypeDefault:             "",
+	api.ClientTypeExtensionClient:     "extensions",
+	api.ClientTypeAppsClient:          "apps",
+	api.ClientTypeBatchClient:         "batch",
+	api.ClientTypeBetaBatchClient:     "batch",
+	api.ClientTypeAutoscalingClient:   "autoscaling",
+	api.ClientTypeStorageClient:       "storage.k8s.io",
+	api.ClientTypeRbacClient:          "rbac.authorization.k8s.io",
+	api.ClientTypeAPIExtensionsClient: "apiextensions.k8s.io",
+	api.ClientTypeNetworkingClient:    "networking.k8s.io",
+	api.ClientTypePluginsClient:       "dashboard.k8s.io",
+}
+
+// Kinds of resources served by routes which do not start with the name of the kind.
+var routeKinds = map[string]string{
+	"crd":           api.ResourceKindCustomResourceDefinition,
+	"log":           api.ResourceKindPod,
+	"appdeployment": api.ResourceKindDeployment,
+	"helmrelease":   api.ResourceKindSecret,
+}
+
+// Routes that are always allowed, as frontend can not work without them.
+var alwaysAllowedRoutes = map[string]bool{
+	"/api/v1/namespace": true,
+}
+
+// Routes that can create or export resources of any kind, so they are disabled when allowed resources are restricted.
+var anyKindRoutes = map[string]bool{
+	"/api/v1/appdeploymentfromfile":   true,
+	"/api/v1/namespace/{name}/export": true,
+}
+
+// ParseAllowedResources parses list of 'group/resource' entries, i.e. 'apps/deployments'. Resources of the core
+// group can be given without group, i.e. 'pods', or with 'core' group. Returns nil if list is empty, which means
+// that all resources are allowed.
+func ParseAllowedResources(entries []string) (map[schema.GroupResource]bool, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	allowed := make(map[schema.GroupResource]bool, len(entries))
+	for _, entry := range entries {
+		groupResource := schema.GroupResource{Resource: strings.TrimSpace(entry)}
+		if i := strings.LastIndex(groupResource.Resource, "/"); i >= 0 {
+			groupResource.Group, groupResource.Resource = groupResource.Resource[:i], groupResource.Resource[i+1:]
+		}
+
+		if groupResource.Group == "core" {
+			groupResource.Group = ""
+		}
+
+		if len(groupResource.Resource) == 0 {
+			return nil, fmt.Errorf("invalid allowed resource %q, it should be in 'group/resource' format", entry)
+		}
+
+		allowed[groupResource] = true
+	}
+
+	return allowed, nil
+}
+
+// AllowedResourceKinds returns sorted list of kinds supported by dashboard that are allowed. Returns nil if all
+// resources are allowed.
+func AllowedResourceKinds(allowed map[schema.GroupResource]bool) []string {
+	if allowed == nil {
+		return nil
+	}
+
+	kinds := make([]string, 0)
+	for kind := range api.KindToAPIMapping {
+		if groupResource, ok := kindGroupResource(kind); ok && allowed[groupResource] {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Returns given kinds without kinds of cluster-scoped resources. Namespaces are kept as frontend can not work without
+// them. All kinds supported by dashboard are filtered if all resources are allowed.
+func namespacedResourceKinds(kinds []string) []string {
+	if kinds == nil {
+		kinds = make([]string, 0, len(api.KindToAPIMapping))
+		for kind := range api.KindToAPIMapping {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+	}
+
+	result := make([]string, 0)
+	for _, kind := range kinds {
+		if api.KindToAPIMapping[kind].Namespaced || kind == api.ResourceKindNamespace {
+			result = append(result, kind)
+		}
+	}
+
+	return result
+}
+
+// Returns filter of the API discovery, which removes resources that are not allowed and, if access to cluster-scoped
+// resources is disabled, cluster-scoped resources except namespaces. Subresources, i.e. 'deployments/scale', are
+// filtered the same way as their resources.
+func discoveryResourceFilter(allowed map[schema.GroupResource]bool, clusterScoped bool) apidiscovery.ResourceFilter {
+	return func(group string, resource apidiscovery.APIResource) bool {
+		name := strings.SplitN(resource.Name, "/", 2)[0]
+		if !clusterScoped && !resource.Namespaced && (len(group) > 0 || name != "namespaces") {
+			return false
+		}
+
+		return allowed == nil || allowed[schema.GroupResource{Group: group, Resource: name}]
+	}
+}
+
+// Returns group and resource of the kind supported by dashboard.
+func kindGroupResource(kind string) (schema.GroupResource, bool) {
+	mapping, ok := api.KindToAPIMapping[kind]
+	if !ok {
+		return schema.GroupResource{}, false
+	}
+
+	return schema.GroupResource{Group: clientTypeGroups[mapping.ClientType], Resource: mapping.Resource}, true
+}
+
+// Returns not found error, the same as allowedResourcesFilter does, if resources of given kind are not allowed. All
+// kinds are allowed if allowed resources are nil.
+func checkKindAllowed(allowed map[schema.GroupResource]bool, kind string) error {
+	if allowed == nil {
+		return nil
+	}
+
+	groupResource, ok := kindGroupResource(kind)
+	if !ok {
+		groupResource = schema.GroupResource{Resource: kind}
+	}
+
+	if !allowed[groupResource] {
+		return errors.NewNotFound(fmt.Sprintf("Resource %s is not allowed", groupResource))
+	}
+
+	return nil
+}
+
+// Returns group and resource of the custom resource defined by CRD with given name, i.e. 'foos.example.com'.
+func crdGroupResource(crd string) schema.GroupResource {
+	parts := strings.SplitN(crd, ".", 2)
+	if len(parts) < 2 {
+		return schema.GroupResource{Resource: crd}
+	}
+
+	return schema.GroupResource{Group: parts[1], Resource: parts[0]}
+}
+
+// Returns groups and resources accessed by the request. Requests that do not access any resources, i.e. login or
+// settings, return empty list.
+func requestGroupResources(request *restful.Request) []schema.GroupResource {
+	route := request.SelectedRoutePath()
+	segments := strings.Split(strings.TrimPrefix(route, "/api/v1/"), "/")
+
+	kinds := []string{}
+	if kind := request.PathParameter("kind"); len(kind) > 0 {
+		kinds = append(kinds, kind)
+	}
+
+	if kind, ok := routeKinds[segments[0]]; ok {
+		kinds = append(kinds, kind)
+	} else if _, ok := api.KindToAPIMapping[segments[0]]; ok {
+		kinds = append(kinds, segments[0])
+	}
+
+	if segments[len(segments)-1] == api.ResourceKindHorizontalPodAutoscaler {
+		kinds = append(kinds, api.ResourceKindHorizontalPodAutoscaler)
+	}
+
+	groupResources := make([]schema.GroupResource, 0, len(kinds))
+	for _, kind := range kinds {
+		groupResource, ok := kindGroupResource(kind)
+		if !ok {
+			// Unknown kinds are not supported by dashboard, so they can not be allowed.
+			groupResource = schema.GroupResource{Resource: kind}
+		}
+		groupResources = append(groupResources, groupResource)
+	}
+
+	if crd := request.PathParameter("crd"); len(crd) > 0 && segments[len(segments)-1] != "{crd}" {
+		groupResources = append(groupResources, crdGroupResource(crd))
+	}
+
+	return groupResources
+}
+
+// Returns filter used to respond with 404 to requests for resources that are not allowed with --allowed-resources.
+func allowedResourcesFilter(allowed map[schema.GroupResource]bool) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		route := request.SelectedRoutePath()
+		if alwaysAllowedRoutes[route] {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		if anyKindRoutes[route] {
+			errors.HandleInternalError(response, errors.NewNotFound("Routes serving resources of any kind are disabled when allowed resources are restricted"))
+			return
+		}
+
+		for _, groupResource := range requestGroupResources(request) {
+			if !allowed[groupResource] {
+				errors.HandleInternalError(response, errors.NewNotFound(fmt.Sprintf("Resource %s is not allowed", groupResource)))
+				return
+			}
+		}
+
+		chain.ProcessFilter(request, response)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubernetes/dashboard/src/app/backend/apidiscovery"
+)
+
+func TestParseAllowedResources(t *testing.T) {
+	cases := []struct {
+		entries     []string
+		expected    map[schema.GroupResource]bool
+		expectedErr bool
+	}{
+		{[]string{}, nil, false},
+		{[]string{"apps/deployments", "pods", "core/services"}, map[schema.GroupResource]bool{
+			{Group: "apps", Resource: "deployments"}: true,
+			{Resource: "pods"}:                       true,
+			{Resource: "services"}:                   true,
+		}, false},
+		{[]string{"apps/"}, nil, true},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseAllowedResources(c.entries)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("ParseAllowedResources(%v) returns error %v, expected error: %t", c.entries, err, c.expectedErr)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("ParseAllowedResources(%v) == %v, expected %v", c.entries, actual, c.expected)
+		}
+	}
+}
+
+func TestAllowedResourceKinds(t *testing.T) {
+	allowed, _ := ParseAllowedResources([]string{"apps/deployments", "pods", "services", "example.com/foos"})
+	expected := []string{"deployment", "pod", "service"}
+
+	if actual := AllowedResourceKinds(allowed); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("AllowedResourceKinds() == %v, expected %v", actual, expected)
+	}
+
+	if actual := AllowedResourceKinds(nil); actual != nil {
+		t.Errorf("AllowedResourceKinds(nil) == %v, expected nil", actual)
+	}
+}
+
+func TestNamespacedResourceKinds(t *testing.T) {
+	kinds := []string{"deployment", "namespace", "node", "persistentvolume", "pod"}
+	expected := []string{"deployment", "namespace", "pod"}
+
+	if actual := namespacedResourceKinds(kinds); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("namespacedResourceKinds(%v) == %v, expected %v", kinds, actual, expected)
+	}
+
+	actual := namespacedResourceKinds(nil)
+	for _, kind := range []string{"node", "clusterrole", "storageclass"} {
+		if containsKind(actual, kind) {
+			t.Errorf("namespacedResourceKinds(nil) == %v, expected it not to contain %s", actual, kind)
+		}
+	}
+
+	if !containsKind(actual, "pod") || !containsKind(actual, "namespace") {
+		t.Errorf("namespacedResourceKinds(nil) == %v, expected it to contain pod and namespace", actual)
+	}
+}
+
+func TestDiscoveryResourceFilter(t *testing.T) {
+	allowed, _ := ParseAllowedResources([]string{"apps/deployments", "pods", "nodes"})
+	pods := apidiscovery.APIResource{Name: "pods", Namespaced: true}
+	scale := apidiscovery.APIResource{Name: "deployments/scale", Namespaced: true}
+	nodes := apidiscovery.APIResource{Name: "nodes"}
+	namespaces := apidiscovery.APIResource{Name: "namespaces"}
+
+	cases := []struct {
+		info          string
+		allowed       map[schema.GroupResource]bool
+		clusterScoped bool
+		group         string
+		resource      apidiscovery.APIResource
+		expected      bool
+	}{
+		{"Should list all resources by default", nil, true, "", nodes, true},
+		{"Should list allowed resource", allowed, true, "", pods, true},
+		{"Should list subresource of allowed resource", allowed, true, "apps", scale, true},
+		{"Should not list resource that is not allowed", allowed, true, "", namespaces, false},
+		{"Should not list resource of other group", allowed, true, "example.com", pods, false},
+		{"Should not list cluster-scoped resource when disabled", nil, false, "", nodes, false},
+		{"Should not list allowed cluster-scoped resource when disabled", allowed, false, "", nodes, false},
+		{"Should list namespaces when cluster-scoped resources are disabled", nil, false, "", namespaces, true},
+	}
+
+	for _, c := range cases {
+		filter := discoveryResourceFilter(c.allowed, c.clusterScoped)
+		if actual := filter(c.group, c.resource); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected %t, but got %t.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func containsKind(kinds []string, kind string) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestAllowedResourcesFilter(t *testing.T) {
+	allowed, _ := ParseAllowedResources([]string{"apps/deployments", "pods", "example.com/foos",
+		"apiextensions.k8s.io/customresourcedefinitions"})
+
+	ws := new(restful.WebService)
+	ws.Filter(allowedResourcesFilter(allowed))
+	ws.Path("/api/v1")
+	routes := []string{"/deployment/{namespace}", "/service/{namespace}", "/log/{namespace}/{pod}", "/namespace",
+		"/namespace/{name}", "/_raw/{kind}/name/{name}", "/scale/{kind}/{namespace}/{name}",
+		"/crd/{namespace}/{crd}/object", "/appdeploymentfromfile", "/settings/global",
+		"/{kind}/{namespace}/{name}/horizontalpodautoscaler"}
+	for _, route := range routes {
+		ws.Route(ws.GET(route).To(func(request *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusOK)
+		}))
+	}
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info           string
+		path           string
+		expectedStatus int
+	}{
+		{"Should allow listed resource", "/api/v1/deployment/default", http.StatusOK},
+		{"Should reject resource outside of the list", "/api/v1/service/default", http.StatusNotFound},
+		{"Should map logs to pods", "/api/v1/log/default/foo", http.StatusOK},
+		{"Should always allow namespace list", "/api/v1/namespace", http.StatusOK},
+		{"Should reject namespace detail", "/api/v1/namespace/default", http.StatusNotFound},
+		{"Should check kind parameter", "/api/v1/_raw/node/name/foo", http.StatusNotFound},
+		{"Should allow kind parameter from the list", "/api/v1/scale/deployment/default/foo", http.StatusOK},
+		{"Should allow listed custom resource", "/api/v1/crd/default/foos.example.com/object", http.StatusOK},
+		{"Should reject other custom resources", "/api/v1/crd/default/bars.example.com/object",
+			http.StatusNotFound},
+		{"Should reject deploy from file", "/api/v1/appdeploymentfromfile", http.StatusNotFound},
+		{"Should allow routes without resources", "/api/v1/settings/global", http.StatusOK},
+		{"Should reject related resources outside of the list",
+			"/api/v1/deployment/default/foo/horizontalpodautoscaler", http.StatusNotFound},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+)
+
+// Returns filter used to serve read-only requests without auth information for resources allowed with
+// --anonymous-resources with credentials of the anonymous service account. Other requests are processed as usual, so
+// they are rejected if they require login.
+func anonymousAccessFilter(allowed map[schema.GroupResource]bool) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if isAnonymousRequest(request, allowed) {
+			client.AllowAnonymousAccess(request)
+		}
+
+		chain.ProcessFilter(request, response)
+	}
+}
+
+// Checks if request does not contain any auth information and only reads resources allowed for anonymous users.
+// Routes that read objects of any kind, i.e. namespace export, are never anonymous.
+func isAnonymousRequest(request *restful.Request, allowed map[schema.GroupResource]bool) bool {
+	if len(client.CredentialsKey(request.Request)) > 0 || request.Request.Method != http.MethodGet ||
+		!isReadOnlyRequest(request.Request.Method, request.SelectedRoutePath()) ||
+		anyKindRoutes[request.SelectedRoutePath()] {
+		return false
+	}
+
+	groupResources := requestGroupResources(request)
+	if len(groupResources) == 0 {
+		return false
+	}
+
+	for _, groupResource := range groupResources {
+		if !allowed[groupResource] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,79 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+)
+
+func TestAnonymousAccessFilter(t *testing.T) {
+	allowed, err := ParseAllowedResources([]string{"pods", "apps/deployments", "namespaces"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ws := new(restful.WebService)
+	ws.Filter(anonymousAccessFilter(allowed))
+	ws.Path("/api/v1")
+	handle := func(request *restful.Request, response *restful.Response) {
+		if anonymous, _ := request.Attribute("anonymousAccess").(bool); anonymous {
+			response.WriteHeader(http.StatusOK)
+			return
+		}
+		response.WriteHeader(http.StatusUnauthorized)
+	}
+	for _, route := range []string{"/pod/{namespace}", "/deployment/{namespace}", "/secret/{namespace}", "/settings",
+		"/namespace/{name}/export"} {
+		ws.Route(ws.GET(route).To(handle))
+	}
+	ws.Route(ws.DELETE("/pod/{namespace}").To(handle))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info      string
+		method    string
+		path      string
+		token     string
+		anonymous bool
+	}{
+		{"Should allow reading allowed resource", http.MethodGet, "/api/v1/pod/default", "", true},
+		{"Should allow reading allowed resource of a group", http.MethodGet, "/api/v1/deployment/default", "", true},
+		{"Should not allow reading other resources", http.MethodGet, "/api/v1/secret/default", "", false},
+		{"Should not allow requests without resources", http.MethodGet, "/api/v1/settings", "", false},
+		{"Should not allow reading resources of any kind", http.MethodGet, "/api/v1/namespace/default/export", "",
+			false},
+		{"Should not allow modifying allowed resource", http.MethodDelete, "/api/v1/pod/default", "", false},
+		{"Should not mark requests with auth information", http.MethodGet, "/api/v1/pod/default", "Bearer token",
+			false},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(c.method, c.path, nil)
+		if len(c.token) > 0 {
+			req.Header.Set("Authorization", c.token)
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, req)
+
+		if anonymous := recorder.Code == http.StatusOK; anonymous != c.anonymous {
+			t.Errorf("Test Case: %s. Expected anonymous access: %t, but got %t.", c.info, c.anonymous, anonymous)
+		}
+	}
+}
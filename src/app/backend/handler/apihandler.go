@@ -15,10 +15,13 @@
 package handler
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -31,15 +34,25 @@ import (
 
 	"github.com/emicklei/go-restful/v3"
 	"golang.org/x/net/xsrftoken"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/remotecommand"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/apidiscovery"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/auth"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/features"
 	"github.com/kubernetes/dashboard/src/app/backend/integration"
+	"github.com/kubernetes/dashboard/src/app/backend/kubecontext"
+	kubecontextapi "github.com/kubernetes/dashboard/src/app/backend/kubecontext/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/clusterrole"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/clusterrolebinding"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
@@ -47,24 +60,29 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/container"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/controller"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/cronjob"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/customcolumn"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/customresourcedefinition"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/daemonset"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/deployment"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/helmrelease"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/horizontalpodautoscaler"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/ingress"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/job"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/logs"
 	ns "github.com/kubernetes/dashboard/src/app/backend/resource/namespace"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/node"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/overviewquery"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolume"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolumeclaim"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/replicaset"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/replicationcontroller"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/resourcequota"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/role"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/rolebinding"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/rollout"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/secret"
 	resourceService "github.com/kubernetes/dashboard/src/app/backend/resource/service"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/serviceaccount"
@@ -83,6 +101,9 @@ const (
 
 	// ResponseLogString is a template for response log message.
 	ResponseLogString = "[%s] Outcoming response to %s with %d status code"
+
+	// PingPath is a path of the endpoint used by liveness check to verify that API requests are still dispatched.
+	PingPath = "/api/ping"
 )
 
 // APIHandler is a representation of API handler. Structure contains clientapi, Heapster clientapi and clientapi configuration.
@@ -101,20 +122,26 @@ type TerminalResponse struct {
 // CreateHTTPAPIHandler creates a new HTTP handler that handles all requests to the API of the backend.
 func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clientapi.ClientManager,
 	authManager authApi.AuthManager, sManager settingsApi.SettingsManager,
-	sbManager systembanner.SystemBannerManager) (http.Handler, error) {
+	sbManager systembanner.SystemBannerManager, kcManager kubecontextapi.ContextManager) (http.Handler, error) {
 	apiHandler := APIHandler{iManager: iManager, cManager: cManager, sManager: sManager}
 	wsContainer := restful.NewContainer()
-	wsContainer.EnableContentEncoding(true)
 
 	apiV1Ws := new(restful.WebService)
 
 	InstallFilters(apiV1Ws, cManager)
+	apiV1Ws.Filter(defaultSortFilter(sManager.CachedDefaultSorts))
 
 	apiV1Ws.Path("/api/v1").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 	wsContainer.Add(apiV1Ws)
 
+	// Ping is served by separate web service, so liveness checks are not logged, audited nor rate limited.
+	pingWs := new(restful.WebService)
+	pingWs.Path(PingPath)
+	pingWs.Route(pingWs.GET("").To(handlePing))
+	wsContainer.Add(pingWs)
+
 	integrationHandler := integration.NewIntegrationHandler(iManager)
 	integrationHandler.Install(apiV1Ws)
 
@@ -122,14 +149,28 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	pluginHandler.Install(apiV1Ws)
 
 	authHandler := auth.NewAuthHandler(authManager)
+	if idleSessions != nil {
+		authHandler.SetSessionGuard(idleSessions)
+	}
 	authHandler.Install(apiV1Ws)
 
-	settingsHandler := settings.NewSettingsHandler(sManager, cManager)
+	settingsHandler := settings.NewSettingsHandler(sManager, cManager, overviewQueryCheck())
 	settingsHandler.Install(apiV1Ws)
 
 	systemBannerHandler := systembanner.NewSystemBannerHandler(sbManager)
 	systemBannerHandler.Install(apiV1Ws)
 
+	contextHandler := kubecontext.NewContextHandler(kcManager)
+	contextHandler.Install(apiV1Ws)
+
+	allowed, _ := ParseAllowedResources(args.Holder.GetAllowedResources())
+	discoveryHandler := apidiscovery.NewDiscoveryHandler(cManager,
+		discoveryResourceFilter(allowed, clusterScopedAllowed()))
+	discoveryHandler.Install(apiV1Ws)
+
+	featureHandler := features.NewFeatureHandler(features.NewCache(), cManager)
+	featureHandler.Install(apiV1Ws)
+
 	apiV1Ws.Route(
 		apiV1Ws.GET("csrftoken/{action}").
 			To(apiHandler.handleGetCsrfToken).
@@ -155,6 +196,11 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 			To(apiHandler.handleProtocolValidity).
 			Reads(validation.ProtocolValiditySpec{}).
 			Writes(validation.ProtocolValidity{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/appdeployment/validate/file").
+			To(apiHandler.handleDeployFromFileValidity).
+			Reads(deployment.AppDeploymentFromFileSpec{}).
+			Writes(deployment.AppDeploymentFromFileValidity{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/appdeployment/protocols").
 			To(apiHandler.handleGetAvailableProtocols).
@@ -244,6 +290,30 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/pod/{namespace}/{pod}/shell/{container}").
 			To(apiHandler.handleExecShell).
 			Writes(TerminalResponse{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/{namespace}/{pod}/portforward/{port}").
+			To(apiHandler.handlePortForward).
+			Writes(PortForwardResponse{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/watch/{kind}").
+			To(apiHandler.handleWatch).
+			Writes(WatchResponse{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/watch/{kind}/namespace/{namespace}").
+			To(apiHandler.handleWatch).
+			Writes(WatchResponse{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/watch/{kind}/name/{name}/event").
+			To(apiHandler.handleWatchResourceEvents).
+			Writes(WatchResponse{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/watch/{kind}/namespace/{namespace}/name/{name}/event").
+			To(apiHandler.handleWatchResourceEvents).
+			Writes(WatchResponse{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/session/warning").
+			To(apiHandler.handleSessionWarning).
+			Writes(SessionWarningResponse{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/pod/{namespace}/{pod}/persistentvolumeclaim").
 			To(apiHandler.handleGetPodPersistentVolumeClaims).
@@ -283,9 +353,8 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 			Reads(deployment.RolloutSpec{}).
 			Writes(deployment.RolloutSpec{}))
 	apiV1Ws.Route(
-		apiV1Ws.PUT("/{kind}/{namespace}/{deployment}/restart").
-			To(apiHandler.handleDeploymentRestart).
-			Writes(deployment.RolloutSpec{}))
+		apiV1Ws.PUT("/{kind}/{namespace}/{name}/restart").
+			To(apiHandler.handleRolloutRestart))
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/{kind}/{namespace}/{deployment}/resume").
 			To(apiHandler.handleDeploymentResume).
@@ -412,6 +481,30 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/namespace/{name}/event").
 			To(apiHandler.handleGetNamespaceEvents).
 			Writes(common.EventList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/namespace/{name}/export").
+			To(apiHandler.handleExportNamespace))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/namespace/{name}/resourcequota").
+			To(apiHandler.handleGetNamespaceResourceQuotaUsage).
+			Writes(resourcequota.ResourceQuotaUsageList{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease").
+			To(apiHandler.handleGetHelmReleaseList).
+			Writes(helmrelease.ReleaseList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease/{namespace}").
+			To(apiHandler.handleGetHelmReleaseList).
+			Writes(helmrelease.ReleaseList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease/{namespace}/{name}").
+			To(apiHandler.handleGetHelmReleaseDetail).
+			Writes(helmrelease.ReleaseDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease/{namespace}/{name}/history").
+			To(apiHandler.handleGetHelmReleaseHistory).
+			Writes(helmrelease.ReleaseHistory{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/secret").
@@ -549,13 +642,31 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/node/{name}/pod").
 			To(apiHandler.handleGetNodePods).
 			Writes(pod.PodList{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/node/{name}/cordon").
+			To(apiHandler.handleNodeCordon))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/node/{name}/uncordon").
+			To(apiHandler.handleNodeUncordon))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/node/{name}/drain").
+			To(apiHandler.handleNodeDrain).
+			Writes(node.DrainProgress{}))
 
+	apiV1Ws.Route(
+		apiV1Ws.POST("/_raw/delete").
+			To(apiHandler.handleBulkDelete).
+			Reads(BulkDeleteSpec{}).
+			Writes(BulkDeleteResult{}))
 	apiV1Ws.Route(
 		apiV1Ws.DELETE("/_raw/{kind}/namespace/{namespace}/name/{name}").
 			To(apiHandler.handleDeleteResource))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/_raw/{kind}/namespace/{namespace}/name/{name}").
 			To(apiHandler.handleGetResource))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/_raw/{kind}/namespace/{namespace}/name/{name}/yaml").
+			To(apiHandler.handleGetResourceManifest))
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/_raw/{kind}/namespace/{namespace}/name/{name}").
 			To(apiHandler.handlePutResource))
@@ -566,9 +677,25 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	apiV1Ws.Route(
 		apiV1Ws.GET("/_raw/{kind}/name/{name}").
 			To(apiHandler.handleGetResource))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/_raw/{kind}/name/{name}/yaml").
+			To(apiHandler.handleGetResourceManifest))
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/_raw/{kind}/name/{name}").
 			To(apiHandler.handlePutResource))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/_raw/{kind}/columns").
+			To(apiHandler.handleGetCustomColumnList).
+			Writes(customcolumn.CustomColumnList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/_raw/{kind}/namespace/{namespace}/columns").
+			To(apiHandler.handleGetCustomColumnList).
+			Writes(customcolumn.CustomColumnList{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/overview/query").
+			To(apiHandler.handleGetOverviewQueryResultList).
+			Writes(overviewquery.OverviewQueryResultList{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/clusterrole").
@@ -699,8 +826,14 @@ func (apiHandler *APIHandler) handleGetClusterRoleList(request *restful.Request,
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := clusterrole.GetClusterRoleList(k8sClient, dataSelect)
+	result, err := clusterrole.GetClusterRoleList(k8sClient, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -731,8 +864,14 @@ func (apiHandler *APIHandler) handleGetClusterRoleBindingList(request *restful.R
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := clusterrolebinding.GetClusterRoleBindingList(k8sClient, dataSelect)
+	result, err := clusterrolebinding.GetClusterRoleBindingList(k8sClient, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -763,9 +902,15 @@ func (apiHandler *APIHandler) handleGetRoleList(request *restful.Request, respon
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := role.GetRoleList(k8sClient, namespace, dataSelect)
+	result, err := role.GetRoleList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -797,9 +942,15 @@ func (apiHandler *APIHandler) handleGetRoleBindingList(request *restful.Request,
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := rolebinding.GetRoleBindingList(k8sClient, namespace, dataSelect)
+	result, err := rolebinding.GetRoleBindingList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -824,6 +975,10 @@ func (apiHandler *APIHandler) handleGetRoleBindingDetail(request *restful.Reques
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func handlePing(request *restful.Request, response *restful.Response) {
+	response.WriteHeader(http.StatusOK)
+}
+
 func (apiHandler *APIHandler) handleGetCsrfToken(request *restful.Request, response *restful.Response) {
 	action := request.PathParameter("action")
 	token := xsrftoken.Generate(apiHandler.cManager.CSRFKey(), "none", action)
@@ -837,11 +992,17 @@ func (apiHandler *APIHandler) handleGetStatefulSetList(request *restful.Request,
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
 	result, err := statefulset.GetStatefulSetList(k8sClient, namespace, dataSelect,
-		apiHandler.iManager.Metric().Client())
+		apiHandler.iManager.Metric().Client(), listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -893,10 +1054,16 @@ func (apiHandler *APIHandler) handleGetStatefulSetEvents(request *restful.Reques
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("statefulset")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -911,9 +1078,15 @@ func (apiHandler *APIHandler) handleGetServiceList(request *restful.Request, res
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := resourceService.GetServiceList(k8sClient, namespace, dataSelect)
+	result, err := resourceService.GetServiceList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -945,11 +1118,17 @@ func (apiHandler *APIHandler) handleGetServiceEvent(request *restful.Request, re
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("service")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := resourceService.GetServiceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := resourceService.GetServiceEvents(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -964,9 +1143,15 @@ func (apiHandler *APIHandler) handleGetServiceAccountList(request *restful.Reque
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := serviceaccount.GetServiceAccountList(k8sClient, namespace, dataSelect)
+	result, err := serviceaccount.GetServiceAccountList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1051,9 +1236,15 @@ func (apiHandler *APIHandler) handleGetIngressList(request *restful.Request, res
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	namespace := parseNamespacePathParameter(request)
-	result, err := ingress.GetIngressList(k8sClient, namespace, dataSelect)
+	result, err := ingress.GetIngressList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1087,9 +1278,15 @@ func (apiHandler *APIHandler) handleGetNetworkPolicyList(request *restful.Reques
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := networkpolicy.GetNetworkPolicyList(k8sClient, namespace, dataSelect)
+	result, err := networkpolicy.GetNetworkPolicyList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1121,9 +1318,15 @@ func (apiHandler *APIHandler) handleGetNodeList(request *restful.Request, respon
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodeList(k8sClient, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := node.GetNodeList(k8sClient, dataSelect, apiHandler.iManager.Metric().Client(), listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1141,7 +1344,8 @@ func (apiHandler *APIHandler) handleGetNodeDetail(request *restful.Request, resp
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodeDetail(k8sClient, apiHandler.iManager.Metric().Client(), name, dataSelect)
+	result, err := node.GetNodeDetail(k8sClient, apiHandler.iManager.Metric().Client(), name,
+		parseNamespacePathParameter(request), dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1156,10 +1360,16 @@ func (apiHandler *APIHandler) handleGetNodeEvents(request *restful.Request, resp
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := event.GetNodeEvents(k8sClient, dataSelect, name)
+	result, err := event.GetNodeEvents(k8sClient, dataSelect, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1177,7 +1387,8 @@ func (apiHandler *APIHandler) handleGetNodePods(request *restful.Request, respon
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodePods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name)
+	result, err := node.GetNodePods(k8sClient, apiHandler.iManager.Metric().Client(), dataSelect, name,
+		parseNamespacePathParameter(request))
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1185,6 +1396,65 @@ func (apiHandler *APIHandler) handleGetNodePods(request *restful.Request, respon
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleNodeCordon(request *restful.Request, response *restful.Response) {
+	apiHandler.setNodeUnschedulable(request, response, true)
+}
+
+func (apiHandler *APIHandler) handleNodeUncordon(request *restful.Request, response *restful.Response) {
+	apiHandler.setNodeUnschedulable(request, response, false)
+}
+
+func (apiHandler *APIHandler) setNodeUnschedulable(request *restful.Request, response *restful.Response,
+	unschedulable bool) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := node.CordonNode(k8sClient, request.PathParameter("name"), unschedulable); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+// Handles drain of the node. Progress is streamed as newline delimited JSON, one DrainProgress object per line,
+// until the drain finishes or exceeds --drain-timeout.
+func (apiHandler *APIHandler) handleNodeDrain(request *restful.Request, response *restful.Response) {
+	cfg, err := apiHandler.cManager.StreamingConfig(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	opts := node.DrainOptions{
+		GracePeriodSeconds: int64(args.Holder.GetDrainGracePeriod()),
+		Timeout:            time.Duration(args.Holder.GetDrainTimeout()) * time.Second,
+		CheckNamespace:     checkNamespaceAllowed,
+	}
+	// Errors are returned only before the first progress is reported, status is already sent after that.
+	flusher, _ := response.ResponseWriter.(http.Flusher)
+	encoder := json.NewEncoder(response)
+	err = node.DrainNode(request.Request.Context(), k8sClient, request.PathParameter("name"), opts,
+		func(progress node.DrainProgress) {
+			response.Header().Set("Content-Type", "application/x-ndjson")
+			encoder.Encode(progress)
+			if flusher != nil {
+				flusher.Flush()
+			}
+		})
+	if err != nil {
+		errors.HandleInternalError(response, err)
+	}
+}
+
 func (apiHandler *APIHandler) handleDeploy(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -1197,6 +1467,10 @@ func (apiHandler *APIHandler) handleDeploy(request *restful.Request, response *r
 		errors.HandleInternalError(response, err)
 		return
 	}
+	if err := checkTargetNamespaceAllowed(appDeploymentSpec.Namespace); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
 	if err := deployment.DeployApp(appDeploymentSpec, k8sClient); err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1215,7 +1489,8 @@ func (apiHandler *APIHandler) handleScaleResource(request *restful.Request, resp
 	kind := request.PathParameter("kind")
 	name := request.PathParameter("name")
 	count := request.QueryParameter("scaleBy")
-	replicaCountSpec, err := scaling.ScaleResource(cfg, kind, namespace, name, count)
+	resourceVersion := request.QueryParameter("resourceVersion")
+	replicaCountSpec, err := scaling.ScaleResource(cfg, kind, namespace, name, count, resourceVersion)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1253,8 +1528,19 @@ func (apiHandler *APIHandler) handleDeployFromFile(request *restful.Request, res
 		errors.HandleInternalError(response, err)
 		return
 	}
+	if err := checkNamespaceAllowed(deploymentSpec.Namespace); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dryRun, err := parseDryRun(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
 
-	isDeployed, err := deployment.DeployAppFromFile(cfg, deploymentSpec)
+	objects, isDeployed, err := deployment.DeployAppFromFile(cfg, deploymentSpec, dryRun, clusterScopedAllowed(),
+		checkNamespaceAllowed)
 	if !isDeployed {
 		errors.HandleInternalError(response, err)
 		return
@@ -1265,11 +1551,18 @@ func (apiHandler *APIHandler) handleDeployFromFile(request *restful.Request, res
 		errorMessage = err.Error()
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, deployment.AppDeploymentFromFileResponse{
+	result := deployment.AppDeploymentFromFileResponse{
 		Name:    deploymentSpec.Name,
 		Content: deploymentSpec.Content,
 		Error:   errorMessage,
-	})
+	}
+	if len(dryRun) > 0 {
+		result.Objects = objects
+		response.WriteHeaderAndEntity(http.StatusOK, result)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
 func (apiHandler *APIHandler) handleDeploymentPause(request *restful.Request, response *restful.Response) {
@@ -1311,21 +1604,24 @@ func (apiHandler *APIHandler) handleDeploymentRollback(request *restful.Request,
 	response.WriteHeaderAndEntity(http.StatusOK, rolloutSpec)
 }
 
-func (apiHandler *APIHandler) handleDeploymentRestart(request *restful.Request, response *restful.Response) {
+// Restarts deployment, stateful set or daemon set with the permissions of the user and returns the updated object,
+// so the new rollout can be shown.
+func (apiHandler *APIHandler) handleRolloutRestart(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	kind := request.PathParameter("kind")
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	rolloutSpec, err := deployment.RestartDeployment(k8sClient, namespace, name)
+	name := request.PathParameter("name")
+	result, err := rollout.RestartWorkload(k8sClient, kind, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusOK, rolloutSpec)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
 func (apiHandler *APIHandler) handleDeploymentResume(request *restful.Request, response *restful.Response) {
@@ -1367,6 +1663,33 @@ func (apiHandler *APIHandler) handleNameValidity(request *restful.Request, respo
 	response.WriteHeaderAndEntity(http.StatusOK, validity)
 }
 
+func (apiHandler *APIHandler) handleDeployFromFileValidity(request *restful.Request, response *restful.Response) {
+	cfg, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(deployment.AppDeploymentFromFileSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := checkNamespaceAllowed(spec.Namespace); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	validity, err := deployment.ValidateAppFromFile(cfg, spec, clusterScopedAllowed(), checkNamespaceAllowed)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, validity)
+}
+
 func (apiHandler *APIHandler) handleImageReferenceValidity(request *restful.Request, response *restful.Response) {
 	spec := new(validation.ImageReferenceValiditySpec)
 	if err := request.ReadEntity(spec); err != nil {
@@ -1402,10 +1725,16 @@ func (apiHandler *APIHandler) handleGetReplicationControllerList(request *restfu
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicationcontroller.GetReplicationControllerList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := replicationcontroller.GetReplicationControllerList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client(), listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1420,10 +1749,16 @@ func (apiHandler *APIHandler) handleGetReplicaSets(request *restful.Request, res
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicaset.GetReplicaSetList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := replicaset.GetReplicaSetList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client(), listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1497,11 +1832,17 @@ func (apiHandler *APIHandler) handleGetReplicaSetEvents(request *restful.Request
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("replicaSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1517,12 +1858,18 @@ func (apiHandler *APIHandler) handleGetPodEvents(request *restful.Request, respo
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	log.Println("Getting events related to a pod in namespace")
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("pod")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := pod.GetEventsForPod(k8sClient, dataSelect, namespace, name)
+	result, err := pod.GetEventsForPod(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1532,19 +1879,25 @@ func (apiHandler *APIHandler) handleGetPodEvents(request *restful.Request, respo
 
 // Handles execute shell API call
 func (apiHandler *APIHandler) handleExecShell(request *restful.Request, response *restful.Response) {
+	options, err := parseExecOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, errors.NewBadRequest(err.Error()))
+		return
+	}
+
 	sessionID, err := genTerminalSessionId()
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	k8sClient, err := apiHandler.cManager.Client(request)
+	cfg, err := apiHandler.cManager.StreamingConfig(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	cfg, err := apiHandler.cManager.Config(request)
+	k8sClient, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1553,115 +1906,328 @@ func (apiHandler *APIHandler) handleExecShell(request *restful.Request, response
 	terminalSessions.Set(sessionID, TerminalSession{
 		id:       sessionID,
 		bound:    make(chan error),
-		sizeChan: make(chan remotecommand.TerminalSize),
+		sizeChan: make(chan remotecommand.TerminalSize, 1),
 	})
-	go WaitForTerminal(k8sClient, cfg, request, sessionID)
+	go WaitForTerminal(k8sClient, cfg, request, sessionID, options)
 	response.WriteHeaderAndEntity(http.StatusOK, TerminalResponse{ID: sessionID})
 }
 
-func (apiHandler *APIHandler) handleGetDeployments(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
-	if err != nil {
-		errors.HandleInternalError(response, err)
+// Handles port-forward API call. Connection to the pod is opened right away, so that errors can be returned to the
+// user, and connections to the port are forwarded once the SockJS connection is bound to the returned session id.
+func (apiHandler *APIHandler) handlePortForward(request *restful.Request, response *restful.Response) {
+	if !args.Holder.GetEnablePortForward() {
+		errors.HandleInternalError(response, errors.NewNotFound("Port forwarding is disabled"))
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
-	if err != nil {
-		errors.HandleInternalError(response, err)
+	port, err := strconv.Atoi(request.PathParameter("port"))
+	if err != nil || port < 1 || port > 65535 {
+		errors.HandleInternalError(response, errors.NewBadRequest(fmt.Sprintf("Invalid port %s",
+			request.PathParameter("port"))))
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (apiHandler *APIHandler) handleGetDeploymentDetail(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
+	sessionID, err := genTerminalSessionId()
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	result, err := deployment.GetDeploymentDetail(k8sClient, namespace, name)
+	cfg, err := apiHandler.cManager.StreamingConfig(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusOK, result)
-}
-
-func (apiHandler *APIHandler) handleGetDeploymentEvents(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
+	k8sClient, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	conn, err := dialPortForward(k8sClient, cfg, request.PathParameter("namespace"), request.PathParameter("pod"))
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusOK, result)
+
+	portForwardSessions.add(sessionID, newPortForwardSession(port, conn))
+	go WaitForPortForward(sessionID, time.Duration(args.Holder.GetPortForwardIdleTimeout())*time.Second)
+	response.WriteHeaderAndEntity(http.StatusOK, PortForwardResponse{ID: sessionID})
 }
 
-func (apiHandler *APIHandler) handleGetDeploymentOldReplicaSets(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
-	if err != nil {
-		errors.HandleInternalError(response, err)
+// Handles watch API call. Watch is opened right away, so that errors can be returned to the user, and its events are
+// streamed once the SockJS connection is bound to the returned session id.
+func (apiHandler *APIHandler) handleWatch(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	if len(namespace) == 0 && len(args.Holder.GetNamespaceAllowlist()) > 0 {
+		errors.HandleInternalError(response, errors.NewForbidden("Watching all namespaces is not allowed"))
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentOldReplicaSets(k8sClient, dataSelect, namespace, name)
+	sessionID, err := genTerminalSessionId()
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (apiHandler *APIHandler) handleGetDeploymentNewReplicaSet(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
+	config, err := apiHandler.cManager.StreamingConfig(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentNewReplicaSet(k8sClient, dataSelect, namespace, name)
+	verber, err := apiHandler.cManager.VerberClient(request, config)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (apiHandler *APIHandler) handleGetPods(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
+	stream, err := verber.Watch(request.PathParameter("kind"), namespace, request.QueryParameter("resourceVersion"))
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
+	session := newWatchSession(sessionID, userKey(request.Request), stream)
+	if err := watchSessions.Add(session, args.Holder.GetMaxWatchesPerSession()); err != nil {
+		stream.Close()
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	go WaitForWatch(sessionID)
+	response.WriteHeaderAndEntity(http.StatusOK, WatchResponse{ID: sessionID})
+}
+
+// Handles watch of events of a single resource. Most recent events, limited by --event-history-limit, are replayed
+// before the live ones. Events are streamed over the SockJS connection bound to the returned session id, the same way
+// as for handleWatch. Events of cluster-scoped resources are watched in all namespaces.
+func (apiHandler *APIHandler) handleWatchResourceEvents(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	if len(namespace) == 0 && len(args.Holder.GetNamespaceAllowlist()) > 0 {
+		errors.HandleInternalError(response, errors.NewForbidden("Watching all namespaces is not allowed"))
+		return
+	}
+
+	sessionID, err := genTerminalSessionId()
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	config, err := apiHandler.cManager.StreamingConfig(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	object, err := verber.Get(request.PathParameter("kind"), len(namespace) > 0, namespace,
+		request.PathParameter("name"))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	stream, err := event.WatchResourceEvents(k8sClient, namespace, accessor.GetUID(),
+		args.Holder.GetEventHistoryLimit())
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	session := newWatchSession(sessionID, userKey(request.Request), stream)
+	if err := watchSessions.Add(session, args.Holder.GetMaxWatchesPerSession()); err != nil {
+		stream.Close()
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	go WaitForWatch(sessionID)
+	response.WriteHeaderAndEntity(http.StatusOK, WatchResponse{ID: sessionID})
+}
+
+// Handles session warning API call. Returned session id binds the SockJS connection that pushes warnings about the
+// expiration of the session, which is identified by credentials sent with this request.
+func (apiHandler *APIHandler) handleSessionWarning(request *restful.Request, response *restful.Response) {
+	leadTime := time.Duration(args.Holder.GetSessionWarningLeadTime()) * time.Second
+	if leadTime == 0 {
+		errors.HandleInternalError(response, errors.NewNotFound("Session warnings are disabled"))
+		return
+	}
+
+	key := client.CredentialsKey(request.Request)
+	if len(key) == 0 {
+		errors.HandleInternalError(response, errors.NewBadRequest("Session warnings require authentication"))
+		return
+	}
+
+	// Creating the client validates the token, so that its expiration time can be trusted.
+	if _, err := apiHandler.cManager.Client(request); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	var tokenExpiry time.Time
+	if token := request.HeaderParameter(client.JWETokenHeader); len(token) > 0 {
+		expiry, err := jwe.TokenExpiry(token)
+		if err != nil {
+			errors.HandleInternalError(response, err)
+			return
+		}
+		tokenExpiry = expiry
+	}
+
+	sessionID, err := genTerminalSessionId()
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	sessionWarningChannels.add(sessionID, newSessionWarningChannel(key, tokenExpiry))
+	go WaitForSessionWarnings(sessionID, leadTime)
+	response.WriteHeaderAndEntity(http.StatusOK, SessionWarningResponse{ID: sessionID})
+}
+
+func (apiHandler *APIHandler) handleGetDeployments(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := deployment.GetDeploymentList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client(), listOptions)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetDeploymentDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	result, err := deployment.GetDeploymentDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetDeploymentEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name, listOptions)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetDeploymentOldReplicaSets(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := deployment.GetDeploymentOldReplicaSets(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetDeploymentNewReplicaSet(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := deployment.GetDeploymentNewReplicaSet(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetPods(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	listOptions, err := parser.ParseListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics // download standard metrics - cpu, and memory - by default
-	result, err := pod.GetPodList(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
+	result, err := pod.GetPodList(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1703,30 +2269,234 @@ func (apiHandler *APIHandler) handleGetReplicationControllerDetail(request *rest
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleUpdateReplicasCount(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
+func (apiHandler *APIHandler) handleUpdateReplicasCount(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("replicationController")
+	spec := new(replicationcontroller.ReplicationControllerSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := replicationcontroller.UpdateReplicasCount(k8sClient, namespace, name, spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (apiHandler *APIHandler) handleGetResource(request *restful.Request, response *restful.Response) {
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := verber.Get(kind, ok, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetCustomColumnList(request *restful.Request, response *restful.Response) {
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	columns := apiHandler.sManager.GetCustomColumns(apiHandler.cManager.InsecureClient())[kind]
+	if len(columns) == 0 {
+		errors.HandleInternalError(response, errors.NewNotFound(fmt.Sprintf("No custom columns are configured for %s", kind)))
+		return
+	}
+
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := customcolumn.GetCustomColumnList(verber, kind, parseNamespacePathParameter(request), columns,
+		dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetOverviewQueryResultList(request *restful.Request, response *restful.Response) {
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	overview := apiHandler.sManager.GetOverview(apiHandler.cManager.InsecureClient())
+	result := overviewquery.GetOverviewQueryResultList(verber, overview, parseNamespacePathParameter(request),
+		overviewQueryCheck())
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Exports all resources in the namespace as a multi-document YAML or, if 'format=tar' query parameter or
+// 'application/x-tar' Accept header is set, as a tar archive with a file per kind.
+func (apiHandler *APIHandler) handleExportNamespace(request *restful.Request, response *restful.Response) {
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	crdClient, err := apiHandler.cManager.APIExtensionsClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("name")
+	export := exportNamespace(verber, namespace, request.QueryParameter("includeSecrets") == "true")
+	export.addCustomResources(crdClient, dynamicClient)
+	for _, warning := range export.warnings {
+		response.AddHeader("Warning", fmt.Sprintf("299 - %q", warning))
+	}
+
+	if request.QueryParameter("format") == "tar" || strings.Contains(request.HeaderParameter("Accept"), "application/x-tar") {
+		archive, err := export.toTar()
+		if err != nil {
+			errors.HandleInternalError(response, err)
+			return
+		}
+
+		response.AddHeader(restful.HEADER_ContentType, "application/x-tar")
+		response.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", namespace+".tar"))
+		response.WriteHeader(http.StatusOK)
+		_, _ = response.Write(archive)
+		return
+	}
+
+	handleManifestDownload(response, namespace, export.toYAML())
+}
+
+func (apiHandler *APIHandler) handleGetResourceManifest(request *restful.Request, response *restful.Response) {
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := verber.Get(kind, ok, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	manifest, err := toCleanManifest(result.(*runtime.Unknown).Raw, request.QueryParameter("keepStatus") == "true")
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	handleManifestDownload(response, name, manifest)
+}
+
+func (apiHandler *APIHandler) handlePutResource(
+	request *restful.Request, response *restful.Response) {
+	config, err := apiHandler.cManager.Config(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	verber, err := apiHandler.cManager.VerberClient(request, config)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	putSpec := &runtime.Unknown{}
+	if err := request.ReadEntity(putSpec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dryRun, err := parseDryRun(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("replicationController")
-	spec := new(replicationcontroller.ReplicationControllerSpec)
-	if err := request.ReadEntity(spec); err != nil {
+	result, err := verber.Put(kind, ok, namespace, name, putSpec, dryRun)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	if err := replicationcontroller.UpdateReplicasCount(k8sClient, namespace, name, spec); err != nil {
-		errors.HandleInternalError(response, err)
+	// Object that would be stored is returned, so the changes made by admission can be reviewed.
+	if len(dryRun) > 0 {
+		response.AddHeader(restful.HEADER_ContentType, restful.MIME_JSON)
+		response.WriteHeader(http.StatusOK)
+		_, _ = response.Write(result.Raw)
 		return
 	}
 
-	response.WriteHeader(http.StatusAccepted)
+	response.WriteHeader(http.StatusCreated)
 }
 
-func (apiHandler *APIHandler) handleGetResource(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleDeleteResource(
+	request *restful.Request, response *restful.Response) {
 	config, err := apiHandler.cManager.Config(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1739,51 +2509,51 @@ func (apiHandler *APIHandler) handleGetResource(request *restful.Request, respon
 		return
 	}
 
-	kind := request.PathParameter("kind")
-	namespace, ok := request.PathParameters()["namespace"]
-	name := request.PathParameter("name")
-	result, err := verber.Get(kind, ok, namespace, name)
+	propagationPolicy, err := parsePropagationPolicy(request.QueryParameter("propagationPolicy"))
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusOK, result)
-}
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
 
-func (apiHandler *APIHandler) handlePutResource(
-	request *restful.Request, response *restful.Response) {
-	config, err := apiHandler.cManager.Config(request)
-	if err != nil {
+	if err := verber.Delete(kind, ok, namespace, name, propagationPolicy); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	verber, err := apiHandler.cManager.VerberClient(request, config)
+	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
+	apiHandler.unpinResource(k8sClient, kind, namespace, name)
 
-	kind := request.PathParameter("kind")
-	namespace, ok := request.PathParameters()["namespace"]
-	name := request.PathParameter("name")
-	putSpec := &runtime.Unknown{}
-	if err := request.ReadEntity(putSpec); err != nil {
+	response.WriteHeader(http.StatusOK)
+}
+
+// Handles deletion of multiple resources. Resources are deleted one by one with credentials of the user and result
+// of every deletion is returned, so that a single failure does not fail the whole request.
+func (apiHandler *APIHandler) handleBulkDelete(request *restful.Request, response *restful.Response) {
+	spec := new(BulkDeleteSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	if err := verber.Put(kind, ok, namespace, name, putSpec); err != nil {
-		errors.HandleInternalError(response, err)
+	if len(spec.Items) == 0 {
+		errors.HandleInternalError(response, errors.NewBadRequest("At least one resource has to be deleted"))
 		return
 	}
 
-	response.WriteHeader(http.StatusCreated)
-}
+	propagationPolicy, err := parsePropagationPolicy(request.QueryParameter("propagationPolicy"))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
 
-func (apiHandler *APIHandler) handleDeleteResource(
-	request *restful.Request, response *restful.Response) {
 	config, err := apiHandler.cManager.Config(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1796,34 +2566,36 @@ func (apiHandler *APIHandler) handleDeleteResource(
 		return
 	}
 
-	kind := request.PathParameter("kind")
-	namespace, ok := request.PathParameters()["namespace"]
-	name := request.PathParameter("name")
-
-	if err := verber.Delete(kind, ok, namespace, name); err != nil {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	// Try to unpin resource if it was pinned.
+	allowed, _ := ParseAllowedResources(args.Holder.GetAllowedResources())
+	result := BulkDeleteResult{Items: bulkDelete(verber, spec.Items, propagationPolicy, allowed)}
+	for _, item := range result.Items {
+		if item.Success {
+			apiHandler.unpinResource(k8sClient, item.Kind, item.Namespace, item.Name)
+		}
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Tries to unpin deleted resource if it was pinned.
+func (apiHandler *APIHandler) unpinResource(k8sClient kubernetes.Interface, kind, namespace, name string) {
 	pinnedResource := &settingsApi.PinnedResource{
 		Name:      name,
 		Kind:      kind,
 		Namespace: namespace,
 	}
 
-	k8sClient, err := apiHandler.cManager.Client(request)
-	if err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
-	if err = apiHandler.sManager.DeletePinnedResource(k8sClient, pinnedResource); err != nil {
+	if err := apiHandler.sManager.DeletePinnedResource(k8sClient, pinnedResource); err != nil {
 		if !errors.IsNotFoundError(err) {
 			log.Printf("error while unpinning resource: %s", err.Error())
 		}
 	}
-
-	response.WriteHeader(http.StatusOK)
 }
 
 func (apiHandler *APIHandler) handleGetReplicationControllerPods(request *restful.Request, response *restful.Response) {
@@ -1857,6 +2629,10 @@ func (apiHandler *APIHandler) handleCreateNamespace(request *restful.Request, re
 		errors.HandleInternalError(response, err)
 		return
 	}
+	if err := checkNamespaceAllowed(namespaceSpec.Name); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
 	if err := ns.CreateNamespace(namespaceSpec, k8sClient); err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1871,8 +2647,15 @@ func (apiHandler *APIHandler) handleGetNamespaces(request *restful.Request, resp
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := ns.GetNamespaceList(k8sClient, dataSelect)
+	result, err := ns.GetNamespaceList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1903,9 +2686,31 @@ func (apiHandler *APIHandler) handleGetNamespaceEvents(request *restful.Request,
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name)
+	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name, listOptions)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetNamespaceResourceQuotaUsage(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	result, err := resourcequota.GetResourceQuotaUsage(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1925,6 +2730,10 @@ func (apiHandler *APIHandler) handleCreateImagePullSecret(request *restful.Reque
 		errors.HandleInternalError(response, err)
 		return
 	}
+	if err := checkNamespaceAllowed(spec.Namespace); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
 	result, err := secret.CreateSecret(k8sClient, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1933,6 +2742,72 @@ func (apiHandler *APIHandler) handleCreateImagePullSecret(request *restful.Reque
 	response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
+func (apiHandler *APIHandler) handleGetHelmReleaseList(request *restful.Request, response *restful.Response) {
+	if !args.Holder.GetEnableHelm() {
+		errors.HandleInternalError(response, errors.NewNotFound("Helm releases are disabled"))
+		return
+	}
+
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := helmrelease.GetReleaseList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetHelmReleaseDetail(request *restful.Request, response *restful.Response) {
+	if !args.Holder.GetEnableHelm() {
+		errors.HandleInternalError(response, errors.NewNotFound("Helm releases are disabled"))
+		return
+	}
+
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := helmrelease.GetReleaseDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetHelmReleaseHistory(request *restful.Request, response *restful.Response) {
+	if !args.Holder.GetEnableHelm() {
+		errors.HandleInternalError(response, errors.NewNotFound("Helm releases are disabled"))
+		return
+	}
+
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := helmrelease.GetReleaseHistory(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleGetSecretDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -1957,9 +2832,15 @@ func (apiHandler *APIHandler) handleGetSecretList(request *restful.Request, resp
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	namespace := parseNamespacePathParameter(request)
-	result, err := secret.GetSecretList(k8sClient, namespace, dataSelect)
+	result, err := secret.GetSecretList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1974,9 +2855,15 @@ func (apiHandler *APIHandler) handleGetConfigMapList(request *restful.Request, r
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := configmap.GetConfigMapList(k8sClient, namespace, dataSelect)
+	result, err := configmap.GetConfigMapList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2008,8 +2895,14 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeList(request *restful.Req
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolume.GetPersistentVolumeList(k8sClient, dataSelect)
+	result, err := persistentvolume.GetPersistentVolumeList(k8sClient, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2040,9 +2933,15 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeClaimList(request *restfu
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(k8sClient, namespace, dataSelect)
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2091,10 +2990,16 @@ func (apiHandler *APIHandler) handleGetReplicationControllerEvents(request *rest
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("replicationController")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2128,10 +3033,16 @@ func (apiHandler *APIHandler) handleGetDaemonSetList(request *restful.Request, r
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := daemonset.GetDaemonSetList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := daemonset.GetDaemonSetList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client(), listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2201,10 +3112,16 @@ func (apiHandler *APIHandler) handleGetDaemonSetEvents(request *restful.Request,
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("daemonSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2220,9 +3137,15 @@ func (apiHandler *APIHandler) handleGetHorizontalPodAutoscalerList(request *rest
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerList(k8sClient, namespace, dataSelect)
+	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerList(k8sClient, namespace, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2273,10 +3196,16 @@ func (apiHandler *APIHandler) handleGetJobList(request *restful.Request, respons
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := job.GetJobList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := job.GetJobList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client(), listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2327,10 +3256,16 @@ func (apiHandler *APIHandler) handleGetJobEvents(request *restful.Request, respo
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := job.GetJobEvents(k8sClient, dataSelect, namespace, name)
+	result, err := job.GetJobEvents(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2345,10 +3280,16 @@ func (apiHandler *APIHandler) handleGetCronJobList(request *restful.Request, res
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := cronjob.GetCronJobList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client())
+	result, err := cronjob.GetCronJobList(k8sClient, namespace, dataSelect, apiHandler.iManager.Metric().Client(), listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2403,10 +3344,16 @@ func (apiHandler *APIHandler) handleGetCronJobEvents(request *restful.Request, r
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := cronjob.GetCronJobEvents(k8sClient, dataSelect, namespace, name)
+	result, err := cronjob.GetCronJobEvents(k8sClient, dataSelect, namespace, name, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2438,8 +3385,14 @@ func (apiHandler *APIHandler) handleGetStorageClassList(request *restful.Request
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := storageclass.GetStorageClassList(k8sClient, dataSelect)
+	result, err := storageclass.GetStorageClassList(k8sClient, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2509,8 +3462,14 @@ func (apiHandler *APIHandler) handleGetCustomResourceDefinitionList(request *res
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := customresourcedefinition.GetCustomResourceDefinitionList(apiextensionsclient, dataSelect)
+	result, err := customresourcedefinition.GetCustomResourceDefinitionList(apiextensionsclient, dataSelect, listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2555,10 +3514,17 @@ func (apiHandler *APIHandler) handleGetCustomResourceObjectList(request *restful
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	crdName := request.PathParameter("crd")
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := customresourcedefinition.GetCustomResourceObjectList(apiextensionsclient, config, namespace, dataSelect, crdName)
+	result, err := customresourcedefinition.GetCustomResourceObjectList(apiextensionsclient, config, namespace, dataSelect, crdName,
+		listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2601,11 +3567,18 @@ func (apiHandler *APIHandler) handleGetCustomResourceObjectEvents(request *restf
 		return
 	}
 
+	listOptions, err := parser.ParseSelectorListOptions(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	name := request.PathParameter("object")
 	namespace := request.PathParameter("namespace")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := customresourcedefinition.GetEventsForCustomResourceObject(k8sClient, dataSelect, namespace, name)
+	result, err := customresourcedefinition.GetEventsForCustomResourceObject(k8sClient, dataSelect, namespace, name,
+		listOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2656,6 +3629,10 @@ func (apiHandler *APIHandler) handleLogs(request *restful.Request, response *res
 	offsetFrom, err1 := strconv.Atoi(request.QueryParameter("offsetFrom"))
 	offsetTo, err2 := strconv.Atoi(request.QueryParameter("offsetTo"))
 	logFilePosition := request.QueryParameter("logFilePosition")
+	tailLines, err := strconv.Atoi(request.QueryParameter("tailLines"))
+	if err != nil {
+		tailLines = 0
+	}
 
 	logSelector := logs.DefaultSelection
 	if err1 == nil && err2 == nil {
@@ -2667,6 +3644,7 @@ func (apiHandler *APIHandler) handleLogs(request *restful.Request, response *res
 			OffsetFrom:      offsetFrom,
 			OffsetTo:        offsetTo,
 			LogFilePosition: logFilePosition,
+			TailLines:       tailLines,
 		}
 	}
 
@@ -2679,7 +3657,13 @@ func (apiHandler *APIHandler) handleLogs(request *restful.Request, response *res
 }
 
 func (apiHandler *APIHandler) handleLogFile(request *restful.Request, response *restful.Response) {
-	k8sClient, err := apiHandler.cManager.Client(request)
+	cfg, err := apiHandler.cManager.StreamingConfig(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(cfg)
 	opts := new(v1.PodLogOptions)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2692,14 +3676,40 @@ func (apiHandler *APIHandler) handleLogFile(request *restful.Request, response *
 	opts.Previous = request.QueryParameter("previous") == "true"
 	opts.Timestamps = request.QueryParameter("timestamps") == "true"
 
+	location, err := parseTimezoneQueryParameter(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	logStream, err := container.GetLogFile(k8sClient, namespace, podID, containerID, opts)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
+
+	if opts.Timestamps {
+		logStream = container.ConvertLogTimestamps(logStream, location)
+	}
 	handleDownload(response, logStream)
 }
 
+// parseTimezoneQueryParameter returns location of the timezone from the 'timezone' query parameter, so that users can
+// override the --default-timezone in their browser.
+func parseTimezoneQueryParameter(request *restful.Request) (*time.Location, error) {
+	timezone := request.QueryParameter("timezone")
+	if len(timezone) == 0 {
+		timezone = args.Holder.GetDefaultTimezone()
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid timezone '%s': %s", timezone, err))
+	}
+
+	return location, nil
+}
+
 // parseNamespacePathParameter parses namespace selector for list pages in path parameter.
 // The namespace selector is a comma separated list of namespaces that are trimmed.
 // No namespaces means "view all user namespaces", i.e., everything except kube-system.
@@ -2713,5 +3723,10 @@ func parseNamespacePathParameter(request *restful.Request) *common.NamespaceQuer
 			nonEmptyNamespaces = append(nonEmptyNamespaces, n)
 		}
 	}
+
+	// Query for all namespaces is limited to the allowed ones
+	if len(nonEmptyNamespaces) == 0 {
+		nonEmptyNamespaces = args.Holder.GetNamespaceAllowlist()
+	}
 	return common.NewNamespaceQuery(nonEmptyNamespaces)
 }
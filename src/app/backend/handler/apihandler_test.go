@@ -29,6 +29,7 @@ import (
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
 	"github.com/kubernetes/dashboard/src/app/backend/client"
+	"github.com/kubernetes/dashboard/src/app/backend/kubecontext"
 	"github.com/kubernetes/dashboard/src/app/backend/settings"
 	"github.com/kubernetes/dashboard/src/app/backend/sync"
 	"github.com/kubernetes/dashboard/src/app/backend/systembanner"
@@ -44,10 +45,11 @@ func getTokenManager() authApi.TokenManager {
 
 func TestCreateHTTPAPIHandler(t *testing.T) {
 	cManager := client.NewClientManager("", "http://localhost:8080")
-	authManager := auth.NewAuthManager(cManager, getTokenManager(), authApi.AuthenticationModes{}, true)
+	authManager := auth.NewAuthManager(cManager, getTokenManager(), authApi.AuthenticationModes{}, true, nil, nil, nil)
 	sManager := settings.NewSettingsManager()
 	sbManager := systembanner.NewSystemBannerManager("Hello world!", "INFO")
-	_, err := CreateHTTPAPIHandler(nil, cManager, authManager, sManager, sbManager)
+	kcManager := kubecontext.NewContextManager("", "")
+	_, err := CreateHTTPAPIHandler(nil, cManager, authManager, sManager, sbManager, kcManager)
 	if err != nil {
 		t.Fatal("CreateHTTPAPIHandler() cannot create HTTP API handler")
 	}
@@ -83,6 +85,27 @@ func TestShouldDoCsrfValidation(t *testing.T) {
 	}
 }
 
+func TestIsReadOnlyRequest(t *testing.T) {
+	cases := []struct {
+		method, route string
+		expected      bool
+	}{
+		{http.MethodGet, "/api/v1/pod/{namespace}", true},
+		{http.MethodPut, "/api/v1/scale/{kind}/{namespace}/{name}/", false},
+		{http.MethodDelete, "/api/v1/_raw/{kind}/namespace/{namespace}/name/{name}", false},
+		{http.MethodPost, "/api/v1/appdeployment", false},
+		{http.MethodPost, "/api/v1/login", true},
+		{http.MethodPost, "/api/v1/appdeployment/validate/name", true},
+		{http.MethodGet, "/api/v1/pod/{namespace}/{pod}/shell/{container}", false},
+	}
+	for _, c := range cases {
+		actual := isReadOnlyRequest(c.method, c.route)
+		if actual != c.expected {
+			t.Errorf("isReadOnlyRequest(%s, %s) returns %t, expected %t", c.method, c.route, actual, c.expected)
+		}
+	}
+}
+
 func TestMapUrlToResource(t *testing.T) {
 	cases := []struct {
 		url, expected string
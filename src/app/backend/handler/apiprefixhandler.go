@@ -0,0 +1,101 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultAPIPrefix is the prefix that routes of the REST API are registered under.
+const DefaultAPIPrefix = "/api/v1"
+
+// ValidateAPIPrefixes checks prefixes passed with --api-prefix. Every prefix has to start with '/', can not be the root
+// path and can not be passed twice.
+func ValidateAPIPrefixes(prefixes []string) error {
+	if len(prefixes) == 0 {
+		return fmt.Errorf("--api-prefix has to be set at least once")
+	}
+
+	seen := map[string]bool{}
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if !strings.HasPrefix(prefix, "/") {
+			return fmt.Errorf("--api-prefix %q has to start with '/'", prefix)
+		}
+
+		if seen[prefix] {
+			return fmt.Errorf("--api-prefix %q is set more than once", prefix)
+		}
+		seen[prefix] = true
+	}
+
+	return nil
+}
+
+// MakeAPIPrefixHandler maps requests under any of given prefixes to DefaultAPIPrefix before passing them to the
+// handler, so that the REST API can be served under multiple prefixes at the same time. Requests under
+// DefaultAPIPrefix are rejected if it is not one of the prefixes. Other requests are passed unchanged. If the only
+// prefix is DefaultAPIPrefix, handler is returned unchanged.
+func MakeAPIPrefixHandler(handler http.Handler, prefixes []string) http.Handler {
+	trimmed := make([]string, 0, len(prefixes))
+	servesDefault := false
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		trimmed = append(trimmed, prefix)
+		servesDefault = servesDefault || prefix == DefaultAPIPrefix
+	}
+
+	if servesDefault && len(trimmed) == 1 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, prefix := range trimmed {
+			if r.URL.Path == prefix || strings.HasPrefix(r.URL.Path, prefix+"/") {
+				handler.ServeHTTP(w, withAPIPrefix(r, prefix))
+				return
+			}
+		}
+
+		if !servesDefault && (r.URL.Path == DefaultAPIPrefix || strings.HasPrefix(r.URL.Path, DefaultAPIPrefix+"/")) {
+			http.NotFound(w, r)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Returns copy of the request with given prefix replaced by DefaultAPIPrefix.
+func withAPIPrefix(r *http.Request, prefix string) *http.Request {
+	if prefix == DefaultAPIPrefix {
+		return r
+	}
+
+	r2 := new(http.Request)
+	*r2 = *r
+	r2.URL = new(url.URL)
+	*r2.URL = *r.URL
+	r2.URL.Path = DefaultAPIPrefix + strings.TrimPrefix(r.URL.Path, prefix)
+	r2.URL.RawPath = ""
+	if strings.HasPrefix(r.URL.RawPath, prefix) {
+		r2.URL.RawPath = DefaultAPIPrefix + strings.TrimPrefix(r.URL.RawPath, prefix)
+	}
+
+	return r2
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMakeAPIPrefixHandler(t *testing.T) {
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	})
+
+	cases := []struct {
+		info           string
+		prefixes       []string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"Should not change requests with default prefix", []string{"/api/v1"}, "/api/v1/pod", http.StatusOK,
+			"/api/v1/pod"},
+		{"Should map new prefix to the default one", []string{"/api/v2"}, "/api/v2/pod/default", http.StatusOK,
+			"/api/v1/pod/default"},
+		{"Should serve both prefixes during migration", []string{"/api/v2", "/api/v1"}, "/api/v1/pod",
+			http.StatusOK, "/api/v1/pod"},
+		{"Should serve new prefix during migration", []string{"/api/v2", "/api/v1"}, "/api/v2/pod", http.StatusOK,
+			"/api/v1/pod"},
+		{"Should ignore trailing slash of prefix", []string{"/api/v2/"}, "/api/v2/pod", http.StatusOK, "/api/v1/pod"},
+		{"Should reject default prefix if it is not served", []string{"/api/v2"}, "/api/v1/pod",
+			http.StatusNotFound, ""},
+		{"Should not change other requests", []string{"/api/v2"}, "/api/sockjs/info", http.StatusOK,
+			"/api/sockjs/info"},
+		{"Should not map prefix used as a name prefix", []string{"/api/v2"}, "/api/v20/pod", http.StatusOK,
+			"/api/v20/pod"},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		MakeAPIPrefixHandler(echo, c.prefixes).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+
+		if c.expectedStatus == http.StatusOK && recorder.Body.String() != c.expectedBody {
+			t.Errorf("Test Case: %s. Expected path %s, but got %s.", c.info, c.expectedBody, recorder.Body.String())
+		}
+	}
+}
+
+func TestValidateAPIPrefixes(t *testing.T) {
+	cases := []struct {
+		info        string
+		prefixes    []string
+		expectedErr bool
+	}{
+		{"Should accept default prefix", []string{"/api/v1"}, false},
+		{"Should accept multiple prefixes", []string{"/api/v2", "/api/v1"}, false},
+		{"Should reject empty list", []string{}, true},
+		{"Should reject relative prefix", []string{"api/v2"}, true},
+		{"Should reject root prefix", []string{"/"}, true},
+		{"Should reject duplicated prefix", []string{"/api/v2", "/api/v2/"}, true},
+	}
+
+	for _, c := range cases {
+		if err := ValidateAPIPrefixes(c.prefixes); (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+}
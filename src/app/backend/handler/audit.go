@@ -0,0 +1,115 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
+)
+
+// Audit verbs of the HTTP methods that modify resources.
+var auditVerbs = map[string]string{
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// Audit verbs of the routes that do not match their HTTP method.
+var auditRouteVerbs = map[string]string{
+	"/api/v1/_raw/delete": "delete",
+}
+
+// Route segments that do not name the resource kind. Kind is taken from the path parameter for them.
+var auditGenericSegments = map[string]bool{
+	"_raw":  true,
+	"scale": true,
+}
+
+// Filter used to write audit event for every request that could modify resources, see isReadOnlyRequest. Event is
+// written after the request is processed, so it contains response status.
+func auditFilter(manager clientapi.ClientManager) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if isReadOnlyRequest(request.Request.Method, request.SelectedRoutePath()) {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		event := newAuditEvent(request, time.Now())
+		event.User = manager.Identity(request)
+		chain.ProcessFilter(request, response)
+
+		event.Status = response.StatusCode()
+		audit.Log(event)
+	}
+}
+
+// Creates audit event describing given request. User and response status are not set.
+func newAuditEvent(request *restful.Request, now time.Time) audit.Event {
+	verb, ok := auditRouteVerbs[request.SelectedRoutePath()]
+	if !ok {
+		verb, ok = auditVerbs[request.Request.Method]
+	}
+	if !ok {
+		verb = strings.ToLower(request.Request.Method)
+	}
+
+	resource, name := auditResource(request)
+	return audit.Event{
+		Timestamp:  now.UTC().Format(time.RFC3339),
+		RequestID:  logging.RequestID(request.Request.Context()),
+		Verb:       verb,
+		Resource:   resource,
+		Namespace:  request.PathParameter("namespace"),
+		Name:       name,
+		Path:       request.Request.URL.Path,
+		RemoteAddr: getRemoteAddr(request.Request),
+	}
+}
+
+// Returns kind and name of the resource based on the selected route, i.e. route
+// '/api/v1/replicationcontroller/{namespace}/{replicationController}/update/pod' gives 'replicationcontroller' kind
+// and value of the 'replicationController' parameter as name.
+func auditResource(request *restful.Request) (resource string, name string) {
+	segments := strings.Split(strings.TrimPrefix(request.SelectedRoutePath(), "/api/v1/"), "/")
+	resource = segments[0]
+	if strings.HasPrefix(resource, "{") || auditGenericSegments[resource] {
+		resource = request.PathParameter("kind")
+	}
+
+	if name = request.PathParameter("name"); len(name) > 0 {
+		return resource, name
+	}
+
+	for _, segment := range segments[1:] {
+		if !strings.HasPrefix(segment, "{") {
+			continue
+		}
+
+		parameter := strings.Trim(segment, "{}")
+		if parameter != "kind" && parameter != "namespace" {
+			return resource, request.PathParameter(parameter)
+		}
+	}
+
+	return resource, ""
+}
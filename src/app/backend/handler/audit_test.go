@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+)
+
+func TestAuditFilter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer audit.Init("", 0)
+
+	path := filepath.Join(dir, "audit.log")
+	if err := audit.Init(path, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	ws := new(restful.WebService)
+	ws.Filter(auditFilter(client.NewClientManager("", "http://localhost:8080")))
+	ws.Path("/api/v1")
+	for _, route := range []*restful.RouteBuilder{
+		ws.GET("/pod/{namespace}/{pod}"),
+		ws.POST("/namespace"),
+		ws.POST("/replicationcontroller/{namespace}/{replicationController}/update/pod"),
+		ws.PUT("/scale/{kind}/{namespace}/{name}/"),
+		ws.DELETE("/_raw/{kind}/name/{name}"),
+		ws.POST("/_raw/delete"),
+		ws.POST("/appdeployment/validate/name"),
+	} {
+		ws.Route(route.To(func(request *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusCreated)
+		}))
+	}
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	requests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/api/v1/pod/default/nginx"},
+		{http.MethodPost, "/api/v1/namespace"},
+		{http.MethodPost, "/api/v1/replicationcontroller/default/frontend/update/pod"},
+		{http.MethodPut, "/api/v1/scale/deployment/default/nginx/"},
+		{http.MethodDelete, "/api/v1/_raw/node/name/worker"},
+		{http.MethodPost, "/api/v1/_raw/delete"},
+		{http.MethodPost, "/api/v1/appdeployment/validate/name"},
+	}
+
+	for _, r := range requests {
+		request := httptest.NewRequest(r.method, r.path, nil)
+		request.Header.Set("Authorization", "Bearer token")
+		request.Header.Set("Impersonate-User", "alice")
+		container.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	expected := []audit.Event{
+		{User: "alice", Verb: "create", Resource: "namespace", Path: "/api/v1/namespace"},
+		{User: "alice", Verb: "create", Resource: "replicationcontroller", Namespace: "default", Name: "frontend",
+			Path: "/api/v1/replicationcontroller/default/frontend/update/pod"},
+		{User: "alice", Verb: "update", Resource: "deployment", Namespace: "default", Name: "nginx",
+			Path: "/api/v1/scale/deployment/default/nginx/"},
+		{User: "alice", Verb: "delete", Resource: "node", Name: "worker", Path: "/api/v1/_raw/node/name/worker"},
+		{User: "alice", Verb: "delete", Path: "/api/v1/_raw/delete"},
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	actual := []audit.Event{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		event := audit.Event{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatal(err)
+		}
+
+		if event.Status != http.StatusCreated || len(event.Timestamp) == 0 || len(event.RemoteAddr) == 0 {
+			t.Errorf("Expected audit event to contain status, timestamp and remote address, but got %+v.", event)
+		}
+		event.Status, event.Timestamp, event.RemoteAddr = 0, "", ""
+		actual = append(actual, event)
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d audit events, but got %d: %+v.", len(expected), len(actual), actual)
+	}
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("Expected audit event %+v, but got %+v.", expected[i], actual[i])
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"strings"
+)
+
+// MakeBasePathHandler strips given base path from incoming requests before passing them to the handler. Requests
+// outside of the base path are rejected and request to the base path without trailing slash is redirected, so that
+// relative URLs of the frontend are resolved correctly. Root paths, i.e. probes and metrics, are passed unchanged, so
+// that they are served both at the root and under the base path. If base path is empty, handler is returned unchanged.
+func MakeBasePathHandler(handler http.Handler, basePath string, rootPaths ...string) http.Handler {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if len(basePath) == 0 {
+		return handler
+	}
+
+	roots := make(map[string]bool, len(rootPaths))
+	for _, path := range rootPaths {
+		roots[path] = true
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if roots[r.URL.Path] {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if r.URL.Path == basePath {
+			target := basePath + "/"
+			if len(r.URL.RawQuery) > 0 {
+				target += "?" + r.URL.RawQuery
+			}
+			http.Redirect(w, r, target, http.StatusMovedPermanently)
+			return
+		}
+
+		if !strings.HasPrefix(r.URL.Path, basePath+"/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		http.StripPrefix(basePath, handler).ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMakeBasePathHandler(t *testing.T) {
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	})
+
+	cases := []struct {
+		info             string
+		basePath         string
+		path             string
+		expectedStatus   int
+		expectedBody     string
+		expectedLocation string
+	}{
+		{"Should not change requests without base path", "", "/api/v1/pod", http.StatusOK, "/api/v1/pod", ""},
+		{"Should strip base path", "/dashboard", "/dashboard/api/v1/pod", http.StatusOK, "/api/v1/pod", ""},
+		{"Should serve root with trailing slash", "/dashboard", "/dashboard/", http.StatusOK, "/", ""},
+		{"Should redirect root without trailing slash", "/dashboard", "/dashboard?lang=en",
+			http.StatusMovedPermanently, "", "/dashboard/?lang=en"},
+		{"Should ignore trailing slash of base path", "/dashboard/", "/dashboard/api/v1/pod", http.StatusOK,
+			"/api/v1/pod", ""},
+		{"Should reject requests outside of base path", "/dashboard", "/api/v1/pod", http.StatusNotFound, "", ""},
+		{"Should reject requests with base path as a name prefix", "/dashboard", "/dashboards/", http.StatusNotFound,
+			"", ""},
+		{"Should serve root paths at the root", "/dashboard", "/readyz", http.StatusOK, "/readyz", ""},
+		{"Should serve root paths under base path", "/dashboard", "/dashboard/readyz", http.StatusOK, "/readyz", ""},
+		{"Should reject paths under root paths", "/dashboard", "/readyz/foo", http.StatusNotFound, "", ""},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		MakeBasePathHandler(echo, c.basePath, "/readyz").ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+
+		if c.expectedStatus == http.StatusOK && recorder.Body.String() != c.expectedBody {
+			t.Errorf("Test Case: %s. Expected path %s, but got %s.", c.info, c.expectedBody, recorder.Body.String())
+		}
+
+		if actual := recorder.Header().Get("Location"); actual != c.expectedLocation {
+			t.Errorf("Test Case: %s. Expected location %q, but got %q.", c.info, c.expectedLocation, actual)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// BulkDeleteItem identifies a resource deleted with the bulk delete request. Namespace is empty for cluster-scoped
+// resources.
+type BulkDeleteItem struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// BulkDeleteSpec is the body of the bulk delete request.
+type BulkDeleteSpec struct {
+	Items []BulkDeleteItem `json:"items"`
+}
+
+// BulkDeleteItemResult is the result of the deletion of a single resource. Reason and status code of the failure are
+// set only if the deletion did not succeed.
+type BulkDeleteItemResult struct {
+	BulkDeleteItem `json:",inline"`
+	Success        bool   `json:"success"`
+	Reason         string `json:"reason,omitempty"`
+	Status         int    `json:"status,omitempty"`
+}
+
+// BulkDeleteResult is sent by handleBulkDelete. Items are in the same order as in the request.
+type BulkDeleteResult struct {
+	Items []BulkDeleteItemResult `json:"items"`
+}
+
+// Parses propagation policy of the delete request. Empty policy is returned as is, so that the default one is used.
+func parsePropagationPolicy(policy string) (metaV1.DeletionPropagation, error) {
+	switch propagationPolicy := metaV1.DeletionPropagation(policy); propagationPolicy {
+	case "", metaV1.DeletePropagationForeground, metaV1.DeletePropagationBackground, metaV1.DeletePropagationOrphan:
+		return propagationPolicy, nil
+	}
+
+	return "", errors.NewBadRequest(fmt.Sprintf("propagationPolicy has to be one of '%s', '%s' or '%s'",
+		metaV1.DeletePropagationForeground, metaV1.DeletePropagationBackground, metaV1.DeletePropagationOrphan))
+}
+
+// Deletes resources one by one, so that failure of any of them does not stop deletion of the others. Route of the
+// request does not carry any kind, namespace or name, so allowed resources, namespace allowlist, access to
+// cluster-scoped resources and protected resources of dashboard are checked for every resource instead.
+func bulkDelete(verber clientapi.ResourceVerber, items []BulkDeleteItem,
+	propagationPolicy metaV1.DeletionPropagation, allowed map[schema.GroupResource]bool) []BulkDeleteItemResult {
+	results := make([]BulkDeleteItemResult, 0, len(items))
+	for _, item := range items {
+		result := BulkDeleteItemResult{BulkDeleteItem: item, Success: true}
+		if err := deleteBulkItem(verber, item, propagationPolicy, allowed); err != nil {
+			result.Success = false
+			result.Reason = err.Error()
+			result.Status = http.StatusInternalServerError
+			if statusError, ok := err.(*k8sErrors.StatusError); ok && statusError.Status().Code > 0 {
+				result.Status = int(statusError.Status().Code)
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func deleteBulkItem(verber clientapi.ResourceVerber, item BulkDeleteItem,
+	propagationPolicy metaV1.DeletionPropagation, allowed map[schema.GroupResource]bool) error {
+	if len(item.Kind) == 0 || len(item.Name) == 0 {
+		return errors.NewBadRequest("Kind and name of the resource are required")
+	}
+
+	if err := checkKindAllowed(allowed, item.Kind); err != nil {
+		return err
+	}
+
+	if authApi.IsProtectedResource(item.Name, item.Namespace) {
+		return errors.NewUnauthorized(errors.MsgDashboardExclusiveResourceError)
+	}
+
+	// Unknown kinds are rejected by the verber
+	if mapping, exists := api.KindToAPIMapping[item.Kind]; exists && !mapping.Namespaced {
+		if !clusterScopedAllowed() {
+			return errors.NewForbidden("Access to cluster-scoped resources is disabled")
+		}
+
+		if item.Kind == api.ResourceKindNamespace {
+			if err := checkNamespaceAllowed(item.Name); err != nil {
+				return err
+			}
+		}
+	} else if err := checkTargetNamespaceAllowed(item.Namespace); err != nil {
+		return err
+	}
+
+	return verber.Delete(item.Kind, len(item.Namespace) > 0, item.Namespace, item.Name, propagationPolicy)
+}
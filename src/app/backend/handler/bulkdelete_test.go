@@ -0,0 +1,213 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+type fakeDeleteVerber struct {
+	deleted  []string
+	policies []metaV1.DeletionPropagation
+}
+
+func (self *fakeDeleteVerber) Put(kind string, namespaceSet bool, namespace string, name string,
+	object *runtime.Unknown, dryRun []string) (*runtime.Unknown, error) {
+	return nil, nil
+}
+
+func (self *fakeDeleteVerber) Get(kind string, namespaceSet bool, namespace string, name string) (runtime.Object,
+	error) {
+	return nil, nil
+}
+
+func (self *fakeDeleteVerber) List(kind string, namespace string, opts metaV1.ListOptions) (runtime.Object,
+	error) {
+	return nil, nil
+}
+
+func (self *fakeDeleteVerber) Watch(kind string, namespace string, resourceVersion string) (io.ReadCloser, error) {
+	return nil, nil
+}
+
+func (self *fakeDeleteVerber) Delete(kind string, namespaceSet bool, namespace string, name string,
+	propagationPolicy metaV1.DeletionPropagation) error {
+	if name == "forbidden" {
+		return errors.NewForbidden("jobs.batch is forbidden")
+	}
+
+	self.deleted = append(self.deleted, kind+"/"+namespace+"/"+name)
+	self.policies = append(self.policies, propagationPolicy)
+	return nil
+}
+
+func TestParsePropagationPolicy(t *testing.T) {
+	cases := []struct {
+		policy      string
+		expectedErr bool
+	}{
+		{"", false},
+		{"Foreground", false},
+		{"Background", false},
+		{"Orphan", false},
+		{"background", true},
+	}
+
+	for _, c := range cases {
+		actual, err := parsePropagationPolicy(c.policy)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("parsePropagationPolicy(%s) returned error %v, expected error: %t", c.policy, err,
+				c.expectedErr)
+		}
+
+		if err == nil && string(actual) != c.policy {
+			t.Errorf("parsePropagationPolicy(%s) == %s, expected %s", c.policy, actual, c.policy)
+		}
+	}
+}
+
+func TestBulkDelete(t *testing.T) {
+	defer args.GetHolderBuilder().SetNamespaceAllowlist([]string{}).SetNamespaceAllowlistClusterScoped(true)
+	args.GetHolderBuilder().SetNamespaceAllowlist([]string{"default"}).SetNamespaceAllowlistClusterScoped(false)
+
+	items := []BulkDeleteItem{
+		{Kind: "job", Namespace: "default", Name: "job-1"},
+		{Kind: "job", Namespace: "default", Name: "forbidden"},
+		{Kind: "job", Namespace: "kube-system", Name: "job-2"},
+		{Kind: "persistentvolume", Name: "pv-1"},
+		{Kind: "job", Namespace: "default"},
+		{Kind: "job", Namespace: "default", Name: "job-3"},
+		{Kind: "namespace", Name: "kube-system"},
+		{Kind: "persistentvolume", Namespace: "default", Name: "pv-2"},
+		{Kind: "job", Name: "job-4"},
+	}
+	verber := &fakeDeleteVerber{}
+
+	actual := bulkDelete(verber, items, metaV1.DeletePropagationBackground, nil)
+	expected := []BulkDeleteItemResult{
+		{BulkDeleteItem: items[0], Success: true},
+		{BulkDeleteItem: items[1], Reason: "jobs.batch is forbidden", Status: http.StatusForbidden},
+		{BulkDeleteItem: items[2], Reason: "Access to namespace kube-system is not allowed",
+			Status: http.StatusForbidden},
+		{BulkDeleteItem: items[3], Reason: "Access to cluster-scoped resources is disabled",
+			Status: http.StatusForbidden},
+		{BulkDeleteItem: items[4], Reason: "Kind and name of the resource are required",
+			Status: http.StatusBadRequest},
+		{BulkDeleteItem: items[5], Success: true},
+		{BulkDeleteItem: items[6], Reason: "Access to cluster-scoped resources is disabled",
+			Status: http.StatusForbidden},
+		{BulkDeleteItem: items[7], Reason: "Access to cluster-scoped resources is disabled",
+			Status: http.StatusForbidden},
+		{BulkDeleteItem: items[8], Reason: "Namespace has to be selected when access to namespaces is restricted",
+			Status: http.StatusForbidden},
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d results, but got %d: %+v.", len(expected), len(actual), actual)
+	}
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("Expected result %+v, but got %+v.", expected[i], actual[i])
+		}
+	}
+
+	expectedDeleted := []string{"job/default/job-1", "job/default/job-3"}
+	if !reflect.DeepEqual(verber.deleted, expectedDeleted) {
+		t.Errorf("Expected deleted resources %v, but got %v.", expectedDeleted, verber.deleted)
+	}
+
+	for _, policy := range verber.policies {
+		if policy != metaV1.DeletePropagationBackground {
+			t.Errorf("Expected %s propagation policy, but got %s.", metaV1.DeletePropagationBackground, policy)
+		}
+	}
+}
+
+func TestBulkDeleteChecksEveryItem(t *testing.T) {
+	defer args.GetHolderBuilder().SetNamespaceAllowlist([]string{}).SetNamespaceAllowlistClusterScoped(true)
+	args.GetHolderBuilder().SetNamespaceAllowlist([]string{"default"}).SetNamespaceAllowlistClusterScoped(true)
+
+	items := []BulkDeleteItem{
+		{Kind: "job", Namespace: "default", Name: "job-1"},
+		{Kind: "secret", Namespace: "default", Name: "secret-1"},
+		{Kind: "namespace", Name: "kube-system"},
+		{Kind: "namespace", Name: "default"},
+	}
+	allowed := map[schema.GroupResource]bool{
+		{Group: "batch", Resource: "jobs"}: true,
+		{Resource: "namespaces"}:           true,
+	}
+	verber := &fakeDeleteVerber{}
+
+	actual := bulkDelete(verber, items, metaV1.DeletePropagationBackground, allowed)
+	expected := []BulkDeleteItemResult{
+		{BulkDeleteItem: items[0], Success: true},
+		{BulkDeleteItem: items[1], Reason: "Resource secrets is not allowed", Status: http.StatusNotFound},
+		{BulkDeleteItem: items[2], Reason: "Access to namespace kube-system is not allowed",
+			Status: http.StatusForbidden},
+		{BulkDeleteItem: items[3], Success: true},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected results %+v, but got %+v.", expected, actual)
+	}
+
+	expectedDeleted := []string{"job/default/job-1", "namespace//default"}
+	if !reflect.DeepEqual(verber.deleted, expectedDeleted) {
+		t.Errorf("Expected deleted resources %v, but got %v.", expectedDeleted, verber.deleted)
+	}
+}
+
+func TestBulkDeleteProtectedResources(t *testing.T) {
+	defer args.GetHolderBuilder().SetNamespace("")
+	args.GetHolderBuilder().SetNamespace("kubernetes-dashboard")
+
+	items := []BulkDeleteItem{
+		{Kind: "secret", Namespace: "kubernetes-dashboard", Name: authApi.EncryptionKeyHolderName},
+		{Kind: "secret", Namespace: "kubernetes-dashboard", Name: authApi.CertificateHolderSecretName},
+		{Kind: "secret", Namespace: "default", Name: authApi.EncryptionKeyHolderName},
+	}
+	verber := &fakeDeleteVerber{}
+
+	actual := bulkDelete(verber, items, metaV1.DeletePropagationBackground, nil)
+	expected := []BulkDeleteItemResult{
+		{BulkDeleteItem: items[0], Reason: errors.MsgDashboardExclusiveResourceError,
+			Status: http.StatusUnauthorized},
+		{BulkDeleteItem: items[1], Reason: errors.MsgDashboardExclusiveResourceError,
+			Status: http.StatusUnauthorized},
+		{BulkDeleteItem: items[2], Success: true},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected results %+v, but got %+v.", expected, actual)
+	}
+
+	expectedDeleted := []string{"secret/default/" + authApi.EncryptionKeyHolderName}
+	if !reflect.DeepEqual(verber.deleted, expectedDeleted) {
+		t.Errorf("Expected deleted resources %v, but got %v.", expectedDeleted, verber.deleted)
+	}
+}
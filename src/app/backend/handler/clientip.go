@@ -0,0 +1,100 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+const (
+	originalForwardedForHeader = "X-Original-Forwarded-For"
+	forwardedForHeader         = "X-Forwarded-For"
+	realIPHeader               = "X-Real-Ip"
+)
+
+// getRemoteAddr returns address of the client that sent the request. Forwarding headers are honored only if the
+// request was sent by one of the proxies from --trusted-proxies, so they can not be spoofed by clients connecting
+// directly. Otherwise, remote address of the connection is returned. X-Original-Forwarded-For is passed through by
+// proxies such as ingress-nginx as it was sent by the client, so it is honored only with
+// --trust-original-forwarded-for, when the header is set by another trusted proxy in front of them.
+func getRemoteAddr(r *http.Request) string {
+	trusted := args.Holder.GetTrustedProxyNets()
+	if !isTrustedAddr(r.RemoteAddr, trusted) {
+		return r.RemoteAddr
+	}
+
+	headers := []string{forwardedForHeader}
+	if args.Holder.GetTrustOriginalForwardedFor() {
+		headers = []string{originalForwardedForHeader, forwardedForHeader}
+	}
+
+	for _, header := range headers {
+		if ip := getRemoteIPFromForwardHeader(r, header, trusted); ip != "" {
+			return ip
+		}
+	}
+
+	if realIP := strings.TrimSpace(r.Header.Get(realIPHeader)); net.ParseIP(realIP) != nil {
+		return realIP
+	}
+
+	return r.RemoteAddr
+}
+
+// Returns address of the client from the forwarding header. Addresses are appended to the header by every proxy, so
+// it is read from the right and the first address that does not belong to a trusted proxy is the client. Addresses
+// left of it could have been set by the client. Returns empty string if the header does not contain valid addresses.
+func getRemoteIPFromForwardHeader(r *http.Request, header string, trusted []*net.IPNet) string {
+	ips := strings.Split(r.Header.Get(header), ",")
+	result := ""
+	for i := len(ips) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(ips[i])
+		if net.ParseIP(ip) == nil {
+			break
+		}
+
+		result = ip
+		if !isTrustedAddr(ip, trusted) {
+			break
+		}
+	}
+
+	return result
+}
+
+// Checks if given address, with or without the port, belongs to one of the trusted CIDRs.
+func isTrustedAddr(addr string, trusted []*net.IPNet) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range trusted {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+func TestGetRemoteAddr(t *testing.T) {
+	defer args.GetHolderBuilder().SetTrustedProxies([]string{})
+	defer args.GetHolderBuilder().SetTrustOriginalForwardedFor(false)
+
+	cases := []struct {
+		info          string
+		trusted       []string
+		trustOriginal bool
+		remoteAddr    string
+		headers       map[string]string
+		expected      string
+	}{
+		{"Should use connection address without trusted proxies", []string{}, false, "10.0.0.1:1234",
+			map[string]string{forwardedForHeader: "1.2.3.4"}, "10.0.0.1:1234"},
+		{"Should ignore headers of untrusted source", []string{"10.0.0.0/8"}, false, "192.168.0.1:1234",
+			map[string]string{forwardedForHeader: "1.2.3.4", realIPHeader: "1.2.3.4"}, "192.168.0.1:1234"},
+		{"Should use forwarded for header of trusted proxy", []string{"10.0.0.0/8"}, false, "10.0.0.1:1234",
+			map[string]string{forwardedForHeader: "1.2.3.4"}, "1.2.3.4"},
+		{"Should skip trusted proxies in forwarded for header", []string{"10.0.0.0/8"}, false, "10.0.0.1:1234",
+			map[string]string{forwardedForHeader: "6.6.6.6, 1.2.3.4, 10.0.0.2"}, "1.2.3.4"},
+		{"Should use leftmost address if all are trusted", []string{"10.0.0.0/8"}, false, "10.0.0.1:1234",
+			map[string]string{forwardedForHeader: "10.0.0.3, 10.0.0.2"}, "10.0.0.3"},
+		{"Should prefer original forwarded for header if it is trusted", []string{"10.0.0.0/8"}, true, "10.0.0.1:1234",
+			map[string]string{originalForwardedForHeader: "5.6.7.8", forwardedForHeader: "1.2.3.4"}, "5.6.7.8"},
+		{"Should ignore original forwarded for header by default", []string{"10.0.0.0/8"}, false, "10.0.0.1:1234",
+			map[string]string{originalForwardedForHeader: "5.6.7.8", forwardedForHeader: "1.2.3.4"}, "1.2.3.4"},
+		{"Should trust no proxy if any CIDR is invalid", []string{"10.0.0.0/8", "invalid"}, false, "10.0.0.1:1234",
+			map[string]string{forwardedForHeader: "1.2.3.4"}, "10.0.0.1:1234"},
+		{"Should use real IP header of trusted proxy", []string{"10.0.0.0/8"}, false, "10.0.0.1:1234",
+			map[string]string{realIPHeader: "1.2.3.4"}, "1.2.3.4"},
+		{"Should ignore invalid addresses", []string{"10.0.0.0/8"}, false, "10.0.0.1:1234",
+			map[string]string{forwardedForHeader: "unknown", realIPHeader: "invalid"}, "10.0.0.1:1234"},
+		{"Should support IPv6", []string{"fd00::/8"}, false, "[fd00::1]:1234",
+			map[string]string{forwardedForHeader: "2001:db8::1"}, "2001:db8::1"},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetTrustedProxies(c.trusted).SetTrustOriginalForwardedFor(c.trustOriginal)
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/pod", nil)
+		request.RemoteAddr = c.remoteAddr
+		for header, value := range c.headers {
+			request.Header.Set(header, value)
+		}
+
+		if actual := getRemoteAddr(request); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected %s, but got %s.", c.info, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+)
+
+// Supported content encodings in the order of preference.
+var compressionEncodings = []string{"gzip", "deflate"}
+
+// Filter used to compress API responses with encoding negotiated using Accept-Encoding header of the request.
+// Responses smaller than minBytes are not compressed. Streaming routes and WebSocket upgrades are never compressed,
+// as their output has to reach the client immediately.
+func compressionFilter(minBytes int) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		encoding := negotiateEncoding(request.Request.Header.Get("Accept-Encoding"))
+		if len(encoding) == 0 || request.Request.Method == http.MethodHead ||
+			streamingRoutes[request.SelectedRoutePath()] || isWebSocketUpgrade(request.Request) {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		writer := &compressingResponseWriter{ResponseWriter: response.ResponseWriter, encoding: encoding,
+			minBytes: minBytes}
+		response.ResponseWriter = writer
+		defer writer.Close()
+		chain.ProcessFilter(request, response)
+	}
+}
+
+// Returns the most preferred supported encoding accepted by the client or empty string if none is accepted.
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		params := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(params[0]))
+		accepted[name] = true
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+
+			if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err != nil || q <= 0 {
+				accepted[name] = false
+			}
+		}
+	}
+
+	for _, encoding := range compressionEncodings {
+		if accepted[encoding] {
+			return encoding
+		}
+	}
+
+	return ""
+}
+
+func isWebSocketUpgrade(request *http.Request) bool {
+	return strings.EqualFold(request.Header.Get("Upgrade"), "websocket")
+}
+
+// compressingResponseWriter buffers response body until it reaches minBytes. Larger bodies are compressed and
+// smaller ones are written as they are once the response is closed, so header and status code are sent only after
+// the decision is made.
+type compressingResponseWriter struct {
+	http.ResponseWriter
+	encoding string
+	minBytes int
+	status   int
+	buffer   []byte
+	// Compressing writer, set once compression started.
+	writer io.WriteCloser
+	// True if response is written without compression.
+	passthrough bool
+}
+
+// WriteHeader implements http.ResponseWriter interface. Status code is sent together with the first part of the body.
+func (self *compressingResponseWriter) WriteHeader(status int) {
+	if self.status == 0 {
+		self.status = status
+	}
+}
+
+// Write implements io.Writer interface.
+func (self *compressingResponseWriter) Write(p []byte) (int, error) {
+	if self.status == 0 {
+		self.status = http.StatusOK
+	}
+
+	if self.writer != nil {
+		return self.writer.Write(p)
+	}
+
+	if self.passthrough {
+		return self.ResponseWriter.Write(p)
+	}
+
+	if !self.isCompressible() {
+		if err := self.startPassthrough(); err != nil {
+			return 0, err
+		}
+		return self.ResponseWriter.Write(p)
+	}
+
+	self.buffer = append(self.buffer, p...)
+	if len(self.buffer) >= self.minBytes {
+		if err := self.startCompression(); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush implements http.Flusher interface. Buffered body is written without compression if compression did not
+// start yet.
+func (self *compressingResponseWriter) Flush() {
+	if self.writer == nil && !self.passthrough && self.status != 0 {
+		self.startPassthrough()
+	}
+
+	if flusher, ok := self.writer.(interface{ Flush() error }); ok {
+		flusher.Flush()
+	}
+
+	if flusher, ok := self.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the original writer, so it can be used by http.ResponseController.
+func (self *compressingResponseWriter) Unwrap() http.ResponseWriter {
+	return self.ResponseWriter
+}
+
+// Close writes the rest of the response. It has to be called after the response is written.
+func (self *compressingResponseWriter) Close() error {
+	if self.writer != nil {
+		return self.writer.Close()
+	}
+
+	if !self.passthrough && self.status != 0 {
+		return self.startPassthrough()
+	}
+
+	return nil
+}
+
+// Responses without body and responses that are already encoded are not compressed.
+func (self *compressingResponseWriter) isCompressible() bool {
+	switch self.status {
+	case http.StatusNoContent, http.StatusNotModified:
+		return false
+	}
+
+	return len(self.Header().Get("Content-Encoding")) == 0
+}
+
+func (self *compressingResponseWriter) startCompression() error {
+	header := self.Header()
+	header.Del("Content-Length")
+	header.Set("Content-Encoding", self.encoding)
+	header.Add("Vary", "Accept-Encoding")
+	self.ResponseWriter.WriteHeader(self.status)
+
+	// Deflate content encoding uses zlib format, see RFC 7230
+	if self.encoding == "gzip" {
+		self.writer = gzip.NewWriter(self.ResponseWriter)
+	} else {
+		self.writer = zlib.NewWriter(self.ResponseWriter)
+	}
+
+	_, err := self.writer.Write(self.buffer)
+	self.buffer = nil
+	return err
+}
+
+func (self *compressingResponseWriter) startPassthrough() error {
+	self.passthrough = true
+	self.ResponseWriter.WriteHeader(self.status)
+
+	_, err := self.ResponseWriter.Write(self.buffer)
+	self.buffer = nil
+	return err
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	cases := []struct {
+		acceptEncoding string
+		expected       string
+	}{
+		{"", ""},
+		{"gzip", "gzip"},
+		{"deflate, gzip", "gzip"},
+		{"gzip;q=0, deflate", "deflate"},
+		{"br, DEFLATE;q=0.5", "deflate"},
+		{"br", ""},
+	}
+
+	for _, c := range cases {
+		if actual := negotiateEncoding(c.acceptEncoding); actual != c.expected {
+			t.Errorf("negotiateEncoding(%q) == %q, expected %q", c.acceptEncoding, actual, c.expected)
+		}
+	}
+}
+
+func TestCompressionFilter(t *testing.T) {
+	large := strings.Repeat("dashboard", 200)
+	ws := new(restful.WebService)
+	ws.Filter(compressionFilter(1024))
+	ws.Path("/api/v1")
+	route := func(body string, status int) restful.RouteFunction {
+		return func(request *restful.Request, response *restful.Response) {
+			response.AddHeader("Content-Length", strconv.Itoa(len(body)))
+			response.WriteHeader(status)
+			response.Write([]byte(body))
+		}
+	}
+	ws.Route(ws.GET("/large").To(route(large, http.StatusOK)))
+	ws.Route(ws.GET("/small").To(route("small", http.StatusOK)))
+	ws.Route(ws.GET("/missing").To(route(large, http.StatusNotFound)))
+	ws.Route(ws.GET("/watch/{kind}").To(route(large, http.StatusOK)))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info             string
+		path             string
+		acceptEncoding   string
+		expectedEncoding string
+		expectedStatus   int
+		expectedBody     string
+	}{
+		{"Should compress large response with gzip", "/api/v1/large", "gzip, deflate", "gzip", http.StatusOK, large},
+		{"Should compress large response with deflate", "/api/v1/large", "deflate", "deflate", http.StatusOK, large},
+		{"Should keep status of compressed response", "/api/v1/missing", "gzip", "gzip", http.StatusNotFound,
+			large},
+		{"Should not compress small response", "/api/v1/small", "gzip", "", http.StatusOK, "small"},
+		{"Should not compress if client does not accept encoding", "/api/v1/large", "", "", http.StatusOK, large},
+		{"Should not compress streaming response", "/api/v1/watch/pod", "gzip", "", http.StatusOK, large},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodGet, c.path, nil)
+		request.Header.Set("Accept-Encoding", c.acceptEncoding)
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+
+		encoding := recorder.Header().Get("Content-Encoding")
+		if encoding != c.expectedEncoding {
+			t.Errorf("Test Case: %s. Expected encoding %q, but got %q.", c.info, c.expectedEncoding, encoding)
+			continue
+		}
+
+		if len(encoding) > 0 && len(recorder.Header().Get("Content-Length")) > 0 {
+			t.Errorf("Test Case: %s. Expected Content-Length to be removed from compressed response.", c.info)
+		}
+
+		var reader io.Reader = recorder.Body
+		switch encoding {
+		case "gzip":
+			reader, _ = gzip.NewReader(recorder.Body)
+		case "deflate":
+			reader, _ = zlib.NewReader(recorder.Body)
+		}
+
+		body, err := ioutil.ReadAll(reader)
+		if err != nil {
+			t.Errorf("Test Case: %s. Could not read body: %s.", c.info, err)
+			continue
+		}
+
+		if string(body) != c.expectedBody {
+			t.Errorf("Test Case: %s. Expected body of length %d, but got %d.", c.info, len(c.expectedBody), len(body))
+		}
+	}
+}
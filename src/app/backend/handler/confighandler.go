@@ -20,6 +20,8 @@ import (
 	"net/http"
 	"text/template"
 	"time"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 )
 
 // AppHandler is an application handler.
@@ -29,6 +31,44 @@ type AppHandler func(http.ResponseWriter, *http.Request) (int, error)
 type AppConfig struct {
 	// ServerTime is current server time.
 	ServerTime int64 `json:"serverTime"`
+	// AllowedResourceKinds lists kinds that can be accessed if they are restricted with --allowed-resources or
+	// access to cluster-scoped resources is disabled.
+	AllowedResourceKinds []string `json:"allowedResourceKinds,omitempty"`
+	// SettingsReadOnly is true if settings can not be changed through dashboard.
+	SettingsReadOnly bool `json:"settingsReadOnly,omitempty"`
+	// DefaultView is the view users land on after opening dashboard or logging in.
+	DefaultView string `json:"defaultView"`
+	// DefaultViewNamespace is the namespace selected in the default view. Empty if it is not configured.
+	DefaultViewNamespace string `json:"defaultViewNamespace,omitempty"`
+	// LoginTitle is the product name displayed on the login page. Empty if default branding is used.
+	LoginTitle string `json:"loginTitle,omitempty"`
+	// LoginLogoURL is the URL of the logo displayed on the login page. Empty if default branding is used.
+	LoginLogoURL string `json:"loginLogoUrl,omitempty"`
+	// AppTitle is the title of the browser tab. Empty if default branding is used.
+	AppTitle string `json:"appTitle,omitempty"`
+	// FaviconURL is the URL of the favicon. Empty if default favicon is used.
+	FaviconURL string `json:"faviconUrl,omitempty"`
+	// CookieSameSite is the SameSite attribute of the cookie that carries the JWE token.
+	CookieSameSite string `json:"cookieSameSite"`
+	// CookieSecure is true if the cookie that carries the JWE token should be sent only over HTTPS.
+	CookieSecure bool `json:"cookieSecure"`
+	// CookieDomain is the Domain attribute of the cookie that carries the JWE token. Empty if it is not configured.
+	CookieDomain string `json:"cookieDomain,omitempty"`
+	// DefaultCreateNamespace is the namespace pre-filled in the create form.
+	DefaultCreateNamespace string `json:"defaultCreateNamespace"`
+	// SessionWarningLeadTime is the time in seconds before the session expires when user is warned. 0 if warnings are
+	// disabled.
+	SessionWarningLeadTime int `json:"sessionWarningLeadTime,omitempty"`
+	// TokenRefreshDisabled is true if tokens can not be refreshed and user has to log in again once they expire.
+	TokenRefreshDisabled bool `json:"tokenRefreshDisabled,omitempty"`
+	// DefaultDeletePropagation is the propagation policy of delete requests that do not specify one.
+	DefaultDeletePropagation string `json:"defaultDeletePropagation"`
+	// DefaultTimezone is the IANA name of the timezone that timestamps are displayed in unless user overrides it.
+	DefaultTimezone string `json:"defaultTimezone"`
+	// AnonymousAccess is true if allowed resources can be viewed without logging in.
+	AnonymousAccess bool `json:"anonymousAccess,omitempty"`
+	// APIPrefix is the prefix of the REST API used by the frontend, relative to the base path.
+	APIPrefix string `json:"apiPrefix"`
 }
 
 const (
@@ -49,8 +89,37 @@ func (fn AppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func getAppConfigJSON() string {
 	log.Println("Getting application global configuration")
 
+	allowed, _ := ParseAllowedResources(args.Holder.GetAllowedResources())
+	defaultView, defaultViewNamespace, err := ParseDefaultView(args.Holder.GetDefaultView())
+	if err != nil {
+		defaultView = DefaultView
+	}
+
+	allowedResourceKinds := AllowedResourceKinds(allowed)
+	if !clusterScopedAllowed() {
+		allowedResourceKinds = namespacedResourceKinds(allowedResourceKinds)
+	}
+
 	config := &AppConfig{
-		ServerTime: time.Now().UTC().UnixNano() / 1e6,
+		ServerTime:               time.Now().UTC().UnixNano() / 1e6,
+		AllowedResourceKinds:     allowedResourceKinds,
+		SettingsReadOnly:         args.Holder.GetSettingsReadOnly(),
+		DefaultView:              defaultView,
+		DefaultViewNamespace:     defaultViewNamespace,
+		LoginTitle:               args.Holder.GetLoginTitle(),
+		LoginLogoURL:             args.Holder.GetLoginLogoURL(),
+		AppTitle:                 args.Holder.GetAppTitle(),
+		FaviconURL:               args.Holder.GetFaviconURL(),
+		CookieSameSite:           args.Holder.GetCookieSameSite(),
+		CookieSecure:             args.Holder.GetCookieSecure(),
+		CookieDomain:             args.Holder.GetCookieDomain(),
+		DefaultCreateNamespace:   args.Holder.GetDefaultCreateNamespace(),
+		SessionWarningLeadTime:   args.Holder.GetSessionWarningLeadTime(),
+		TokenRefreshDisabled:     !args.Holder.GetEnableTokenRefresh(),
+		DefaultDeletePropagation: args.Holder.GetDefaultDeletePropagation(),
+		DefaultTimezone:          args.Holder.GetDefaultTimezone(),
+		AnonymousAccess:          args.Holder.GetAnonymousAccess(),
+		APIPrefix:                args.Holder.GetAPIPrefix(),
 	}
 
 	jsonConfig, _ := json.Marshal(config)
@@ -0,0 +1,90 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net"
+	"net/http"
+	"sync"
+)
+
+// connectionLimiter bounds number of connections, i.e. HTTP requests and WebSocket or SockJS sessions, handled at
+// the same time for a single client IP.
+type connectionLimiter struct {
+	mux         sync.Mutex
+	max         int
+	connections map[string]int
+}
+
+// Reserves a connection for the client with given IP. Returns false if the client reached the limit.
+func (self *connectionLimiter) acquire(ip string) bool {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	if self.connections[ip] >= self.max {
+		return false
+	}
+
+	self.connections[ip]++
+	return true
+}
+
+func (self *connectionLimiter) release(ip string) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	self.connections[ip]--
+	if self.connections[ip] <= 0 {
+		delete(self.connections, ip)
+	}
+}
+
+// Returns IP of the client that sent the request, without the port. Forwarding headers are honored only for trusted
+// proxies, see getRemoteAddr.
+func getClientIP(r *http.Request) string {
+	addr := getRemoteAddr(r)
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
+}
+
+// MakeConnectionLimitHandler returns handler that rejects connections of clients that already have maxConnections
+// connections open with 429 status code and Retry-After header. Liveness ping is not limited. Limit equal to 0
+// disables it.
+func MakeConnectionLimitHandler(handler http.Handler, maxConnections int) http.Handler {
+	if maxConnections <= 0 {
+		return handler
+	}
+
+	limiter := &connectionLimiter{max: maxConnections, connections: map[string]int{}}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == PingPath {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		ip := getClientIP(r)
+		if !limiter.acquire(ip) {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "Too many connections from this address, try again later", http.StatusTooManyRequests)
+			return
+		}
+
+		defer limiter.release(ip)
+		handler.ServeHTTP(w, r)
+	})
+}
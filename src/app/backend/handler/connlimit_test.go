@@ -0,0 +1,98 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+func TestConnectionLimitHandler(t *testing.T) {
+	defer args.GetHolderBuilder().SetTrustedProxies([]string{})
+	args.GetHolderBuilder().SetTrustedProxies([]string{"10.0.0.0/8"})
+
+	cases := []struct {
+		info           string
+		maxConnections int
+		held           []string
+		remoteAddr     string
+		forwardedFor   string
+		path           string
+		expected       int
+	}{
+		{"Should allow connections when limit is disabled", 0, []string{"1.2.3.4:1000", "1.2.3.4:1001"},
+			"1.2.3.4:1002", "", "/api/v1/pod", http.StatusOK},
+		{"Should allow connections under the limit", 2, []string{"1.2.3.4:1000"}, "1.2.3.4:1001", "",
+			"/api/v1/pod", http.StatusOK},
+		{"Should reject connections over the limit", 1, []string{"1.2.3.4:1000"}, "1.2.3.4:1001", "",
+			"/api/sockjs/123", http.StatusTooManyRequests},
+		{"Should count connections of each IP separately", 1, []string{"1.2.3.4:1000"}, "5.6.7.8:1000", "",
+			"/api/v1/pod", http.StatusOK},
+		{"Should not limit liveness ping", 1, []string{"1.2.3.4:1000"}, "1.2.3.4:1001", "", PingPath,
+			http.StatusOK},
+		{"Should use client IP forwarded by trusted proxy", 1, []string{"1.2.3.4:1000"}, "10.0.0.1:1000",
+			"1.2.3.4", "/api/v1/pod", http.StatusTooManyRequests},
+		{"Should not count trusted proxy as one client", 1, []string{"1.2.3.4:1000"}, "10.0.0.1:1000",
+			"5.6.7.8", "/api/v1/pod", http.StatusOK},
+	}
+
+	for _, c := range cases {
+		release := make(chan struct{})
+		started := sync.WaitGroup{}
+		finished := sync.WaitGroup{}
+		blocking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Hold") == "true" {
+				started.Done()
+				<-release
+			}
+		})
+		handler := MakeConnectionLimitHandler(blocking, c.maxConnections)
+
+		for _, addr := range c.held {
+			started.Add(1)
+			finished.Add(1)
+			request := httptest.NewRequest(http.MethodGet, "/api/watch/pod", nil)
+			request.RemoteAddr = addr
+			request.Header.Set("Hold", "true")
+			go func() {
+				defer finished.Done()
+				handler.ServeHTTP(httptest.NewRecorder(), request)
+			}()
+		}
+		started.Wait()
+
+		request := httptest.NewRequest(http.MethodGet, c.path, nil)
+		request.RemoteAddr = c.remoteAddr
+		if len(c.forwardedFor) > 0 {
+			request.Header.Set(forwardedForHeader, c.forwardedFor)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		close(release)
+		finished.Wait()
+
+		if recorder.Code != c.expected {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expected, recorder.Code)
+		}
+
+		if c.expected == http.StatusTooManyRequests && recorder.Header().Get("Retry-After") != "1" {
+			t.Errorf("Test Case: %s. Expected Retry-After header to be set.", c.info)
+		}
+	}
+}
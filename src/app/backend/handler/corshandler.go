@@ -0,0 +1,83 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	corsAllowedMethods = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
+	corsAllowedHeaders = "Accept, Authorization, Content-Type, jweToken, X-CSRF-TOKEN, X-XSRF-TOKEN"
+)
+
+// MakeCORSHandler adds support for cross-origin requests coming from given origins. Origins can be either exact,
+// i.e. 'https://portal.example.com', or contain a single wildcard subdomain, i.e. 'https://*.example.com'.
+// If no origins are given, handler is returned unchanged and no CORS headers are emitted.
+func MakeCORSHandler(handler http.Handler, allowedOrigins []string) http.Handler {
+	if len(allowedOrigins) == 0 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 || !isOriginAllowed(origin, allowedOrigins) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+
+		// Preflight requests are answered here, as API router does not know OPTIONS routes.
+		if r.Method == http.MethodOptions && len(r.Header.Get("Access-Control-Request-Method")) > 0 {
+			w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", corsAllowedHeaders)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func isOriginAllowed(origin string, allowedOrigins []string) bool {
+	for _, allowed := range allowedOrigins {
+		if matchesOrigin(origin, allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks if origin matches given pattern. Wildcard matches one or more subdomain labels.
+func matchesOrigin(origin, pattern string) bool {
+	wildcard := strings.Index(pattern, "*")
+	if wildcard < 0 {
+		return strings.EqualFold(origin, pattern)
+	}
+
+	prefix, suffix := strings.ToLower(pattern[:wildcard]), strings.ToLower(pattern[wildcard+1:])
+	origin = strings.ToLower(origin)
+	if len(origin) <= len(prefix)+len(suffix) || !strings.HasPrefix(origin, prefix) || !strings.HasSuffix(origin, suffix) {
+		return false
+	}
+
+	subdomain := origin[len(prefix) : len(origin)-len(suffix)]
+	return !strings.ContainsAny(subdomain, "/:@*") && !strings.HasPrefix(subdomain, ".")
+}
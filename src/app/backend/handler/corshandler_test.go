@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMatchesOrigin(t *testing.T) {
+	cases := []struct {
+		origin, pattern string
+		expected        bool
+	}{
+		{"https://portal.example.com", "https://portal.example.com", true},
+		{"https://Portal.Example.com", "https://portal.example.com", true},
+		{"http://portal.example.com", "https://portal.example.com", false},
+		{"https://a.example.com", "https://*.example.com", true},
+		{"https://a.b.example.com", "https://*.example.com", true},
+		{"https://example.com", "https://*.example.com", false},
+		{"https://evil.com/.example.com", "https://*.example.com", false},
+		{"https://a.example.com.evil.com", "https://*.example.com", false},
+		{"http://a.example.com", "https://*.example.com", false},
+	}
+
+	for _, c := range cases {
+		if actual := matchesOrigin(c.origin, c.pattern); actual != c.expected {
+			t.Errorf("matchesOrigin(%s, %s) returns %t, expected %t", c.origin, c.pattern, actual, c.expected)
+		}
+	}
+}
+
+func TestMakeCORSHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cases := []struct {
+		info           string
+		allowedOrigins []string
+		method         string
+		origin         string
+		expectedOrigin string
+		expectedStatus int
+	}{
+		{"No CORS headers should be set if origins are not configured",
+			nil, http.MethodGet, "https://a.example.com", "", http.StatusOK},
+		{"Matching origin should be echoed",
+			[]string{"https://*.example.com"}, http.MethodGet, "https://a.example.com", "https://a.example.com", http.StatusOK},
+		{"Not matching origin should not be echoed",
+			[]string{"https://*.example.com"}, http.MethodGet, "https://example.org", "", http.StatusOK},
+		{"Preflight request should be answered",
+			[]string{"https://portal.example.com"}, http.MethodOptions, "https://portal.example.com", "https://portal.example.com", http.StatusNoContent},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(c.method, "/api/v1/pod", nil)
+		request.Header.Set("Origin", c.origin)
+		if c.method == http.MethodOptions {
+			request.Header.Set("Access-Control-Request-Method", http.MethodPut)
+		}
+
+		recorder := httptest.NewRecorder()
+		MakeCORSHandler(next, c.allowedOrigins).ServeHTTP(recorder, request)
+
+		if actual := recorder.Header().Get("Access-Control-Allow-Origin"); actual != c.expectedOrigin {
+			t.Errorf("Test Case: %s. Expected allowed origin %q, but got %q.", c.info, c.expectedOrigin, actual)
+		}
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/auth"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Names of the cookie and header of the double-submit CSRF token. They are the defaults of Angular HTTP client, which
+// copies the cookie to the header of every mutating request.
+const (
+	csrfCookieName = "XSRF-TOKEN"
+	csrfHeaderName = "X-XSRF-TOKEN"
+)
+
+// Routes that do not require the CSRF token, as they only extend the session of the user.
+var csrfExemptRoutes = map[string]bool{
+	"/api/v1/token/refresh": true,
+}
+
+// csrfTokens issues and verifies double-submit CSRF tokens. Tokens are signed with the CSRF key, so that cookies set
+// by other sites, i.e. from a sibling domain, are not accepted.
+type csrfTokens struct {
+	key []byte
+}
+
+// Returns new token in 'random.signature' format.
+func (self *csrfTokens) generate() (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	value := base64.RawURLEncoding.EncodeToString(random)
+	return value + "." + self.sign(value), nil
+}
+
+func (self *csrfTokens) valid(token string) bool {
+	parts := strings.Split(token, ".")
+	return len(parts) == 2 && hmac.Equal([]byte(parts[1]), []byte(self.sign(parts[0])))
+}
+
+func (self *csrfTokens) sign(value string) string {
+	mac := hmac.New(sha256.New, self.key)
+	mac.Write([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Filter used to protect mutating requests with double-submit CSRF token. The token is issued in a cookie readable by
+// the frontend and every request that is not safe has to send it back in the header. Cookies can not be read by
+// other sites, so they can not forge such requests.
+func csrfFilter(csrfKey string) restful.FilterFunction {
+	tokens := &csrfTokens{key: []byte(csrfKey)}
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		cookie, err := request.Request.Cookie(csrfCookieName)
+		hasToken := err == nil && tokens.valid(cookie.Value)
+		if !hasToken {
+			token, err := tokens.generate()
+			if err != nil {
+				errors.HandleInternalError(response, err)
+				return
+			}
+			http.SetCookie(response, auth.NewCookie(csrfCookieName, token))
+		}
+
+		if isSafeMethod(request.Request.Method) || csrfExemptRoutes[request.SelectedRoutePath()] ||
+			!hasCookieCredentials(request.Request) {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		if !hasToken || !hmac.Equal([]byte(request.HeaderParameter(csrfHeaderName)), []byte(cookie.Value)) {
+			errors.HandleInternalError(response, errors.NewForbidden("CSRF token is missing or invalid, it has to "+
+				"be sent in "+csrfHeaderName+" header with the value of "+csrfCookieName+" cookie"))
+			return
+		}
+
+		chain.ProcessFilter(request, response)
+	}
+}
+
+// Checks if credentials of the request can be sent by the browser on its own. Requests of API clients that send
+// credentials only in the Authorization header can not be forged by other sites, so they do not need the CSRF token.
+func hasCookieCredentials(request *http.Request) bool {
+	if _, err := request.Cookie(auth.JWETokenCookieName); err == nil {
+		return true
+	}
+
+	return len(request.Header.Get("Authorization")) == 0
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
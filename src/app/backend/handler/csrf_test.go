@@ -0,0 +1,143 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/auth"
+)
+
+func TestCSRFFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Filter(csrfFilter("test-key"))
+	ws.Path("/api/v1")
+	handle := func(request *restful.Request, response *restful.Response) { response.WriteHeader(http.StatusOK) }
+	ws.Route(ws.GET("/pod").To(handle))
+	ws.Route(ws.POST("/namespace").To(handle))
+	ws.Route(ws.DELETE("/_raw/{kind}/name/{name}").To(handle))
+	ws.Route(ws.POST("/token/refresh").To(handle))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	tokens := &csrfTokens{key: []byte("test-key")}
+	token, err := tokens.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := (&csrfTokens{key: []byte("other-key")}).generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info           string
+		method         string
+		path           string
+		cookie         string
+		header         string
+		expectedStatus int
+		expectedCookie bool
+	}{
+		{"Should allow safe requests without token and issue it", http.MethodGet, "/api/v1/pod", "", "",
+			http.StatusOK, true},
+		{"Should not issue new token if cookie has valid one", http.MethodGet, "/api/v1/pod", token, "",
+			http.StatusOK, false},
+		{"Should allow mutating requests with token in cookie and header", http.MethodPost, "/api/v1/namespace",
+			token, token, http.StatusOK, false},
+		{"Should reject mutating requests without header", http.MethodDelete, "/api/v1/_raw/pod/name/web", token,
+			"", http.StatusForbidden, false},
+		{"Should reject mutating requests with different header", http.MethodPost, "/api/v1/namespace", token,
+			forged, http.StatusForbidden, false},
+		{"Should reject mutating requests without cookie", http.MethodPost, "/api/v1/namespace", "", token,
+			http.StatusForbidden, true},
+		{"Should reject tokens not signed with the key", http.MethodPost, "/api/v1/namespace", forged, forged,
+			http.StatusForbidden, true},
+		{"Should not require token to refresh the session", http.MethodPost, "/api/v1/token/refresh", "", "",
+			http.StatusOK, true},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(c.method, c.path, nil)
+		if len(c.cookie) > 0 {
+			request.AddCookie(&http.Cookie{Name: csrfCookieName, Value: c.cookie})
+		}
+		if len(c.header) > 0 {
+			request.Header.Set(csrfHeaderName, c.header)
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+
+		issued := ""
+		for _, cookie := range recorder.Result().Cookies() {
+			if cookie.Name == csrfCookieName {
+				issued = cookie.Value
+			}
+		}
+
+		if (len(issued) > 0) != c.expectedCookie || (len(issued) > 0 && !tokens.valid(issued)) {
+			t.Errorf("Test Case: %s. Expected token to be issued: %t, but got cookie %q.", c.info, c.expectedCookie,
+				issued)
+		}
+	}
+}
+
+func TestCSRFFilterCredentials(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Filter(csrfFilter("test-key"))
+	ws.Route(ws.POST("/api/v1/namespace").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusOK)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info           string
+		authorization  string
+		jweCookie      bool
+		expectedStatus int
+	}{
+		{"Should not require token with credentials only in Authorization header", "Bearer token", false,
+			http.StatusOK},
+		{"Should require token with credentials in cookie", "", true, http.StatusForbidden},
+		{"Should require token with credentials in cookie and Authorization header", "Bearer token", true,
+			http.StatusForbidden},
+		{"Should require token without credentials", "", false, http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/namespace", nil)
+		if len(c.authorization) > 0 {
+			request.Header.Set("Authorization", c.authorization)
+		}
+		if c.jweCookie {
+			request.AddCookie(&http.Cookie{Name: auth.JWETokenCookieName, Value: "token"})
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
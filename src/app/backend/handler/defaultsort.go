@@ -0,0 +1,69 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+// Filter used to sort lists in the default order configured for their kind in settings if request does not specify
+// the order. Default sorts are validated when they are saved, so they are not validated again.
+func defaultSortFilter(getDefaultSorts func() map[string]settingsApi.DefaultSort) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if len(request.QueryParameter("sortBy")) > 0 {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		if kind, ok := listRouteKind(request.SelectedRoutePath()); ok {
+			if sort, exists := getDefaultSorts()[kind]; exists {
+				setDefaultSort(request.Request, sort)
+			}
+		}
+
+		chain.ProcessFilter(request, response)
+	}
+}
+
+// Returns kind of resources listed by the route, i.e. 'pod' for '/api/v1/pod/{namespace}' and 'event' for
+// '/api/v1/deployment/{namespace}/{deployment}/event'. Returns false if route does not list resources.
+func listRouteKind(route string) (string, bool) {
+	parts := strings.Split(strings.TrimPrefix(route, "/api/v1/"), "/")
+	if len(parts) > 1 && parts[len(parts)-1] == "{namespace}" {
+		parts = parts[:len(parts)-1]
+	}
+
+	kind := parts[len(parts)-1]
+	_, exists := api.KindToAPIMapping[kind]
+	return kind, exists
+}
+
+func setDefaultSort(request *http.Request, sort settingsApi.DefaultSort) {
+	query := request.URL.Query()
+	query.Set("sortBy", strings.Join(sort.SortBy, ","))
+	if len(sort.Order) > 0 && len(query.Get("order")) == 0 {
+		query.Set("order", sort.Order)
+	}
+
+	request.URL.RawQuery = query.Encode()
+	// Parsed form is dropped, so query parameters are parsed again with the default sort.
+	request.Form = nil
+}
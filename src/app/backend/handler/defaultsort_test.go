@@ -0,0 +1,91 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+func TestListRouteKind(t *testing.T) {
+	cases := []struct {
+		route        string
+		expectedKind string
+		expectedList bool
+	}{
+		{"/api/v1/pod", "pod", true},
+		{"/api/v1/pod/{namespace}", "pod", true},
+		{"/api/v1/deployment/{namespace}/{deployment}/event", "event", true},
+		{"/api/v1/node/{name}/pod", "pod", true},
+		{"/api/v1/pod/{namespace}/{pod}", "{pod}", false},
+		{"/api/v1/namespace/{name}/export", "export", false},
+	}
+
+	for _, c := range cases {
+		kind, isList := listRouteKind(c.route)
+		if isList != c.expectedList || (isList && kind != c.expectedKind) {
+			t.Errorf("listRouteKind(%s) == (%s, %t), expected (%s, %t)", c.route, kind, isList, c.expectedKind,
+				c.expectedList)
+		}
+	}
+}
+
+func TestDefaultSortFilter(t *testing.T) {
+	sorts := map[string]settingsApi.DefaultSort{
+		"pod":   {SortBy: []string{"creationTimestamp"}, Order: "desc"},
+		"event": {SortBy: []string{"lastTimestamp", "name"}},
+	}
+
+	var sortBy, order string
+	ws := new(restful.WebService)
+	ws.Filter(defaultSortFilter(func() map[string]settingsApi.DefaultSort { return sorts }))
+	ws.Path("/api/v1")
+	for _, route := range []string{"/pod/{namespace}", "/pod/{namespace}/{pod}/event", "/service"} {
+		ws.Route(ws.GET(route).To(func(request *restful.Request, response *restful.Response) {
+			sortBy, order = request.QueryParameter("sortBy"), request.QueryParameter("order")
+		}))
+	}
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info           string
+		path           string
+		expectedSortBy string
+		expectedOrder  string
+	}{
+		{"Should use default sort of the kind", "/api/v1/pod/default", "creationTimestamp", "desc"},
+		{"Should use default sort of events listed by other resources", "/api/v1/pod/default/web/event",
+			"lastTimestamp,name", ""},
+		{"Should keep sort of the request", "/api/v1/pod/default?sortBy=a,name", "a,name", ""},
+		{"Should keep order of the request", "/api/v1/pod/default?order=asc", "creationTimestamp", "asc"},
+		{"Should not sort kinds without default sort", "/api/v1/service", "", ""},
+	}
+
+	for _, c := range cases {
+		sortBy, order = "", ""
+		container.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if sortBy != c.expectedSortBy || order != c.expectedOrder {
+			t.Errorf("Test Case: %s. Expected sortBy %q and order %q, but got %q and %q.", c.info, c.expectedSortBy,
+				c.expectedOrder, sortBy, order)
+		}
+	}
+}
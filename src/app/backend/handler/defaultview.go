@@ -0,0 +1,72 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// DefaultView is the view users land on if --default-view is not set.
+const DefaultView = "workloads"
+
+// Namespace selector value of the frontend that selects all namespaces.
+const allNamespacesSelector = "_all"
+
+// Views of the frontend that can be used as the default view. Keep it in sync with routes defined in
+// chrome/routing.ts of the frontend.
+var defaultViews = map[string]bool{
+	"workloads": true, "overview": true, "cluster": true, "discovery": true, "config": true, "create": true,
+	"cronjob": true, "daemonset": true, "deployment": true, "job": true, "pod": true, "replicaset": true,
+	"replicationcontroller": true, "statefulset": true, "ingress": true, "service": true, "configmap": true,
+	"persistentvolumeclaim": true, "secret": true, "storageclass": true, "customresourcedefinition": true,
+	"clusterrolebinding": true, "clusterrole": true, "namespace": true, "networkpolicy": true, "node": true,
+	"persistentvolume": true, "serviceaccount": true, "role": true, "rolebinding": true, "plugin": true,
+	"settings": true, "about": true,
+}
+
+// ParseDefaultView parses value of --default-view in format '<view>' or '<view>?namespace=<namespace>', i.e.
+// 'overview?namespace=kube-system'. Returns error if the view is not known or namespace is not valid.
+func ParseDefaultView(value string) (view string, namespace string, err error) {
+	if len(value) == 0 {
+		return DefaultView, "", nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid --default-view %s: %s", value, err.Error())
+	}
+
+	view = strings.Trim(parsed.Path, "/")
+	if !defaultViews[view] {
+		return "", "", fmt.Errorf("invalid --default-view %s: unknown view %q", value, view)
+	}
+
+	query := parsed.Query()
+	namespace = query.Get("namespace")
+	query.Del("namespace")
+	if len(query) > 0 {
+		return "", "", fmt.Errorf("invalid --default-view %s: only namespace parameter is supported", value)
+	}
+
+	if len(namespace) > 0 && namespace != allNamespacesSelector && len(validation.IsDNS1123Label(namespace)) > 0 {
+		return "", "", fmt.Errorf("invalid --default-view %s: invalid namespace %q", value, namespace)
+	}
+
+	return view, namespace, nil
+}
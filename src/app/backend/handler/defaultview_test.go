@@ -0,0 +1,51 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"testing"
+)
+
+func TestParseDefaultView(t *testing.T) {
+	cases := []struct {
+		value             string
+		expectedView      string
+		expectedNamespace string
+		expectedErr       bool
+	}{
+		{"", DefaultView, "", false},
+		{"overview", "overview", "", false},
+		{"/pod", "pod", "", false},
+		{"overview?namespace=kube-system", "overview", "kube-system", false},
+		{"workloads?namespace=_all", "workloads", "_all", false},
+		{"unknown", "", "", true},
+		{"shell", "", "", true},
+		{"overview?namespace=Invalid_Namespace", "", "", true},
+		{"overview?namespace=default&filterBy=name", "", "", true},
+	}
+
+	for _, c := range cases {
+		view, namespace, err := ParseDefaultView(c.value)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("ParseDefaultView(%s) returned error %v, expected error: %t", c.value, err, c.expectedErr)
+			continue
+		}
+
+		if view != c.expectedView || namespace != c.expectedNamespace {
+			t.Errorf("ParseDefaultView(%s) == (%s, %s), expected (%s, %s)", c.value, view, namespace,
+				c.expectedView, c.expectedNamespace)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+
+	restful "github.com/emicklei/go-restful/v3"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Returns dry run option of the apiserver requested with the dryRun query parameter, i.e. '?dryRun=All'. Changes
+// requested with the option are validated and admitted, but not persisted.
+func parseDryRun(request *restful.Request) ([]string, error) {
+	switch dryRun := request.QueryParameter("dryRun"); dryRun {
+	case "":
+		return nil, nil
+	case metaV1.DryRunAll:
+		return []string{metaV1.DryRunAll}, nil
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("dryRun has to be '%s', got '%s'", metaV1.DryRunAll, dryRun))
+	}
+}
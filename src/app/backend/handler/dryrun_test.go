@@ -0,0 +1,47 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	restful "github.com/emicklei/go-restful/v3"
+)
+
+func TestParseDryRun(t *testing.T) {
+	cases := []struct {
+		info        string
+		url         string
+		expected    []string
+		expectedErr bool
+	}{
+		{"Should not request dry run by default", "/api/v1/appdeploymentfromfile", nil, false},
+		{"Should request dry run of all stages", "/api/v1/appdeploymentfromfile?dryRun=All", []string{"All"}, false},
+		{"Should reject unsupported dry run value", "/api/v1/appdeploymentfromfile?dryRun=true", nil, true},
+	}
+
+	for _, c := range cases {
+		actual, err := parseDryRun(restful.NewRequest(httptest.NewRequest("POST", c.url, nil)))
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %v, but got %v.", c.info, c.expected, actual)
+		}
+	}
+}
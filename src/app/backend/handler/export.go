@@ -0,0 +1,279 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+)
+
+// Kinds that are not exported, because their objects are generated by the cluster.
+var exportExcludedKinds = map[string]bool{
+	api.ResourceKindEvent:    true,
+	api.ResourceKindEndpoint: true,
+}
+
+// Type of the secrets that are generated for service accounts and are never exported.
+const serviceAccountTokenSecretType = "kubernetes.io/service-account-token"
+
+// Warning returned when secrets are included in the export.
+const exportSecretsWarning = "Export contains secrets of the namespace in plain text, store it securely"
+
+// namespaceExport contains clean manifests of all objects of a namespace grouped by kind. Kinds that could not be
+// exported are listed in errors, so that the rest of the objects can still be downloaded.
+type namespaceExport struct {
+	namespace string
+	kinds     []string
+	manifests map[string][][]byte
+	errors    map[string]error
+	warnings  []string
+}
+
+// Exports the namespace object and all objects in the namespace. Secrets are exported only if includeSecrets is true.
+// Objects owned by other objects, i.e. pods of a replica set, are skipped as they are recreated by their owners.
+func exportNamespace(verber clientapi.ResourceVerber, namespace string, includeSecrets bool) *namespaceExport {
+	log.Printf("Exporting all resources in the namespace %s", namespace)
+	export := &namespaceExport{
+		namespace: namespace,
+		manifests: map[string][][]byte{},
+		errors:    map[string]error{},
+	}
+
+	if includeSecrets {
+		export.warnings = append(export.warnings, exportSecretsWarning)
+	}
+
+	if raw, err := verber.Get(api.ResourceKindNamespace, false, "", namespace); err != nil {
+		export.addError(api.ResourceKindNamespace, err)
+	} else {
+		export.addManifest(api.ResourceKindNamespace, raw.(*runtime.Unknown).Raw)
+	}
+
+	for _, kind := range exportedKinds(includeSecrets) {
+		raw, err := verber.List(kind, namespace, metaV1.ListOptions{})
+		if err != nil {
+			export.addError(kind, err)
+			continue
+		}
+
+		list := &unstructured.UnstructuredList{}
+		if err := list.UnmarshalJSON(raw.(*runtime.Unknown).Raw); err != nil {
+			export.addError(kind, err)
+			continue
+		}
+
+		export.addItems(kind, list.Items)
+	}
+
+	return export
+}
+
+// Adds objects of all namespaced custom resources to the export. They are grouped by the name of their definition,
+// i.e. 'foos.example.com', and listed in their first version, the same way as on the custom resource pages.
+func (self *namespaceExport) addCustomResources(crdClient apiextensionsclientset.Interface,
+	dynamicClient dynamic.Interface) {
+	crds, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().List(context.TODO(), metaV1.ListOptions{})
+	if err != nil {
+		self.addError(api.ResourceKindCustomResourceDefinition, err)
+		return
+	}
+
+	sort.Slice(crds.Items, func(i, j int) bool { return crds.Items[i].Name < crds.Items[j].Name })
+	for _, crd := range crds.Items {
+		if crd.Spec.Scope != apiextensions.NamespaceScoped || len(crd.Spec.Versions) == 0 {
+			continue
+		}
+
+		resource := schema.GroupVersionResource{Group: crd.Spec.Group, Version: crd.Spec.Versions[0].Name,
+			Resource: crd.Spec.Names.Plural}
+		list, err := dynamicClient.Resource(resource).Namespace(self.namespace).List(context.TODO(),
+			metaV1.ListOptions{})
+		if err != nil {
+			self.addError(crd.Name, err)
+			continue
+		}
+
+		self.addItems(crd.Name, list.Items)
+	}
+}
+
+// Returns sorted names of the namespaced kinds that are exported.
+func exportedKinds(includeSecrets bool) []string {
+	kinds := make([]string, 0)
+	for kind, mapping := range api.KindToAPIMapping {
+		if mapping.Namespaced && !exportExcludedKinds[kind] && (includeSecrets || kind != api.ResourceKindSecret) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	sort.Strings(kinds)
+	return kinds
+}
+
+func isExported(item *unstructured.Unstructured) bool {
+	if len(item.GetOwnerReferences()) > 0 {
+		return false
+	}
+
+	secretType, _, _ := unstructured.NestedString(item.Object, "type")
+	return item.GetKind() != "Secret" || secretType != serviceAccountTokenSecretType
+}
+
+func (self *namespaceExport) addItems(kind string, items []unstructured.Unstructured) {
+	for _, item := range items {
+		if !isExported(&item) {
+			continue
+		}
+
+		data, err := item.MarshalJSON()
+		if err != nil {
+			self.addError(kind, err)
+			continue
+		}
+		self.addManifest(kind, data)
+	}
+}
+
+func (self *namespaceExport) addManifest(kind string, raw []byte) {
+	manifest, err := toCleanManifest(raw, false)
+	if err != nil {
+		self.addError(kind, err)
+		return
+	}
+
+	if _, exists := self.manifests[kind]; !exists {
+		self.kinds = append(self.kinds, kind)
+	}
+	self.manifests[kind] = append(self.manifests[kind], manifest)
+}
+
+// Only the first error of the kind is kept, they are usually the same for all objects of the kind.
+func (self *namespaceExport) addError(kind string, err error) {
+	log.Printf("Could not export %s in the namespace %s: %s", kind, self.namespace, err)
+	if _, exists := self.errors[kind]; !exists {
+		self.errors[kind] = err
+	}
+}
+
+// Returns summary of the warnings and errors as YAML comments, so that the bundle can still be applied. Returns nil
+// if the export finished without any.
+func (self *namespaceExport) summary() []byte {
+	if len(self.errors) == 0 && len(self.warnings) == 0 {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "# Export summary of the namespace %s\n", self.namespace)
+	for _, warning := range self.warnings {
+		fmt.Fprintf(buf, "# WARNING: %s\n", warning)
+	}
+
+	kinds := make([]string, 0, len(self.errors))
+	for kind := range self.errors {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	if len(kinds) > 0 {
+		fmt.Fprintf(buf, "# Objects of %d kinds could not be exported:\n", len(kinds))
+	}
+
+	for _, kind := range kinds {
+		fmt.Fprintf(buf, "# - %s: %s\n", kind, strings.ReplaceAll(self.errors[kind].Error(), "\n", " "))
+	}
+
+	return buf.Bytes()
+}
+
+// Returns all manifests as a multi-document YAML. Summary, if any, is the first document.
+func (self *namespaceExport) toYAML() []byte {
+	documents := make([][]byte, 0)
+	if summary := self.summary(); summary != nil {
+		documents = append(documents, summary)
+	}
+
+	for _, kind := range self.kinds {
+		documents = append(documents, self.manifests[kind]...)
+	}
+
+	return joinYAMLDocuments(documents)
+}
+
+// Returns tar archive with a multi-document YAML file per kind, i.e. 'deployment.yaml', and the summary in
+// 'summary.txt' if there are any warnings or errors.
+func (self *namespaceExport) toTar() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := tar.NewWriter(buf)
+	files := make([]string, 0, len(self.kinds)+1)
+	contents := map[string][]byte{}
+	if summary := self.summary(); summary != nil {
+		files = append(files, "summary.txt")
+		contents["summary.txt"] = summary
+	}
+
+	for _, kind := range self.kinds {
+		name := kind + ".yaml"
+		files = append(files, name)
+		contents[name] = joinYAMLDocuments(self.manifests[kind])
+	}
+
+	now := time.Now()
+	for _, name := range files {
+		header := &tar.Header{Name: self.namespace + "/" + name, Mode: 0644, Size: int64(len(contents[name])),
+			ModTime: now}
+		if err := writer.WriteHeader(header); err != nil {
+			return nil, err
+		}
+
+		if _, err := writer.Write(contents[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func joinYAMLDocuments(documents [][]byte) []byte {
+	buf := &bytes.Buffer{}
+	for i, document := range documents {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(document)
+	}
+
+	return buf.Bytes()
+}
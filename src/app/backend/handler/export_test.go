@@ -0,0 +1,232 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	crdfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+)
+
+// exportVerber returns lists of objects by kind. Kinds without a list return forbidden error.
+type exportVerber struct {
+	clientapi.ResourceVerber
+	lists map[string]string
+}
+
+func (self *exportVerber) Get(kind string, namespaceSet bool, namespace, name string) (runtime.Object, error) {
+	return &runtime.Unknown{Raw: []byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"` + name +
+		`","uid":"1","resourceVersion":"2"},"status":{"phase":"Active"}}`)}, nil
+}
+
+func (self *exportVerber) List(kind string, namespace string, opts metaV1.ListOptions) (runtime.Object,
+	error) {
+	if list, ok := self.lists[kind]; ok {
+		return &runtime.Unknown{Raw: []byte(list)}, nil
+	}
+
+	if kind == api.ResourceKindCronJob {
+		return nil, errors.New("cronjobs.batch is forbidden")
+	}
+
+	return &runtime.Unknown{Raw: []byte(`{"apiVersion":"v1","kind":"List","items":[]}`)}, nil
+}
+
+func newExportVerber() *exportVerber {
+	return &exportVerber{lists: map[string]string{
+		api.ResourceKindConfigMap: `{"apiVersion":"v1","kind":"ConfigMapList","items":[
+			{"metadata":{"name":"config","namespace":"foo","managedFields":[],"creationTimestamp":"2021-01-01T00:00:00Z"},
+			 "data":{"key":"value"}}]}`,
+		api.ResourceKindPod: `{"apiVersion":"v1","kind":"PodList","items":[
+			{"metadata":{"name":"owned","namespace":"foo","ownerReferences":[{"kind":"ReplicaSet","name":"rs"}]}},
+			{"metadata":{"name":"standalone","namespace":"foo"},"status":{"phase":"Running"}}]}`,
+		api.ResourceKindSecret: `{"apiVersion":"v1","kind":"SecretList","items":[
+			{"metadata":{"name":"password","namespace":"foo"},"type":"Opaque"},
+			{"metadata":{"name":"token","namespace":"foo"},"type":"kubernetes.io/service-account-token"}]}`,
+	}}
+}
+
+func TestExportNamespace(t *testing.T) {
+	cases := []struct {
+		info             string
+		includeSecrets   bool
+		expectedKinds    []string
+		expectedWarnings int
+	}{
+		{"Should exclude secrets by default", false, []string{"namespace", "configmap", "pod"}, 0},
+		{"Should include secrets with a warning", true, []string{"namespace", "configmap", "pod", "secret"}, 1},
+	}
+
+	for _, c := range cases {
+		export := exportNamespace(newExportVerber(), "foo", c.includeSecrets)
+
+		if !reflect.DeepEqual(export.kinds, c.expectedKinds) {
+			t.Errorf("Test Case: %s. Expected kinds %v, but got %v.", c.info, c.expectedKinds, export.kinds)
+		}
+
+		if len(export.warnings) != c.expectedWarnings {
+			t.Errorf("Test Case: %s. Expected %d warnings, but got %v.", c.info, c.expectedWarnings, export.warnings)
+		}
+
+		if _, exists := export.errors[api.ResourceKindCronJob]; !exists || len(export.errors) != 1 {
+			t.Errorf("Test Case: %s. Expected error of cron jobs, but got %v.", c.info, export.errors)
+		}
+
+		if len(export.manifests[api.ResourceKindPod]) != 1 || len(export.manifests[api.ResourceKindSecret]) > 1 {
+			t.Errorf("Test Case: %s. Expected owned pods and tokens to be skipped, but got %d pods and %d secrets.",
+				c.info, len(export.manifests[api.ResourceKindPod]), len(export.manifests[api.ResourceKindSecret]))
+		}
+	}
+}
+
+func newCustomResourceDefinition(name, group, plural string,
+	scope apiextensions.ResourceScope) *apiextensions.CustomResourceDefinition {
+	return &apiextensions.CustomResourceDefinition{
+		ObjectMeta: metaV1.ObjectMeta{Name: name},
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Group:    group,
+			Names:    apiextensions.CustomResourceDefinitionNames{Plural: plural},
+			Scope:    scope,
+			Versions: []apiextensions.CustomResourceDefinitionVersion{{Name: "v1"}},
+		},
+	}
+}
+
+func newCustomResource(kind, namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace, "uid": "1"},
+	}}
+}
+
+func TestNamespaceExportAddCustomResources(t *testing.T) {
+	crdClient := crdfake.NewSimpleClientset(
+		newCustomResourceDefinition("foos.example.com", "example.com", "foos", apiextensions.NamespaceScoped),
+		newCustomResourceDefinition("bars.example.com", "example.com", "bars", apiextensions.ClusterScoped),
+	)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: "example.com", Version: "v1", Resource: "foos"}: "FooList",
+			{Group: "example.com", Version: "v1", Resource: "bars"}: "BarList",
+		},
+		newCustomResource("Foo", "foo", "a"),
+		newCustomResource("Foo", "bar", "b"),
+		newCustomResource("Bar", "", "c"),
+	)
+
+	export := exportNamespace(newExportVerber(), "foo", false)
+	export.addCustomResources(crdClient, dynamicClient)
+
+	manifests := export.manifests["foos.example.com"]
+	if len(manifests) != 1 || !strings.Contains(string(manifests[0]), "name: a\n") {
+		t.Errorf("Expected custom resource of the namespace to be exported, but got %q.", manifests)
+	}
+
+	if _, exists := export.manifests["bars.example.com"]; exists {
+		t.Errorf("Expected cluster-scoped custom resources to be skipped, but got %v.", export.kinds)
+	}
+
+	crdClient = crdfake.NewSimpleClientset()
+	crdClient.PrependReactor("list", "customresourcedefinitions", func(action core.Action) (bool,
+		runtime.Object, error) {
+		return true, nil, errors.New("customresourcedefinitions is forbidden")
+	})
+	export.addCustomResources(crdClient, dynamicClient)
+
+	if _, exists := export.errors[api.ResourceKindCustomResourceDefinition]; !exists {
+		t.Errorf("Expected error of custom resource definitions in the summary, but got %v.", export.errors)
+	}
+}
+
+func TestNamespaceExportToYAML(t *testing.T) {
+	bundle := string(exportNamespace(newExportVerber(), "foo", false).toYAML())
+
+	documents := strings.Split(bundle, "---\n")
+	if len(documents) != 4 {
+		t.Fatalf("Expected summary and 3 manifests, but got:\n%s", bundle)
+	}
+
+	if !strings.HasPrefix(documents[0], "# Export summary of the namespace foo\n") ||
+		!strings.Contains(documents[0], "# - cronjob: cronjobs.batch is forbidden\n") {
+		t.Errorf("Expected summary with the error of cron jobs, but got:\n%s", documents[0])
+	}
+
+	for _, field := range []string{"uid:", "resourceVersion:", "managedFields:", "creationTimestamp:", "status:"} {
+		if strings.Contains(bundle, field) {
+			t.Errorf("Expected %s to be removed, but got:\n%s", field, bundle)
+		}
+	}
+
+	if !strings.Contains(documents[2], "\nkind: ConfigMap\n") {
+		t.Errorf("Expected kind of the list items to be set, but got:\n%s", documents[2])
+	}
+}
+
+func TestNamespaceExportToTar(t *testing.T) {
+	archive, err := exportNamespace(newExportVerber(), "foo", true).toTar()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{}
+	reader := tar.NewReader(bytes.NewReader(archive))
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content, _ := ioutil.ReadAll(reader)
+		files[header.Name] = string(content)
+	}
+
+	expected := []string{"foo/summary.txt", "foo/namespace.yaml", "foo/configmap.yaml", "foo/pod.yaml",
+		"foo/secret.yaml"}
+	for _, name := range expected {
+		if _, exists := files[name]; !exists {
+			t.Errorf("Expected file %s in the archive, but got %v", name, reflect.ValueOf(files).MapKeys())
+		}
+	}
+
+	if !strings.Contains(files["foo/summary.txt"], "WARNING: "+exportSecretsWarning) {
+		t.Errorf("Expected warning about secrets in the summary, but got:\n%s", files["foo/summary.txt"])
+	}
+
+	if strings.Contains(files["foo/secret.yaml"], "name: token") {
+		t.Errorf("Expected service account tokens to be skipped, but got:\n%s", files["foo/secret.yaml"])
+	}
+}
@@ -17,6 +17,7 @@ package handler
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -24,27 +25,128 @@ import (
 	"time"
 
 	"github.com/emicklei/go-restful/v3"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/xsrftoken"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/audit"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/discoverycache"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/handler/parser"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
+	"github.com/kubernetes/dashboard/src/app/backend/tracing"
 )
 
-const (
-	originalForwardedForHeader = "X-Original-Forwarded-For"
-	forwardedForHeader         = "X-Forwarded-For"
-	realIPHeader               = "X-Real-Ip"
-)
+// Routes that do not modify any resources, but do not use GET method. They are available in read-only mode.
+var readOnlyAllowedRoutes = map[string]bool{
+	"/api/v1/login":                                 true,
+	"/api/v1/token/refresh":                         true,
+	"/api/v1/appdeployment/validate/name":           true,
+	"/api/v1/appdeployment/validate/imagereference": true,
+	"/api/v1/appdeployment/validate/protocol":       true,
+	"/api/v1/appdeployment/validate/file":           true,
+	"/api/v1/kubecontext/{name}":                    true,
+}
+
+// Routes that use GET method, but allow interaction with containers. They are not available in read-only mode.
+var readOnlyRestrictedRoutes = map[string]bool{
+	"/api/v1/pod/{namespace}/{pod}/shell/{container}":  true,
+	"/api/v1/pod/{namespace}/{pod}/portforward/{port}": true,
+}
 
 // InstallFilters installs defined filter for given web service
 func InstallFilters(ws *restful.WebService, manager clientapi.ClientManager) {
+	if args.Holder.GetEnableCompression() {
+		ws.Filter(compressionFilter(args.Holder.GetCompressionMinBytes()))
+	}
+	ws.Filter(requestIDFilter)
+	if tracing.Enabled() {
+		ws.Filter(tracingFilter)
+	}
+	ws.Filter(maxRequestBodyFilter(args.Holder.GetMaxRequestBodyBytes()))
 	ws.Filter(requestAndResponseLogger)
+	if audit.Enabled() {
+		ws.Filter(auditFilter(manager))
+	}
+	ws.Filter(streamingFilter)
 	ws.Filter(metricsFilter)
 	ws.Filter(validateXSRFFilter(manager.CSRFKey()))
+	if args.Holder.GetEnableCSRF() {
+		ws.Filter(csrfFilter(manager.CSRFKey()))
+	}
 	ws.Filter(restrictedResourcesFilter)
+	ws.Filter(namespaceAllowlistFilter)
+	if allowed, _ := ParseAllowedResources(args.Holder.GetAllowedResources()); allowed != nil {
+		ws.Filter(allowedResourcesFilter(allowed))
+	}
+	if args.Holder.GetAnonymousAccess() {
+		allowed, _ := ParseAllowedResources(args.Holder.GetAnonymousResources())
+		ws.Filter(anonymousAccessFilter(allowed))
+	}
+	ws.Filter(readOnlyFilter)
+	ws.Filter(dataSelectFilter)
+
+	if args.Holder.GetRateLimitQPS() > 0 {
+		ws.Filter(newRateLimiter(args.Holder.GetRateLimitQPS(), args.Holder.GetRateLimitBurst(),
+			manager.VerifyCredentials).Filter)
+	}
+
+	// Installed after the rate limiter, so that verification of unknown sessions is limited too.
+	if args.Holder.GetSessionIdleTimeout() > 0 {
+		idleSessions = newSessionTracker(time.Duration(args.Holder.GetSessionIdleTimeout())*time.Second,
+			sessionRetention(), manager.VerifyCredentials)
+		ws.Filter(idleSessions.Filter)
+	}
+
+	ws.Filter(cacheBustFilter(manager))
+}
+
+// Filter used to reject all requests that could modify resources or interact with containers when dashboard
+// runs in read-only mode.
+func readOnlyFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if !args.Holder.GetReadOnly() || isReadOnlyRequest(request.Request.Method, request.SelectedRoutePath()) {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	errors.HandleInternalError(response, errors.NewForbidden("Dashboard runs in read-only mode, modifying resources and interacting with containers is disabled"))
+}
+
+// Filter used to reject requests that sort lists by unsupported properties before they reach the apiserver.
+func dataSelectFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if err := parser.ValidateDataSelectPathParameter(request); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	chain.ProcessFilter(request, response)
+}
+
+// Returns filter used to drop cached discovery results when client asks for fresh ones with 'Cache-Control: no-cache'
+// header. Cache is shared by all users, so only users whose credentials are accepted by the apiserver can drop it.
+// Installed after the rate limiter, so that verification of the credentials is limited too.
+func cacheBustFilter(manager clientapi.ClientManager) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if discoverycache.IsCacheBust(request.Request) && len(client.CredentialsKey(request.Request)) > 0 &&
+			manager.VerifyCredentials(request) == nil {
+			discoverycache.DefaultCache.Invalidate()
+		}
+
+		chain.ProcessFilter(request, response)
+	}
+}
+
+func isReadOnlyRequest(method, route string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return !readOnlyRestrictedRoutes[route]
+	default:
+		return readOnlyAllowedRoutes[route]
+	}
 }
 
 // Filter used to restrict access to dashboard exclusive resource, i.e. secret used to store dashboard encryption key.
@@ -61,14 +163,44 @@ func restrictedResourcesFilter(request *restful.Request, response *restful.Respo
 // web-service filter function used for request and response logging.
 func requestAndResponseLogger(request *restful.Request, response *restful.Response,
 	chain *restful.FilterChain) {
-	if args.Holder.GetAPILogLevel() != "NONE" {
-		log.Printf(formatRequestLog(request))
+	if args.Holder.GetAPILogLevel() == "NONE" {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	if logging.IsJSON() {
+		start := time.Now()
+		logging.Info("Incoming request", requestLogFields(request))
+		chain.ProcessFilter(request, response)
+		logging.Info("Outcoming response", responseLogFields(response, request, time.Since(start)))
+		return
 	}
 
+	log.Printf(formatRequestLog(request))
 	chain.ProcessFilter(request, response)
+	log.Printf(formatResponseLog(response, request))
+}
+
+// requestLogFields returns structured request log fields used by JSON log format.
+func requestLogFields(request *restful.Request) logging.Fields {
+	return logging.Fields{
+		"method":     request.Request.Method,
+		"path":       request.Request.URL.Path,
+		"proto":      request.Request.Proto,
+		"remoteAddr": getRemoteAddr(request.Request),
+		"requestID":  logging.RequestID(request.Request.Context()),
+	}
+}
 
-	if args.Holder.GetAPILogLevel() != "NONE" {
-		log.Printf(formatResponseLog(response, request))
+// responseLogFields returns structured response log fields used by JSON log format. Latency is given in seconds.
+func responseLogFields(response *restful.Response, request *restful.Request, latency time.Duration) logging.Fields {
+	return logging.Fields{
+		"method":     request.Request.Method,
+		"path":       request.Request.URL.Path,
+		"remoteAddr": getRemoteAddr(request.Request),
+		"status":     response.StatusCode(),
+		"latency":    latency.Seconds(),
+		"requestID":  logging.RequestID(request.Request.Context()),
 	}
 }
 
@@ -86,8 +218,9 @@ func formatRequestLog(request *restful.Request) string {
 		content = string(byteArr)
 	}
 
-	// Restore request body so we can read it again in regular request handlers
-	request.Request.Body = ioutil.NopCloser(bytes.NewReader(byteArr))
+	// Restore request body so we can read it again in regular request handlers. Read error, i.e. exceeded body size
+	// limit, is passed to the handlers as well.
+	request.Request.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(byteArr), errorReader{err: err}))
 
 	// Is DEBUG level logging enabled? Yes?
 	// Great now let's filter out any content from sensitive URLs
@@ -99,6 +232,19 @@ func formatRequestLog(request *restful.Request) string {
 		request.Request.Method, uri, getRemoteAddr(request.Request), content)
 }
 
+// errorReader returns the given error on every read or io.EOF if the error is nil.
+type errorReader struct {
+	err error
+}
+
+func (self errorReader) Read([]byte) (int, error) {
+	if self.err == nil {
+		return 0, io.EOF
+	}
+
+	return 0, self.err
+}
+
 // formatResponseLog formats response log string.
 func formatResponseLog(response *restful.Response, request *restful.Request) string {
 	return fmt.Sprintf(ResponseLogString, time.Now().Format(time.RFC3339),
@@ -125,20 +271,28 @@ func metricsFilter(req *restful.Request, resp *restful.Response,
 	chain *restful.FilterChain) {
 	resource := mapUrlToResource(req.SelectedRoutePath())
 	httpClient := utilnet.GetHTTPClient(req.Request)
+	reqStart := time.Now()
 
 	chain.ProcessFilter(req, resp)
 
+	monitorRequest(req.Request.Method, req.SelectedRoutePath(), resp.StatusCode(), reqStart)
 	if resource != nil {
 		monitor(
 			req.Request.Method,
 			*resource, httpClient,
 			resp.Header().Get("Content-Type"),
 			resp.StatusCode(),
-			time.Now(),
+			reqStart,
 		)
 	}
 }
 
+// Filter used to name span of the request after its route, so spans of requests for different objects are grouped.
+func tracingFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	trace.SpanFromContext(request.Request.Context()).SetName(request.Request.Method + " " + request.SelectedRoutePath())
+	chain.ProcessFilter(request, response)
+}
+
 func validateXSRFFilter(csrfKey string) restful.FilterFunction {
 	return func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
 		resource := mapUrlToResource(req.SelectedRoutePath())
@@ -183,26 +337,3 @@ func mapUrlToResource(url string) *string {
 	}
 	return &parts[3]
 }
-
-// getRemoteAddr extracts the remote address of the request, taking into
-// account proxy headers.
-func getRemoteAddr(r *http.Request) string {
-	if ip := getRemoteIPFromForwardHeader(r, originalForwardedForHeader); ip != "" {
-		return ip
-	}
-
-	if ip := getRemoteIPFromForwardHeader(r, forwardedForHeader); ip != "" {
-		return ip
-	}
-
-	if realIP := strings.TrimSpace(r.Header.Get(realIPHeader)); realIP != "" {
-		return realIP
-	}
-
-	return r.RemoteAddr
-}
-
-func getRemoteIPFromForwardHeader(r *http.Request, header string) string {
-	ips := strings.Split(r.Header.Get(header), ",")
-	return strings.TrimSpace(ips[0])
-}
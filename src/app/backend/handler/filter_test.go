@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/discoverycache"
+)
+
+// verifyingClientManager accepts only "valid" token.
+type verifyingClientManager struct {
+	clientapi.ClientManager
+}
+
+func (self *verifyingClientManager) VerifyCredentials(request *restful.Request) error {
+	if request.Request.Header.Get("Authorization") != "Bearer valid" {
+		return errors.New("Unauthorized")
+	}
+	return nil
+}
+
+func TestCacheBustFilter(t *testing.T) {
+	defer discoverycache.DefaultCache.Invalidate()
+	defer args.GetHolderBuilder().SetDiscoveryRefreshInterval(0)
+	args.GetHolderBuilder().SetDiscoveryRefreshInterval(60)
+
+	ws := new(restful.WebService)
+	ws.Filter(cacheBustFilter(&verifyingClientManager{}))
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusOK)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api" {
+			w.Write([]byte(`{"kind":"APIVersions","versions":["v1"]}`))
+			return
+		}
+		w.Write([]byte(`{"kind":"APIGroupList","groups":[]}`))
+	}))
+	defer server.Close()
+	client := discovery.NewDiscoveryClientForConfigOrDie(&rest.Config{Host: server.URL})
+
+	cases := []struct {
+		info          string
+		authorization string
+		expectedBust  bool
+	}{
+		{"Unauthenticated request should not drop the cache", "", false},
+		{"Request with invalid token should not drop the cache", "Bearer random", false},
+		{"Request with valid token should drop the cache", "Bearer valid", true},
+	}
+
+	for _, c := range cases {
+		if _, err := discoverycache.Wrap(client).ServerGroups(); err != nil {
+			t.Fatalf("Test Case: %s. Expected groups to be cached, but got error: %s.", c.info, err)
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "/test", nil)
+		request.Header.Set("Cache-Control", "no-cache")
+		if len(c.authorization) > 0 {
+			request.Header.Set("Authorization", c.authorization)
+		}
+		container.ServeHTTP(httptest.NewRecorder(), request)
+
+		if busted := discoverycache.DefaultCache.Refreshed(client).IsZero(); busted != c.expectedBust {
+			t.Errorf("Test Case: %s. Expected cache to be dropped: %t, but got %t.", c.info, c.expectedBust, busted)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
+)
+
+// HealthCheck verifies that a single dependency of dashboard is healthy. It should return an error otherwise.
+type HealthCheck func(ctx context.Context) error
+
+// HealthHandler serves health endpoints such as '/readyz'. It reports healthy state only if all registered checks
+// pass within configured timeout.
+type HealthHandler struct {
+	checks  map[string]HealthCheck
+	timeout time.Duration
+}
+
+// AddCheck registers health check with given name.
+func (handler *HealthHandler) AddCheck(name string, check HealthCheck) *HealthHandler {
+	handler.checks[name] = check
+	return handler
+}
+
+// ServeHTTP runs all registered checks and responds with 200 if all of them pass or 503 otherwise.
+func (handler *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handler.timeout)
+	defer cancel()
+
+	names := make([]string, 0, len(handler.checks))
+	for name := range handler.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	healthy := true
+	output := bytes.Buffer{}
+	for _, name := range names {
+		if err := handler.checks[name](ctx); err != nil {
+			healthy = false
+			fmt.Fprintf(&output, "[-]%s failed: %s\n", name, err)
+			continue
+		}
+
+		fmt.Fprintf(&output, "[+]%s ok\n", name)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if !healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	w.Write(output.Bytes())
+}
+
+// CreateHealthHandler creates health handler that bounds duration of all checks with given timeout.
+func CreateHealthHandler(timeout time.Duration) *HealthHandler {
+	return &HealthHandler{checks: map[string]HealthCheck{}, timeout: timeout}
+}
+
+// APIServerHealthCheck returns health check that verifies if apiserver can be reached using given client. It runs
+// a lightweight '/version' request.
+func APIServerHealthCheck(client kubernetes.Interface) HealthCheck {
+	return func(ctx context.Context) error {
+		return client.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Error()
+	}
+}
+
+// RouterHealthCheck returns health check that sends request with given path through the router and verifies that it
+// is answered with 200. It fails if the request is not answered before the check times out, i.e. when handlers are
+// stuck, so the process can be restarted.
+func RouterHealthCheck(router http.Handler, path string) HealthCheck {
+	return func(ctx context.Context) error {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return err
+		}
+
+		status := make(chan int, 1)
+		go func() {
+			writer := &statusWriter{header: http.Header{}}
+			router.ServeHTTP(writer, request)
+			status <- writer.Status()
+		}()
+
+		select {
+		case code := <-status:
+			if code != http.StatusOK {
+				return fmt.Errorf("%s responded with %d status code", path, code)
+			}
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("%s did not respond: %s", path, ctx.Err())
+		}
+	}
+}
+
+// statusWriter is http.ResponseWriter that discards the body and keeps only the status code.
+type statusWriter struct {
+	header http.Header
+	code   int
+}
+
+// Header implements http.ResponseWriter interface.
+func (self *statusWriter) Header() http.Header {
+	return self.header
+}
+
+// Write implements http.ResponseWriter interface.
+func (self *statusWriter) Write(p []byte) (int, error) {
+	if self.code == 0 {
+		self.code = http.StatusOK
+	}
+	return len(p), nil
+}
+
+// WriteHeader implements http.ResponseWriter interface.
+func (self *statusWriter) WriteHeader(code int) {
+	if self.code == 0 {
+		self.code = code
+	}
+}
+
+// Status returns written status code or 200 if nothing was written.
+func (self *statusWriter) Status() int {
+	if self.code == 0 {
+		return http.StatusOK
+	}
+	return self.code
+}
+
+// EncryptionKeyHealthCheck returns health check that verifies if encryption key used to generate tokens can be loaded
+// from the secret in dashboard namespace. Logins fail if it is removed or can not be read anymore.
+func EncryptionKeyHealthCheck(keyHolder jwe.KeyHolder, client kubernetes.Interface) HealthCheck {
+	return func(ctx context.Context) error {
+		return keyHolder.Check(ctx, client)
+	}
+}
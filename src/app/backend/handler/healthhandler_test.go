@@ -0,0 +1,91 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthHandler_ServeHTTP(t *testing.T) {
+	passing := func(ctx context.Context) error { return nil }
+	failing := func(ctx context.Context) error { return errors.New("unreachable") }
+	hanging := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	cases := []struct {
+		info     string
+		checks   map[string]HealthCheck
+		expected int
+	}{
+		{"no checks", map[string]HealthCheck{}, http.StatusOK},
+		{"passing checks", map[string]HealthCheck{"a": passing, "b": passing}, http.StatusOK},
+		{"failing check", map[string]HealthCheck{"a": passing, "b": failing}, http.StatusServiceUnavailable},
+		{"check exceeding timeout", map[string]HealthCheck{"a": hanging}, http.StatusServiceUnavailable},
+	}
+
+	for _, c := range cases {
+		handler := CreateHealthHandler(10 * time.Millisecond)
+		for name, check := range c.checks {
+			handler.AddCheck(name, check)
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if recorder.Code != c.expected {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expected, recorder.Code)
+		}
+	}
+}
+
+func TestRouterHealthCheck(t *testing.T) {
+	stuck := make(chan struct{})
+	defer close(stuck)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/stuck", func(w http.ResponseWriter, r *http.Request) {
+		<-stuck
+	})
+
+	cases := []struct {
+		info        string
+		path        string
+		expectedErr bool
+	}{
+		{"Should pass when request is answered", "/ping", false},
+		{"Should fail when request is answered with error", "/error", true},
+		{"Should fail when request is not answered before timeout", "/stuck", true},
+	}
+
+	for _, c := range cases {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		err := RouterHealthCheck(mux, c.path)(ctx)
+		cancel()
+
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Prefixes of API paths served by streaming handlers outside of the REST API.
+var streamingPathPrefixes = []string{"/api/sockjs/", "/api/watch/"}
+
+// InFlightLimiter bounds number of API requests and streaming connections handled at the same time. Streaming
+// connections are counted separately, as they are kept open for a long time.
+type InFlightLimiter struct {
+	requests chan struct{}
+	streams  chan struct{}
+}
+
+// Handler returns handler that rejects requests over the limit with 503 status code and Retry-After header. Liveness
+// ping is not limited, so busy dashboard is not restarted.
+func (self *InFlightLimiter) Handler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		semaphore := self.requests
+		if isStreamingRequest(r) {
+			semaphore = self.streams
+		}
+
+		if semaphore == nil || r.URL.Path == PingPath {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+			defer func() { <-semaphore }()
+			handler.ServeHTTP(w, r)
+		default:
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "Too many requests in flight, try again later", http.StatusServiceUnavailable)
+		}
+	})
+}
+
+func isStreamingRequest(r *http.Request) bool {
+	for _, prefix := range streamingPathPrefixes {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return true
+		}
+	}
+
+	return isStreamingPath(r.URL.Path)
+}
+
+// NewInFlightLimiter creates limiter allowing given number of API requests and streaming connections at the same
+// time. Limit equal to 0 disables it.
+func NewInFlightLimiter(maxRequests, maxStreams int) *InFlightLimiter {
+	limiter := &InFlightLimiter{}
+	if maxRequests > 0 {
+		limiter.requests = make(chan struct{}, maxRequests)
+	}
+
+	if maxStreams > 0 {
+		limiter.streams = make(chan struct{}, maxStreams)
+	}
+
+	return limiter
+}
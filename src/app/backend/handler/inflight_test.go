@@ -0,0 +1,83 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestInFlightLimiter(t *testing.T) {
+	cases := []struct {
+		info        string
+		maxRequests int
+		maxStreams  int
+		held        []string
+		path        string
+		expected    int
+	}{
+		{"Should allow requests when limits are disabled", 0, 0, []string{"/api/v1/pod", "/api/v1/pod"},
+			"/api/v1/pod", http.StatusOK},
+		{"Should allow requests under the limit", 2, 0, []string{"/api/v1/pod"}, "/api/v1/pod", http.StatusOK},
+		{"Should reject requests over the limit", 1, 0, []string{"/api/v1/pod"}, "/api/v1/pod",
+			http.StatusServiceUnavailable},
+		{"Should not limit liveness ping", 1, 0, []string{"/api/v1/pod"}, PingPath, http.StatusOK},
+		{"Should not count streams as requests", 1, 0, []string{"/api/watch/pod"}, "/api/v1/pod", http.StatusOK},
+		{"Should reject streams over the limit", 0, 1, []string{"/api/sockjs/123"},
+			"/api/v1/log/file/default/pod/container", http.StatusServiceUnavailable},
+		{"Should not count requests as streams", 0, 1, []string{"/api/v1/pod"}, "/api/v1/watch/pod",
+			http.StatusOK},
+	}
+
+	for _, c := range cases {
+		release := make(chan struct{})
+		started := sync.WaitGroup{}
+		finished := sync.WaitGroup{}
+		blocking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Hold") == "true" {
+				started.Done()
+				<-release
+			}
+		})
+		handler := NewInFlightLimiter(c.maxRequests, c.maxStreams).Handler(blocking)
+
+		for _, path := range c.held {
+			started.Add(1)
+			finished.Add(1)
+			request := httptest.NewRequest(http.MethodGet, path, nil)
+			request.Header.Set("Hold", "true")
+			go func() {
+				defer finished.Done()
+				handler.ServeHTTP(httptest.NewRecorder(), request)
+			}()
+		}
+		started.Wait()
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+		close(release)
+		finished.Wait()
+
+		if recorder.Code != c.expected {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expected, recorder.Code)
+		}
+
+		if c.expected == http.StatusServiceUnavailable && recorder.Header().Get("Retry-After") != "1" {
+			t.Errorf("Test Case: %s. Expected Retry-After header to be set.", c.info)
+		}
+	}
+}
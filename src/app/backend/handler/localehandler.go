@@ -16,21 +16,42 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
+	"html"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/golang/glog"
 	"golang.org/x/text/language"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
 )
 
 const defaultLocaleDir = "en"
 const assetsDir = "public"
 
+// DefaultLocaleConfig is the path to the localization configuration shipped together with dashboard. It is also used
+// as a fallback in case config map with localization configuration does not exist.
+const DefaultLocaleConfig = "./locale_conf.json"
+
+var (
+	baseElementRegexp = regexp.MustCompile(`<base\s+href="[^"]*"\s*/?>`)
+	headElementRegexp = regexp.MustCompile(`<head(\s[^>]*)?>`)
+)
+
+// Prefix of the locale config that points to the config map key instead of a file.
+const localeConfigMapPrefix = "configmap://"
+
 // Localization is a spec for the localization configuration of dashboard.
 type Localization struct {
 	Translations []string `json:"translations"`
@@ -40,38 +61,174 @@ type Localization struct {
 // based on the Accept-Language header.
 type LocaleHandler struct {
 	SupportedLocales []language.Tag
+
+	mux sync.RWMutex
+}
+
+// LocaleConfigMapRef points to the config map key that contains the localization configuration.
+type LocaleConfigMapRef struct {
+	Namespace string
+	Name      string
+	Key       string
+}
+
+// ParseLocaleConfigMapRef parses locale config in 'configmap://namespace/name/key' format. Returns nil if given
+// locale config is a file path.
+func ParseLocaleConfigMapRef(localeConfig string) (*LocaleConfigMapRef, error) {
+	if !strings.HasPrefix(localeConfig, localeConfigMapPrefix) {
+		return nil, nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(localeConfig, localeConfigMapPrefix), "/")
+	if len(parts) != 3 || len(parts[0]) == 0 || len(parts[1]) == 0 || len(parts[2]) == 0 {
+		return nil, fmt.Errorf("invalid locale config %q, expected format is '%snamespace/name/key'",
+			localeConfig, localeConfigMapPrefix)
+	}
+
+	return &LocaleConfigMapRef{Namespace: parts[0], Name: parts[1], Key: parts[2]}, nil
 }
 
-// CreateLocaleHandler loads the localization configuration and constructs a LocaleHandler.
+// CreateLocaleHandler loads the localization configuration from comma separated list of files and constructs a
+// LocaleHandler. Files that could not be loaded are skipped.
 func CreateLocaleHandler() *LocaleHandler {
 	locales, err := getSupportedLocales(args.Holder.GetLocaleConfig())
 	if err != nil {
-		glog.Warningf("Error when loading the localization configuration. Dashboard will not be localized. %s", err)
-		locales = []language.Tag{}
+		glog.Warningf("Error when loading the localization configuration. Dashboard will only be localized with "+
+			"locales %v. %s", locales, err)
 	}
 	return &LocaleHandler{SupportedLocales: locales}
 }
 
-func getSupportedLocales(configFile string) ([]language.Tag, error) {
-	// read config file
+// CreateConfigMapLocaleHandler constructs a LocaleHandler that loads the localization configuration from given key
+// of the config map kept up to date by the synchronizer. Supported locales are reloaded every time the config map
+// changes. Default localization configuration is used in case the config map or key does not exist.
+func CreateConfigMapLocaleHandler(synchronizer syncApi.Synchronizer, key string) *LocaleHandler {
+	handler := &LocaleHandler{}
+	handler.loadFromConfigMap(synchronizer.Get(), key)
+
+	synchronizer.RegisterActionHandler(func(obj runtime.Object) {
+		handler.loadFromConfigMap(obj, key)
+	}, watch.Added, watch.Modified)
+	synchronizer.RegisterActionHandler(func(runtime.Object) {
+		handler.loadFromConfigMap(nil, key)
+	}, watch.Deleted)
+
+	return handler
+}
+
+func (handler *LocaleHandler) loadFromConfigMap(obj runtime.Object, key string) {
+	var locales []language.Tag
+	var err error
+
+	configMap, ok := obj.(*v1.ConfigMap)
+	if !ok || configMap == nil {
+		err = fmt.Errorf("config map does not exist")
+	} else if data, exists := configMap.Data[key]; !exists {
+		err = fmt.Errorf("config map %s/%s does not contain key %s", configMap.Namespace, configMap.Name, key)
+	} else {
+		locales = parseSupportedLocales([]byte(data))
+	}
+
+	if err != nil {
+		locales, err = getSupportedLocales(DefaultLocaleConfig)
+		if err != nil {
+			locales = []language.Tag{}
+		}
+	}
+
+	handler.setSupportedLocales(locales)
+}
+
+func (handler *LocaleHandler) setSupportedLocales(locales []language.Tag) {
+	handler.mux.Lock()
+	defer handler.mux.Unlock()
+
+	// Config map is periodically resynchronized, log only actual changes.
+	if !reflect.DeepEqual(locales, handler.SupportedLocales) {
+		glog.Infof("Loaded localization configuration with locales: %v", locales)
+	}
+	handler.SupportedLocales = locales
+}
+
+// Returns locales from comma separated list of locale config files. Files are merged in order, locales of later files
+// are appended to locales of earlier ones. Locales of files that could be loaded are returned even if other files are
+// missing or malformed, error lists all such files then.
+func getSupportedLocales(configFiles string) ([]language.Tag, error) {
+	result := []language.Tag{}
+	failures := []string{}
+	for _, configFile := range strings.Split(configFiles, ",") {
+		configFile = strings.TrimSpace(configFile)
+		if len(configFile) == 0 {
+			continue
+		}
+
+		locales, err := loadLocaleConfig(configFile)
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+
+		result = mergeLocales(result, locales)
+	}
+
+	if len(failures) > 0 {
+		return result, fmt.Errorf("could not load locale config: %s", strings.Join(failures, "; "))
+	}
+
+	return result, nil
+}
+
+func loadLocaleConfig(configFile string) ([]language.Tag, error) {
 	localesFile, err := ioutil.ReadFile(configFile)
 	if err != nil {
-		return []language.Tag{}, err
+		return nil, err
+	}
+
+	localization := Localization{}
+	if err := json.Unmarshal(localesFile, &localization); err != nil {
+		return nil, fmt.Errorf("malformed locale config file %s: %s", configFile, err.Error())
 	}
 
+	return toLocales(localization), nil
+}
+
+// Appends locales that are not yet present in the base to it.
+func mergeLocales(base []language.Tag, locales []language.Tag) []language.Tag {
+	for _, locale := range locales {
+		exists := false
+		for _, existing := range base {
+			if existing == locale {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			base = append(base, locale)
+		}
+	}
+
+	return base
+}
+
+func parseSupportedLocales(localesFile []byte) []language.Tag {
 	// unmarshall
 	localization := Localization{}
-	err = json.Unmarshal(localesFile, &localization)
+	err := json.Unmarshal(localesFile, &localization)
 	if err != nil {
 		glog.Warningf("%s %s", string(localesFile), err)
 	}
 
-	// filter locale keys
+	return toLocales(localization)
+}
+
+// filter locale keys
+func toLocales(localization Localization) []language.Tag {
 	result := []language.Tag{}
 	for _, translation := range localization.Translations {
 		result = append(result, language.Make(translation))
 	}
-	return result, nil
+	return result
 }
 
 // getAssetsDir determines the absolute path to the localized frontend assets
@@ -109,8 +266,39 @@ func (handler *LocaleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		acceptLanguage = r.Header.Get("Accept-Language")
 	}
 
-	dirName := handler.determineLocalizedDir(acceptLanguage)
-	http.FileServer(http.Dir(dirName)).ServeHTTP(w, r)
+	fs := localizedFileSystem(handler.determineLocalizedDir(acceptLanguage))
+	if basePath := args.Holder.GetBasePath(); len(basePath) > 0 && r.URL.EscapedPath() == "/" {
+		handler.serveIndex(w, r, fs, basePath)
+		return
+	}
+
+	http.FileServer(fs).ServeHTTP(w, r)
+}
+
+// Serves index.html with base href pointing to the base path, so that frontend assets and API calls are resolved
+// relative to it.
+func (handler *LocaleHandler) serveIndex(w http.ResponseWriter, r *http.Request, fs http.FileSystem,
+	basePath string) {
+	index, err := readFile(fs, "/index.html")
+	if err != nil {
+		http.FileServer(fs).ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(setBaseHref(index, basePath+"/"))
+}
+
+// Replaces href of the base element or adds base element to the head if it does not exist.
+func setBaseHref(index []byte, href string) []byte {
+	base := []byte(`<base href="` + html.EscapeString(href) + `">`)
+	if baseElementRegexp.Match(index) {
+		return baseElementRegexp.ReplaceAllLiteral(index, base)
+	}
+
+	return headElementRegexp.ReplaceAllFunc(index, func(head []byte) []byte {
+		return append(append(append([]byte{}, head...), '\n'), base...)
+	})
 }
 
 func (handler *LocaleHandler) determineLocalizedDir(locale string) string {
@@ -149,6 +337,9 @@ func (handler *LocaleHandler) getLocaleDir(locale string) string {
 }
 
 func (handler *LocaleHandler) getLocaleMap() map[string]struct{} {
+	handler.mux.RLock()
+	defer handler.mux.RUnlock()
+
 	result := map[string]struct{}{}
 	for _, tag := range handler.SupportedLocales {
 		result[tag.String()] = struct{}{}
@@ -20,9 +20,15 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"golang.org/x/text/language"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
 )
 
 func languageMake(locales []string) []language.Tag {
@@ -66,6 +72,146 @@ func TestGetSupportedLocales(t *testing.T) {
 	}
 }
 
+func TestGetSupportedLocalesMultipleFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-locale-config")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"base.json":      `{"translations":["en","ja"]}`,
+		"overlay.json":   `{"translations":["ja","fr"]}`,
+		"malformed.json": `{"translations":`,
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("%s", err)
+		}
+	}
+
+	path := func(names ...string) string {
+		paths := []string{}
+		for _, name := range names {
+			paths = append(paths, filepath.Join(dir, name))
+		}
+		return strings.Join(paths, ", ")
+	}
+
+	cases := []struct {
+		info        string
+		configFiles string
+		expected    []language.Tag
+		expectedErr bool
+	}{
+		{"Should load single file", path("base.json"), languageMake([]string{"en", "ja"}), false},
+		{"Should merge files in order", path("base.json", "overlay.json"), languageMake([]string{"en", "ja", "fr"}),
+			false},
+		{"Should load other files if one is missing", path("base.json", "missing.json", "overlay.json"),
+			languageMake([]string{"en", "ja", "fr"}), true},
+		{"Should load other files if one is malformed", path("malformed.json", "overlay.json"),
+			languageMake([]string{"ja", "fr"}), true},
+	}
+
+	for _, c := range cases {
+		actual, err := getSupportedLocales(c.configFiles)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestParseLocaleConfigMapRef(t *testing.T) {
+	cases := []struct {
+		localeConfig string
+		expected     *LocaleConfigMapRef
+		expectedErr  bool
+	}{
+		{"./locale_conf.json", nil, false},
+		{"configmap://kube-system/locales/locale_conf.json",
+			&LocaleConfigMapRef{Namespace: "kube-system", Name: "locales", Key: "locale_conf.json"}, false},
+		{"configmap://kube-system/locales", nil, true},
+		{"configmap://kube-system//locale_conf.json", nil, true},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseLocaleConfigMapRef(c.localeConfig)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("ParseLocaleConfigMapRef(%s) returns error %v, expected error: %t", c.localeConfig, err,
+				c.expectedErr)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("ParseLocaleConfigMapRef(%s) returns %#v, expected %#v", c.localeConfig, actual, c.expected)
+		}
+	}
+}
+
+func TestCreateConfigMapLocaleHandler(t *testing.T) {
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{Name: "locales", Namespace: "kube-system"},
+		Data:       map[string]string{"locale_conf.json": `{"translations": ["en", "ja"]}`},
+	}
+	client := fake.NewSimpleClientset(configMap)
+	synchronizer := sync.NewSynchronizerManager(client).ConfigMap("kube-system", "locales")
+
+	handler := CreateConfigMapLocaleHandler(synchronizer, "locale_conf.json")
+	if expected := languageMake([]string{"en", "ja"}); !reflect.DeepEqual(handler.SupportedLocales, expected) {
+		t.Errorf("CreateConfigMapLocaleHandler() loads %#v, expected %#v", handler.SupportedLocales, expected)
+	}
+
+	cases := []struct {
+		info      string
+		configMap *v1.ConfigMap
+		expected  []language.Tag
+	}{
+		{
+			"Should reload locales when config map changes",
+			&v1.ConfigMap{Data: map[string]string{"locale_conf.json": `{"translations": ["en", "de", "fr"]}`}},
+			languageMake([]string{"en", "de", "fr"}),
+		},
+		{
+			"Should fall back to default locales when key does not exist",
+			&v1.ConfigMap{Data: map[string]string{}},
+			[]language.Tag{},
+		},
+		{
+			"Should fall back to default locales when config map does not exist",
+			nil,
+			[]language.Tag{},
+		},
+	}
+
+	for _, c := range cases {
+		handler.loadFromConfigMap(c.configMap, "locale_conf.json")
+		if !reflect.DeepEqual(handler.SupportedLocales, c.expected) {
+			t.Errorf("Test Case: %s. Expected locales %#v, but got %#v.", c.info, c.expected, handler.SupportedLocales)
+		}
+	}
+}
+
+func TestSetBaseHref(t *testing.T) {
+	cases := []struct {
+		index    string
+		expected string
+	}{
+		{`<head><base href=""></head>`, `<head><base href="/dashboard/"></head>`},
+		{`<head lang="en"><title>Dashboard</title></head>`,
+			"<head lang=\"en\">\n<base href=\"/dashboard/\"><title>Dashboard</title></head>"},
+		{`<header><head></head></header>`, "<header><head>\n<base href=\"/dashboard/\"></head></header>"},
+	}
+
+	for _, c := range cases {
+		if actual := string(setBaseHref([]byte(c.index), "/dashboard/")); actual != c.expected {
+			t.Errorf("setBaseHref(%s) returns %s, expected %s", c.index, actual, c.expected)
+		}
+	}
+}
+
 func TestDetermineLocale(t *testing.T) {
 	assetsDir := getAssetsDir()
 	defaultDir := filepath.Join(assetsDir, defaultLocaleDir)
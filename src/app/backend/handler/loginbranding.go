@@ -0,0 +1,72 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Prefix of data URIs that can be used as the login logo or favicon.
+const imageDataURIPrefix = "data:image/"
+
+// ValidateLoginLogoURL checks that value of --login-logo-url is either absolute http or https URL or base64 encoded
+// image data URI, i.e. 'data:image/png;base64,...'. Empty value is valid and keeps the default branding.
+func ValidateLoginLogoURL(value string) error {
+	return validateImageURL("login-logo-url", value)
+}
+
+// ValidateFaviconURL checks that value of --favicon-url is either absolute http or https URL or base64 encoded image
+// data URI. Empty value is valid and keeps the default favicon.
+func ValidateFaviconURL(value string) error {
+	return validateImageURL("favicon-url", value)
+}
+
+func validateImageURL(flag, value string) error {
+	if len(value) == 0 {
+		return nil
+	}
+
+	if strings.HasPrefix(value, imageDataURIPrefix) {
+		return validateImageDataURI(flag, value)
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid --%s %s: %s", flag, value, err.Error())
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+		return fmt.Errorf("invalid --%s %s: expected absolute http or https URL or image data URI", flag, value)
+	}
+
+	return nil
+}
+
+func validateImageDataURI(flag, value string) error {
+	parts := strings.SplitN(strings.TrimPrefix(value, imageDataURIPrefix), ",", 2)
+	if len(parts) != 2 || !strings.HasSuffix(parts[0], ";base64") || parts[0] == ";base64" {
+		return fmt.Errorf("invalid --%s: image data URI has to be in 'data:image/<type>;base64,<data>' format",
+			flag)
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(parts[1]); err != nil {
+		return fmt.Errorf("invalid --%s: could not decode image data URI: %s", flag, err.Error())
+	}
+
+	return nil
+}
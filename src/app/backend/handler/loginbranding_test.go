@@ -0,0 +1,65 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"testing"
+)
+
+func TestValidateLoginLogoURL(t *testing.T) {
+	cases := []struct {
+		value       string
+		expectedErr bool
+	}{
+		{"", false},
+		{"https://example.com/logo.svg", false},
+		{"http://example.com:8080/static/logo.png?v=2", false},
+		{"data:image/png;base64,iVBORw0KGgo=", false},
+		{"data:image/svg+xml;base64,PHN2Zz48L3N2Zz4=", false},
+		{"/assets/logo.png", true},
+		{"ftp://example.com/logo.png", true},
+		{"javascript:alert(1)", true},
+		{"https://", true},
+		{"data:image/png,plain", true},
+		{"data:image/;base64,iVBORw0KGgo=", true},
+		{"data:image/png;base64,not base64!", true},
+		{"data:text/html;base64,PGgxPjwvaDE+", true},
+	}
+
+	for _, c := range cases {
+		if err := ValidateLoginLogoURL(c.value); (err != nil) != c.expectedErr {
+			t.Errorf("ValidateLoginLogoURL(%q) returned error %v, expected error: %t", c.value, err, c.expectedErr)
+		}
+	}
+}
+
+func TestValidateFaviconURL(t *testing.T) {
+	cases := []struct {
+		value       string
+		expectedErr bool
+	}{
+		{"", false},
+		{"https://example.com/favicon.ico", false},
+		{"data:image/x-icon;base64,AAABAAEAEBA=", false},
+		{"favicon.ico", true},
+		{"data:image/x-icon,plain", true},
+	}
+
+	for _, c := range cases {
+		if err := ValidateFaviconURL(c.value); (err != nil) != c.expectedErr {
+			t.Errorf("ValidateFaviconURL(%q) returned error %v, expected error: %t", c.value, err, c.expectedErr)
+		}
+	}
+}
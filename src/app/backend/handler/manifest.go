@@ -0,0 +1,72 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Metadata fields set by the apiserver that prevent the manifest from being applied again.
+var runtimeMetadataFields = []string{"managedFields", "resourceVersion", "uid", "creationTimestamp"}
+
+// Converts JSON representation of the resource returned by the apiserver to YAML manifest that can be applied again.
+// Runtime metadata and status are removed, status can be kept for debugging. Order of the fields is preserved.
+func toCleanManifest(raw []byte, keepStatus bool) ([]byte, error) {
+	// JSON is valid YAML, so it can be decoded directly without losing integer values.
+	manifest := yaml.MapSlice{}
+	if err := yaml.Unmarshal(raw, &manifest); err != nil {
+		return nil, err
+	}
+
+	if !keepStatus {
+		manifest = removeKeys(manifest, "status")
+	}
+
+	for i, item := range manifest {
+		if metadata, ok := item.Value.(yaml.MapSlice); ok && item.Key == "metadata" {
+			manifest[i].Value = removeKeys(metadata, runtimeMetadataFields...)
+		}
+	}
+
+	return yaml.Marshal(manifest)
+}
+
+func removeKeys(mapSlice yaml.MapSlice, keys ...string) yaml.MapSlice {
+	removed := make(map[interface{}]bool, len(keys))
+	for _, key := range keys {
+		removed[key] = true
+	}
+
+	result := yaml.MapSlice{}
+	for _, item := range mapSlice {
+		if !removed[item.Key] {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// Writes manifest as YAML file attachment named after the resource.
+func handleManifestDownload(response *restful.Response, name string, manifest []byte) {
+	response.AddHeader(restful.HEADER_ContentType, "application/yaml")
+	response.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".yaml"))
+	response.WriteHeader(http.StatusOK)
+	_, _ = response.Write(manifest)
+}
@@ -0,0 +1,76 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"testing"
+)
+
+func TestToCleanManifest(t *testing.T) {
+	raw := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"bar","uid":"1234",` +
+		`"resourceVersion":"42","creationTimestamp":"2021-01-01T00:00:00Z","labels":{"app":"foo"},` +
+		`"managedFields":[{"manager":"kubectl"}]},"data":{"size":"1000000"},"spec":{"replicas":1000000},` +
+		`"status":{"phase":"Active"}}`
+
+	cases := []struct {
+		info       string
+		keepStatus bool
+		expected   string
+	}{
+		{"Should remove runtime metadata and status", false, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: bar
+  labels:
+    app: foo
+data:
+  size: "1000000"
+spec:
+  replicas: 1000000
+`},
+		{"Should keep status if requested", true, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: bar
+  labels:
+    app: foo
+data:
+  size: "1000000"
+spec:
+  replicas: 1000000
+status:
+  phase: Active
+`},
+	}
+
+	for _, c := range cases {
+		actual, err := toCleanManifest([]byte(raw), c.keepStatus)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Expected no error, but got %s.", c.info, err)
+		}
+
+		if string(actual) != c.expected {
+			t.Errorf("Test Case: %s. Expected:\n%s\nbut got:\n%s", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestToCleanManifestInvalid(t *testing.T) {
+	if _, err := toCleanManifest([]byte(`{"metadata":`), false); err == nil {
+		t.Error("Expected error for invalid resource.")
+	}
+}
@@ -47,6 +47,27 @@ var (
 		},
 		[]string{"verb", "resource"},
 	)
+	httpRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dashboard_http_requests_total",
+			Help: "Counter of HTTP requests handled by dashboard broken out for each method, route path template and HTTP response code.",
+		},
+		[]string{"method", "path", "code"},
+	)
+	httpRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "dashboard_http_request_duration_seconds",
+			Help:    "Response latency distribution in seconds for each method, route path template and HTTP response code.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "path", "code"},
+	)
+	activeWebSocketConnections = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "dashboard_websocket_connections_active",
+			Help: "Number of active WebSocket connections used by exec into containers.",
+		},
+	)
 )
 
 // Initialize all metrics in prometheus
@@ -54,6 +75,9 @@ func init() {
 	prometheus.MustRegister(requestCounter)
 	prometheus.MustRegister(requestLatencies)
 	prometheus.MustRegister(requestLatenciesSummary)
+	prometheus.MustRegister(httpRequestCounter)
+	prometheus.MustRegister(httpRequestDuration)
+	prometheus.MustRegister(activeWebSocketConnections)
 }
 
 // Track API call in prometheus
@@ -63,3 +87,11 @@ func monitor(verb, resource string, client, contentType string, httpCode int, re
 	requestLatencies.WithLabelValues(verb, resource).Observe(elapsed)
 	requestLatenciesSummary.WithLabelValues(verb, resource).Observe(elapsed)
 }
+
+// Track HTTP request handled by dashboard in prometheus. Path should be a route template, i.e.
+// '/api/v1/pod/{namespace}', to keep number of label values bounded.
+func monitorRequest(method, path string, httpCode int, reqStart time.Time) {
+	code := strconv.Itoa(httpCode)
+	httpRequestCounter.WithLabelValues(method, path, code).Inc()
+	httpRequestDuration.WithLabelValues(method, path, code).Observe(time.Since(reqStart).Seconds())
+}
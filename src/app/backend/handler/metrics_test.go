@@ -0,0 +1,44 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetricsFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Filter(metricsFilter)
+	ws.Route(ws.GET("/api/v1/test/{name}").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusNoContent)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	counter := httpRequestCounter.WithLabelValues(http.MethodGet, "/api/v1/test/{name}", "204")
+	before := testutil.ToFloat64(counter)
+	for _, name := range []string{"a", "b"} {
+		container.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/test/"+name, nil))
+	}
+
+	if actual := testutil.ToFloat64(counter) - before; actual != 2 {
+		t.Errorf("Expected 2 requests to be counted for route path template, but got %v.", actual)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Routes that give access to cluster-scoped resources. They are rejected when access to cluster-scoped resources is
+// disabled.
+var clusterScopedRoutes = map[string]bool{
+	"/api/v1/node":                                         true,
+	"/api/v1/node/{name}":                                  true,
+	"/api/v1/node/{name}/event":                            true,
+	"/api/v1/node/{name}/pod":                              true,
+	"/api/v1/node/{name}/cordon":                           true,
+	"/api/v1/node/{name}/uncordon":                         true,
+	"/api/v1/node/{name}/drain":                            true,
+	"/api/v1/clusterrole":                                  true,
+	"/api/v1/clusterrole/{name}":                           true,
+	"/api/v1/clusterrolebinding":                           true,
+	"/api/v1/clusterrolebinding/{name}":                    true,
+	"/api/v1/persistentvolume":                             true,
+	"/api/v1/persistentvolume/{persistentvolume}":          true,
+	"/api/v1/crd":                                          true,
+	"/api/v1/crd/{crd}":                                    true,
+	"/api/v1/storageclass":                                 true,
+	"/api/v1/storageclass/{storageclass}":                  true,
+	"/api/v1/storageclass/{storageclass}/persistentvolume": true,
+	"/api/v1/_raw/{kind}/name/{name}":                      true,
+	"/api/v1/_raw/{kind}/name/{name}/yaml":                 true,
+	"/api/v1/scale/{kind}/{name}":                          true,
+	"/api/v1/scale/{kind}/{name}/":                         true,
+	"/api/v1/watch/{kind}/name/{name}/event":               true,
+
+	// Persistent volume bound to the claim from the namespace path parameter
+	"/api/v1/persistentvolume/namespace/{namespace}/name/{persistentvolume}": true,
+}
+
+// Routes that give access to resources of the kind from the kind path parameter in all namespaces. They are rejected
+// as cluster-scoped routes only when the kind is cluster-scoped.
+var kindScopedRoutes = map[string]bool{
+	"/api/v1/_raw/{kind}/columns": true,
+	"/api/v1/watch/{kind}":        true,
+}
+
+// Routes that refer to the namespace with the name path parameter instead of the namespace path parameter.
+var namespaceNameRoutes = map[string]bool{
+	"/api/v1/namespace/{name}":               true,
+	"/api/v1/namespace/{name}/event":         true,
+	"/api/v1/namespace/{name}/export":        true,
+	"/api/v1/namespace/{name}/resourcequota": true,
+}
+
+// Filter used to reject requests targeting namespaces outside of the --namespace-allowlist and, if disabled,
+// requests for cluster-scoped resources.
+func namespaceAllowlistFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	route := request.SelectedRoutePath()
+	if isClusterScopedRoute(route, request.PathParameter("kind")) && !clusterScopedAllowed() {
+		errors.HandleInternalError(response, errors.NewForbidden("Access to cluster-scoped resources is disabled"))
+		return
+	}
+
+	if len(args.Holder.GetNamespaceAllowlist()) == 0 {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	// Raw routes of namespaces refer to them with the name path parameter as well
+	namespaces := request.PathParameter("namespace")
+	if namespaceNameRoutes[route] || (request.PathParameter("kind") == api.ResourceKindNamespace &&
+		len(namespaces) == 0) {
+		namespaces = request.PathParameter("name")
+	}
+
+	for _, namespace := range strings.Split(namespaces, ",") {
+		if err := checkNamespaceAllowed(strings.TrimSpace(namespace)); err != nil {
+			errors.HandleInternalError(response, err)
+			return
+		}
+	}
+
+	chain.ProcessFilter(request, response)
+}
+
+// Returns true if given route gives access to cluster-scoped resources. Kind is the kind path parameter of the route.
+func isClusterScopedRoute(route, kind string) bool {
+	if kindScopedRoutes[route] {
+		mapping, exists := api.KindToAPIMapping[kind]
+		return exists && !mapping.Namespaced
+	}
+
+	return clusterScopedRoutes[route]
+}
+
+// Returns false if access to cluster-scoped resources is disabled with --disable-cluster-scoped or, when
+// --namespace-allowlist is set, with --namespace-allowlist-cluster-scoped.
+func clusterScopedAllowed() bool {
+	if args.Holder.GetDisableClusterScoped() {
+		return false
+	}
+
+	return len(args.Holder.GetNamespaceAllowlist()) == 0 || args.Holder.GetNamespaceAllowlistClusterScoped()
+}
+
+// Returns forbidden error if namespace allowlist is set and it does not contain given namespace that objects are
+// created in. Namespace has to be selected in such case.
+func checkTargetNamespaceAllowed(namespace string) error {
+	if len(namespace) == 0 && len(args.Holder.GetNamespaceAllowlist()) > 0 {
+		return errors.NewForbidden("Namespace has to be selected when access to namespaces is restricted")
+	}
+
+	return checkNamespaceAllowed(namespace)
+}
+
+// Returns forbidden error if namespace allowlist is set and it does not contain given namespace. Empty namespace
+// is always allowed.
+func checkNamespaceAllowed(namespace string) error {
+	allowlist := args.Holder.GetNamespaceAllowlist()
+	if len(allowlist) == 0 || len(namespace) == 0 {
+		return nil
+	}
+
+	for _, allowed := range allowlist {
+		if namespace == allowed {
+			return nil
+		}
+	}
+
+	return errors.NewForbidden(fmt.Sprintf("Access to namespace %s is not allowed", namespace))
+}
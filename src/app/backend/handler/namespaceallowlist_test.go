@@ -0,0 +1,157 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+func TestNamespaceAllowlistFilter(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetNamespaceAllowlist([]string{}).SetNamespaceAllowlistClusterScoped(true)
+	}()
+
+	ws := new(restful.WebService)
+	ws.Filter(namespaceAllowlistFilter)
+	ws.Path("/api/v1")
+	for _, route := range []string{"/pod", "/pod/{namespace}", "/node", "/namespace/{name}", "/_raw/{kind}/name/{name}",
+		"/_raw/{kind}/namespace/{namespace}/name/{name}"} {
+		ws.Route(ws.GET(route).To(func(request *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusOK)
+		}))
+	}
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info           string
+		allowlist      []string
+		clusterScoped  bool
+		path           string
+		expectedStatus int
+	}{
+		{"Should allow all namespaces when allowlist is empty", []string{}, true, "/api/v1/pod/other",
+			http.StatusOK},
+		{"Should allow namespace from the allowlist", []string{"foo", "bar"}, true, "/api/v1/pod/bar",
+			http.StatusOK},
+		{"Should allow multiple namespaces from the allowlist", []string{"foo", "bar"}, true,
+			"/api/v1/pod/foo,bar", http.StatusOK},
+		{"Should reject namespace outside of the allowlist", []string{"foo"}, true, "/api/v1/pod/other",
+			http.StatusForbidden},
+		{"Should reject multiple namespaces if any is outside of the allowlist", []string{"foo"}, true,
+			"/api/v1/pod/foo,other", http.StatusForbidden},
+		{"Should allow queries for all namespaces", []string{"foo"}, true, "/api/v1/pod", http.StatusOK},
+		{"Should reject namespace detail outside of the allowlist", []string{"foo"}, true,
+			"/api/v1/namespace/other", http.StatusForbidden},
+		{"Should reject raw namespace outside of the allowlist", []string{"foo"}, true,
+			"/api/v1/_raw/namespace/name/kube-system", http.StatusForbidden},
+		{"Should allow raw namespace from the allowlist", []string{"foo"}, true, "/api/v1/_raw/namespace/name/foo",
+			http.StatusOK},
+		{"Should allow raw cluster-scoped resources", []string{"foo"}, true, "/api/v1/_raw/clusterrole/name/admin",
+			http.StatusOK},
+		{"Should reject raw namespaced resource outside of the allowlist", []string{"foo"}, true,
+			"/api/v1/_raw/pod/namespace/other/name/app", http.StatusForbidden},
+		{"Should allow cluster-scoped resources", []string{"foo"}, true, "/api/v1/node", http.StatusOK},
+		{"Should reject cluster-scoped resources", []string{"foo"}, false, "/api/v1/node", http.StatusForbidden},
+		{"Should allow cluster-scoped resources when allowlist is empty", []string{}, false, "/api/v1/node",
+			http.StatusOK},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetNamespaceAllowlist(c.allowlist).SetNamespaceAllowlistClusterScoped(c.clusterScoped)
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
+
+func TestNamespaceAllowlistFilterDisableClusterScoped(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetDisableClusterScoped(false)
+	}()
+	args.GetHolderBuilder().SetDisableClusterScoped(true)
+
+	ws := new(restful.WebService)
+	ws.Filter(namespaceAllowlistFilter)
+	ws.Path("/api/v1")
+	for _, route := range []string{"/pod/{namespace}", "/node", "/namespace", "/_raw/{kind}/name/{name}",
+		"/_raw/{kind}/columns", "/watch/{kind}", "/persistentvolume/namespace/{namespace}/name/{persistentvolume}"} {
+		ws.Route(ws.GET(route).To(func(request *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusOK)
+		}))
+	}
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info           string
+		path           string
+		expectedStatus int
+	}{
+		{"Should allow namespaced resources", "/api/v1/pod/foo", http.StatusOK},
+		{"Should allow namespace list", "/api/v1/namespace", http.StatusOK},
+		{"Should reject cluster-scoped resources", "/api/v1/node", http.StatusForbidden},
+		{"Should reject cluster-scoped raw resources", "/api/v1/_raw/clusterrole/name/admin", http.StatusForbidden},
+		{"Should allow custom columns of namespaced kind", "/api/v1/_raw/secret/columns", http.StatusOK},
+		{"Should reject custom columns of cluster-scoped kind", "/api/v1/_raw/node/columns", http.StatusForbidden},
+		{"Should allow watch of namespaced kind", "/api/v1/watch/pod", http.StatusOK},
+		{"Should reject watch of cluster-scoped kind", "/api/v1/watch/node", http.StatusForbidden},
+		{"Should reject persistent volume of a claim", "/api/v1/persistentvolume/namespace/foo/name/pv",
+			http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
+
+func TestCheckTargetNamespaceAllowed(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetNamespaceAllowlist([]string{})
+	}()
+
+	cases := []struct {
+		info      string
+		allowlist []string
+		namespace string
+		expectErr bool
+	}{
+		{"Should allow any namespace when allowlist is empty", []string{}, "", false},
+		{"Should allow namespace from the allowlist", []string{"foo"}, "foo", false},
+		{"Should reject namespace outside of the allowlist", []string{"foo"}, "other", true},
+		{"Should reject empty namespace when allowlist is set", []string{"foo"}, "", true},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetNamespaceAllowlist(c.allowlist)
+		if err := checkTargetNamespaceAllowed(c.namespace); (err != nil) != c.expectErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectErr, err)
+		}
+	}
+}
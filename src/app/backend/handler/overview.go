@@ -0,0 +1,42 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+// Returns check of the overview queries against --allowed-resources, --namespace-allowlist and
+// --disable-cluster-scoped. Overview route does not carry kinds and namespaces of the queries, so they are checked
+// the same way as filters check path parameters of other routes. Namespaces can always be counted, as their list
+// is limited to the allowed ones.
+func overviewQueryCheck() func(query settingsApi.OverviewQuery) error {
+	allowed, _ := ParseAllowedResources(args.Holder.GetAllowedResources())
+	return func(query settingsApi.OverviewQuery) error {
+		if err := checkKindAllowed(allowed, query.Kind); err != nil {
+			return err
+		}
+
+		mapping, exists := api.KindToAPIMapping[query.Kind]
+		if exists && !mapping.Namespaced && query.Kind != api.ResourceKindNamespace && !clusterScopedAllowed() {
+			return errors.NewForbidden("Access to cluster-scoped resources is disabled")
+		}
+
+		return checkNamespaceAllowed(query.Namespace)
+	}
+}
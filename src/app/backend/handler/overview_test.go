@@ -0,0 +1,51 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+func TestOverviewQueryCheck(t *testing.T) {
+	defer args.GetHolderBuilder().SetAllowedResources([]string{}).SetNamespaceAllowlist([]string{}).
+		SetDisableClusterScoped(false)
+	args.GetHolderBuilder().SetAllowedResources([]string{"pods", "nodes", "namespaces"}).
+		SetNamespaceAllowlist([]string{"foo"}).SetDisableClusterScoped(true)
+	check := overviewQueryCheck()
+
+	cases := []struct {
+		info      string
+		query     settingsApi.OverviewQuery
+		expectErr bool
+	}{
+		{"Should allow allowed kind in all namespaces", settingsApi.OverviewQuery{Kind: "pod"}, false},
+		{"Should allow allowed kind in allowed namespace", settingsApi.OverviewQuery{Kind: "pod", Namespace: "foo"},
+			false},
+		{"Should reject namespace outside of the allowlist", settingsApi.OverviewQuery{Kind: "pod",
+			Namespace: "kube-system"}, true},
+		{"Should reject kind that is not allowed", settingsApi.OverviewQuery{Kind: "secret"}, true},
+		{"Should reject cluster-scoped kind", settingsApi.OverviewQuery{Kind: "node"}, true},
+		{"Should allow namespaces", settingsApi.OverviewQuery{Kind: "namespace"}, false},
+	}
+
+	for _, c := range cases {
+		if err := check(c.query); (err != nil) != c.expectErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectErr, err)
+		}
+	}
+}
@@ -15,12 +15,24 @@
 package parser
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/emicklei/go-restful/v3"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// OrderAscending is the value of order parameter sorting the list in ascending order.
+	OrderAscending = "asc"
+	// OrderDescending is the value of order parameter sorting the list in descending order.
+	OrderDescending = "desc"
 )
 
 func parsePaginationPathParameter(request *restful.Request) *dataselect.PaginationQuery {
@@ -42,9 +54,23 @@ func parseFilterPathParameter(request *restful.Request) *dataselect.FilterQuery
 	return dataselect.NewFilterQuery(strings.Split(request.QueryParameter("filterBy"), ","))
 }
 
-// Parses query parameters of the request and returns a SortQuery object
+// Parses query parameters of the request and returns a SortQuery object. Sort parameter is either a list of order
+// and property pairs, i.e. 'd,creationTimestamp,a,name', or a list of properties sorted in the order given by order
+// parameter, i.e. 'sortBy=creationTimestamp,name&order=desc'.
 func parseSortPathParameter(request *restful.Request) *dataselect.SortQuery {
-	return dataselect.NewSortQuery(strings.Split(request.QueryParameter("sortBy"), ","))
+	sortBy := request.QueryParameter("sortBy")
+	order := request.QueryParameter("order")
+	if len(sortBy) == 0 {
+		return dataselect.NoSort
+	}
+
+	if len(order) == 0 {
+		if sortQuery := dataselect.NewSortQuery(strings.Split(sortBy, ",")); sortQuery != dataselect.NoSort {
+			return sortQuery
+		}
+	}
+
+	return dataselect.NewOrderedSortQuery(strings.Split(sortBy, ","), order != OrderDescending)
 }
 
 // Parses query parameters of the request and returns a MetricQuery object
@@ -71,6 +97,43 @@ func parseMetricPathParameter(request *restful.Request) *dataselect.MetricQuery
 
 }
 
+// ValidateDataSelectPathParameter returns bad request error if the request sorts by property that is not supported or
+// in unknown order.
+func ValidateDataSelectPathParameter(request *restful.Request) error {
+	if order := request.QueryParameter("order"); len(order) > 0 && order != OrderAscending && order != OrderDescending {
+		return errors.NewBadRequest(fmt.Sprintf("Order has to be one of '%s' or '%s'", OrderAscending,
+			OrderDescending))
+	}
+
+	for _, sortBy := range parseSortPathParameter(request).SortByList {
+		if !isSortable(sortBy.Property) {
+			return errors.NewBadRequest(fmt.Sprintf("Unsupported sort field %s, supported fields are: %s",
+				sortBy.Property, sortableProperties()))
+		}
+	}
+
+	return nil
+}
+
+func isSortable(property dataselect.PropertyName) bool {
+	for _, sortable := range dataselect.SortableProperties {
+		if property == sortable {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortableProperties() string {
+	properties := make([]string, len(dataselect.SortableProperties))
+	for i, property := range dataselect.SortableProperties {
+		properties[i] = string(property)
+	}
+
+	return strings.Join(properties, ", ")
+}
+
 // ParseDataSelectPathParameter parses query parameters of the request and returns a DataSelectQuery object
 func ParseDataSelectPathParameter(request *restful.Request) *dataselect.DataSelectQuery {
 	paginationQuery := parsePaginationPathParameter(request)
@@ -79,3 +142,45 @@ func ParseDataSelectPathParameter(request *restful.Request) *dataselect.DataSele
 	metricQuery := parseMetricPathParameter(request)
 	return dataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery, metricQuery)
 }
+
+// ParseSelectorListOptions parses 'labelSelector' and 'fieldSelector' query parameters of the request and returns
+// options that pass them to the apiserver the same way as 'kubectl get -l' and '--field-selector'. Returns bad request
+// error if any of the selectors cannot be parsed.
+func ParseSelectorListOptions(request *restful.Request) (metaV1.ListOptions, error) {
+	options := metaV1.ListOptions{
+		LabelSelector: request.QueryParameter("labelSelector"),
+		FieldSelector: request.QueryParameter("fieldSelector"),
+	}
+
+	if _, err := labels.Parse(options.LabelSelector); err != nil {
+		return options, errors.NewBadRequest(fmt.Sprintf("invalid labelSelector '%s': %s", options.LabelSelector, err))
+	}
+
+	if _, err := fields.ParseSelector(options.FieldSelector); err != nil {
+		return options, errors.NewBadRequest(fmt.Sprintf("invalid fieldSelector '%s': %s", options.FieldSelector, err))
+	}
+
+	return options, nil
+}
+
+// ParseListOptions parses 'limit' and 'continue' query parameters of the request together with the selectors parsed
+// by ParseSelectorListOptions and returns options used to fetch the list from the apiserver in chunks. Field selector
+// is validated by the apiserver against fields supported by the resource. Whole list is fetched if the request does
+// not set a limit.
+func ParseListOptions(request *restful.Request) (metaV1.ListOptions, error) {
+	options, err := ParseSelectorListOptions(request)
+	if err != nil {
+		return options, err
+	}
+
+	options.Continue = request.QueryParameter("continue")
+	if limitParam := request.QueryParameter("limit"); len(limitParam) > 0 {
+		limit, err := strconv.ParseInt(limitParam, 10, 64)
+		if err != nil || limit < 0 {
+			return options, errors.NewBadRequest("limit has to be a non-negative number")
+		}
+		options.Limit = limit
+	}
+
+	return options, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+func TestParseListOptions(t *testing.T) {
+	cases := []struct {
+		info             string
+		query            string
+		expectedLimit    int64
+		expectedContinue string
+		expectedErr      bool
+	}{
+		{"Should fetch whole list by default", "", 0, "", false},
+		{"Should use limit of the request", "?limit=50&continue=token", 50, "token", false},
+		{"Should allow to fetch whole list", "?limit=0", 0, "", false},
+		{"Should reject negative limit", "?limit=-1", 0, "", true},
+		{"Should reject invalid limit", "?limit=all", 0, "", true},
+		{"Should reject invalid field selector", "?fieldSelector=status.phase", 0, "", true},
+	}
+
+	for _, c := range cases {
+		request := restful.NewRequest(httptest.NewRequest(http.MethodGet, "/api/v1/pod"+c.query, nil))
+		options, err := ParseListOptions(request)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+			continue
+		}
+
+		if err == nil && (options.Limit != c.expectedLimit || options.Continue != c.expectedContinue) {
+			t.Errorf("Test Case: %s. Expected limit %d and continue '%s', but got %d and '%s'.", c.info,
+				c.expectedLimit, c.expectedContinue, options.Limit, options.Continue)
+		}
+	}
+}
+
+func TestParseSelectorListOptions(t *testing.T) {
+	cases := []struct {
+		info                  string
+		query                 string
+		expectedLabelSelector string
+		expectedFieldSelector string
+		expectedErr           bool
+	}{
+		{"Should select everything without selectors", "", "", "", false},
+		{"Should pass label selector", "?labelSelector=app%3Dweb,tier!%3Dcache", "app=web,tier!=cache", "", false},
+		{"Should pass set based label selector", "?labelSelector=app+in+(web,api)", "app in (web,api)", "", false},
+		{"Should pass field selector", "?fieldSelector=status.phase%3DRunning", "", "status.phase=Running", false},
+		{"Should reject invalid label selector", "?labelSelector=app+in+(web", "", "", true},
+		{"Should reject invalid field selector", "?fieldSelector=status.phase", "", "", true},
+	}
+
+	for _, c := range cases {
+		request := restful.NewRequest(httptest.NewRequest(http.MethodGet, "/api/v1/deployment"+c.query, nil))
+		options, err := ParseSelectorListOptions(request)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+			continue
+		}
+
+		if err != nil {
+			if !k8sErrors.IsBadRequest(err) {
+				t.Errorf("Test Case: %s. Expected bad request error, but got %v.", c.info, err)
+			}
+			continue
+		}
+
+		if options.LabelSelector != c.expectedLabelSelector || options.FieldSelector != c.expectedFieldSelector {
+			t.Errorf("Test Case: %s. Expected selectors '%s' and '%s', but got '%s' and '%s'.", c.info,
+				c.expectedLabelSelector, c.expectedFieldSelector, options.LabelSelector, options.FieldSelector)
+		}
+	}
+}
+
+func TestParseSortPathParameter(t *testing.T) {
+	cases := []struct {
+		info     string
+		query    string
+		expected []dataselect.SortBy
+	}{
+		{"Should not sort without sort parameter", "", []dataselect.SortBy{}},
+		{"Should parse order and property pairs", "?sortBy=d,creationTimestamp,a,name", []dataselect.SortBy{
+			{Property: dataselect.CreationTimestampProperty, Ascending: false},
+			{Property: dataselect.NameProperty, Ascending: true},
+		}},
+		{"Should sort by properties in given order", "?sortBy=namespace,name&order=desc", []dataselect.SortBy{
+			{Property: dataselect.NamespaceProperty, Ascending: false},
+			{Property: dataselect.NameProperty, Ascending: false},
+		}},
+		{"Should sort ascending by default", "?sortBy=name", []dataselect.SortBy{
+			{Property: dataselect.NameProperty, Ascending: true},
+		}},
+	}
+
+	for _, c := range cases {
+		request := restful.NewRequest(httptest.NewRequest(http.MethodGet, "/api/v1/pod"+c.query, nil))
+		if actual := parseSortPathParameter(request).SortByList; !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestValidateDataSelectPathParameter(t *testing.T) {
+	cases := []struct {
+		info        string
+		query       string
+		expectedErr bool
+	}{
+		{"Should allow request without sort", "", false},
+		{"Should allow supported properties", "?sortBy=d,creationTimestamp,a,name", false},
+		{"Should allow supported properties with order", "?sortBy=namespace&order=asc", false},
+		{"Should reject unsupported property", "?sortBy=a,image", true},
+		{"Should reject unsupported property with order", "?sortBy=name,image&order=desc", true},
+		{"Should reject unknown order", "?sortBy=name&order=random", true},
+	}
+
+	for _, c := range cases {
+		request := restful.NewRequest(httptest.NewRequest(http.MethodGet, "/api/v1/pod"+c.query, nil))
+		if err := ValidateDataSelectPathParameter(request); (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+	}
+}
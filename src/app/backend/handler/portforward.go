@@ -0,0 +1,397 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/igm/sockjs-go.v2/sockjs"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// Time between checks of the port-forward idle timeout.
+var portForwardIdleCheckInterval = time.Second
+
+// Size of the buffer used to read data from the forwarded connection.
+const portForwardBufferSize = 32 * 1024
+
+// PortForwardResponse is sent by handlePortForward. The Id is a random session id that binds the original REST
+// request and the SockJS connection.
+type PortForwardResponse struct {
+	ID string `json:"id"`
+}
+
+// PortForwardMessage is the messaging protocol between the frontend and the port-forward session. Many connections
+// to the forwarded port can be open at the same time, they are identified by the Connection number chosen by the
+// frontend.
+//
+// OP     DIRECTION  FIELD(S) USED     DESCRIPTION
+// ---------------------------------------------------------------------
+// bind   fe->be     SessionID         Id sent back from PortForwardResponse
+// open   fe->be     Connection        Opens new connection to the forwarded port
+// data   fe->be     Connection, Data  Base64 encoded data sent to the connection
+// data   be->fe     Connection, Data  Base64 encoded data received from the connection
+// close  fe->be     Connection        Closes the connection
+// close  be->fe     Connection, Data  Connection was closed by the pod, Data contains the reason if there was an error
+type PortForwardMessage struct {
+	Op         string `json:"op"`
+	SessionID  string `json:"sessionId,omitempty"`
+	Connection int    `json:"connection"`
+	Data       string `json:"data,omitempty"`
+}
+
+// portForwardSession waits for the SockJS connection to be bound to the connection opened to the pod.
+type portForwardSession struct {
+	port  int
+	conn  httpstream.Connection
+	bound chan sockjs.Session
+}
+
+func newPortForwardSession(port int, conn httpstream.Connection) *portForwardSession {
+	return &portForwardSession{port: port, conn: conn, bound: make(chan sockjs.Session, 1)}
+}
+
+// portForwardSessionMap stores sessions that wait for the SockJS connection to be bound.
+type portForwardSessionMap struct {
+	mux      sync.Mutex
+	sessions map[string]*portForwardSession
+}
+
+func (self *portForwardSessionMap) add(id string, session *portForwardSession) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.sessions[id] = session
+}
+
+func (self *portForwardSessionMap) get(id string) *portForwardSession {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	return self.sessions[id]
+}
+
+func (self *portForwardSessionMap) remove(id string) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	delete(self.sessions, id)
+}
+
+var portForwardSessions = portForwardSessionMap{sessions: map[string]*portForwardSession{}}
+
+// Pair of streams created for every forwarded connection, as expected by the portforward subresource.
+type portForwardStreams struct {
+	data  httpstream.Stream
+	error httpstream.Stream
+}
+
+// portForwarder multiplexes connections to the forwarded port of the pod over a single SockJS connection.
+type portForwarder struct {
+	port         int
+	conn         httpstream.Connection
+	send         func(PortForwardMessage) error
+	mux          sync.Mutex
+	streams      map[int]*portForwardStreams
+	requestID    int
+	lastActivity int64
+}
+
+// Handles message sent by the frontend. Returns error if the message is invalid, errors of the single connection are
+// sent to the frontend with the close message.
+func (self *portForwarder) handle(msg PortForwardMessage) error {
+	self.touch(time.Now())
+
+	switch msg.Op {
+	case "open":
+		return self.open(msg.Connection)
+	case "data":
+		data, err := base64.StdEncoding.DecodeString(msg.Data)
+		if err != nil {
+			return err
+		}
+
+		streams := self.get(msg.Connection)
+		if streams == nil {
+			return fmt.Errorf("connection %d is not open", msg.Connection)
+		}
+
+		if _, err := streams.data.Write(data); err != nil && self.remove(msg.Connection) {
+			return self.send(PortForwardMessage{Op: "close", Connection: msg.Connection, Data: err.Error()})
+		}
+		return nil
+	case "close":
+		self.remove(msg.Connection)
+		return nil
+	default:
+		return fmt.Errorf("unknown message type '%s'", msg.Op)
+	}
+}
+
+// Opens new connection to the forwarded port. Data received from it is sent to the frontend until it is closed.
+func (self *portForwarder) open(id int) error {
+	self.mux.Lock()
+	if _, exists := self.streams[id]; exists {
+		self.mux.Unlock()
+		return fmt.Errorf("connection %d is already open", id)
+	}
+
+	self.requestID++
+	streams, err := self.createStreams(self.requestID)
+	if err != nil {
+		self.mux.Unlock()
+		return self.send(PortForwardMessage{Op: "close", Connection: id, Data: err.Error()})
+	}
+
+	self.streams[id] = streams
+	self.mux.Unlock()
+
+	go self.forward(id, streams)
+	return nil
+}
+
+func (self *portForwarder) createStreams(requestID int) (*portForwardStreams, error) {
+	headers := http.Header{}
+	headers.Set(v1.StreamType, v1.StreamTypeError)
+	headers.Set(v1.PortHeader, strconv.Itoa(self.port))
+	headers.Set(v1.PortForwardRequestIDHeader, strconv.Itoa(requestID))
+	errorStream, err := self.conn.CreateStream(headers)
+	if err != nil {
+		return nil, err
+	}
+	// Error stream is only read
+	errorStream.Close()
+
+	headers.Set(v1.StreamType, v1.StreamTypeData)
+	dataStream, err := self.conn.CreateStream(headers)
+	if err != nil {
+		errorStream.Reset()
+		return nil, err
+	}
+
+	return &portForwardStreams{data: dataStream, error: errorStream}, nil
+}
+
+// Sends data received from the connection to the frontend. Frontend is told about the connection closed by the pod
+// with the reason read from the error stream.
+func (self *portForwarder) forward(id int, streams *portForwardStreams) {
+	errChan := make(chan error, 1)
+	go func() {
+		message, err := ioutil.ReadAll(streams.error)
+		switch {
+		case err != nil:
+			errChan <- err
+		case len(message) > 0:
+			errChan <- fmt.Errorf("%s", message)
+		}
+		close(errChan)
+	}()
+
+	buf := make([]byte, portForwardBufferSize)
+	for {
+		n, err := streams.data.Read(buf)
+		if n > 0 {
+			self.touch(time.Now())
+			msg := PortForwardMessage{Op: "data", Connection: id, Data: base64.StdEncoding.EncodeToString(buf[:n])}
+			if self.send(msg) != nil {
+				break
+			}
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	reason := ""
+	if err := <-errChan; err != nil {
+		reason = err.Error()
+	}
+
+	if self.remove(id) {
+		self.send(PortForwardMessage{Op: "close", Connection: id, Data: reason})
+	}
+}
+
+func (self *portForwarder) get(id int) *portForwardStreams {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	return self.streams[id]
+}
+
+// Closes connection with given id. Returns false if it was already closed.
+func (self *portForwarder) remove(id int) bool {
+	self.mux.Lock()
+	streams, exists := self.streams[id]
+	delete(self.streams, id)
+	self.mux.Unlock()
+
+	if !exists {
+		return false
+	}
+
+	streams.data.Reset()
+	streams.error.Reset()
+	return true
+}
+
+func (self *portForwarder) touch(now time.Time) {
+	atomic.StoreInt64(&self.lastActivity, now.UnixNano())
+}
+
+// Checks if no data was sent in any direction for given timeout. Zero timeout means that forwarder is never idle.
+func (self *portForwarder) idle(now time.Time, timeout time.Duration) bool {
+	return timeout > 0 && now.Sub(time.Unix(0, atomic.LoadInt64(&self.lastActivity))) > timeout
+}
+
+func newPortForwarder(port int, conn httpstream.Connection, send func(PortForwardMessage) error) *portForwarder {
+	forwarder := &portForwarder{port: port, conn: conn, send: send, streams: map[int]*portForwardStreams{}}
+	forwarder.touch(time.Now())
+	return forwarder
+}
+
+// Opens connection to the portforward subresource of the pod. Streams of the forwarded connections are created on it.
+func dialPortForward(k8sClient kubernetes.Interface, cfg *rest.Config, namespace, pod string) (httpstream.Connection,
+	error) {
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	req := k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("portforward")
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+	conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	return conn, err
+}
+
+// handlePortForwardSession is called by net/http for any new /api/portforward connections.
+func handlePortForwardSession(session sockjs.Session) {
+	buf, err := session.Recv()
+	if err != nil {
+		log.Printf("handlePortForwardSession: can't Recv: %v", err)
+		return
+	}
+
+	var msg PortForwardMessage
+	if err = json.Unmarshal([]byte(buf), &msg); err != nil {
+		log.Printf("handlePortForwardSession: can't UnMarshal (%v): %s", err, buf)
+		return
+	}
+
+	if msg.Op != "bind" {
+		log.Printf("handlePortForwardSession: expected 'bind' message, got: %s", buf)
+		return
+	}
+
+	portForwardSession := portForwardSessions.get(msg.SessionID)
+	if portForwardSession == nil {
+		log.Printf("handlePortForwardSession: can't find session '%s'", msg.SessionID)
+		return
+	}
+
+	select {
+	case portForwardSession.bound <- session:
+	default:
+		log.Printf("handlePortForwardSession: session '%s' is already bound", msg.SessionID)
+	}
+}
+
+// CreatePortForwardHandler is called from main for /api/portforward.
+func CreatePortForwardHandler(path string) http.Handler {
+	return newSockJSHandler(path, handlePortForwardSession)
+}
+
+// WaitForPortForward is called from apihandler.handlePortForward as a goroutine. It waits for the SockJS connection
+// to be bound in handlePortForwardSession and then forwards connections opened by the frontend until the SockJS
+// connection is closed, the connection to the pod is lost or no data was sent for the idle timeout.
+func WaitForPortForward(sessionID string, idleTimeout time.Duration) {
+	defer portForwardSessions.remove(sessionID)
+	portForwardSession := portForwardSessions.get(sessionID)
+	defer portForwardSession.conn.Close()
+
+	var session sockjs.Session
+	select {
+	case session = <-portForwardSession.bound:
+	case <-time.After(watchBindTimeout):
+		log.Printf("Port-forward session %s was not bound in %s", sessionID, watchBindTimeout)
+		return
+	}
+
+	activeWebSocketConnections.Inc()
+	defer activeWebSocketConnections.Dec()
+
+	forwarder := newPortForwarder(portForwardSession.port, portForwardSession.conn, func(msg PortForwardMessage) error {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		return session.Send(string(data))
+	})
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			buf, err := session.Recv()
+			if err != nil {
+				return
+			}
+
+			var msg PortForwardMessage
+			if err = json.Unmarshal([]byte(buf), &msg); err == nil {
+				err = forwarder.handle(msg)
+			}
+
+			if err != nil {
+				session.Close(2, err.Error())
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(portForwardIdleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if forwarder.idle(time.Now(), idleTimeout) {
+				session.Close(1, "Port forward was idle for too long")
+				return
+			}
+		case <-portForwardSession.conn.CloseChan():
+			session.Close(2, "Connection to the pod was closed")
+			return
+		case <-closed:
+			return
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+// fakeStream is the stream of the forwarded connection. Data written by the pod is read from the pipe.
+type fakeStream struct {
+	headers http.Header
+	reader  *io.PipeReader
+	pod     *io.PipeWriter
+	mux     sync.Mutex
+	written bytes.Buffer
+	reset   bool
+}
+
+func (self *fakeStream) Read(p []byte) (int, error) { return self.reader.Read(p) }
+
+func (self *fakeStream) Write(p []byte) (int, error) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	return self.written.Write(p)
+}
+
+func (self *fakeStream) Close() error { return nil }
+
+func (self *fakeStream) Reset() error {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.reset = true
+	return self.reader.Close()
+}
+
+func (self *fakeStream) Headers() http.Header { return self.headers }
+
+func (self *fakeStream) Identifier() uint32 { return 0 }
+
+type fakeConnection struct {
+	streams []*fakeStream
+	closed  chan bool
+}
+
+func (self *fakeConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	reader, writer := io.Pipe()
+	stream := &fakeStream{headers: headers.Clone(), reader: reader, pod: writer}
+	self.streams = append(self.streams, stream)
+	return stream, nil
+}
+
+func (self *fakeConnection) Close() error { return nil }
+
+func (self *fakeConnection) CloseChan() <-chan bool { return self.closed }
+
+func (self *fakeConnection) SetIdleTimeout(timeout time.Duration) {}
+
+func newTestPortForwarder() (*portForwarder, *fakeConnection, chan PortForwardMessage) {
+	conn := &fakeConnection{closed: make(chan bool)}
+	messages := make(chan PortForwardMessage, 10)
+	forwarder := newPortForwarder(8080, conn, func(msg PortForwardMessage) error {
+		messages <- msg
+		return nil
+	})
+	return forwarder, conn, messages
+}
+
+func receive(t *testing.T, messages chan PortForwardMessage) PortForwardMessage {
+	select {
+	case msg := <-messages:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("Expected message to be sent to the frontend")
+		return PortForwardMessage{}
+	}
+}
+
+func TestPortForwarderOpen(t *testing.T) {
+	forwarder, conn, _ := newTestPortForwarder()
+
+	for _, id := range []int{1, 2} {
+		if err := forwarder.handle(PortForwardMessage{Op: "open", Connection: id}); err != nil {
+			t.Fatalf("Could not open connection %d: %s", id, err)
+		}
+	}
+
+	expected := []struct {
+		streamType string
+		requestID  string
+	}{
+		{v1.StreamTypeError, "1"}, {v1.StreamTypeData, "1"}, {v1.StreamTypeError, "2"}, {v1.StreamTypeData, "2"},
+	}
+	if len(conn.streams) != len(expected) {
+		t.Fatalf("Expected %d streams, but got %d", len(expected), len(conn.streams))
+	}
+
+	for i, e := range expected {
+		headers := conn.streams[i].Headers()
+		if headers.Get(v1.StreamType) != e.streamType || headers.Get(v1.PortForwardRequestIDHeader) != e.requestID ||
+			headers.Get(v1.PortHeader) != "8080" {
+			t.Errorf("Expected stream %d of type %s with request id %s to port 8080, but got headers %v", i,
+				e.streamType, e.requestID, headers)
+		}
+	}
+
+	if err := forwarder.handle(PortForwardMessage{Op: "open", Connection: 1}); err == nil {
+		t.Error("Expected error when connection is opened twice")
+	}
+}
+
+func TestPortForwarderData(t *testing.T) {
+	forwarder, conn, messages := newTestPortForwarder()
+	if err := forwarder.handle(PortForwardMessage{Op: "open", Connection: 1}); err != nil {
+		t.Fatal(err)
+	}
+	errorStream, dataStream := conn.streams[0], conn.streams[1]
+
+	err := forwarder.handle(PortForwardMessage{Op: "data", Connection: 1,
+		Data: base64.StdEncoding.EncodeToString([]byte("GET / HTTP/1.1"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dataStream.mux.Lock()
+	if written := dataStream.written.String(); written != "GET / HTTP/1.1" {
+		t.Errorf("Expected data to be written to the pod, but got %q", written)
+	}
+	dataStream.mux.Unlock()
+
+	go dataStream.pod.Write([]byte("HTTP/1.1 200 OK"))
+	msg := receive(t, messages)
+	if data, _ := base64.StdEncoding.DecodeString(msg.Data); msg.Op != "data" || msg.Connection != 1 ||
+		string(data) != "HTTP/1.1 200 OK" {
+		t.Errorf("Expected data of the connection 1 to be sent, but got %#v", msg)
+	}
+
+	go func() {
+		errorStream.pod.Write([]byte("connection refused"))
+		errorStream.pod.Close()
+		dataStream.pod.Close()
+	}()
+	msg = receive(t, messages)
+	if msg != (PortForwardMessage{Op: "close", Connection: 1, Data: "connection refused"}) {
+		t.Errorf("Expected connection to be closed with the error, but got %#v", msg)
+	}
+
+	if err := forwarder.handle(PortForwardMessage{Op: "data", Connection: 1, Data: ""}); err == nil {
+		t.Error("Expected error when data are sent to the closed connection")
+	}
+}
+
+func TestPortForwarderClose(t *testing.T) {
+	forwarder, conn, messages := newTestPortForwarder()
+	if err := forwarder.handle(PortForwardMessage{Op: "open", Connection: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := forwarder.handle(PortForwardMessage{Op: "close", Connection: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, stream := range conn.streams {
+		stream.mux.Lock()
+		if !stream.reset {
+			t.Errorf("Expected stream %d to be reset", i)
+		}
+		stream.mux.Unlock()
+	}
+
+	select {
+	case msg := <-messages:
+		t.Errorf("Expected no message after the frontend closed the connection, but got %#v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := forwarder.handle(PortForwardMessage{Op: "unknown"}); err == nil {
+		t.Error("Expected error for unknown message type")
+	}
+}
+
+func TestPortForwarderIdle(t *testing.T) {
+	forwarder, _, _ := newTestPortForwarder()
+	now := time.Now()
+	forwarder.touch(now)
+
+	cases := []struct {
+		info     string
+		elapsed  time.Duration
+		timeout  time.Duration
+		expected bool
+	}{
+		{"Should not be idle before the timeout", time.Minute, 5 * time.Minute, false},
+		{"Should be idle after the timeout", 6 * time.Minute, 5 * time.Minute, true},
+		{"Should never be idle without the timeout", time.Hour, 0, false},
+	}
+
+	for _, c := range cases {
+		if actual := forwarder.idle(now.Add(c.elapsed), c.timeout); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected idle: %t, but got %t.", c.info, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"golang.org/x/time/rate"
+
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+type rateLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter limits number of API requests per user using token bucket algorithm. Users are identified by
+// credentials sent with the request once the apiserver accepted them. Requests without credentials or with
+// credentials that were not accepted are limited by the remote address, so that clients can not get a fresh limit
+// with every random token.
+type rateLimiter struct {
+	mux   sync.Mutex
+	qps   rate.Limit
+	burst int
+	// Time after which bucket of the user that did not send any request is full again, so that its limiter can be
+	// forgotten.
+	idleTimeout time.Duration
+	entries     map[string]*rateLimiterEntry
+	lastSweep   time.Time
+	// Checks that the apiserver accepts credentials of the request.
+	verify func(request *restful.Request) error
+}
+
+// Filter rejects request with 429 status code and Retry-After header if user exceeded the limit.
+func (self *rateLimiter) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	now := time.Now()
+	key := client.CredentialsKey(request.Request)
+	delay, verified := self.reserve(key, now, false)
+	if !verified {
+		// Credentials are verified only if the request is within the limit of the remote address, so that the
+		// apiserver is not flooded with reviews of random tokens.
+		delay, _ = self.reserve(remoteAddrKey(request.Request), now, true)
+		if delay == 0 && len(key) > 0 && self.verify(request) == nil {
+			self.add(key, now)
+		}
+	}
+
+	if delay == 0 {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	response.AddHeader("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+	errors.HandleInternalError(response, errors.NewGenericResponse(http.StatusTooManyRequests, ""))
+}
+
+// Reserves a token for the user with given key. Returns time that the user has to wait for the next token or 0 if
+// request can be processed immediately, and whether the user has a limiter. Limiter is created for the unknown user
+// only if create is true.
+func (self *rateLimiter) reserve(key string, now time.Time, create bool) (time.Duration, bool) {
+	if len(key) == 0 {
+		return 0, false
+	}
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	self.sweep(now)
+	entry, exists := self.entries[key]
+	if !exists {
+		if !create {
+			return 0, false
+		}
+
+		entry = self.newEntry()
+		self.entries[key] = entry
+	}
+	entry.lastSeen = now
+
+	reservation := entry.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return self.idleTimeout, true
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		reservation.CancelAt(now)
+	}
+
+	return delay, true
+}
+
+// Adds limiter of the user with given key, whose credentials were accepted by the apiserver.
+func (self *rateLimiter) add(key string, now time.Time) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	if _, exists := self.entries[key]; !exists {
+		entry := self.newEntry()
+		entry.lastSeen = now
+		self.entries[key] = entry
+	}
+}
+
+func (self *rateLimiter) newEntry() *rateLimiterEntry {
+	return &rateLimiterEntry{limiter: rate.NewLimiter(self.qps, self.burst)}
+}
+
+// Removes limiters of users that did not send any request for idleTimeout. Their buckets are full, so they would
+// behave the same as new limiters. Users identified by credentials have to be verified again.
+func (self *rateLimiter) sweep(now time.Time) {
+	if now.Sub(self.lastSweep) < self.idleTimeout {
+		return
+	}
+
+	for key, entry := range self.entries {
+		if now.Sub(entry.lastSeen) >= self.idleTimeout {
+			delete(self.entries, key)
+		}
+	}
+	self.lastSweep = now
+}
+
+func remoteAddrKey(request *http.Request) string {
+	return "anonymous/" + getClientIP(request)
+}
+
+// Returns key identifying user that sent the request. Credentials are hashed so they are not kept in memory.
+func userKey(request *http.Request) string {
+	if key := client.CredentialsKey(request); len(key) > 0 {
+		return key
+	}
+
+	return remoteAddrKey(request)
+}
+
+func newRateLimiter(qps float64, burst int, verify func(request *restful.Request) error) *rateLimiter {
+	// Limiters are kept at least for a second, so that they are not swept with every request when the limit is high.
+	idleTimeout := time.Duration(float64(burst) / qps * float64(time.Second))
+	if idleTimeout < time.Second {
+		idleTimeout = time.Second
+	}
+
+	return &rateLimiter{
+		qps:         rate.Limit(qps),
+		burst:       burst,
+		idleTimeout: idleTimeout,
+		entries:     map[string]*rateLimiterEntry{},
+		lastSweep:   time.Now(),
+		verify:      verify,
+	}
+}
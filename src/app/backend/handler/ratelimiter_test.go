@@ -0,0 +1,158 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	limiter := newRateLimiter(1, 2, nil)
+	now := time.Now()
+
+	cases := []struct {
+		info             string
+		key              string
+		at               time.Time
+		create           bool
+		expected         time.Duration
+		expectedExisting bool
+	}{
+		{"First request within burst should pass", "a", now, true, 0, true},
+		{"Second request within burst should pass", "a", now, true, 0, true},
+		{"Request over burst should wait for the next token", "a", now, true, time.Second, true},
+		{"Other user should have separate limit", "b", now, true, 0, true},
+		{"Unknown user should not get limiter", "c", now, false, 0, false},
+		{"Request should pass after token is refilled", "a", now.Add(time.Second), false, 0, true},
+	}
+
+	for _, c := range cases {
+		actual, existing := limiter.reserve(c.key, c.at, c.create)
+		if actual != c.expected || existing != c.expectedExisting {
+			t.Errorf("Test Case: %s. Expected delay %s and existing %t, but got %s and %t.", c.info, c.expected,
+				c.expectedExisting, actual, existing)
+		}
+	}
+}
+
+func TestRateLimiter_Sweep(t *testing.T) {
+	limiter := newRateLimiter(1, 2, nil)
+	now := limiter.lastSweep
+
+	limiter.reserve("a", now, true)
+	limiter.reserve("b", now.Add(time.Second), true)
+	limiter.reserve("c", now.Add(2*time.Second), true)
+
+	if _, exists := limiter.entries["a"]; exists || len(limiter.entries) != 2 {
+		t.Errorf("Expected limiter with full bucket to be removed, but got %v.", limiter.entries)
+	}
+}
+
+// Returns container with a rate limited route that accepts only "valid" token.
+func newRateLimitedContainer() *restful.Container {
+	ws := new(restful.WebService)
+	verify := func(request *restful.Request) error {
+		if request.Request.Header.Get("Authorization") != "Bearer valid" {
+			return errors.New("Unauthorized")
+		}
+		return nil
+	}
+	ws.Filter(newRateLimiter(1, 1, verify).Filter)
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusOK)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+	return container
+}
+
+func TestRateLimiter_Filter(t *testing.T) {
+	cases := []struct {
+		info           string
+		tokens         []string
+		expectedStatus []int
+	}{
+		{"Requests with invalid tokens should share limit of the address", []string{"random-1", "random-2"},
+			[]int{http.StatusOK, http.StatusTooManyRequests}},
+		{"Request with valid token should be verified within limit of the address", []string{"random", "valid"},
+			[]int{http.StatusOK, http.StatusTooManyRequests}},
+		{"Verified user should have separate limit", []string{"valid", "valid", "valid", "random"},
+			[]int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests, http.StatusTooManyRequests}},
+	}
+
+	for _, c := range cases {
+		container := newRateLimitedContainer()
+		for i, token := range c.tokens {
+			request := httptest.NewRequest(http.MethodGet, "/test", nil)
+			request.Header.Set("Authorization", "Bearer "+token)
+			recorder := httptest.NewRecorder()
+			container.ServeHTTP(recorder, request)
+
+			if recorder.Code != c.expectedStatus[i] {
+				t.Errorf("Test Case: %s. Expected status %d of request %d, but got %d.", c.info, c.expectedStatus[i],
+					i, recorder.Code)
+			}
+
+			expectedRetryAfter := ""
+			if c.expectedStatus[i] == http.StatusTooManyRequests {
+				expectedRetryAfter = "1"
+			}
+
+			if actual := recorder.Header().Get("Retry-After"); actual != expectedRetryAfter {
+				t.Errorf("Test Case: %s. Expected Retry-After %q of request %d, but got %q.", c.info,
+					expectedRetryAfter, i, actual)
+			}
+		}
+	}
+}
+
+func TestRateLimiter_FilterRemotePort(t *testing.T) {
+	cases := []struct {
+		info           string
+		remoteAddr     string
+		expectedStatus int
+	}{
+		{"First connection should pass", "192.0.2.1:1234", http.StatusOK},
+		{"Connection from other port should share limit of the address", "192.0.2.1:5678",
+			http.StatusTooManyRequests},
+		{"Other address should have separate limit", "192.0.2.2:1234", http.StatusOK},
+	}
+
+	container := newRateLimitedContainer()
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodGet, "/test", nil)
+		request.RemoteAddr = c.remoteAddr
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+
+	first := httptest.NewRequest(http.MethodGet, "/test", nil)
+	first.RemoteAddr = "192.0.2.1:1234"
+	second := httptest.NewRequest(http.MethodGet, "/test", nil)
+	second.RemoteAddr = "192.0.2.1:5678"
+	if userKey(first) != userKey(second) {
+		t.Errorf("Expected the same user key of the address, but got %s and %s.", userKey(first), userKey(second))
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Routes that accept user uploaded files, i.e. manifests deployed from file. They opt into a higher request body
+// size limit. Value is the multiple of --max-request-body-bytes allowed for the route.
+var largeRequestBodyRoutes = map[string]int64{
+	"/api/v1/appdeploymentfromfile": 4,
+}
+
+// Filter used to limit size of the request body. Requests that declare larger body are rejected with 413 status
+// code immediately, otherwise reading the body fails once the limit is exceeded and handlers respond with 413.
+// Limit that is not positive disables the filter.
+func maxRequestBodyFilter(limit int64) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		routeLimit := requestBodyLimit(limit, request.SelectedRoutePath())
+		if routeLimit <= 0 || request.Request.Body == nil || request.Request.Body == http.NoBody {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		if request.Request.ContentLength > routeLimit {
+			errors.HandleInternalError(response, errors.NewGenericResponse(http.StatusRequestEntityTooLarge, ""))
+			return
+		}
+
+		request.Request.Body = &limitedBody{
+			ReadCloser: http.MaxBytesReader(response.ResponseWriter, request.Request.Body, routeLimit),
+			limit:      routeLimit,
+		}
+		chain.ProcessFilter(request, response)
+	}
+}
+
+// Returns body size limit of given route.
+func requestBodyLimit(limit int64, route string) int64 {
+	if multiple, ok := largeRequestBodyRoutes[route]; ok {
+		return limit * multiple
+	}
+
+	return limit
+}
+
+// Request body limited by http.MaxBytesReader, which also makes the server close the connection once the limit is
+// exceeded. Reads past the limit fail with errors.ErrRequestBodyTooLarge, so handlers can respond with 413.
+type limitedBody struct {
+	io.ReadCloser
+	limit int64
+	read  int64
+}
+
+// Read implements io.Reader.
+func (self *limitedBody) Read(p []byte) (int, error) {
+	n, err := self.ReadCloser.Read(p)
+	self.read += int64(n)
+	if err != nil && err != io.EOF && self.read >= self.limit {
+		return n, errors.ErrRequestBodyTooLarge
+	}
+
+	return n, err
+}
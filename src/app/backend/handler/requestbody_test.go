@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+func TestMaxRequestBodyFilter(t *testing.T) {
+	read := func(request *restful.Request, response *restful.Response) {
+		if _, err := ioutil.ReadAll(request.Request.Body); err != nil {
+			errors.HandleInternalError(response, err)
+			return
+		}
+		response.WriteHeader(http.StatusOK)
+	}
+
+	cases := []struct {
+		info           string
+		limit          int64
+		path           string
+		body           string
+		chunked        bool
+		expectedStatus int
+	}{
+		{"Should allow body within the limit", 10, "/api/v1/namespace", "0123456789", false, http.StatusOK},
+		{"Should reject body over the limit", 10, "/api/v1/namespace", "0123456789a", false,
+			http.StatusRequestEntityTooLarge},
+		{"Should reject chunked body over the limit", 10, "/api/v1/namespace", "0123456789a", true,
+			http.StatusRequestEntityTooLarge},
+		{"Should allow larger body for file upload", 10, "/api/v1/appdeploymentfromfile", strings.Repeat("a", 40),
+			true, http.StatusOK},
+		{"Should reject file upload over its limit", 10, "/api/v1/appdeploymentfromfile", strings.Repeat("a", 41),
+			false, http.StatusRequestEntityTooLarge},
+		{"Should allow any body when limit is disabled", 0, "/api/v1/namespace", strings.Repeat("a", 100), false,
+			http.StatusOK},
+	}
+
+	for _, c := range cases {
+		ws := new(restful.WebService)
+		ws.Filter(maxRequestBodyFilter(c.limit))
+		ws.Filter(requestAndResponseLogger)
+		ws.Path("/api/v1")
+		ws.Route(ws.POST("/namespace").To(read))
+		ws.Route(ws.POST("/appdeploymentfromfile").To(read))
+		container := restful.NewContainer()
+		container.Add(ws)
+
+		request := httptest.NewRequest(http.MethodPost, c.path, strings.NewReader(c.body))
+		if c.chunked {
+			request.ContentLength = -1
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
+)
+
+// Maximum length of the request ID accepted from the client. Longer IDs are replaced with generated ones.
+const maxRequestIDLength = 128
+
+// Filter used to attach ID to every request, so that it can be correlated across logs of dashboard and other
+// components. ID sent by the client in the configured header is reused, otherwise a new UUID is generated. ID is
+// echoed back to the client in the same header.
+func requestIDFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	header := args.Holder.GetRequestIDHeader()
+	requestID := request.Request.Header.Get(header)
+	if !isValidRequestID(requestID) {
+		requestID = string(uuid.NewUUID())
+	}
+
+	request.Request = request.Request.WithContext(logging.WithRequestID(request.Request.Context(), requestID))
+	response.AddHeader(header, requestID)
+	chain.ProcessFilter(request, response)
+}
+
+// Request ID is written to the logs, so only reasonably short IDs consisting of printable ASCII characters are
+// accepted to prevent log injection.
+func isValidRequestID(requestID string) bool {
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range requestID {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/logging"
+)
+
+func TestRequestIDFilter(t *testing.T) {
+	args.GetHolderBuilder().SetRequestIDHeader("X-Request-ID")
+
+	var contextRequestID string
+	ws := new(restful.WebService)
+	ws.Filter(requestIDFilter)
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		contextRequestID = logging.RequestID(request.Request.Context())
+		response.WriteHeader(http.StatusOK)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info      string
+		requestID string
+		generated bool
+	}{
+		{"Should reuse request ID sent by the client", "abc-123", false},
+		{"Should generate request ID if it is missing", "", true},
+		{"Should generate request ID if it contains control characters", "abc\n123", true},
+		{"Should generate request ID if it is too long", strings.Repeat("a", maxRequestIDLength+1), true},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodGet, "/test", nil)
+		if len(c.requestID) > 0 {
+			request.Header["X-Request-Id"] = []string{c.requestID}
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		actual := recorder.Header().Get("X-Request-ID")
+		if actual != contextRequestID {
+			t.Errorf("Test Case: %s. Expected echoed request ID %q to match request context ID %q.", c.info,
+				actual, contextRequestID)
+		}
+
+		if c.generated && (actual == c.requestID || len(actual) == 0) {
+			t.Errorf("Test Case: %s. Expected request ID to be generated, but got %q.", c.info, actual)
+		}
+
+		if !c.generated && actual != c.requestID {
+			t.Errorf("Test Case: %s. Expected request ID %q, but got %q.", c.info, c.requestID, actual)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSecurityHeadersHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cases := []struct {
+		hstsMaxAge   int
+		frameOptions string
+		expected     map[string]string
+	}{
+		{31536000, FrameOptionsDeny, map[string]string{
+			"Strict-Transport-Security": "max-age=31536000",
+			"X-Content-Type-Options":    "nosniff",
+			"X-Frame-Options":           "DENY",
+		}},
+		{0, FrameOptionsSameOrigin, map[string]string{
+			"Strict-Transport-Security": "",
+			"X-Content-Type-Options":    "nosniff",
+			"X-Frame-Options":           "SAMEORIGIN",
+		}},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		MakeSecurityHeadersHandler(next, c.hstsMaxAge, c.frameOptions).
+			ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		for header, expected := range c.expected {
+			if actual := recorder.Header().Get(header); actual != expected {
+				t.Errorf("MakeSecurityHeadersHandler(%d, %s): expected %s header %q, but got %q", c.hstsMaxAge,
+					c.frameOptions, header, expected, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Header set by the frontend on requests that are not triggered by the user, i.e. auto refresh of resources.
+// Such requests do not count as session activity.
+const backgroundRequestHeader = "X-Dashboard-Background"
+
+// sessionTracker rejects requests of sessions that were inactive for longer than the idle timeout. Sessions are
+// identified by credentials sent with the request, so every token generated on login starts a new session, while
+// refreshed token continues the session of the token it was refreshed from. Unauthenticated requests and requests
+// with credentials not accepted by the apiserver are not tracked.
+type sessionTracker struct {
+	mux     sync.Mutex
+	timeout time.Duration
+	// Zero if sessions are never forgotten.
+	retention    time.Duration
+	lastActivity map[string]time.Time
+	lastSweep    time.Time
+	// Checks that the apiserver accepts credentials of the request before the session is started.
+	verify func(request *restful.Request) error
+}
+
+// Tracker of idle sessions installed by InstallFilters, nil if idle timeout is disabled.
+var idleSessions *sessionTracker
+
+// Filter rejects request with 401 status code if session of the user is idle for longer than the timeout.
+func (self *sessionTracker) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	key := client.CredentialsKey(request.Request)
+	if len(key) == 0 || !self.isStarted(key) && self.verify(request) != nil ||
+		self.touch(key, !isBackgroundRequest(request.Request), time.Now()) {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	errors.HandleInternalError(response, errors.NewUnauthorized(errors.MsgSessionIdleError))
+}
+
+// Checks if session with given key is still active and records the activity if request was made by the user.
+// First request of the session always starts it, as it is sent right after the login.
+func (self *sessionTracker) touch(key string, userActivity bool, now time.Time) bool {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	self.sweep(now)
+	lastActivity, exists := self.lastActivity[key]
+	if exists && now.Sub(lastActivity) > self.timeout {
+		return false
+	}
+
+	if !exists || userActivity {
+		self.lastActivity[key] = now
+	}
+
+	return true
+}
+
+func (self *sessionTracker) isStarted(key string) bool {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	_, exists := self.lastActivity[key]
+	return exists
+}
+
+// Returns time after which session with given key is rejected as idle. Returns false if session was not started yet.
+func (self *sessionTracker) idleDeadline(key string) (time.Time, bool) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	lastActivity, exists := self.lastActivity[key]
+	return lastActivity.Add(self.timeout), exists
+}
+
+// CheckRefresh implements authApi.SessionGuard interface. Tokens of idle sessions and of sessions that were not
+// started can not be refreshed.
+func (self *sessionTracker) CheckRefresh(token string) error {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	lastActivity, exists := self.lastActivity[client.JWETokenKey(token)]
+	if !exists || time.Since(lastActivity) > self.timeout {
+		return errors.NewUnauthorized(errors.MsgSessionIdleError)
+	}
+
+	return nil
+}
+
+// Refreshed implements authApi.SessionGuard interface. Refresh does not count as user activity, so refreshed token
+// is rejected once the session is idle.
+func (self *sessionTracker) Refreshed(token, refreshedToken string) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	if lastActivity, exists := self.lastActivity[client.JWETokenKey(token)]; exists {
+		self.lastActivity[client.JWETokenKey(refreshedToken)] = lastActivity
+	}
+}
+
+// Removes sessions idle for longer than the retention. Tokens of such sessions are already expired, so they can not
+// be reused.
+func (self *sessionTracker) sweep(now time.Time) {
+	if self.retention == 0 || now.Sub(self.lastSweep) < self.timeout {
+		return
+	}
+
+	for key, lastActivity := range self.lastActivity {
+		if now.Sub(lastActivity) > self.retention {
+			delete(self.lastActivity, key)
+		}
+	}
+	self.lastSweep = now
+}
+
+func isBackgroundRequest(request *http.Request) bool {
+	return request.Header.Get(backgroundRequestHeader) == "true"
+}
+
+// Returns time after which idle session can be forgotten, that is idle timeout extended by the longest token TTL
+// of the enabled authentication modes. Returns zero if tokens of any enabled mode never expire.
+func sessionRetention() time.Duration {
+	modeTTLs := map[authApi.AuthenticationMode]int{
+		authApi.Basic: args.Holder.GetTokenTTLBasic(),
+		authApi.Token: args.Holder.GetTokenTTLToken(),
+	}
+	modes := authApi.ToAuthenticationModes(args.Holder.GetAuthenticationMode())
+	if len(modes) == 0 {
+		modes.Add(authApi.Token)
+	}
+
+	longest := 0
+	for _, mode := range modes.Array() {
+		// Negative values mean that mode uses --token-ttl
+		ttl, exists := modeTTLs[mode]
+		if !exists || ttl < 0 {
+			ttl = args.Holder.GetTokenTTL()
+		}
+
+		if ttl == 0 {
+			return 0
+		}
+
+		if ttl > longest {
+			longest = ttl
+		}
+	}
+
+	return time.Duration(args.Holder.GetSessionIdleTimeout()+longest) * time.Second
+}
+
+func newSessionTracker(timeout, retention time.Duration, verify func(request *restful.Request) error) *sessionTracker {
+	return &sessionTracker{
+		timeout:      timeout,
+		retention:    retention,
+		lastActivity: map[string]time.Time{},
+		lastSweep:    time.Now(),
+		verify:       verify,
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+)
+
+func TestSessionTracker_Touch(t *testing.T) {
+	tracker := newSessionTracker(time.Minute, time.Hour, nil)
+	now := time.Now()
+
+	cases := []struct {
+		info         string
+		key          string
+		userActivity bool
+		at           time.Time
+		expected     bool
+	}{
+		{"First request should start the session", "a", false, now, true},
+		{"User request within timeout should pass", "a", true, now.Add(50 * time.Second), true},
+		{"Background request should not extend the session", "a", false, now.Add(100 * time.Second), true},
+		{"Request after idle timeout should be rejected", "a", true, now.Add(111 * time.Second), false},
+		{"Session should stay locked", "a", true, now.Add(112 * time.Second), false},
+		{"Other session should be tracked separately", "b", true, now.Add(112 * time.Second), true},
+	}
+
+	for _, c := range cases {
+		if actual := tracker.touch(c.key, c.userActivity, c.at); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected %t, but got %t.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestSessionTracker_Filter(t *testing.T) {
+	ws := new(restful.WebService)
+	verify := func(request *restful.Request) error {
+		if request.Request.Header.Get("Authorization") != "Bearer test" {
+			return errors.New("Unauthorized")
+		}
+		return nil
+	}
+	tracker := newSessionTracker(-time.Second, time.Hour, verify)
+	ws.Filter(tracker.Filter)
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusOK)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	cases := []struct {
+		info           string
+		authorization  string
+		expectedStatus int
+	}{
+		{"Unauthenticated request should not be tracked", "", http.StatusOK},
+		{"First request should start the session", "Bearer test", http.StatusOK},
+		{"Idle session should be rejected", "Bearer test", http.StatusUnauthorized},
+		{"Unauthenticated request should still pass", "", http.StatusOK},
+		{"Request with invalid token should not start the session", "Bearer random", http.StatusOK},
+		{"Request with invalid token should not be tracked", "Bearer random", http.StatusOK},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodGet, "/test", nil)
+		if len(c.authorization) > 0 {
+			request.Header.Set("Authorization", c.authorization)
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, request)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+
+	if len(tracker.lastActivity) != 1 {
+		t.Errorf("Expected only session with valid token to be tracked, but got %v.", tracker.lastActivity)
+	}
+}
+
+func TestSessionTracker_Sweep(t *testing.T) {
+	tracker := newSessionTracker(time.Minute, time.Hour, nil)
+	now := tracker.lastSweep
+
+	tracker.touch("a", true, now)
+	tracker.touch("b", true, now.Add(30*time.Minute))
+	tracker.touch("c", true, now.Add(time.Hour+time.Minute))
+
+	if _, exists := tracker.lastActivity["a"]; exists || len(tracker.lastActivity) != 2 {
+		t.Errorf("Expected session idle for longer than retention to be removed, but got %v.",
+			tracker.lastActivity)
+	}
+}
+
+func TestSessionTracker_CheckRefresh(t *testing.T) {
+	tracker := newSessionTracker(time.Minute, time.Hour, nil)
+	now := time.Now()
+	tracker.touch(client.JWETokenKey("active"), true, now)
+	tracker.touch(client.JWETokenKey("idle"), true, now.Add(-2*time.Minute))
+
+	cases := []struct {
+		info     string
+		token    string
+		expected bool
+	}{
+		{"Should refresh token of active session", "active", true},
+		{"Should reject refresh after idle timeout", "idle", false},
+		{"Should reject refresh of unknown session", "unknown", false},
+	}
+
+	for _, c := range cases {
+		if err := tracker.CheckRefresh(c.token); (err == nil) != c.expected {
+			t.Errorf("Test Case: %s. Expected refresh allowed to be %t, but got %v.", c.info, c.expected, err)
+		}
+	}
+
+	tracker.Refreshed("active", "refreshed")
+	tracker.Refreshed("unknown", "other")
+	if lastActivity := tracker.lastActivity[client.JWETokenKey("refreshed")]; !lastActivity.Equal(now) {
+		t.Errorf("Expected refreshed token to continue the session, but got last activity %s.", lastActivity)
+	}
+
+	if _, exists := tracker.lastActivity[client.JWETokenKey("other")]; exists {
+		t.Errorf("Expected refresh of unknown session not to start a session, but got %v.", tracker.lastActivity)
+	}
+
+	if tracker.touch(client.JWETokenKey("refreshed"), true, now.Add(2*time.Minute)) {
+		t.Error("Expected refreshed token to be rejected once the session is idle.")
+	}
+}
+
+func TestSessionRetention(t *testing.T) {
+	defer args.GetHolderBuilder().SetSessionIdleTimeout(0)
+	defer args.GetHolderBuilder().SetTokenTTL(0)
+	defer args.GetHolderBuilder().SetTokenTTLToken(0)
+	defer args.GetHolderBuilder().SetTokenTTLBasic(0)
+	defer args.GetHolderBuilder().SetAuthenticationMode(nil)
+	args.GetHolderBuilder().SetSessionIdleTimeout(60)
+
+	cases := []struct {
+		info     string
+		modes    []string
+		ttl      int
+		ttlBasic int
+		ttlToken int
+		expected time.Duration
+	}{
+		{"Should extend timeout by the longest token TTL", []string{"token", "basic"}, 900, -1, 1800,
+			31 * time.Minute},
+		{"Should not forget sessions if tokens never expire", []string{"token"}, 900, -1, 0, 0},
+		{"Should not forget sessions if mode without override never expires", []string{"token", "basic"}, 0, 900,
+			-1, 0},
+		{"Should use overrides if every mode has positive TTL", []string{"token", "basic"}, 0, 900, 1800,
+			31 * time.Minute},
+		{"Should ignore overrides of disabled modes", []string{"token"}, 900, 0, -1, 16 * time.Minute},
+		{"Should use token mode by default", nil, 0, -1, 1800, 31 * time.Minute},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetAuthenticationMode(c.modes)
+		args.GetHolderBuilder().SetTokenTTL(c.ttl)
+		args.GetHolderBuilder().SetTokenTTLBasic(c.ttlBasic)
+		args.GetHolderBuilder().SetTokenTTLToken(c.ttlToken)
+		if actual := sessionRetention(); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected retention %s, but got %s.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestSessionTracker_SweepWithoutRetention(t *testing.T) {
+	tracker := newSessionTracker(time.Minute, 0, nil)
+	now := tracker.lastSweep
+
+	tracker.touch("a", true, now)
+	tracker.touch("b", true, now.Add(48*time.Hour))
+
+	if len(tracker.lastActivity) != 2 || tracker.touch("a", true, now.Add(48*time.Hour)) {
+		t.Errorf("Expected sessions to be kept and locked when tokens never expire, but got %v.",
+			tracker.lastActivity)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/igm/sockjs-go.v2/sockjs"
+)
+
+// Time between checks of the session expiration time.
+var sessionWarningCheckInterval = time.Second
+
+// Reasons of the session expiration sent with SessionWarningMessage.
+const (
+	// SessionExpiryToken means that the token of the session expires.
+	SessionExpiryToken = "token"
+	// SessionExpiryIdle means that the session is rejected after the idle timeout.
+	SessionExpiryIdle = "idle"
+)
+
+// SessionWarningResponse is sent by handleSessionWarning. The Id is a random session id that binds the original REST
+// request and the SockJS connection.
+type SessionWarningResponse struct {
+	ID string `json:"id"`
+}
+
+// SessionWarningMessage is the messaging protocol between the frontend and the session warning channel.
+//
+// OP        DIRECTION  FIELD(S) USED      DESCRIPTION
+// ---------------------------------------------------------------------
+// bind      fe->be     SessionID          Id sent back from SessionWarningResponse
+// warning   be->fe     Reason, ExpiresIn  Session expires in ExpiresIn seconds unless it is extended
+// extended  be->fe                        Session was extended by user activity and the warning can be dismissed
+// expired   be->fe     Reason             Session has expired and user has to log in again
+type SessionWarningMessage struct {
+	Op        string `json:"op"`
+	SessionID string `json:"sessionId,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	ExpiresIn int    `json:"expiresIn,omitempty"`
+}
+
+// sessionWarningChannel pushes warnings about expiration of the session identified by the credentials key.
+type sessionWarningChannel struct {
+	key         string
+	tokenExpiry time.Time
+	bound       chan sockjs.Session
+}
+
+func newSessionWarningChannel(key string, tokenExpiry time.Time) *sessionWarningChannel {
+	return &sessionWarningChannel{key: key, tokenExpiry: tokenExpiry, bound: make(chan sockjs.Session, 1)}
+}
+
+// sessionWarningChannelMap stores channels that wait for the SockJS connection to be bound.
+type sessionWarningChannelMap struct {
+	mux      sync.Mutex
+	channels map[string]*sessionWarningChannel
+}
+
+func (self *sessionWarningChannelMap) add(id string, channel *sessionWarningChannel) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.channels[id] = channel
+}
+
+func (self *sessionWarningChannelMap) get(id string) *sessionWarningChannel {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	return self.channels[id]
+}
+
+func (self *sessionWarningChannelMap) remove(id string) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	delete(self.channels, id)
+}
+
+var sessionWarningChannels = sessionWarningChannelMap{channels: map[string]*sessionWarningChannel{}}
+
+// sessionWarner decides which message should be sent to the user based on the current expiration time of the session.
+// Warning is sent once per expiration time, the frontend counts down the remaining time on its own.
+type sessionWarner struct {
+	leadTime       time.Duration
+	warnedDeadline time.Time
+}
+
+// Returns message that should be sent to the user or nil if nothing changed since the last check. Zero deadline
+// means that session never expires.
+func (self *sessionWarner) check(deadline time.Time, reason string, now time.Time) *SessionWarningMessage {
+	if deadline.IsZero() || deadline.Sub(now) > self.leadTime {
+		if self.warnedDeadline.IsZero() {
+			return nil
+		}
+
+		self.warnedDeadline = time.Time{}
+		return &SessionWarningMessage{Op: "extended"}
+	}
+
+	if !deadline.After(now) {
+		return &SessionWarningMessage{Op: "expired", Reason: reason}
+	}
+
+	if deadline.Equal(self.warnedDeadline) {
+		return nil
+	}
+
+	self.warnedDeadline = deadline
+	return &SessionWarningMessage{Op: "warning", Reason: reason,
+		ExpiresIn: int(math.Ceil(deadline.Sub(now).Seconds()))}
+}
+
+// Returns time when session with given key expires and the reason of the expiration. Session expires either when its
+// token expires or when it becomes idle, whichever comes first. Returns zero time if session never expires.
+func sessionDeadline(tokenExpiry time.Time, key string) (time.Time, string) {
+	deadline, reason := tokenExpiry, SessionExpiryToken
+	if idleSessions == nil {
+		return deadline, reason
+	}
+
+	if idle, exists := idleSessions.idleDeadline(key); exists && (deadline.IsZero() || idle.Before(deadline)) {
+		return idle, SessionExpiryIdle
+	}
+
+	return deadline, reason
+}
+
+// handleSessionWarningSession is called by net/http for any new /api/session connections.
+func handleSessionWarningSession(session sockjs.Session) {
+	buf, err := session.Recv()
+	if err != nil {
+		log.Printf("handleSessionWarningSession: can't Recv: %v", err)
+		return
+	}
+
+	var msg SessionWarningMessage
+	if err = json.Unmarshal([]byte(buf), &msg); err != nil {
+		log.Printf("handleSessionWarningSession: can't UnMarshal (%v): %s", err, buf)
+		return
+	}
+
+	if msg.Op != "bind" {
+		log.Printf("handleSessionWarningSession: expected 'bind' message, got: %s", buf)
+		return
+	}
+
+	channel := sessionWarningChannels.get(msg.SessionID)
+	if channel == nil {
+		log.Printf("handleSessionWarningSession: can't find session '%s'", msg.SessionID)
+		return
+	}
+
+	select {
+	case channel.bound <- session:
+	default:
+		log.Printf("handleSessionWarningSession: session '%s' is already bound", msg.SessionID)
+	}
+}
+
+// CreateSessionWarningHandler is called from main for /api/session.
+func CreateSessionWarningHandler(path string) http.Handler {
+	return newSockJSHandler(path, handleSessionWarningSession)
+}
+
+// WaitForSessionWarnings is called from apihandler.handleSessionWarning as a goroutine. It waits for the SockJS
+// connection to be bound in handleSessionWarningSession and then pushes warnings about the session expiration until
+// the session expires or the connection is closed by the client.
+func WaitForSessionWarnings(sessionID string, leadTime time.Duration) {
+	defer sessionWarningChannels.remove(sessionID)
+	channel := sessionWarningChannels.get(sessionID)
+
+	var session sockjs.Session
+	select {
+	case session = <-channel.bound:
+	case <-time.After(watchBindTimeout):
+		log.Printf("Session warning channel %s was not bound in %s", sessionID, watchBindTimeout)
+		return
+	}
+
+	activeWebSocketConnections.Inc()
+	defer activeWebSocketConnections.Dec()
+
+	closed := make(chan struct{})
+	go func() {
+		for {
+			if _, err := session.Recv(); err != nil {
+				close(closed)
+				return
+			}
+		}
+	}()
+
+	warner := &sessionWarner{leadTime: leadTime}
+	ticker := time.NewTicker(sessionWarningCheckInterval)
+	defer ticker.Stop()
+	for {
+		deadline, reason := sessionDeadline(channel.tokenExpiry, channel.key)
+		if msg := warner.check(deadline, reason, time.Now()); msg != nil {
+			data, err := json.Marshal(msg)
+			if err == nil {
+				err = session.Send(string(data))
+			}
+
+			if err != nil {
+				session.Close(2, err.Error())
+				return
+			}
+
+			if msg.Op == "expired" {
+				session.Close(1, "Session expired")
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-closed:
+			return
+		}
+	}
+}
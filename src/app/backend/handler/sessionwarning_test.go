@@ -0,0 +1,93 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSessionWarnerCheck(t *testing.T) {
+	now := time.Now()
+	deadline := now.Add(30 * time.Second)
+	warner := &sessionWarner{leadTime: time.Minute}
+
+	cases := []struct {
+		info     string
+		deadline time.Time
+		now      time.Time
+		expected *SessionWarningMessage
+	}{
+		{"Should not warn if session never expires", time.Time{}, now, nil},
+		{"Should not warn before the lead time", now.Add(2 * time.Minute), now, nil},
+		{"Should warn within the lead time", deadline, now,
+			&SessionWarningMessage{Op: "warning", Reason: SessionExpiryIdle, ExpiresIn: 30}},
+		{"Should warn only once per deadline", deadline, now.Add(time.Second), nil},
+		{"Should report extended session", now.Add(5 * time.Minute), now.Add(2 * time.Second),
+			&SessionWarningMessage{Op: "extended"}},
+		{"Should not report extended session twice", now.Add(5 * time.Minute), now.Add(3 * time.Second), nil},
+		{"Should warn again for the new deadline", now.Add(5 * time.Minute), now.Add(4*time.Minute + 30*time.Second),
+			&SessionWarningMessage{Op: "warning", Reason: SessionExpiryIdle, ExpiresIn: 30}},
+		{"Should report expired session", now.Add(5 * time.Minute), now.Add(5 * time.Minute),
+			&SessionWarningMessage{Op: "expired", Reason: SessionExpiryIdle}},
+	}
+
+	for _, c := range cases {
+		actual := warner.check(c.deadline, SessionExpiryIdle, c.now)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %+v, but got %+v.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestSessionDeadline(t *testing.T) {
+	defer func() {
+		idleSessions = nil
+	}()
+
+	now := time.Now()
+	tracker := newSessionTracker(time.Minute, time.Hour, nil)
+	tracker.touch("active", true, now)
+
+	cases := []struct {
+		info             string
+		tracker          *sessionTracker
+		tokenExpiry      time.Time
+		key              string
+		expectedDeadline time.Time
+		expectedReason   string
+	}{
+		{"Should use token expiry if idle timeout is disabled", nil, now.Add(time.Hour), "active",
+			now.Add(time.Hour), SessionExpiryToken},
+		{"Should use idle deadline if it comes first", tracker, now.Add(time.Hour), "active",
+			now.Add(time.Minute), SessionExpiryIdle},
+		{"Should use token expiry if it comes first", tracker, now.Add(time.Second), "active",
+			now.Add(time.Second), SessionExpiryToken},
+		{"Should use idle deadline if token never expires", tracker, time.Time{}, "active",
+			now.Add(time.Minute), SessionExpiryIdle},
+		{"Should use token expiry if session was not started", tracker, now.Add(time.Hour), "other",
+			now.Add(time.Hour), SessionExpiryToken},
+	}
+
+	for _, c := range cases {
+		idleSessions = c.tracker
+		deadline, reason := sessionDeadline(c.tokenExpiry, c.key)
+		if !deadline.Equal(c.expectedDeadline) || reason != c.expectedReason {
+			t.Errorf("Test Case: %s. Expected deadline %s with reason %s, but got %s with reason %s.", c.info,
+				c.expectedDeadline, c.expectedReason, deadline, reason)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"sync"
+)
+
+// StartupHandler responds with 503 to all requests until the actual handler is set, i.e. while dashboard waits for
+// the initial connection to the apiserver. This way readiness checks fail instead of the listeners being down.
+type StartupHandler struct {
+	mux     sync.RWMutex
+	handler http.Handler
+}
+
+// SetHandler sets handler that serves all following requests.
+func (self *StartupHandler) SetHandler(handler http.Handler) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.handler = handler
+}
+
+// ServeHTTP implements http.Handler interface.
+func (self *StartupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	self.mux.RLock()
+	handler := self.handler
+	self.mux.RUnlock()
+
+	if handler != nil {
+		handler.ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Retry-After", "1")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	w.Write([]byte("[-]apiserver failed: waiting for the initial connection to the apiserver\n"))
+}
+
+// NewStartupHandler creates StartupHandler without any handler set.
+func NewStartupHandler() *StartupHandler {
+	return &StartupHandler{}
+}
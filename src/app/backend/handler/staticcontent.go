@@ -0,0 +1,70 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+// overlayFileSystem serves files from the first file system that contains them. It is used to overlay bundled
+// frontend assets with files from --static-content-dir.
+type overlayFileSystem []http.FileSystem
+
+// Open implements http.FileSystem interface. Lookup falls back to the next file system only if the file does not
+// exist, other errors are returned.
+func (self overlayFileSystem) Open(name string) (http.File, error) {
+	var err error
+	for _, fs := range self {
+		var file http.File
+		file, err = fs.Open(name)
+		if err == nil || !os.IsNotExist(err) {
+			return file, err
+		}
+	}
+
+	return nil, err
+}
+
+// Returns file system with frontend assets of given localized directory. If --static-content-dir is set its files
+// take precedence over bundled assets. It has the same layout as the bundled assets directory, i.e. 'en/index.html'.
+func localizedFileSystem(dirName string) http.FileSystem {
+	staticContentDir := args.Holder.GetStaticContentDir()
+	if len(staticContentDir) == 0 {
+		return http.Dir(dirName)
+	}
+
+	rel, err := filepath.Rel(getAssetsDir(), dirName)
+	if err != nil {
+		return http.Dir(dirName)
+	}
+
+	return overlayFileSystem{http.Dir(filepath.Join(staticContentDir, rel)), http.Dir(dirName)}
+}
+
+// Reads whole file with given name from the file system.
+func readFile(fs http.FileSystem, name string) ([]byte, error) {
+	file, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ioutil.ReadAll(file)
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOverlayFileSystem(t *testing.T) {
+	overlay, err := ioutil.TempDir("", "dashboard-overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(overlay)
+
+	bundled, err := ioutil.TempDir("", "dashboard-bundled")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(bundled)
+
+	files := map[string]string{
+		filepath.Join(overlay, "main.js"):    "patched",
+		filepath.Join(bundled, "main.js"):    "bundled",
+		filepath.Join(bundled, "styles.css"): "bundled",
+	}
+	for path, content := range files {
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs := overlayFileSystem{http.Dir(overlay), http.Dir(bundled)}
+	cases := []struct {
+		name        string
+		expected    string
+		expectedErr bool
+	}{
+		{"/main.js", "patched", false},
+		{"/styles.css", "bundled", false},
+		{"/missing.js", "", true},
+	}
+
+	for _, c := range cases {
+		content, err := readFile(fs, c.name)
+		if (err != nil) != c.expectedErr || string(content) != c.expected {
+			t.Errorf("readFile(%s) == (%s, %v), expected %s and error: %t", c.name, content, err, c.expected,
+				c.expectedErr)
+		}
+	}
+}
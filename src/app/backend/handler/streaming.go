@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+)
+
+// Routes that stream data to the user for a long time or start such streaming sessions. They are exempted from HTTP
+// server timeouts and request timeout.
+var streamingRoutes = map[string]bool{
+	"/api/v1/log/file/{namespace}/{pod}/{container}":  true,
+	"/api/v1/node/{name}/drain":                       true,
+	"/api/v1/pod/{namespace}/{pod}/shell/{container}": true,
+	"/api/v1/watch/{kind}":                            true,
+	"/api/v1/watch/{kind}/namespace/{namespace}":      true,
+}
+
+// Key under which ConnContext stores the connection that a request was received on.
+type connContextKey struct{}
+
+// ConnContext stores the connection in the context of requests received on it. It should be set as ConnContext of
+// every HTTP server using MakeStreamingHandler or streaming routes, so they can clear connection deadlines.
+func ConnContext(ctx context.Context, conn net.Conn) context.Context {
+	return context.WithValue(ctx, connContextKey{}, conn)
+}
+
+// MakeStreamingHandler exempts given handler from read and write timeouts of the HTTP server. It should be used
+// for long running connections, i.e. WebSocket connections used by exec into containers.
+func MakeStreamingHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		disableTimeouts(r)
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Filter used to exempt streaming routes from HTTP server timeouts.
+func streamingFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if streamingRoutes[request.SelectedRoutePath()] {
+		disableTimeouts(request.Request)
+	}
+
+	chain.ProcessFilter(request, response)
+}
+
+// Clears connection deadlines set by the HTTP server. Deadlines are kept by the connection even after it is hijacked
+// during WebSocket upgrade and are set again by the server before the next request is read. HTTP/2 connections are
+// shared by multiple requests, so their deadlines are left untouched.
+func disableTimeouts(r *http.Request) {
+	conn, ok := r.Context().Value(connContextKey{}).(net.Conn)
+	if !ok || r.ProtoMajor != 1 {
+		return
+	}
+
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		log.Printf("Could not disable read timeout for streaming request: %s", err)
+	}
+
+	if err := conn.SetWriteDeadline(time.Time{}); err != nil {
+		log.Printf("Could not disable write timeout for streaming request: %s", err)
+	}
+}
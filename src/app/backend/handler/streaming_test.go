@@ -0,0 +1,63 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMakeStreamingHandler(t *testing.T) {
+	stream := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("first"))
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("second"))
+	})
+
+	cases := []struct {
+		info      string
+		handler   http.Handler
+		expectErr bool
+	}{
+		{"Should fail when write timeout is exceeded", stream, true},
+		{"Should exempt streaming handler from write timeout", MakeStreamingHandler(stream), false},
+	}
+
+	for _, c := range cases {
+		server := httptest.NewUnstartedServer(c.handler)
+		server.Config.WriteTimeout = 100 * time.Millisecond
+		server.Config.ConnContext = ConnContext
+		server.Start()
+
+		body := ""
+		response, err := http.Get(server.URL)
+		if err == nil {
+			var data []byte
+			data, err = ioutil.ReadAll(response.Body)
+			body = string(data)
+			response.Body.Close()
+		}
+		server.Close()
+
+		if (err != nil || body != "firstsecond") != c.expectErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got body %q and error %v.", c.info, c.expectErr, body,
+				err)
+		}
+	}
+}
@@ -18,10 +18,14 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 
 	restful "github.com/emicklei/go-restful/v3"
@@ -31,10 +35,82 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 )
 
 const END_OF_TRANSMISSION = "\u0004"
 
+// Shells that can be selected with the shell parameter.
+var validShells = []string{"bash", "sh", "powershell", "cmd"}
+
+// Commands tried one by one if neither the request nor --default-exec-command selects the command.
+var defaultExecCommands = [][]string{{"/bin/bash"}, {"/bin/sh"}, {"powershell"}, {"cmd"}}
+
+// Allowed values of the TERM environment variable.
+var termPattern = regexp.MustCompile(`^[A-Za-z0-9._+-]+$`)
+
+// execOptions describe the process started in the container.
+type execOptions struct {
+	// Commands that are tried one by one until one of them starts.
+	commands [][]string
+	// Value of the TERM environment variable, empty if it is not set.
+	term string
+	// Initial size of the terminal, nil if it is not known.
+	size *remotecommand.TerminalSize
+}
+
+// Parses exec options from the request. Command can be given with the command parameter or selected with the shell
+// parameter, otherwise the one set with --default-exec-command or default shells are tried.
+func parseExecOptions(request *restful.Request) (*execOptions, error) {
+	options := &execOptions{term: request.QueryParameter("term")}
+	if len(options.term) > 0 && !termPattern.MatchString(options.term) {
+		return nil, fmt.Errorf("invalid TERM %q", options.term)
+	}
+
+	switch shell := request.QueryParameter("shell"); {
+	case len(strings.Fields(request.QueryParameter("command"))) > 0:
+		options.commands = [][]string{strings.Fields(request.QueryParameter("command"))}
+	case isValidShell(validShells, shell):
+		options.commands = [][]string{{shell}}
+	case len(strings.Fields(args.Holder.GetDefaultExecCommand())) > 0:
+		options.commands = [][]string{strings.Fields(args.Holder.GetDefaultExecCommand())}
+	default:
+		options.commands = defaultExecCommands
+	}
+
+	rows, cols := request.QueryParameter("rows"), request.QueryParameter("cols")
+	if len(rows) == 0 && len(cols) == 0 {
+		return options, nil
+	}
+
+	height, err := strconv.ParseUint(rows, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of rows %q", rows)
+	}
+
+	width, err := strconv.ParseUint(cols, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of columns %q", cols)
+	}
+
+	options.size = &remotecommand.TerminalSize{Width: uint16(width), Height: uint16(height)}
+	return options, nil
+}
+
+// Checks if the process did not start, i.e. because the command does not exist in the container. Errors of processes
+// that exited on their own are not caused by the command, so other commands are not tried then.
+func isExecStartError(err error) bool {
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		// Exit codes used by shells and runtimes for commands that can not be found or executed
+		return exitErr.ExitStatus() == 126 || exitErr.ExitStatus() == 127
+	}
+
+	return true
+}
+
 // PtyHandler is what remotecommand expects from a pty
 type PtyHandler interface {
 	io.Reader
@@ -165,6 +241,7 @@ func (sm *SessionMap) Close(sessionId string, status uint32, reason string) {
 	if err != nil {
 		log.Println(err)
 	}
+	activeWebSocketConnections.Dec()
 
 	delete(sm.Sessions, sessionId)
 }
@@ -202,12 +279,13 @@ func handleTerminalSession(session sockjs.Session) {
 
 	terminalSession.sockJSSession = session
 	terminalSessions.Set(msg.SessionID, terminalSession)
+	activeWebSocketConnections.Inc()
 	terminalSession.bound <- nil
 }
 
 // CreateAttachHandler is called from main for /api/sockjs
 func CreateAttachHandler(path string) http.Handler {
-	return sockjs.NewHandler(path, sockjs.DefaultOptions, handleTerminalSession)
+	return newSockJSHandler(path, handleTerminalSession)
 }
 
 // startProcess is called by handleAttach
@@ -275,32 +353,58 @@ func isValidShell(validShells []string, shell string) bool {
 	return false
 }
 
+// Starts the command in the container. If TERM is set, command is started with env first and without it if env does
+// not exist in the container. Initial size of the terminal is queued before every attempt.
+func startCommand(k8sClient kubernetes.Interface, cfg *rest.Config, request *restful.Request, cmd []string,
+	options *execOptions, session TerminalSession) error {
+	attempts := [][]string{cmd}
+	if len(options.term) > 0 {
+		attempts = [][]string{append([]string{"env", "TERM=" + options.term}, cmd...), cmd}
+	}
+
+	var err error
+	for _, attempt := range attempts {
+		if options.size != nil {
+			select {
+			case session.sizeChan <- *options.size:
+			default:
+			}
+		}
+
+		if err = startProcess(k8sClient, cfg, request, attempt, session); err == nil || !isExecStartError(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
 // WaitForTerminal is called from apihandler.handleAttach as a goroutine
 // Waits for the SockJS connection to be opened by the client the session to be bound in handleTerminalSession
-func WaitForTerminal(k8sClient kubernetes.Interface, cfg *rest.Config, request *restful.Request, sessionId string) {
-	shell := request.QueryParameter("shell")
-
+func WaitForTerminal(k8sClient kubernetes.Interface, cfg *rest.Config, request *restful.Request, sessionId string,
+	options *execOptions) {
 	select {
 	case <-terminalSessions.Get(sessionId).bound:
 		close(terminalSessions.Get(sessionId).bound)
 
+		// Try commands until one of them starts or all fail
+		// FIXME: if the first command fails then the first keyboard event is lost
 		var err error
-		validShells := []string{"bash", "sh", "powershell", "cmd"}
-
-		if isValidShell(validShells, shell) {
-			cmd := []string{shell}
-			err = startProcess(k8sClient, cfg, request, cmd, terminalSessions.Get(sessionId))
-		} else {
-			// No shell given or it was not valid: try some shells until one succeeds or all fail
-			// FIXME: if the first shell fails then the first keyboard event is lost
-			for _, testShell := range validShells {
-				cmd := []string{testShell}
-				if err = startProcess(k8sClient, cfg, request, cmd, terminalSessions.Get(sessionId)); err == nil {
-					break
-				}
+		tried := make([]string, 0, len(options.commands))
+		for _, cmd := range options.commands {
+			tried = append(tried, strings.Join(cmd, " "))
+			if err = startCommand(k8sClient, cfg, request, cmd, options, terminalSessions.Get(sessionId)); err == nil ||
+				!isExecStartError(err) {
+				break
 			}
 		}
 
+		if err != nil && isExecStartError(err) {
+			terminalSessions.Close(sessionId, 2, fmt.Sprintf("Could not start a shell in container %s, tried: %s. "+
+				"Last error: %s", request.PathParameter("container"), strings.Join(tried, ", "), err.Error()))
+			return
+		}
+
 		if err != nil {
 			terminalSessions.Close(sessionId, 2, err.Error())
 			return
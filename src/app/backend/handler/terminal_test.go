@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+func TestParseExecOptions(t *testing.T) {
+	defer args.GetHolderBuilder().SetDefaultExecCommand("")
+
+	cases := []struct {
+		info           string
+		query          string
+		defaultCommand string
+		expected       *execOptions
+		expectedErr    bool
+	}{
+		{"Should try default shells", "", "", &execOptions{commands: defaultExecCommands}, false},
+		{"Should use command from the request", "?command=/busybox/sh+-l&shell=bash", "/bin/ash",
+			&execOptions{commands: [][]string{{"/busybox/sh", "-l"}}}, false},
+		{"Should use selected shell", "?shell=bash", "/bin/ash", &execOptions{commands: [][]string{{"bash"}}}, false},
+		{"Should use default command", "?shell=unknown", "/bin/ash -l",
+			&execOptions{commands: [][]string{{"/bin/ash", "-l"}}}, false},
+		{"Should parse TERM and terminal size", "?term=xterm-256color&rows=24&cols=80", "",
+			&execOptions{commands: defaultExecCommands, term: "xterm-256color",
+				size: &remotecommand.TerminalSize{Width: 80, Height: 24}}, false},
+		{"Should reject invalid TERM", "?term=xterm%3Brm", "", nil, true},
+		{"Should reject invalid terminal size", "?rows=24", "", nil, true},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetDefaultExecCommand(c.defaultCommand)
+		request := restful.NewRequest(httptest.NewRequest("GET", "/api/v1/pod/ns/pod/shell/container"+c.query, nil))
+
+		actual, err := parseExecOptions(request)
+		if (err != nil) != c.expectedErr || !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %#v and error: %t, but got %#v and %v.", c.info, c.expected,
+				c.expectedErr, actual, err)
+		}
+	}
+}
+
+func TestIsExecStartError(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{errors.New(`exec: "bash": executable file not found in $PATH`), true},
+		{utilexec.CodeExitError{Err: errors.New("command terminated with exit code 127"), Code: 127}, true},
+		{utilexec.CodeExitError{Err: errors.New("command terminated with exit code 1"), Code: 1}, false},
+	}
+
+	for _, c := range cases {
+		if actual := isExecStartError(c.err); actual != c.expected {
+			t.Errorf("isExecStartError(%v) == %t, expected %t", c.err, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MakeTimeoutHandler cancels context of every request handled by given handler once timeout passes and responds
+// with 504 status code if the handler did not finish in time. Response of the handler is buffered until it
+// finishes. Streaming routes are not affected. Timeout lower or equal to 0 disables it.
+func MakeTimeoutHandler(handler http.Handler, timeout time.Duration) http.Handler {
+	if timeout <= 0 {
+		return handler
+	}
+
+	return &timeoutHandler{handler: handler, timeout: timeout}
+}
+
+type timeoutHandler struct {
+	handler http.Handler
+	timeout time.Duration
+}
+
+// ServeHTTP implements http.Handler interface. It works the same way as http.TimeoutHandler, but responds with
+// 504 status code and elapsed time.
+func (self *timeoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if isStreamingPath(r.URL.Path) {
+		self.handler.ServeHTTP(w, r)
+		return
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(r.Context(), self.timeout)
+	defer cancel()
+
+	tw := &timeoutWriter{header: make(http.Header)}
+	done := make(chan struct{})
+	panics := make(chan interface{}, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				panics <- p
+			}
+		}()
+		self.handler.ServeHTTP(tw, r.WithContext(ctx))
+		close(done)
+	}()
+
+	select {
+	case p := <-panics:
+		panic(p)
+	case <-done:
+		tw.mux.Lock()
+		defer tw.mux.Unlock()
+		for key, values := range tw.header {
+			w.Header()[key] = values
+		}
+		if tw.code == 0 {
+			tw.code = http.StatusOK
+		}
+		w.WriteHeader(tw.code)
+		w.Write(tw.body.Bytes())
+	case <-ctx.Done():
+		tw.mux.Lock()
+		defer tw.mux.Unlock()
+		tw.timedOut = true
+		if ctx.Err() == context.DeadlineExceeded {
+			http.Error(w, fmt.Sprintf("Request timed out after %s", time.Since(start).Round(time.Millisecond)),
+				http.StatusGatewayTimeout)
+		}
+	}
+}
+
+// Checks if given path matches one of the streaming routes. Path parameters match any non-empty path segment.
+func isStreamingPath(path string) bool {
+	segments := strings.Split(path, "/")
+	for route := range streamingRoutes {
+		if matchesRoute(strings.Split(route, "/"), segments) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesRoute(routeSegments, pathSegments []string) bool {
+	if len(routeSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range routeSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if len(pathSegments[i]) == 0 {
+				return false
+			}
+			continue
+		}
+
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// timeoutWriter buffers response of the handler, so that it can be discarded when timeout passes.
+type timeoutWriter struct {
+	mux      sync.Mutex
+	header   http.Header
+	body     bytes.Buffer
+	code     int
+	timedOut bool
+}
+
+// Header implements http.ResponseWriter interface.
+func (self *timeoutWriter) Header() http.Header {
+	return self.header
+}
+
+// Write implements http.ResponseWriter interface.
+func (self *timeoutWriter) Write(p []byte) (int, error) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	if self.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+
+	if self.code == 0 {
+		self.code = http.StatusOK
+	}
+	return self.body.Write(p)
+}
+
+// WriteHeader implements http.ResponseWriter interface.
+func (self *timeoutWriter) WriteHeader(code int) {
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	if self.timedOut || self.code != 0 {
+		return
+	}
+
+	self.code = code
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimeoutHandler(t *testing.T) {
+	handler := MakeTimeoutHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/slow") {
+			<-r.Context().Done()
+			return
+		}
+
+		if _, ok := r.Context().Deadline(); ok {
+			w.Header().Set("X-Deadline", "true")
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("done"))
+	}), 50*time.Millisecond)
+
+	cases := []struct {
+		info             string
+		path             string
+		expectedStatus   int
+		expectedBody     string
+		expectedDeadline string
+	}{
+		{"Should pass response of the handler finished in time", "/api/v1/pod", http.StatusCreated, "done",
+			"true"},
+		{"Should respond with 504 if handler did not finish in time", "/api/v1/slow", http.StatusGatewayTimeout,
+			"Request timed out after", ""},
+		{"Should not set deadline for streaming routes", "/api/v1/log/file/default/pod/container",
+			http.StatusCreated, "done", ""},
+		{"Should not set deadline for exec sessions", "/api/v1/pod/default/pod/shell/container",
+			http.StatusCreated, "done", ""},
+		{"Should set deadline for other routes with the same prefix", "/api/v1/pod/default/pod",
+			http.StatusCreated, "done", "true"},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+
+		if !strings.Contains(recorder.Body.String(), c.expectedBody) {
+			t.Errorf("Test Case: %s. Expected body to contain %q, but got %q.", c.info, c.expectedBody,
+				recorder.Body.String())
+		}
+
+		if actual := recorder.Header().Get("X-Deadline"); actual != c.expectedDeadline {
+			t.Errorf("Test Case: %s. Expected X-Deadline header %q, but got %q.", c.info, c.expectedDeadline, actual)
+		}
+	}
+}
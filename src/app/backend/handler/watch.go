@@ -0,0 +1,235 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/igm/sockjs-go.v2/sockjs"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// Time after which watch session that was not bound by the SockJS connection is closed.
+const watchBindTimeout = time.Minute
+
+// WatchResponse is sent by handleWatch. The Id is a random session id that binds the original REST request and the
+// SockJS connection.
+type WatchResponse struct {
+	ID string `json:"id"`
+}
+
+// WatchMessage is the messaging protocol between the frontend and WatchSession.
+//
+// OP      DIRECTION  FIELD(S) USED                  DESCRIPTION
+// ---------------------------------------------------------------------
+// bind    fe->be     SessionID                      Id sent back from WatchResponse
+// event   be->fe     Type, Object, ResourceVersion  Event of type ADDED, MODIFIED, DELETED or ERROR
+// end     be->fe     ResourceVersion                Watch was closed by apiserver, resume it from ResourceVersion
+type WatchMessage struct {
+	Op              string          `json:"op"`
+	SessionID       string          `json:"sessionId,omitempty"`
+	Type            string          `json:"type,omitempty"`
+	Object          json.RawMessage `json:"object,omitempty"`
+	ResourceVersion string          `json:"resourceVersion,omitempty"`
+}
+
+// Watch event in the format returned by the apiserver.
+type watchEvent struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+// WatchSession streams events of the watch opened during the REST request to the SockJS connection.
+type WatchSession struct {
+	id     string
+	owner  string
+	stream io.ReadCloser
+	bound  chan sockjs.Session
+}
+
+func newWatchSession(id, owner string, stream io.ReadCloser) *WatchSession {
+	return &WatchSession{id: id, owner: owner, stream: stream, bound: make(chan sockjs.Session, 1)}
+}
+
+// WatchSessionMap stores all watch sessions and number of watches opened by every user, so that it can be limited.
+type WatchSessionMap struct {
+	Sessions map[string]*WatchSession
+	Watches  map[string]int
+	Lock     sync.Mutex
+}
+
+// Add stores a new session of given owner with given watch stream. Returns error if owner already has maximum number
+// of watches open. Maximum lower or equal to 0 means that number of watches is not limited.
+func (sm *WatchSessionMap) Add(session *WatchSession, max int) error {
+	sm.Lock.Lock()
+	defer sm.Lock.Unlock()
+	if max > 0 && sm.Watches[session.owner] >= max {
+		return errors.NewGenericResponse(http.StatusTooManyRequests,
+			fmt.Sprintf("Maximum number of %d watches per session exceeded", max))
+	}
+
+	sm.Sessions[session.id] = session
+	sm.Watches[session.owner]++
+	return nil
+}
+
+// Get returns a session with given id or nil if it does not exist.
+func (sm *WatchSessionMap) Get(sessionId string) *WatchSession {
+	sm.Lock.Lock()
+	defer sm.Lock.Unlock()
+	return sm.Sessions[sessionId]
+}
+
+// Remove stops the watch of the session with given id and removes it from the map. It does nothing if session was
+// already removed.
+func (sm *WatchSessionMap) Remove(sessionId string) {
+	sm.Lock.Lock()
+	defer sm.Lock.Unlock()
+	session, ok := sm.Sessions[sessionId]
+	if !ok {
+		return
+	}
+
+	if err := session.stream.Close(); err != nil {
+		log.Printf("Could not close watch %s: %s", sessionId, err)
+	}
+
+	delete(sm.Sessions, sessionId)
+	if sm.Watches[session.owner]--; sm.Watches[session.owner] <= 0 {
+		delete(sm.Watches, session.owner)
+	}
+}
+
+var watchSessions = WatchSessionMap{Sessions: map[string]*WatchSession{}, Watches: map[string]int{}}
+
+// handleWatchSession is called by net/http for any new /api/watch connections.
+func handleWatchSession(session sockjs.Session) {
+	buf, err := session.Recv()
+	if err != nil {
+		log.Printf("handleWatchSession: can't Recv: %v", err)
+		return
+	}
+
+	var msg WatchMessage
+	if err = json.Unmarshal([]byte(buf), &msg); err != nil {
+		log.Printf("handleWatchSession: can't UnMarshal (%v): %s", err, buf)
+		return
+	}
+
+	if msg.Op != "bind" {
+		log.Printf("handleWatchSession: expected 'bind' message, got: %s", buf)
+		return
+	}
+
+	watchSession := watchSessions.Get(msg.SessionID)
+	if watchSession == nil {
+		log.Printf("handleWatchSession: can't find session '%s'", msg.SessionID)
+		return
+	}
+
+	select {
+	case watchSession.bound <- session:
+	default:
+		log.Printf("handleWatchSession: session '%s' is already bound", msg.SessionID)
+	}
+}
+
+// CreateWatchHandler is called from main for /api/watch.
+func CreateWatchHandler(path string) http.Handler {
+	return newSockJSHandler(path, handleWatchSession)
+}
+
+// WaitForWatch is called from apihandler.handleWatch as a goroutine. It waits for the SockJS connection to be bound
+// in handleWatchSession and then streams watch events to it until either side closes the connection.
+func WaitForWatch(sessionId string) {
+	defer watchSessions.Remove(sessionId)
+	watchSession := watchSessions.Get(sessionId)
+
+	var session sockjs.Session
+	select {
+	case session = <-watchSession.bound:
+	case <-time.After(watchBindTimeout):
+		log.Printf("Watch session %s was not bound in %s", sessionId, watchBindTimeout)
+		return
+	}
+
+	activeWebSocketConnections.Inc()
+	defer activeWebSocketConnections.Dec()
+
+	// Closing the stream unblocks decoding of the next event when the SockJS connection is closed by the client
+	go func() {
+		for {
+			if _, err := session.Recv(); err != nil {
+				watchSessions.Remove(sessionId)
+				return
+			}
+		}
+	}()
+
+	resourceVersion, err := streamWatchEvents(watchSession.stream, func(msg WatchMessage) error {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		return session.Send(string(data))
+	})
+	if err != nil && err != io.EOF {
+		session.Close(2, err.Error())
+		return
+	}
+
+	if data, err := json.Marshal(WatchMessage{Op: "end", ResourceVersion: resourceVersion}); err == nil {
+		session.Send(string(data))
+	}
+	session.Close(1, "Watch closed")
+}
+
+// Decodes watch events from the stream and passes them to the send function until the stream ends. Returns resource
+// version of the last received object, so that watch can be resumed from it.
+func streamWatchEvents(stream io.Reader, send func(WatchMessage) error) (string, error) {
+	decoder := json.NewDecoder(stream)
+	resourceVersion := ""
+	for {
+		var event watchEvent
+		if err := decoder.Decode(&event); err != nil {
+			return resourceVersion, err
+		}
+
+		msg := WatchMessage{Op: "event", Type: event.Type, Object: event.Object}
+		if event.Type != "ERROR" {
+			var object struct {
+				Metadata struct {
+					ResourceVersion string `json:"resourceVersion"`
+				} `json:"metadata"`
+			}
+			if err := json.Unmarshal(event.Object, &object); err == nil {
+				resourceVersion = object.Metadata.ResourceVersion
+				msg.ResourceVersion = resourceVersion
+			}
+		}
+
+		if err := send(msg); err != nil {
+			return resourceVersion, err
+		}
+	}
+}
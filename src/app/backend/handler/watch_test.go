@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestWatchSessionMap(t *testing.T) {
+	sessions := WatchSessionMap{Sessions: map[string]*WatchSession{}, Watches: map[string]int{}}
+	newSession := func(id, owner string) *WatchSession {
+		return newWatchSession(id, owner, ioutil.NopCloser(strings.NewReader("")))
+	}
+
+	if err := sessions.Add(newSession("a", "user"), 2); err != nil {
+		t.Fatalf("Expected first watch to be added, but got error: %s", err)
+	}
+	if err := sessions.Add(newSession("b", "user"), 2); err != nil {
+		t.Fatalf("Expected second watch to be added, but got error: %s", err)
+	}
+	if err := sessions.Add(newSession("c", "other"), 2); err != nil {
+		t.Fatalf("Expected watch of other user to be added, but got error: %s", err)
+	}
+
+	err := sessions.Add(newSession("d", "user"), 2)
+	if statusErr, ok := err.(*k8serrors.StatusError); !ok || statusErr.Status().Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected watch over the limit to be rejected with 429, but got: %v", err)
+	}
+
+	sessions.Remove("a")
+	sessions.Remove("a")
+	if sessions.Get("a") != nil || sessions.Watches["user"] != 1 {
+		t.Fatalf("Expected removed watch to be released, but got %d watches", sessions.Watches["user"])
+	}
+
+	if err := sessions.Add(newSession("d", "user"), 2); err != nil {
+		t.Fatalf("Expected watch to be added after release, but got error: %s", err)
+	}
+
+	if err := sessions.Add(newSession("e", "user"), 0); err != nil {
+		t.Fatalf("Expected watches not to be limited, but got error: %s", err)
+	}
+}
+
+func TestStreamWatchEvents(t *testing.T) {
+	stream := strings.NewReader(`{"type":"ADDED","object":{"metadata":{"name":"a","resourceVersion":"1"}}}
+{"type":"MODIFIED","object":{"metadata":{"name":"a","resourceVersion":"2"}}}
+{"type":"ERROR","object":{"kind":"Status","code":410}}
+`)
+
+	var actual []WatchMessage
+	resourceVersion, err := streamWatchEvents(stream, func(msg WatchMessage) error {
+		actual = append(actual, msg)
+		return nil
+	})
+
+	expected := []WatchMessage{
+		{Op: "event", Type: "ADDED", ResourceVersion: "1",
+			Object: []byte(`{"metadata":{"name":"a","resourceVersion":"1"}}`)},
+		{Op: "event", Type: "MODIFIED", ResourceVersion: "2",
+			Object: []byte(`{"metadata":{"name":"a","resourceVersion":"2"}}`)},
+		{Op: "event", Type: "ERROR", Object: []byte(`{"kind":"Status","code":410}`)},
+	}
+
+	if err == nil || err.Error() != "EOF" {
+		t.Errorf("Expected stream to end with EOF, but got %v", err)
+	}
+
+	if resourceVersion != "2" {
+		t.Errorf("Expected last resource version 2, but got %s", resourceVersion)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected messages %+v, but got %+v", expected, actual)
+	}
+}
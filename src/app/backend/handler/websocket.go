@@ -0,0 +1,227 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/igm/sockjs-go.v2/sockjs"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+// Status code of closing the session whose message exceeds --websocket-max-message-bytes. It is the same as the
+// WebSocket 'message too big' status code.
+const closeMessageTooBig = 1009
+
+var errMessageTooBig = errors.New("message too big")
+
+// Messages are JSON encoded twice, by dashboard frontend and by SockJS, before they are sent. Escaping of a byte, i.e.
+// '\x1b' encoded as '\\u001b', takes at most 7 bytes, so frames of messages within the limit are at most that much
+// bigger, plus the brackets and quotes of SockJS frame.
+const (
+	maxMessageEncodingFactor = 7
+	sockJSFrameOverhead      = 4
+)
+
+// limitedSession closes the session once the client sends a message larger than the limit.
+type limitedSession struct {
+	sockjs.Session
+	maxMessageBytes int
+}
+
+// Recv implements sockjs.Session interface.
+func (self *limitedSession) Recv() (string, error) {
+	msg, err := self.Session.Recv()
+	if err != nil || self.maxMessageBytes == 0 || len(msg) <= self.maxMessageBytes {
+		return msg, err
+	}
+
+	self.Session.Close(closeMessageTooBig, fmt.Sprintf("Message exceeds the limit of %d bytes", self.maxMessageBytes))
+	return "", errMessageTooBig
+}
+
+// limitedResponseWriter wraps connection hijacked by the WebSocket transport, so that frames are limited before they
+// are buffered.
+type limitedResponseWriter struct {
+	http.ResponseWriter
+	maxFrameBytes int64
+}
+
+// Hijack implements http.Hijacker interface.
+func (self *limitedResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := self.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response does not implement http.Hijacker")
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &limitedConn{Conn: conn, maxFrameBytes: self.maxFrameBytes}, rw, nil
+}
+
+// limitedConn fails reads of the WebSocket connection once payload of a data message, which may be fragmented into
+// multiple frames, exceeds the limit. Frames are tracked from their headers, so messages are never buffered here.
+type limitedConn struct {
+	net.Conn
+	maxFrameBytes int64
+	// Header of the frame that is being read, empty when payload is read.
+	header []byte
+	// Remaining bytes of the payload of the current frame.
+	payload int64
+	// Bytes of the current data message received so far.
+	messageBytes int64
+}
+
+// Read implements net.Conn interface.
+func (self *limitedConn) Read(p []byte) (int, error) {
+	n, err := self.Conn.Read(p)
+	if scanErr := self.scan(p[:n]); scanErr != nil {
+		return 0, scanErr
+	}
+
+	return n, err
+}
+
+func (self *limitedConn) scan(data []byte) error {
+	for len(data) > 0 {
+		if self.payload > 0 {
+			n := int64(len(data))
+			if n > self.payload {
+				n = self.payload
+			}
+			self.payload -= n
+			data = data[n:]
+			continue
+		}
+
+		self.header = append(self.header, data[0])
+		data = data[1:]
+		if len(self.header) < frameHeaderSize(self.header) {
+			continue
+		}
+
+		length := framePayloadLength(self.header)
+		if opcode := self.header[0] & 0x0f; opcode < 8 {
+			// Continuation frames have opcode 0, control frames can be sent between them.
+			if opcode != 0 {
+				self.messageBytes = 0
+			}
+
+			if length > self.maxFrameBytes-self.messageBytes {
+				return errMessageTooBig
+			}
+			self.messageBytes += length
+		}
+
+		self.payload = length
+		self.header = self.header[:0]
+	}
+
+	return nil
+}
+
+// Returns size of the WebSocket frame header that starts with given bytes.
+func frameHeaderSize(header []byte) int {
+	if len(header) < 2 {
+		return 2
+	}
+
+	size := 2
+	switch header[1] & 0x7f {
+	case 126:
+		size += 2
+	case 127:
+		size += 8
+	}
+
+	// Frames sent by clients are always masked.
+	if header[1]&0x80 != 0 {
+		size += 4
+	}
+
+	return size
+}
+
+// Returns payload length of the WebSocket frame with given complete header.
+func framePayloadLength(header []byte) int64 {
+	switch length := header[1] & 0x7f; length {
+	case 126:
+		return int64(binary.BigEndian.Uint16(header[2:4]))
+	case 127:
+		if length := binary.BigEndian.Uint64(header[2:10]); length <= math.MaxInt64 {
+			return int64(length)
+		}
+		return math.MaxInt64
+	default:
+		return int64(length)
+	}
+}
+
+// newSockJSHandler creates handler of the SockJS endpoint used by all dashboard WebSocket endpoints. Heartbeats are
+// sent every --websocket-ping-interval seconds and messages are limited by --websocket-max-message-bytes. Frames
+// received over WebSocket and bodies of the messages sent with HTTP requests are limited as well, so that big
+// messages are rejected before they are buffered.
+func newSockJSHandler(path string, handleSession func(sockjs.Session)) http.Handler {
+	options := sockjs.DefaultOptions
+	if interval := args.Holder.GetWebSocketPingInterval(); interval > 0 {
+		options.HeartbeatDelay = time.Duration(interval) * time.Second
+	}
+	maxMessageBytes := args.Holder.GetWebSocketMaxMessageBytes()
+
+	handler := sockjs.NewHandler(path, options, func(session sockjs.Session) {
+		handleSession(&limitedSession{Session: session, maxMessageBytes: maxMessageBytes})
+	})
+	if maxMessageBytes == 0 {
+		return handler
+	}
+
+	maxFrameBytes := int64(maxMessageBytes)*maxMessageEncodingFactor + sockJSFrameOverhead
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/websocket"):
+			w = &limitedResponseWriter{ResponseWriter: w, maxFrameBytes: maxFrameBytes}
+		case r.Body != nil && (strings.HasSuffix(r.URL.Path, "/xhr_send") ||
+			strings.HasSuffix(r.URL.Path, "/jsonp_send")):
+			body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxFrameBytes+1))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if int64(len(body)) > maxFrameBytes {
+				http.Error(w, errMessageTooBig.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
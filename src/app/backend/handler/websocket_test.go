@@ -0,0 +1,187 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gopkg.in/igm/sockjs-go.v2/sockjs"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+type fakeSession struct {
+	messages    []string
+	closeStatus uint32
+}
+
+func (self *fakeSession) ID() string { return "fake" }
+
+func (self *fakeSession) Recv() (string, error) {
+	msg := self.messages[0]
+	self.messages = self.messages[1:]
+	return msg, nil
+}
+
+func (self *fakeSession) Send(string) error { return nil }
+
+func (self *fakeSession) Close(status uint32, reason string) error {
+	self.closeStatus = status
+	return nil
+}
+
+func TestLimitedSession(t *testing.T) {
+	cases := []struct {
+		info                string
+		maxMessageBytes     int
+		message             string
+		expectedErr         error
+		expectedCloseStatus uint32
+	}{
+		{"Should not limit messages without the limit", 0, "message", nil, 0},
+		{"Should pass messages within the limit", 7, "message", nil, 0},
+		{"Should close session on too big message", 6, "message", errMessageTooBig, closeMessageTooBig},
+	}
+
+	for _, c := range cases {
+		fake := &fakeSession{messages: []string{c.message}}
+		session := &limitedSession{Session: fake, maxMessageBytes: c.maxMessageBytes}
+		msg, err := session.Recv()
+
+		if err != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error %v, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if err == nil && msg != c.message {
+			t.Errorf("Test Case: %s. Expected message %s, but got %s.", c.info, c.message, msg)
+		}
+
+		if fake.closeStatus != c.expectedCloseStatus {
+			t.Errorf("Test Case: %s. Expected close status %d, but got %d.", c.info, c.expectedCloseStatus,
+				fake.closeStatus)
+		}
+	}
+}
+
+// Returns masked client frame header with given first byte and payload length.
+func newFrameHeader(first byte, length int) []byte {
+	header := []byte{first, 0x80}
+	switch {
+	case length > 0xffff:
+		header[1] |= 127
+		header = append(header, make([]byte, 8)...)
+		binary.BigEndian.PutUint64(header[2:], uint64(length))
+	case length > 125:
+		header[1] |= 126
+		header = append(header, make([]byte, 2)...)
+		binary.BigEndian.PutUint16(header[2:], uint16(length))
+	default:
+		header[1] |= byte(length)
+	}
+
+	return append(header, 0, 0, 0, 0)
+}
+
+// fakeConn returns given data in reads of at most chunk bytes.
+type fakeConn struct {
+	net.Conn
+	data  []byte
+	chunk int
+}
+
+func (self *fakeConn) Read(p []byte) (int, error) {
+	if len(p) > self.chunk {
+		p = p[:self.chunk]
+	}
+	n := copy(p, self.data)
+	self.data = self.data[n:]
+	return n, nil
+}
+
+func TestLimitedConn(t *testing.T) {
+	frame := func(first byte, length int) []byte {
+		return append(newFrameHeader(first, length), bytes.Repeat([]byte{'a'}, length)...)
+	}
+	join := func(frames ...[]byte) []byte { return bytes.Join(frames, nil) }
+
+	cases := []struct {
+		info        string
+		data        []byte
+		expectedErr error
+	}{
+		{"Should pass frames within the limit", join(frame(0x81, 10), frame(0x81, 10)), nil},
+		{"Should reject too big frame", frame(0x81, 11), errMessageTooBig},
+		{"Should reject frame with 16-bit length", newFrameHeader(0x81, 200), errMessageTooBig},
+		{"Should reject frame with 64-bit length", newFrameHeader(0x82, 70000), errMessageTooBig},
+		{"Should reject too big fragmented message", join(frame(0x01, 6), frame(0x89, 20), frame(0x80, 5)),
+			errMessageTooBig},
+		{"Should pass fragmented message within the limit", join(frame(0x01, 6), frame(0x80, 4), frame(0x81, 10)),
+			nil},
+		{"Should not limit control frames", frame(0x89, 100), nil},
+	}
+
+	for _, c := range cases {
+		for _, chunk := range []int{1, 3, 4096} {
+			conn := &limitedConn{Conn: &fakeConn{data: c.data, chunk: chunk}, maxFrameBytes: 10}
+			var err error
+			for read := 0; read < len(c.data) && err == nil; {
+				var n int
+				n, err = conn.Read(make([]byte, 4096))
+				read += n
+			}
+
+			if err != c.expectedErr {
+				t.Errorf("Test Case: %s. Expected error %v in reads of %d bytes, but got %v.", c.info,
+					c.expectedErr, chunk, err)
+			}
+		}
+	}
+}
+
+func TestSockJSHandlerSendLimit(t *testing.T) {
+	args.GetHolderBuilder().SetWebSocketMaxMessageBytes(1)
+	defer args.GetHolderBuilder().SetWebSocketMaxMessageBytes(0)
+
+	cases := []struct {
+		info           string
+		path           string
+		body           string
+		expectedStatus int
+	}{
+		{"Should reject too big xhr_send body", "/api/sockjs/server/session/xhr_send",
+			`["` + strings.Repeat("a", 100) + `"]`, http.StatusRequestEntityTooLarge},
+		{"Should reject too big jsonp_send body", "/api/sockjs/server/session/jsonp_send",
+			`d=["` + strings.Repeat("a", 100) + `"]`, http.StatusRequestEntityTooLarge},
+		{"Should pass body within the limit", "/api/sockjs/server/session/xhr_send", `["a"]`,
+			http.StatusNotFound},
+	}
+
+	handler := newSockJSHandler("/api/sockjs", func(session sockjs.Session) {})
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodPost, c.path, strings.NewReader(c.body))
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("Test Case: %s. Expected status %d, but got %d.", c.info, c.expectedStatus, recorder.Code)
+		}
+	}
+}
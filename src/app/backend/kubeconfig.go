@@ -0,0 +1,95 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// Prefix of the --kubeconfig value that names environment variable with kubeconfig content.
+	kubeConfigEnvPrefix = "env:"
+	// Value of --kubeconfig that reads kubeconfig content from stdin.
+	kubeConfigStdin = "-"
+)
+
+// resolveKubeConfig returns path of the kubeconfig file given with --kubeconfig. Kubeconfig given inline, either as
+// 'env:VARNAME' or as '-' for stdin, is parsed and validated, relative paths in it are resolved against working
+// directory and it is written to a temporary file, so that it is loaded the same as kubeconfig file. Returned function
+// removes the temporary file.
+func resolveKubeConfig(value string, stdin io.Reader) (string, func(), error) {
+	var content []byte
+	switch {
+	case value == kubeConfigStdin:
+		data, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not read --kubeconfig from stdin: %s", err)
+		}
+		content = data
+	case strings.HasPrefix(value, kubeConfigEnvPrefix):
+		name := strings.TrimPrefix(value, kubeConfigEnvPrefix)
+		data, ok := os.LookupEnv(name)
+		if !ok || len(data) == 0 {
+			return "", nil, fmt.Errorf("environment variable %s given with --kubeconfig is not set", name)
+		}
+		content = []byte(data)
+	default:
+		return value, func() {}, nil
+	}
+
+	config, err := clientcmd.Load(content)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not parse --kubeconfig: %s", err)
+	}
+
+	if err := clientcmd.Validate(*config); err != nil {
+		return "", nil, fmt.Errorf("invalid --kubeconfig: %s", err)
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, cluster := range config.Clusters {
+		cluster.LocationOfOrigin = filepath.Join(dir, "kubeconfig")
+	}
+	for _, authInfo := range config.AuthInfos {
+		authInfo.LocationOfOrigin = filepath.Join(dir, "kubeconfig")
+	}
+	if err := clientcmd.ResolveLocalPaths(config); err != nil {
+		return "", nil, fmt.Errorf("invalid --kubeconfig: %s", err)
+	}
+
+	file, err := ioutil.TempFile("", "kubeconfig")
+	if err != nil {
+		return "", nil, err
+	}
+	file.Close()
+
+	if err := clientcmd.WriteToFile(*config, file.Name()); err != nil {
+		os.Remove(file.Name())
+		return "", nil, err
+	}
+
+	return file.Name(), func() { os.Remove(file.Name()) }, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://10.0.0.1:6443
+    insecure-skip-tls-verify: true
+users:
+- name: test
+  user:
+    token: secret
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+`
+
+func TestResolveKubeConfig(t *testing.T) {
+	os.Setenv("TEST_KUBECONFIG", testKubeConfig)
+	os.Setenv("TEST_INVALID_KUBECONFIG", "clusters: [")
+	defer os.Unsetenv("TEST_KUBECONFIG")
+	defer os.Unsetenv("TEST_INVALID_KUBECONFIG")
+
+	cases := []struct {
+		info        string
+		value       string
+		stdin       string
+		expectedErr bool
+	}{
+		{"Should read kubeconfig from environment variable", "env:TEST_KUBECONFIG", "", false},
+		{"Should read kubeconfig from stdin", "-", testKubeConfig, false},
+		{"Should reject unset environment variable", "env:TEST_MISSING_KUBECONFIG", "", true},
+		{"Should reject kubeconfig that can not be parsed", "env:TEST_INVALID_KUBECONFIG", "", true},
+		{"Should reject empty kubeconfig", "-", "", true},
+	}
+
+	for _, c := range cases {
+		path, remove, err := resolveKubeConfig(c.value, strings.NewReader(c.stdin))
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+			continue
+		}
+
+		if err != nil {
+			continue
+		}
+
+		cfg, err := clientcmd.BuildConfigFromFlags("", path)
+		if err != nil {
+			t.Errorf("Test Case: %s. Expected config to be built, but got error: %s.", c.info, err)
+		} else if cfg.Host != "https://10.0.0.1:6443" || cfg.BearerToken != "secret" {
+			t.Errorf("Test Case: %s. Unexpected config: %#v.", c.info, cfg)
+		}
+
+		remove()
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("Test Case: %s. Expected temporary kubeconfig file to be removed.", c.info)
+		}
+	}
+
+	if path, _, err := resolveKubeConfig("/etc/kubeconfig", nil); err != nil || path != "/etc/kubeconfig" {
+		t.Errorf("Expected kubeconfig path to be returned unchanged, but got %s and %v", path, err)
+	}
+}
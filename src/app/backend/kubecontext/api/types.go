@@ -0,0 +1,42 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// ContextManager is used to list and select contexts of the kubeconfig file passed to dashboard.
+type ContextManager interface {
+	// List returns all contexts of the kubeconfig file. Given selected context is marked as active if it exists,
+	// otherwise default context is marked.
+	List(selected string) (*ContextList, error)
+	// Select checks if context with given name exists and if its cluster is reachable.
+	Select(name string) error
+}
+
+// Context represents a single context of the kubeconfig file.
+type Context struct {
+	// Name of the context.
+	Name string `json:"name"`
+	// Cluster is a name of the cluster referenced by the context.
+	Cluster string `json:"cluster"`
+	// Server is an address of the cluster apiserver.
+	Server string `json:"server"`
+	// Namespace is a default namespace of the context.
+	Namespace string `json:"namespace"`
+}
+
+// ContextList contains list of kubeconfig contexts and name of the context active for the session.
+type ContextList struct {
+	Contexts []Context `json:"contexts"`
+	Active   string    `json:"active"`
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontext
+
+import (
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/kubecontext/api"
+)
+
+// ContextHandler manages all endpoints related to kubeconfig contexts.
+type ContextHandler struct {
+	manager api.ContextManager
+}
+
+// Install creates new endpoints for kubeconfig contexts management.
+func (self *ContextHandler) Install(ws *restful.WebService) {
+	ws.Route(
+		ws.GET("/kubecontext").
+			To(self.handleList).
+			Writes(api.ContextList{}))
+	ws.Route(
+		ws.PUT("/kubecontext/{name}").
+			To(self.handleSelect).
+			Writes(api.ContextList{}))
+}
+
+func (self *ContextHandler) handleList(request *restful.Request, response *restful.Response) {
+	result, err := self.manager.List(selectedContext(request))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (self *ContextHandler) handleSelect(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	if err := self.manager.Select(name); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := self.manager.List(name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	http.SetCookie(response, &http.Cookie{
+		Name:     client.KubeContextCookieName,
+		Value:    name,
+		Path:     args.Holder.GetBasePath() + "/",
+		HttpOnly: true,
+		Secure:   request.Request.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func selectedContext(request *restful.Request) string {
+	cookie, err := request.Request.Cookie(client.KubeContextCookieName)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// NewContextHandler creates ContextHandler.
+func NewContextHandler(manager api.ContextManager) ContextHandler {
+	return ContextHandler{manager: manager}
+}
@@ -0,0 +1,135 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontext
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/kubecontext/api"
+)
+
+// Time after which cluster of the selected context is considered unreachable.
+const reachabilityTimeout = 5 * time.Second
+
+// Implements ContextManager interface
+type contextManager struct {
+	kubeConfigPath string
+	defaultContext string
+	// Checks if cluster of given context is reachable. Can be replaced in tests.
+	checkReachable func(config *clientcmdapi.Config, name string) error
+}
+
+// List implements ContextManager interface. See ContextManager for more information.
+func (self *contextManager) List(selected string) (*api.ContextList, error) {
+	config, err := self.load()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api.ContextList{Contexts: make([]api.Context, 0, len(config.Contexts))}
+	for name, context := range config.Contexts {
+		server := ""
+		if cluster, exists := config.Clusters[context.Cluster]; exists {
+			server = cluster.Server
+		}
+
+		result.Contexts = append(result.Contexts, api.Context{
+			Name:      name,
+			Cluster:   context.Cluster,
+			Server:    server,
+			Namespace: context.Namespace,
+		})
+	}
+
+	sort.Slice(result.Contexts, func(i, j int) bool {
+		return result.Contexts[i].Name < result.Contexts[j].Name
+	})
+
+	result.Active = self.defaultContextName(config)
+	if _, exists := config.Contexts[selected]; exists {
+		result.Active = selected
+	}
+
+	return result, nil
+}
+
+// Select implements ContextManager interface. See ContextManager for more information.
+func (self *contextManager) Select(name string) error {
+	config, err := self.load()
+	if err != nil {
+		return err
+	}
+
+	if _, exists := config.Contexts[name]; !exists {
+		return errors.NewNotFound(fmt.Sprintf("context %s not found in kubeconfig file", name))
+	}
+
+	if err := self.checkReachable(config, name); err != nil {
+		return errors.NewServiceUnavailable(fmt.Sprintf("cluster of context %s is unreachable: %s", name, err.Error()))
+	}
+
+	return nil
+}
+
+func (self *contextManager) load() (*clientcmdapi.Config, error) {
+	if len(self.kubeConfigPath) == 0 {
+		return nil, errors.NewInvalid("contexts are available only if --kubeconfig argument is provided")
+	}
+
+	return clientcmd.LoadFromFile(self.kubeConfigPath)
+}
+
+func (self *contextManager) defaultContextName(config *clientcmdapi.Config) string {
+	if len(self.defaultContext) > 0 {
+		return self.defaultContext
+	}
+
+	return config.CurrentContext
+}
+
+// Queries apiserver version using given context. Request is bounded by reachabilityTimeout, so unreachable
+// clusters are reported instead of blocking the request.
+func checkReachable(config *clientcmdapi.Config, name string) error {
+	cfg, err := clientcmd.NewNonInteractiveClientConfig(*config, name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return err
+	}
+
+	cfg.Timeout = reachabilityTimeout
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Discovery().ServerVersion()
+	return err
+}
+
+// NewContextManager creates context manager for given kubeconfig file. Default context is used if session
+// did not select any, if it is empty current context of the kubeconfig file is used.
+func NewContextManager(kubeConfigPath, defaultContext string) api.ContextManager {
+	return &contextManager{
+		kubeConfigPath: kubeConfigPath,
+		defaultContext: defaultContext,
+		checkReachable: checkReachable,
+	}
+}
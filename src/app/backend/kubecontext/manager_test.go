@@ -0,0 +1,143 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontext
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kubernetes/dashboard/src/app/backend/kubecontext/api"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://127.0.0.1:1
+- name: prod-cluster
+  cluster:
+    server: https://127.0.0.1:2
+contexts:
+- name: prod
+  context:
+    cluster: prod-cluster
+    user: admin
+    namespace: kube-system
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: admin
+users:
+- name: admin
+  user:
+    token: test
+`
+
+func writeTestKubeConfig(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "kubecontext")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(path, []byte(testKubeConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestContextManager_List(t *testing.T) {
+	path := writeTestKubeConfig(t)
+	contexts := []api.Context{
+		{Name: "dev", Cluster: "dev-cluster", Server: "https://127.0.0.1:1"},
+		{Name: "prod", Cluster: "prod-cluster", Server: "https://127.0.0.1:2", Namespace: "kube-system"},
+	}
+
+	cases := []struct {
+		info           string
+		defaultContext string
+		selected       string
+		expected       *api.ContextList
+	}{
+		{"Current context should be active by default", "", "",
+			&api.ContextList{Contexts: contexts, Active: "dev"}},
+		{"Default context should override current context", "prod", "",
+			&api.ContextList{Contexts: contexts, Active: "prod"}},
+		{"Selected context should be active", "", "prod",
+			&api.ContextList{Contexts: contexts, Active: "prod"}},
+		{"Not existing selected context should be ignored", "", "test",
+			&api.ContextList{Contexts: contexts, Active: "dev"}},
+	}
+
+	for _, c := range cases {
+		actual, err := NewContextManager(path, c.defaultContext).List(c.selected)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %s", c.info, err)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestContextManager_Select(t *testing.T) {
+	path := writeTestKubeConfig(t)
+	reachable := func(config *clientcmdapi.Config, name string) error { return nil }
+
+	cases := []struct {
+		info           string
+		kubeConfigPath string
+		name           string
+		reachable      bool
+		expectedCode   int32
+	}{
+		{"Existing and reachable context should be selected", path, "prod", true, 0},
+		{"Not existing context should not be selected", path, "test", true, http.StatusNotFound},
+		{"Unreachable context should not be selected", path, "dev", false, http.StatusServiceUnavailable},
+		{"Contexts should not be available without kubeconfig", "", "dev", true, http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		manager := NewContextManager(c.kubeConfigPath, "").(*contextManager)
+		if c.reachable {
+			manager.checkReachable = reachable
+		}
+
+		err := manager.Select(c.name)
+		if c.expectedCode == 0 {
+			if err != nil {
+				t.Errorf("Test Case: %s. Unexpected error: %s", c.info, err)
+			}
+			continue
+		}
+
+		statusErr, ok := err.(*k8serrors.StatusError)
+		if !ok || statusErr.ErrStatus.Code != c.expectedCode {
+			t.Errorf("Test Case: %s. Expected error with code %d, but got %v.", c.info, c.expectedCode, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// FormatText is the default log format of the standard log package.
+	FormatText = "text"
+	// FormatJSON formats every log entry as a single line JSON object.
+	FormatJSON = "json"
+
+	// SeverityInfo is the severity of all entries written with the standard log package.
+	SeverityInfo = "INFO"
+)
+
+// Fields contains additional structured information attached to the log entry.
+type Fields map[string]interface{}
+
+var (
+	mux    sync.Mutex
+	format = FormatText
+	output io.Writer
+)
+
+// Init configures standard log package to write entries in given format to given output.
+func Init(logFormat string, out io.Writer) error {
+	mux.Lock()
+	defer mux.Unlock()
+
+	switch logFormat {
+	case FormatText:
+		log.SetFlags(log.LstdFlags)
+		log.SetOutput(out)
+	case FormatJSON:
+		log.SetFlags(log.Lshortfile)
+		log.SetOutput(&jsonWriter{out: out})
+	default:
+		return fmt.Errorf("unsupported log format %q, should be one of '%s' or '%s'", logFormat, FormatText, FormatJSON)
+	}
+
+	format = logFormat
+	output = out
+	return nil
+}
+
+// IsJSON returns true if entries are written in JSON format.
+func IsJSON() bool {
+	mux.Lock()
+	defer mux.Unlock()
+	return format == FormatJSON
+}
+
+// Info writes entry with given message and fields. Fields are written as separate JSON keys in JSON format and
+// appended to the message in text format.
+func Info(message string, fields Fields) {
+	if !IsJSON() {
+		_ = log.Output(2, formatText(message, fields))
+		return
+	}
+
+	caller := ""
+	if _, file, line, ok := runtime.Caller(1); ok {
+		caller = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	writeJSON(output, SeverityInfo, caller, message, fields)
+}
+
+func formatText(message string, fields Fields) string {
+	keys := sortedKeys(fields)
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, message)
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, fields[key]))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func writeJSON(out io.Writer, severity, caller, message string, fields Fields) {
+	entry := make(map[string]interface{}, len(fields)+4)
+	for key, value := range fields {
+		entry[key] = value
+	}
+	entry["timestamp"] = time.Now().UTC().Format(time.RFC3339Nano)
+	entry["severity"] = severity
+	entry["caller"] = caller
+	entry["message"] = message
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		data, _ = json.Marshal(map[string]string{"severity": severity, "caller": caller, "message": message})
+	}
+
+	out.Write(append(data, '\n'))
+}
+
+// jsonWriter converts entries of the standard log package configured with log.Lshortfile flag into JSON.
+type jsonWriter struct {
+	out io.Writer
+}
+
+// Write implements io.Writer interface. Every call contains exactly one entry in format 'file:line: message'.
+func (self *jsonWriter) Write(p []byte) (int, error) {
+	entry := string(bytes.TrimSuffix(p, []byte("\n")))
+	caller, message := "", entry
+	if parts := strings.SplitN(entry, ": ", 2); len(parts) == 2 {
+		caller, message = parts[0], parts[1]
+	}
+
+	writeJSON(self.out, SeverityInfo, caller, message, nil)
+	return len(p), nil
+}
+
+func sortedKeys(fields Fields) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
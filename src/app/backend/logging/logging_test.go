@@ -0,0 +1,103 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestInit(t *testing.T) {
+	defer Init(FormatText, os.Stderr)
+
+	cases := []struct {
+		format      string
+		expectedErr bool
+	}{
+		{FormatText, false},
+		{FormatJSON, false},
+		{"xml", true},
+	}
+
+	for _, c := range cases {
+		err := Init(c.format, &bytes.Buffer{})
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Init(%s) returns error %v, expected error: %t", c.format, err, c.expectedErr)
+		}
+	}
+}
+
+func TestJSONFormat(t *testing.T) {
+	defer Init(FormatText, os.Stderr)
+
+	out := &bytes.Buffer{}
+	if err := Init(FormatJSON, out); err != nil {
+		t.Fatal(err)
+	}
+
+	log.Printf("Hello %s", "world")
+	Info("Incoming request", Fields{"method": "GET", "status": 200})
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 log entries, but got %d: %s", len(lines), out.String())
+	}
+
+	cases := []struct {
+		line     string
+		expected map[string]interface{}
+	}{
+		{lines[0], map[string]interface{}{"severity": SeverityInfo, "message": "Hello world"}},
+		{lines[1], map[string]interface{}{"severity": SeverityInfo, "message": "Incoming request", "method": "GET",
+			"status": float64(200)}},
+	}
+
+	for _, c := range cases {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(c.line), &entry); err != nil {
+			t.Fatalf("Log entry %s is not valid JSON: %s", c.line, err)
+		}
+
+		for key, value := range c.expected {
+			if entry[key] != value {
+				t.Errorf("Log entry %s: expected %s to be %v, but got %v", c.line, key, value, entry[key])
+			}
+		}
+
+		for _, key := range []string{"timestamp", "caller"} {
+			if value, ok := entry[key].(string); !ok || len(value) == 0 {
+				t.Errorf("Log entry %s: expected non-empty %s", c.line, key)
+			}
+		}
+	}
+}
+
+func TestTextFormat(t *testing.T) {
+	defer Init(FormatText, os.Stderr)
+
+	out := &bytes.Buffer{}
+	if err := Init(FormatText, out); err != nil {
+		t.Fatal(err)
+	}
+
+	Info("Incoming request", Fields{"path": "/api/v1/pod", "method": "GET"})
+	if !strings.HasSuffix(out.String(), "Incoming request method=GET path=/api/v1/pod\n") {
+		t.Errorf("Unexpected log entry: %s", out.String())
+	}
+}
@@ -107,10 +107,18 @@ func (cm *fakeClientManager) CanI(req *restful.Request, ssar *v1.SelfSubjectAcce
 	panic("implement me")
 }
 
+func (cm *fakeClientManager) InsecureConfig() *rest.Config {
+	panic("implement me")
+}
+
 func (cm *fakeClientManager) Config(req *restful.Request) (*rest.Config, error) {
 	panic("implement me")
 }
 
+func (cm *fakeClientManager) StreamingConfig(req *restful.Request) (*rest.Config, error) {
+	panic("implement me")
+}
+
 func (cm *fakeClientManager) ClientCmdConfig(req *restful.Request) (clientcmd.ClientConfig, error) {
 	panic("implement me")
 }
@@ -127,6 +135,14 @@ func (cm *fakeClientManager) VerberClient(req *restful.Request, config *rest.Con
 	panic("implement me")
 }
 
+func (cm *fakeClientManager) Identity(req *restful.Request) string {
+	return ""
+}
+
+func (cm *fakeClientManager) VerifyCredentials(req *restful.Request) error {
+	return nil
+}
+
 func (cm *fakeClientManager) SetTokenManager(manager authApi.TokenManager) {
 	panic("implement me")
 }
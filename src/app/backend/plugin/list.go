@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/plugin/apis/v1alpha1"
 	pluginclientset "github.com/kubernetes/dashboard/src/app/backend/plugin/client/clientset/versioned"
@@ -91,11 +92,13 @@ func toPluginList(plugins []v1alpha1.Plugin, nonCriticalErrors []error, dsQuery
 }
 
 func toPlugin(plugin v1alpha1.Plugin) Plugin {
+	path := fmt.Sprintf("%s/%s/%s/%s.js", args.Holder.GetAPIPrefix(), api.ResourceKindPlugin, plugin.Namespace,
+		plugin.Name)
 	return Plugin{
 		ObjectMeta:   api.NewObjectMeta(plugin.ObjectMeta),
 		TypeMeta:     api.NewTypeMeta(api.ResourceKindPlugin),
 		Name:         plugin.ObjectMeta.Name,
-		Path:         fmt.Sprintf("/api/v1/%s/%s/%s.js", api.ResourceKindPlugin, plugin.Namespace, plugin.Name),
+		Path:         path,
 		Dependencies: append([]string{}, plugin.Spec.Dependencies...),
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	rbac "k8s.io/api/rbac/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -38,10 +39,11 @@ type ClusterRole struct {
 	TypeMeta   api.TypeMeta   `json:"typeMeta"`
 }
 
-func GetClusterRoleList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterRoleList, error) {
+func GetClusterRoleList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery,
+	listOptions metaV1.ListOptions) (*ClusterRoleList, error) {
 	log.Println("Getting list of RBAC roles")
 	channels := &common.ResourceChannels{
-		ClusterRoleList: common.GetClusterRoleListChannel(client, 1),
+		ClusterRoleList: common.GetClusterRoleListChannel(client, listOptions, 1),
 	}
 
 	return GetClusterRoleListFromChannels(channels, dsQuery)
@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	rbac "k8s.io/api/rbac/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -42,10 +43,11 @@ type ClusterRoleBinding struct {
 }
 
 // GetClusterRoleBindingList returns a list of all ClusterRoleBindings in the cluster.
-func GetClusterRoleBindingList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterRoleBindingList, error) {
+func GetClusterRoleBindingList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery,
+	listOptions metaV1.ListOptions) (*ClusterRoleBindingList, error) {
 	log.Print("Getting list of all clusterRoleBindings in the cluster")
 	channels := &common.ResourceChannels{
-		ClusterRoleBindingList: common.GetClusterRoleBindingListChannel(client, 1),
+		ClusterRoleBindingList: common.GetClusterRoleBindingListChannel(client, listOptions, 1),
 	}
 
 	return GetClusterRoleBindingListFromChannels(channels, dsQuery)
@@ -20,6 +20,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 )
 
 // FilterDeploymentPodsByOwnerReference returns a subset of pods controlled by given deployment.
@@ -103,6 +105,30 @@ func GetInitContainerNames(podTemplate *v1.PodSpec) []string {
 	return initContainerNames
 }
 
+// DefaultContainerAnnotation is the annotation of the pod used by kubectl to select the container for logs and exec
+// when none is specified.
+const DefaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// GetDefaultContainerName returns name of the container of the pod that should be used for logs and exec by default.
+// It is the one set with DefaultContainerAnnotation, unless it does not exist or the annotation is ignored with
+// --ignore-default-container-annotation, and the first container otherwise.
+func GetDefaultContainerName(pod *v1.Pod) string {
+	if name, exists := pod.Annotations[DefaultContainerAnnotation]; exists &&
+		!args.Holder.GetIgnoreDefaultContainerAnnotation() {
+		for _, container := range pod.Spec.Containers {
+			if container.Name == name {
+				return name
+			}
+		}
+	}
+
+	if len(pod.Spec.Containers) == 0 {
+		return ""
+	}
+
+	return pod.Spec.Containers[0].Name
+}
+
 // GetNonduplicateContainerImages returns list of container image strings without duplicates
 func GetNonduplicateContainerImages(podList []v1.Pod) []string {
 	var containerImages []string
@@ -21,6 +21,8 @@ import (
 	batch "k8s.io/api/batch/v1"
 	api "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 )
 
 type metaObj struct {
@@ -196,6 +198,37 @@ func TestGetInitContainerNames(t *testing.T) {
 	}
 }
 
+func TestGetDefaultContainerName(t *testing.T) {
+	defer args.GetHolderBuilder().SetIgnoreDefaultContainerAnnotation(false)
+	newPod := func(annotation string) *api.Pod {
+		pod := &api.Pod{Spec: api.PodSpec{Containers: []api.Container{{Name: "istio-proxy"}, {Name: "app"}}}}
+		if len(annotation) > 0 {
+			pod.Annotations = map[string]string{DefaultContainerAnnotation: annotation}
+		}
+		return pod
+	}
+
+	cases := []struct {
+		info             string
+		pod              *api.Pod
+		ignoreAnnotation bool
+		expected         string
+	}{
+		{"Should select the first container without annotation", newPod(""), false, "istio-proxy"},
+		{"Should select the annotated container", newPod("app"), false, "app"},
+		{"Should ignore the annotation when disabled", newPod("app"), true, "istio-proxy"},
+		{"Should ignore the annotation of a missing container", newPod("missing"), false, "istio-proxy"},
+		{"Should return empty name for a pod without containers", &api.Pod{}, false, ""},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetIgnoreDefaultContainerAnnotation(c.ignoreAnnotation)
+		if actual := GetDefaultContainerName(c.pod); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected %s, but got %s.", c.info, c.expected, actual)
+		}
+	}
+}
+
 func TestGetNonduplicateContainerImages(t *testing.T) {
 	expected := []string{"Container1", "Container2", "Container3"}
 	pods := make([]api.Pod, 2, 2)
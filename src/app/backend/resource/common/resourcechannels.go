@@ -134,14 +134,13 @@ type ServiceListChannel struct {
 // GetServiceListChannel returns a pair of channels to a Service list and errors that both
 // must be read numReads times.
 func GetServiceListChannel(client client.Interface, nsQuery *NamespaceQuery,
-	numReads int) ServiceListChannel {
-
+	options metaV1.ListOptions, numReads int) ServiceListChannel {
 	channel := ServiceListChannel{
 		List:  make(chan *v1.ServiceList, numReads),
 		Error: make(chan error, numReads),
 	}
 	go func() {
-		list, err := client.CoreV1().Services(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.CoreV1().Services(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		var filteredItems []v1.Service
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -387,9 +386,8 @@ type ReplicationControllerListChannel struct {
 // GetReplicationControllerListChannel Returns a pair of channels to a
 // Replication Controller list and errors that both must be read
 // numReads times.
-func GetReplicationControllerListChannel(client client.Interface,
-	nsQuery *NamespaceQuery, numReads int) ReplicationControllerListChannel {
-
+func GetReplicationControllerListChannel(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) ReplicationControllerListChannel {
 	channel := ReplicationControllerListChannel{
 		List:  make(chan *v1.ReplicationControllerList, numReads),
 		Error: make(chan error, numReads),
@@ -397,7 +395,7 @@ func GetReplicationControllerListChannel(client client.Interface,
 
 	go func() {
 		list, err := client.CoreV1().ReplicationControllers(nsQuery.ToRequestParam()).
-			List(context.TODO(), api.ListEverything)
+			List(context.TODO(), options)
 		var filteredItems []v1.ReplicationController
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -422,9 +420,8 @@ type DeploymentListChannel struct {
 
 // GetDeploymentListChannel returns a pair of channels to a Deployment list and errors
 // that both must be read numReads times.
-func GetDeploymentListChannel(client client.Interface,
-	nsQuery *NamespaceQuery, numReads int) DeploymentListChannel {
-
+func GetDeploymentListChannel(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) DeploymentListChannel {
 	channel := DeploymentListChannel{
 		List:  make(chan *apps.DeploymentList, numReads),
 		Error: make(chan error, numReads),
@@ -432,7 +429,7 @@ func GetDeploymentListChannel(client client.Interface,
 
 	go func() {
 		list, err := client.AppsV1().Deployments(nsQuery.ToRequestParam()).
-			List(context.TODO(), api.ListEverything)
+			List(context.TODO(), options)
 		var filteredItems []apps.Deployment
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -498,14 +495,15 @@ type DaemonSetListChannel struct {
 
 // GetDaemonSetListChannel returns a pair of channels to a DaemonSet list and errors that both must be read
 // numReads times.
-func GetDaemonSetListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) DaemonSetListChannel {
+func GetDaemonSetListChannel(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) DaemonSetListChannel {
 	channel := DaemonSetListChannel{
 		List:  make(chan *apps.DaemonSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.AppsV1().DaemonSets(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.AppsV1().DaemonSets(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		var filteredItems []apps.DaemonSet
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -529,15 +527,15 @@ type JobListChannel struct {
 }
 
 // GetJobListChannel returns a pair of channels to a Job list and errors that both must be read numReads times.
-func GetJobListChannel(client client.Interface,
-	nsQuery *NamespaceQuery, numReads int) JobListChannel {
+func GetJobListChannel(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) JobListChannel {
 	channel := JobListChannel{
 		List:  make(chan *batch.JobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1().Jobs(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.BatchV1().Jobs(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		var filteredItems []batch.Job
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -561,14 +559,15 @@ type CronJobListChannel struct {
 }
 
 // GetCronJobListChannel returns a pair of channels to a Cron Job list and errors that both must be read numReads times.
-func GetCronJobListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) CronJobListChannel {
+func GetCronJobListChannel(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) CronJobListChannel {
 	channel := CronJobListChannel{
 		List:  make(chan *batch2.CronJobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		var filteredItems []batch2.CronJob
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -593,15 +592,15 @@ type StatefulSetListChannel struct {
 
 // GetStatefulSetListChannel returns a pair of channels to a StatefulSet list and errors that both must be read
 // numReads times.
-func GetStatefulSetListChannel(client client.Interface,
-	nsQuery *NamespaceQuery, numReads int) StatefulSetListChannel {
+func GetStatefulSetListChannel(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) StatefulSetListChannel {
 	channel := StatefulSetListChannel{
 		List:  make(chan *apps.StatefulSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		statefulSets, err := client.AppsV1().StatefulSets(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		statefulSets, err := client.AppsV1().StatefulSets(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		var filteredItems []apps.StatefulSet
 		for _, item := range statefulSets.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -627,15 +626,14 @@ type ConfigMapListChannel struct {
 // GetConfigMapListChannel returns a pair of channels to a ConfigMap list and errors that both must be read
 // numReads times.
 func GetConfigMapListChannel(client client.Interface, nsQuery *NamespaceQuery,
-	numReads int) ConfigMapListChannel {
-
+	options metaV1.ListOptions, numReads int) ConfigMapListChannel {
 	channel := ConfigMapListChannel{
 		List:  make(chan *v1.ConfigMapList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().ConfigMaps(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.CoreV1().ConfigMaps(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		var filteredItems []v1.ConfigMap
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -694,14 +692,15 @@ type RoleListChannel struct {
 
 // GetRoleListChannel returns a pair of channels to a Role list for a namespace and errors that
 // both must be read numReads times.
-func GetRoleListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) RoleListChannel {
+func GetRoleListChannel(client client.Interface, nsQuery *NamespaceQuery, options metaV1.ListOptions,
+	numReads int) RoleListChannel {
 	channel := RoleListChannel{
 		List:  make(chan *rbac.RoleList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.RbacV1().Roles(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.RbacV1().Roles(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -719,14 +718,15 @@ type ClusterRoleListChannel struct {
 
 // GetClusterRoleListChannel returns a pair of channels to a ClusterRole list and errors that
 // both must be read numReads times.
-func GetClusterRoleListChannel(client client.Interface, numReads int) ClusterRoleListChannel {
+func GetClusterRoleListChannel(client client.Interface, options metaV1.ListOptions,
+	numReads int) ClusterRoleListChannel {
 	channel := ClusterRoleListChannel{
 		List:  make(chan *rbac.ClusterRoleList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.RbacV1().ClusterRoles().List(context.TODO(), api.ListEverything)
+		list, err := client.RbacV1().ClusterRoles().List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -744,14 +744,15 @@ type RoleBindingListChannel struct {
 
 // GetRoleBindingListChannel returns a pair of channels to a RoleBinding list for a namespace and errors that
 // both must be read numReads times.
-func GetRoleBindingListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) RoleBindingListChannel {
+func GetRoleBindingListChannel(client client.Interface, nsQuery *NamespaceQuery, options metaV1.ListOptions,
+	numReads int) RoleBindingListChannel {
 	channel := RoleBindingListChannel{
 		List:  make(chan *rbac.RoleBindingList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.RbacV1().RoleBindings(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.RbacV1().RoleBindings(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -769,7 +770,7 @@ type ClusterRoleBindingListChannel struct {
 
 // GetClusterRoleBindingListChannel returns a pair of channels to a ClusterRoleBinding list and
 // errors that both must be read numReads times.
-func GetClusterRoleBindingListChannel(client client.Interface,
+func GetClusterRoleBindingListChannel(client client.Interface, options metaV1.ListOptions,
 	numReads int) ClusterRoleBindingListChannel {
 	channel := ClusterRoleBindingListChannel{
 		List:  make(chan *rbac.ClusterRoleBindingList, numReads),
@@ -777,7 +778,7 @@ func GetClusterRoleBindingListChannel(client client.Interface,
 	}
 
 	go func() {
-		list, err := client.RbacV1().ClusterRoleBindings().List(context.TODO(), api.ListEverything)
+		list, err := client.RbacV1().ClusterRoleBindings().List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -795,15 +796,14 @@ type PersistentVolumeListChannel struct {
 
 // GetPersistentVolumeListChannel returns a pair of channels to a PersistentVolume list and errors
 // that both must be read numReads times.
-func GetPersistentVolumeListChannel(client client.Interface,
-	numReads int) PersistentVolumeListChannel {
+func GetPersistentVolumeListChannel(client client.Interface, options metaV1.ListOptions, numReads int) PersistentVolumeListChannel {
 	channel := PersistentVolumeListChannel{
 		List:  make(chan *v1.PersistentVolumeList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().PersistentVolumes().List(context.TODO(), api.ListEverything)
+		list, err := client.CoreV1().PersistentVolumes().List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -822,15 +822,14 @@ type PersistentVolumeClaimListChannel struct {
 // GetPersistentVolumeClaimListChannel returns a pair of channels to a PersistentVolumeClaim list
 // and errors that both must be read numReads times.
 func GetPersistentVolumeClaimListChannel(client client.Interface, nsQuery *NamespaceQuery,
-	numReads int) PersistentVolumeClaimListChannel {
-
+	options metaV1.ListOptions, numReads int) PersistentVolumeClaimListChannel {
 	channel := PersistentVolumeClaimListChannel{
 		List:  make(chan *v1.PersistentVolumeClaimList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().PersistentVolumeClaims(nsQuery.ToRequestParam()).List(context.TODO(), api.ListEverything)
+		list, err := client.CoreV1().PersistentVolumeClaims(nsQuery.ToRequestParam()).List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -848,14 +847,15 @@ type CustomResourceDefinitionChannelV1 struct {
 
 // GetCustomResourceDefinitionChannelV1 returns a pair of channels to a CustomResourceDefinition list and errors
 // that both must be read numReads times.
-func GetCustomResourceDefinitionChannelV1(client apiextensionsclientset.Interface, numReads int) CustomResourceDefinitionChannelV1 {
+func GetCustomResourceDefinitionChannelV1(client apiextensionsclientset.Interface, options metaV1.ListOptions,
+	numReads int) CustomResourceDefinitionChannelV1 {
 	channel := CustomResourceDefinitionChannelV1{
 		List:  make(chan *apiextensions.CustomResourceDefinitionList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.ApiextensionsV1().CustomResourceDefinitions().List(context.TODO(), api.ListEverything)
+		list, err := client.ApiextensionsV1().CustomResourceDefinitions().List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -873,14 +873,15 @@ type CustomResourceDefinitionChannelV1beta1 struct {
 
 // GetCustomResourceDefinitionChannelV1beta1 returns a pair of channels to a CustomResourceDefinition list and errors
 // that both must be read numReads times.
-func GetCustomResourceDefinitionChannelV1beta1(client apiextensionsclientset.Interface, numReads int) CustomResourceDefinitionChannelV1beta1 {
+func GetCustomResourceDefinitionChannelV1beta1(client apiextensionsclientset.Interface, options metaV1.ListOptions,
+	numReads int) CustomResourceDefinitionChannelV1beta1 {
 	channel := CustomResourceDefinitionChannelV1beta1{
 		List:  make(chan *apiextensionsv1beta1.CustomResourceDefinitionList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.ApiextensionsV1beta1().CustomResourceDefinitions().List(context.TODO(), api.ListEverything)
+		list, err := client.ApiextensionsV1beta1().CustomResourceDefinitions().List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -926,7 +927,7 @@ type HorizontalPodAutoscalerListChannel struct {
 // GetHorizontalPodAutoscalerListChannel returns a pair of channels to MetricsByPod and errors that
 // both must be read numReads times.
 func GetHorizontalPodAutoscalerListChannel(client client.Interface, nsQuery *NamespaceQuery,
-	numReads int) HorizontalPodAutoscalerListChannel {
+	options metaV1.ListOptions, numReads int) HorizontalPodAutoscalerListChannel {
 	channel := HorizontalPodAutoscalerListChannel{
 		List:  make(chan *autoscaling.HorizontalPodAutoscalerList, numReads),
 		Error: make(chan error, numReads),
@@ -934,7 +935,7 @@ func GetHorizontalPodAutoscalerListChannel(client client.Interface, nsQuery *Nam
 
 	go func() {
 		list, err := client.AutoscalingV1().HorizontalPodAutoscalers(nsQuery.ToRequestParam()).
-			List(context.TODO(), api.ListEverything)
+			List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -952,14 +953,15 @@ type StorageClassListChannel struct {
 
 // GetStorageClassListChannel returns a pair of channels to a storage class list and
 // errors that both must be read numReads times.
-func GetStorageClassListChannel(client client.Interface, numReads int) StorageClassListChannel {
+func GetStorageClassListChannel(client client.Interface, options metaV1.ListOptions,
+	numReads int) StorageClassListChannel {
 	channel := StorageClassListChannel{
 		List:  make(chan *storage.StorageClassList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.StorageV1().StorageClasses().List(context.TODO(), api.ListEverything)
+		list, err := client.StorageV1().StorageClasses().List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -18,7 +18,10 @@ import (
 	"context"
 	"io"
 	"io/ioutil"
+	"strings"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/logs"
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,15 +29,12 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 )
 
-// maximum number of lines loaded from the apiserver
-var lineReadLimit int64 = 5000
-
-// maximum number of bytes loaded from the apiserver
-var byteReadLimit int64 = 500000
-
 // PodContainerList is a list of containers of a pod.
 type PodContainerList struct {
 	Containers []string `json:"containers"`
+
+	// DefaultContainer is the container that should be selected when none is specified.
+	DefaultContainer string `json:"defaultContainer"`
 }
 
 // GetPodContainers returns containers that a pod has.
@@ -44,7 +44,7 @@ func GetPodContainers(client kubernetes.Interface, namespace, podID string) (*Po
 		return nil, err
 	}
 
-	containers := &PodContainerList{Containers: make([]string, 0)}
+	containers := &PodContainerList{Containers: make([]string, 0), DefaultContainer: common.GetDefaultContainerName(pod)}
 
 	for _, container := range pod.Spec.Containers {
 		containers.Containers = append(containers.Containers, container.Name)
@@ -53,7 +53,7 @@ func GetPodContainers(client kubernetes.Interface, namespace, podID string) (*Po
 	return containers, nil
 }
 
-// GetLogDetails returns logs for particular pod and container. When container is null, logs for the first one
+// GetLogDetails returns logs for particular pod and container. When container is null, logs for the default one
 // are returned. Previous indicates to read archived logs created by log rotation or container crash
 func GetLogDetails(client kubernetes.Interface, namespace, podID string, container string,
 	logSelector *logs.Selection, usePreviousLogs bool) (*logs.LogDetails, error) {
@@ -63,7 +63,7 @@ func GetLogDetails(client kubernetes.Interface, namespace, podID string, contain
 	}
 
 	if len(container) == 0 {
-		container = pod.Spec.Containers[0].Name
+		container = common.GetDefaultContainerName(pod)
 	}
 
 	logOptions := mapToLogOptions(container, logSelector, usePreviousLogs)
@@ -85,16 +85,58 @@ func mapToLogOptions(container string, logSelector *logs.Selection, previous boo
 		Timestamps: true,
 	}
 
+	// Limit of bytes would drop the newest lines when reading from the end, so they are trimmed after the read.
 	if logSelector.LogFilePosition == logs.Beginning {
-		logOptions.LimitBytes = &byteReadLimit
+		limitBytes := byteReadLimit()
+		logOptions.LimitBytes = &limitBytes
 	} else {
-		logOptions.TailLines = &lineReadLimit
+		tailLines := lineReadLimit(logSelector)
+		logOptions.TailLines = &tailLines
 	}
 
 	return logOptions
 }
 
-// Construct a request for getting the logs for a pod and retrieves the logs.
+// Returns maximum number of lines loaded from the end of the log file. Selection can request more lines than
+// --log-default-tail-lines to load older logs, but not more than --log-max-bytes, as every line takes at least a byte
+// and more lines would not fit in the byte read limit anyway.
+func lineReadLimit(logSelector *logs.Selection) int64 {
+	lines := int64(args.Holder.GetLogDefaultTailLines())
+	if logSelector.TailLines > 0 {
+		lines = int64(logSelector.TailLines)
+	}
+
+	if limit := byteReadLimit(); limit > 0 && lines > limit {
+		return limit
+	}
+
+	return lines
+}
+
+// Returns maximum number of bytes loaded from the apiserver.
+func byteReadLimit() int64 {
+	return int64(args.Holder.GetLogMaxBytes())
+}
+
+// Removes the oldest lines of the logs that do not fit in the byte read limit. Returns true if any line was removed.
+func trimOldestLogs(rawLogs string) (string, bool) {
+	limit := byteReadLimit()
+	if limit <= 0 || int64(len(rawLogs)) <= limit {
+		return rawLogs, false
+	}
+
+	rawLogs = rawLogs[int64(len(rawLogs))-limit:]
+	// First line was cut in the middle.
+	if index := strings.Index(rawLogs, "\n"); index >= 0 {
+		rawLogs = rawLogs[index+1:]
+	}
+
+	return rawLogs, true
+}
+
+// Construct a request for getting the logs for a pod and retrieves the logs. At most byte read limit is kept in
+// memory. Logs read from the beginning are cut at the limit. Only the newest bytes of the logs read from the end are
+// kept, one byte above the limit, so that trimOldestLogs can tell that the oldest lines were dropped.
 func readRawLogs(client kubernetes.Interface, namespace, podID string, logOptions *v1.PodLogOptions) (
 	string, error) {
 	readCloser, err := openStream(client, namespace, podID, logOptions)
@@ -104,12 +146,71 @@ func readRawLogs(client kubernetes.Interface, namespace, podID string, logOption
 
 	defer readCloser.Close()
 
-	result, err := ioutil.ReadAll(readCloser)
-	if err != nil {
+	limit := byteReadLimit()
+	if limit <= 0 {
+		result, err := ioutil.ReadAll(readCloser)
+		if err != nil {
+			return "", err
+		}
+
+		return string(result), nil
+	}
+
+	if logOptions.LimitBytes != nil {
+		result, err := ioutil.ReadAll(io.LimitReader(readCloser, limit))
+		if err != nil {
+			return "", err
+		}
+
+		return string(result), nil
+	}
+
+	tail := newRingBuffer(limit + 1)
+	if _, err := io.Copy(tail, readCloser); err != nil {
 		return "", err
 	}
 
-	return string(result), nil
+	return tail.String(), nil
+}
+
+// ringBuffer keeps only the last bytes written to it that fit in its size.
+type ringBuffer struct {
+	data []byte
+	next int
+	full bool
+}
+
+func (self *ringBuffer) Write(p []byte) (int, error) {
+	written := len(p)
+	if len(p) > len(self.data) {
+		p = p[len(p)-len(self.data):]
+	}
+
+	copied := copy(self.data[self.next:], p)
+	self.next += copied
+	if copied < len(p) {
+		self.next = copy(self.data, p[copied:])
+	}
+
+	if copied < len(p) || self.next == len(self.data) {
+		self.next %= len(self.data)
+		self.full = true
+	}
+
+	return written, nil
+}
+
+// String returns the bytes kept in the buffer in the order they were written.
+func (self *ringBuffer) String() string {
+	if !self.full {
+		return string(self.data[:self.next])
+	}
+
+	return string(self.data[self.next:]) + string(self.data[:self.next])
+}
+
+func newRingBuffer(size int64) *ringBuffer {
+	return &ringBuffer{data: make([]byte, size)}
 }
 
 // GetLogFile returns a stream to the log file which can be piped directly to the response. This avoids out of memory
@@ -136,18 +237,29 @@ func openStream(client kubernetes.Interface, namespace, podID string, logOptions
 
 // ConstructLogDetails creates a new log details structure for given parameters.
 func ConstructLogDetails(podID string, rawLogs string, container string, logSelector *logs.Selection) *logs.LogDetails {
+	bytesTrimmed := false
+	if logSelector.LogFilePosition == logs.End {
+		rawLogs, bytesTrimmed = trimOldestLogs(rawLogs)
+	}
+
 	parsedLines := logs.ToLogLines(rawLogs)
 	logLines, fromDate, toDate, logSelection, lastPage := parsedLines.SelectLogs(logSelector)
 
-	readLimitReached := isReadLimitReached(int64(len(rawLogs)), int64(len(parsedLines)), logSelector.LogFilePosition)
+	readLimitReached := bytesTrimmed ||
+		isReadLimitReached(int64(len(rawLogs)), int64(len(parsedLines)), logSelector)
 	truncated := readLimitReached && lastPage
 
+	if logSelector.LogFilePosition == logs.End {
+		logSelection.TailLines = int(lineReadLimit(logSelector))
+	}
+
 	info := logs.LogInfo{
 		PodName:       podID,
 		ContainerName: container,
 		FromDate:      fromDate,
 		ToDate:        toDate,
 		Truncated:     truncated,
+		MoreAvailable: truncated && !bytesTrimmed && logSelector.LogFilePosition == logs.End,
 	}
 	return &logs.LogDetails{
 		Info:      info,
@@ -157,7 +269,7 @@ func ConstructLogDetails(podID string, rawLogs string, container string, logSele
 }
 
 // Checks if the amount of log file returned from the apiserver is equal to the read limits
-func isReadLimitReached(bytesLoaded int64, linesLoaded int64, logFilePosition string) bool {
-	return (logFilePosition == logs.Beginning && bytesLoaded >= byteReadLimit) ||
-		(logFilePosition == logs.End && linesLoaded >= lineReadLimit)
+func isReadLimitReached(bytesLoaded int64, linesLoaded int64, logSelector *logs.Selection) bool {
+	return (logSelector.LogFilePosition == logs.Beginning && bytesLoaded >= byteReadLimit()) ||
+		(logSelector.LogFilePosition == logs.End && linesLoaded >= lineReadLimit(logSelector))
 }
@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/logs"
 	v1 "k8s.io/api/core/v1"
 )
@@ -84,7 +85,10 @@ func BenchmarkGetLogDetails4(b *testing.B) { benchmarkGetLogDetails(2000, "9", b
 
 func TestGetLogs(t *testing.T) {
 	// for the test cases, the line read limit is reduced to 10
-	lineReadLimit = int64(10)
+	args.GetHolderBuilder().SetLogDefaultTailLines(10)
+	defer func() {
+		args.GetHolderBuilder().SetLogDefaultTailLines(0)
+	}()
 	cases := []struct {
 		info        string
 		podId       string
@@ -331,6 +335,7 @@ func TestGetLogs(t *testing.T) {
 					FromDate:      "1",
 					ToDate:        "2",
 					Truncated:     true, // Read limit is set to 10. Log lines could not be loaded
+					MoreAvailable: true,
 				},
 				LogLines: logs.LogLines{logs.LogLine{ // Last available page of logs is returned
 					Timestamp: "1",
@@ -347,6 +352,7 @@ func TestGetLogs(t *testing.T) {
 					OffsetFrom:      -5,
 					OffsetTo:        -3,
 					LogFilePosition: "end",
+					TailLines:       10,
 				},
 			},
 		},
@@ -387,6 +393,14 @@ func TestGetLogs(t *testing.T) {
 }
 
 func TestMapToLogOptions(t *testing.T) {
+	args.GetHolderBuilder().SetLogDefaultTailLines(5000).SetLogMaxBytes(500000)
+	defer func() {
+		args.GetHolderBuilder().SetLogDefaultTailLines(0).SetLogMaxBytes(0)
+	}()
+
+	byteLimit := int64(500000)
+	defaultLineLimit := int64(5000)
+	requestedLineLimit := int64(10000)
 	cases := []struct {
 		info        string
 		container   string
@@ -401,7 +415,7 @@ func TestMapToLogOptions(t *testing.T) {
 			&v1.PodLogOptions{
 				Container:  "test",
 				Timestamps: true,
-				LimitBytes: &byteReadLimit,
+				LimitBytes: &byteLimit,
 			},
 		},
 		{"Line limit must be set, when reading the log file from the end",
@@ -412,7 +426,31 @@ func TestMapToLogOptions(t *testing.T) {
 			&v1.PodLogOptions{
 				Container:  "test",
 				Timestamps: true,
-				TailLines:  &lineReadLimit,
+				TailLines:  &defaultLineLimit,
+			},
+		},
+		{"Requested line limit must be set, when loading older logs from the end",
+			"test",
+			&logs.Selection{
+				LogFilePosition: "end",
+				TailLines:       10000,
+			},
+			&v1.PodLogOptions{
+				Container:  "test",
+				Timestamps: true,
+				TailLines:  &requestedLineLimit,
+			},
+		},
+		{"Requested line limit must not exceed the byte limit",
+			"test",
+			&logs.Selection{
+				LogFilePosition: "end",
+				TailLines:       1000000000,
+			},
+			&v1.PodLogOptions{
+				Container:  "test",
+				Timestamps: true,
+				TailLines:  &byteLimit,
 			},
 		},
 	}
@@ -424,3 +462,59 @@ func TestMapToLogOptions(t *testing.T) {
 
 	}
 }
+
+func TestTrimOldestLogs(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetLogMaxBytes(0)
+	}()
+
+	cases := []struct {
+		info            string
+		maxBytes        int
+		rawLogs         string
+		expected        string
+		expectedTrimmed bool
+	}{
+		{"Should keep logs within the limit", 100, "1 log1\n2 log2\n", "1 log1\n2 log2\n", false},
+		{"Should drop the oldest lines above the limit", 10, "1 log1\n2 log2\n3 log3", "3 log3", true},
+		{"Should drop line cut at the limit", 9, "1 log1\n2 log2\n3 log3", "3 log3", true},
+		{"Should keep logs if limit is not set", 0, "1 log1\n2 log2\n", "1 log1\n2 log2\n", false},
+	}
+
+	for _, c := range cases {
+		args.GetHolderBuilder().SetLogMaxBytes(c.maxBytes)
+		actual, trimmed := trimOldestLogs(c.rawLogs)
+		if actual != c.expected || trimmed != c.expectedTrimmed {
+			t.Errorf("Test Case: %s. Expected %q (trimmed: %t), but got %q (trimmed: %t).", c.info, c.expected,
+				c.expectedTrimmed, actual, trimmed)
+		}
+	}
+}
+
+func TestRingBuffer(t *testing.T) {
+	cases := []struct {
+		info     string
+		size     int64
+		writes   []string
+		expected string
+	}{
+		{"Should keep all bytes that fit", 10, []string{"1 log1\n", "2"}, "1 log1\n2"},
+		{"Should keep the newest bytes", 9, []string{"1 log1\n", "2 log2\n"}, "1\n2 log2\n"},
+		{"Should keep the newest bytes of a long write", 4, []string{"1 log1\n2 log2"}, "log2"},
+		{"Should keep bytes filling the buffer", 7, []string{"1 log1", "\n"}, "1 log1\n"},
+		{"Should wrap around many times", 3, []string{"ab", "cd", "ef", "g"}, "efg"},
+	}
+
+	for _, c := range cases {
+		buffer := newRingBuffer(c.size)
+		for _, write := range c.writes {
+			if n, err := buffer.Write([]byte(write)); n != len(write) || err != nil {
+				t.Errorf("Test Case: %s. Expected %d bytes to be written, but got %d (%v).", c.info, len(write), n, err)
+			}
+		}
+
+		if actual := buffer.String(); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected %q, but got %q.", c.info, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"time"
+)
+
+// timestampReader converts timestamps that prefix log lines when logs are read with 'timestamps' option to given
+// location. The apiserver always returns them in UTC. Lines without a timestamp are not changed.
+type timestampReader struct {
+	source   io.ReadCloser
+	reader   *bufio.Reader
+	location *time.Location
+	buf      []byte
+	err      error
+}
+
+// Read implements io.Reader interface.
+func (self *timestampReader) Read(p []byte) (int, error) {
+	if len(self.buf) == 0 && self.err == nil {
+		line, err := self.reader.ReadBytes('\n')
+		self.buf, self.err = convertLogTimestamp(line, self.location), err
+	}
+
+	n := copy(p, self.buf)
+	self.buf = self.buf[n:]
+	if len(self.buf) > 0 {
+		return n, nil
+	}
+
+	return n, self.err
+}
+
+// Close implements io.Closer interface.
+func (self *timestampReader) Close() error {
+	return self.source.Close()
+}
+
+// ConvertLogTimestamps returns a stream of the log file with timestamps converted to given location.
+func ConvertLogTimestamps(logStream io.ReadCloser, location *time.Location) io.ReadCloser {
+	return &timestampReader{source: logStream, reader: bufio.NewReader(logStream), location: location}
+}
+
+func convertLogTimestamp(line []byte, location *time.Location) []byte {
+	idx := bytes.IndexByte(line, ' ')
+	if idx < 0 {
+		return line
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, string(line[:idx]))
+	if err != nil {
+		return line
+	}
+
+	return append([]byte(timestamp.In(location).Format(time.RFC3339Nano)), line[idx:]...)
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvertLogTimestamps(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		info     string
+		logs     string
+		expected string
+	}{
+		{"Should convert timestamps of all lines",
+			"2021-01-15T10:00:00.123456789Z first line\n2021-07-15T10:00:00Z second line\n",
+			"2021-01-15T11:00:00.123456789+01:00 first line\n2021-07-15T12:00:00+02:00 second line\n"},
+		{"Should convert last line without new line", "2021-01-15T10:00:00Z last", "2021-01-15T11:00:00+01:00 last"},
+		{"Should not change lines without timestamps", "plain line\n\n2021-01-15 10:00:00 other\n",
+			"plain line\n\n2021-01-15 10:00:00 other\n"},
+		{"Should handle empty logs", "", ""},
+	}
+
+	for _, c := range cases {
+		stream := ConvertLogTimestamps(ioutil.NopCloser(strings.NewReader(c.logs)), berlin)
+		actual, err := ioutil.ReadAll(stream)
+		if err != nil {
+			t.Errorf("Test Case: %s. Unexpected error: %s.", c.info, err)
+			continue
+		}
+
+		if string(actual) != c.expected {
+			t.Errorf("Test Case: %s. Expected %q, but got %q.", c.info, c.expected, string(actual))
+		}
+	}
+}
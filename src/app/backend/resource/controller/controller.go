@@ -46,6 +46,8 @@ type LogSources struct {
 	ContainerNames     []string `json:"containerNames"`
 	InitContainerNames []string `json:"initContainerNames"`
 	PodNames           []string `json:"podNames"`
+	// DefaultContainer is the default container of the first pod. It is empty if there are no pods.
+	DefaultContainer string `json:"defaultContainer"`
 }
 
 // ResourceController is an interface, that allows to perform operations on resource controller. To
@@ -34,6 +34,8 @@ func (self CronJobCell) GetProperty(name dataselect.PropertyName) dataselect.Com
 		return dataselect.StdComparableTime(self.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(self.ObjectMeta.Namespace)
+	case dataselect.ActiveProperty:
+		return dataselect.StdComparableInt(len(self.Status.Active))
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
@@ -18,14 +18,15 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 )
 
 // GetCronJobEvents gets events associated to cron job.
-func GetCronJobEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
-	*common.EventList, error) {
+func GetCronJobEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string,
+	listOptions metaV1.ListOptions) (*common.EventList, error) {
 
-	raw, err := event.GetEvents(client, namespace, name)
+	raw, err := event.GetEvents(client, namespace, name, listOptions)
 	if err != nil {
 		return event.EmptyEventList, err
 	}
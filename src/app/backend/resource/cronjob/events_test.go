@@ -69,7 +69,8 @@ func TestGetJobEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList)
 
-		actual, _ := cronjob.GetCronJobEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
+		actual, _ := cronjob.GetCronJobEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name,
+			api.ListEverything)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
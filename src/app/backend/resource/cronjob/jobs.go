@@ -48,7 +48,7 @@ func GetCronJobJobs(client client.Interface, metricClient metricapi.MetricClient
 	}
 
 	channels := &common.ResourceChannels{
-		JobList:   common.GetJobListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		JobList:   common.GetJobListChannel(client, common.NewSameNamespaceQuery(namespace), api.ListEverything, 1),
 		PodList:   common.GetPodListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
 		EventList: common.GetEventListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
 	}
@@ -55,11 +55,12 @@ type CronJob struct {
 
 // GetCronJobList returns a list of all CronJobs in the cluster.
 func GetCronJobList(client client.Interface, nsQuery *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*CronJobList, error) {
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient,
+	listOptions metav1.ListOptions) (*CronJobList, error) {
 	log.Print("Getting list of all cron jobs in the cluster")
 
 	channels := &common.ResourceChannels{
-		CronJobList: common.GetCronJobListChannel(client, nsQuery, 1),
+		CronJobList: common.GetCronJobListChannel(client, nsQuery, listOptions, 1),
 	}
 
 	return GetCronJobListFromChannels(channels, dsQuery, metricClient)
@@ -0,0 +1,188 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customcolumn
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+// CustomColumnList contains a list of objects of a kind with values of custom columns configured in the settings.
+type CustomColumnList struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Columns that values are computed for.
+	Columns []settingsApi.CustomColumn `json:"columns"`
+
+	// Objects with values of the columns.
+	Rows []Row `json:"rows"`
+
+	// List of non-critical errors, i.e. invalid JSONPath expressions of the columns.
+	Errors []error `json:"errors"`
+}
+
+// Row is an object with values of custom columns in the same order as the columns. Values of paths that are missing
+// in the object are empty.
+type Row struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	Cells      []string       `json:"cells"`
+}
+
+// GetCustomColumnList returns a list of objects of given kind with values of given columns. Namespaced objects are
+// limited to the namespaces matching given namespace query, cluster-scoped objects are not filtered.
+func GetCustomColumnList(verber clientapi.ResourceVerber, kind string, nsQuery *common.NamespaceQuery,
+	columns []settingsApi.CustomColumn, dsQuery *dataselect.DataSelectQuery) (*CustomColumnList, error) {
+	namespace := nsQuery.ToRequestParam()
+	if mapping, exists := api.KindToAPIMapping[kind]; exists && !mapping.Namespaced {
+		namespace, nsQuery = "", common.NewNamespaceQuery(nil)
+	}
+	log.Printf("Getting custom columns of %s list in the namespace %s", kind, namespace)
+
+	raw, err := verber.List(kind, namespace, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	list := &unstructured.UnstructuredList{}
+	if err := list.UnmarshalJSON(raw.(*runtime.Unknown).Raw); err != nil {
+		return nil, err
+	}
+
+	items := make([]unstructured.Unstructured, 0, len(list.Items))
+	for _, item := range list.Items {
+		if nsQuery.Matches(item.GetNamespace()) {
+			items = append(items, item)
+		}
+	}
+
+	return toCustomColumnList(items, columns, dsQuery), nil
+}
+
+func toCustomColumnList(items []unstructured.Unstructured, columns []settingsApi.CustomColumn,
+	dsQuery *dataselect.DataSelectQuery) *CustomColumnList {
+	parsers, nonCriticalErrors := parseColumns(columns)
+	result := &CustomColumnList{
+		Columns: columns,
+		Rows:    make([]Row, 0),
+		Errors:  nonCriticalErrors,
+	}
+
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(items), dsQuery)
+	result.ListMeta = api.ListMeta{TotalItems: filteredTotal}
+
+	for _, item := range fromCells(cells) {
+		row := Row{ObjectMeta: api.NewObjectMeta(toObjectMeta(&item)), Cells: make([]string, len(parsers))}
+		for i, parser := range parsers {
+			if parser != nil {
+				row.Cells[i] = evaluate(parser, item.Object)
+			}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result
+}
+
+// Parses JSONPath expressions of the columns. Expressions without braces, i.e. '.metadata.name', are accepted the
+// same as by kubectl custom columns. Parser of the column with invalid expression is nil.
+func parseColumns(columns []settingsApi.CustomColumn) ([]*jsonpath.JSONPath, []error) {
+	parsers := make([]*jsonpath.JSONPath, len(columns))
+	errs := make([]error, 0)
+	for i, column := range columns {
+		expression := column.JSONPath
+		if !strings.HasPrefix(expression, "{") {
+			expression = fmt.Sprintf("{%s}", expression)
+		}
+
+		parser := jsonpath.New(column.Name).AllowMissingKeys(true)
+		if err := parser.Parse(expression); err != nil {
+			errs = append(errs, fmt.Errorf("invalid JSONPath of column %s: %s", column.Name, err))
+			continue
+		}
+		parsers[i] = parser
+	}
+
+	return parsers, errs
+}
+
+// Returns value found in the object formatted the same as by kubectl. Empty value is returned if the path is
+// missing in the object.
+func evaluate(parser *jsonpath.JSONPath, object map[string]interface{}) string {
+	buffer := &bytes.Buffer{}
+	if err := parser.Execute(buffer, object); err != nil {
+		return ""
+	}
+
+	return buffer.String()
+}
+
+func toObjectMeta(item *unstructured.Unstructured) metaV1.ObjectMeta {
+	return metaV1.ObjectMeta{
+		Name:              item.GetName(),
+		Namespace:         item.GetNamespace(),
+		Labels:            item.GetLabels(),
+		Annotations:       item.GetAnnotations(),
+		CreationTimestamp: item.GetCreationTimestamp(),
+		UID:               item.GetUID(),
+	}
+}
+
+// ObjectCell is a wrapper of unstructured object that implements DataCell interface.
+type ObjectCell unstructured.Unstructured
+
+// GetProperty is used to get property of the object, e.g. name.
+func (self ObjectCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	object := unstructured.Unstructured(self)
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(object.GetName())
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(object.GetCreationTimestamp().Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(object.GetNamespace())
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []unstructured.Unstructured) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ObjectCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []unstructured.Unstructured {
+	std := make([]unstructured.Unstructured, len(cells))
+	for i := range std {
+		std[i] = unstructured.Unstructured(cells[i].(ObjectCell))
+	}
+	return std
+}
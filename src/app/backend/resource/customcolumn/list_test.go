@@ -0,0 +1,126 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customcolumn
+
+import (
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+func newObject(name string, labels map[string]interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default", "labels": labels},
+		"spec": map[string]interface{}{"containers": []interface{}{
+			map[string]interface{}{"name": "app", "image": "nginx"},
+			map[string]interface{}{"name": "sidecar", "image": "envoy"},
+		}},
+	}}
+}
+
+func TestToCustomColumnList(t *testing.T) {
+	columns := []settingsApi.CustomColumn{
+		{Name: "Team", JSONPath: "{.metadata.labels.team}"},
+		{Name: "Images", JSONPath: ".spec.containers[*].image"},
+		{Name: "Missing", JSONPath: "{.status.podIP}"},
+		{Name: "Invalid", JSONPath: "{.metadata.labels["},
+	}
+	items := []unstructured.Unstructured{
+		newObject("b", map[string]interface{}{"team": "payments"}),
+		newObject("a", nil),
+	}
+	sortByName := dataselect.NewDataSelectQuery(dataselect.NoPagination,
+		dataselect.NewSortQuery([]string{"a", dataselect.NameProperty}), dataselect.NoFilter, dataselect.NoMetrics)
+
+	result := toCustomColumnList(items, columns, sortByName)
+
+	if result.ListMeta.TotalItems != 2 || len(result.Rows) != 2 {
+		t.Fatalf("Expected 2 rows, but got %#v", result)
+	}
+
+	if len(result.Errors) != 1 {
+		t.Errorf("Expected one error of invalid column, but got %v", result.Errors)
+	}
+
+	expected := [][]string{{"", "nginx envoy", "", ""}, {"payments", "nginx envoy", "", ""}}
+	for i, row := range result.Rows {
+		if !reflect.DeepEqual(row.Cells, expected[i]) {
+			t.Errorf("Expected cells of %s to be %v, but got %v", row.ObjectMeta.Name, expected[i], row.Cells)
+		}
+	}
+
+	if result.Rows[0].ObjectMeta.Name != "a" || result.Rows[0].ObjectMeta.Namespace != "default" {
+		t.Errorf("Expected rows to be sorted by name, but got %#v", result.Rows[0].ObjectMeta)
+	}
+}
+
+// listVerber returns the same list of secrets from two namespaces for any kind and records listed namespaces.
+type listVerber struct {
+	clientapi.ResourceVerber
+	namespaces []string
+}
+
+func (self *listVerber) List(kind string, namespace string, opts metaV1.ListOptions) (runtime.Object, error) {
+	self.namespaces = append(self.namespaces, namespace)
+	return &runtime.Unknown{Raw: []byte(`{"apiVersion":"v1","kind":"List","items":[
+		{"apiVersion":"v1","kind":"Secret","metadata":{"name":"a","namespace":"foo"}},
+		{"apiVersion":"v1","kind":"Secret","metadata":{"name":"b","namespace":"bar"}}]}`)}, nil
+}
+
+func TestGetCustomColumnList(t *testing.T) {
+	columns := []settingsApi.CustomColumn{{Name: "Name", JSONPath: "{.metadata.name}"}}
+	cases := []struct {
+		info               string
+		kind               string
+		namespaces         []string
+		expectedNamespace  string
+		expectedRowsLength int
+	}{
+		{"Should list namespaced kind in all namespaces", api.ResourceKindSecret, nil, "", 2},
+		{"Should list namespaced kind in single namespace", api.ResourceKindSecret, []string{"foo"}, "foo", 1},
+		{"Should limit namespaced kind to multiple namespaces", api.ResourceKindSecret, []string{"foo", "baz"}, "",
+			1},
+		{"Should not limit cluster-scoped kind to namespaces", api.ResourceKindNode, []string{"foo", "baz"}, "", 2},
+	}
+
+	for _, c := range cases {
+		verber := &listVerber{}
+		result, err := GetCustomColumnList(verber, c.kind, common.NewNamespaceQuery(c.namespaces), columns,
+			dataselect.NoDataSelect)
+		if err != nil {
+			t.Fatalf("Test Case: %s. Unexpected error: %s", c.info, err)
+		}
+
+		if !reflect.DeepEqual(verber.namespaces, []string{c.expectedNamespace}) {
+			t.Errorf("Test Case: %s. Expected list in namespace %q, but got %v.", c.info, c.expectedNamespace,
+				verber.namespaces)
+		}
+
+		if len(result.Rows) != c.expectedRowsLength {
+			t.Errorf("Test Case: %s. Expected %d rows, but got %d.", c.info, c.expectedRowsLength, len(result.Rows))
+		}
+	}
+}
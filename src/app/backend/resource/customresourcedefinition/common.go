@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/rest"
 
+	"github.com/kubernetes/dashboard/src/app/backend/discoverycache"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/customresourcedefinition/types"
@@ -42,7 +43,7 @@ var (
 )
 
 func GetExtensionsAPIVersion(client clientset.Interface) (string, error) {
-	list, err := client.Discovery().ServerGroups()
+	list, err := discoverycache.Wrap(client.Discovery()).ServerGroups()
 	if err != nil {
 		return "", err
 	}
@@ -72,7 +73,8 @@ func GetExtensionsAPIRestClient(client clientset.Interface) (rest.Interface, err
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api version: %s", version))
 }
 
-func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*types.CustomResourceDefinitionList, error) {
+func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery,
+	listOptions metav1.ListOptions) (*types.CustomResourceDefinitionList, error) {
 	version, err := GetExtensionsAPIVersion(client)
 	if err != nil {
 		return nil, err
@@ -80,9 +82,9 @@ func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, ds
 
 	switch version {
 	case v1:
-		return crdv1.GetCustomResourceDefinitionList(client, dsQuery)
+		return crdv1.GetCustomResourceDefinitionList(client, dsQuery, listOptions)
 	case v1beta1:
-		return crdv1beta1.GetCustomResourceDefinitionList(client, dsQuery)
+		return crdv1beta1.GetCustomResourceDefinitionList(client, dsQuery, listOptions)
 	}
 
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api version: %s", version))
@@ -105,7 +107,7 @@ func GetCustomResourceDefinitionDetail(client apiextensionsclientset.Interface,
 }
 
 func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config *rest.Config, namespace *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery, crdName string) (*types.CustomResourceObjectList, error) {
+	dsQuery *dataselect.DataSelectQuery, crdName string, listOptions metav1.ListOptions) (*types.CustomResourceObjectList, error) {
 	version, err := GetExtensionsAPIVersion(client)
 	if err != nil {
 		return nil, err
@@ -113,9 +115,9 @@ func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config
 
 	switch version {
 	case v1:
-		return crdv1.GetCustomResourceObjectList(client, config, namespace, dsQuery, crdName)
+		return crdv1.GetCustomResourceObjectList(client, config, namespace, dsQuery, crdName, listOptions)
 	case v1beta1:
-		return crdv1beta1.GetCustomResourceObjectList(client, config, namespace, dsQuery, crdName)
+		return crdv1beta1.GetCustomResourceObjectList(client, config, namespace, dsQuery, crdName, listOptions)
 	}
 
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
@@ -15,6 +15,7 @@
 package customresourcedefinition
 
 import (
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
@@ -24,6 +25,6 @@ import (
 
 // GetEventsForCustomResourceObject gets events that are associated with this CR object.
 func GetEventsForCustomResourceObject(client client.Interface, dsQuery *dataselect.DataSelectQuery,
-	namespace, name string) (*common.EventList, error) {
-	return event.GetResourceEvents(client, dsQuery, namespace, name)
+	namespace, name string, listOptions metaV1.ListOptions) (*common.EventList, error) {
+	return event.GetResourceEvents(client, dsQuery, namespace, name, listOptions)
 }
@@ -77,7 +77,8 @@ func TestGetEventsForCustomResourceObject(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.objectList)
 
-		actual, _ := GetEventsForCustomResourceObject(fakeClient, dataselect.NoDataSelect, c.namespace, c.objectName)
+		actual, _ := GetEventsForCustomResourceObject(fakeClient, dataselect.NoDataSelect, c.namespace, c.objectName,
+			api.ListEverything)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetEventsForCustomResourceObject == \ngot %#v, \nexpected %#v", actual,
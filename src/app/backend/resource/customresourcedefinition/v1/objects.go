@@ -32,7 +32,7 @@ import (
 
 // GetCustomResourceObjectList gets objects for a CR.
 func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config *rest.Config, namespace *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery, crdName string) (*types.CustomResourceObjectList, error) {
+	dsQuery *dataselect.DataSelectQuery, crdName string, listOptions metav1.ListOptions) (*types.CustomResourceObjectList, error) {
 	var list *types.CustomResourceObjectList
 
 	customResourceDefinition, err := client.ApiextensionsV1().
@@ -52,6 +52,7 @@ func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config
 	raw, err := restClient.Get().
 		NamespaceIfScoped(namespace.ToRequestParam(), customResourceDefinition.Spec.Scope == apiextensionsv1.NamespaceScoped).
 		Resource(customResourceDefinition.Spec.Names.Plural).
+		VersionedParams(&listOptions, metav1.ParameterCodec).
 		Do(context.TODO()).Raw()
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
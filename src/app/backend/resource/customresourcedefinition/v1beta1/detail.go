@@ -36,7 +36,8 @@ func GetCustomResourceDefinitionDetail(client apiextensionsclientset.Interface,
 		return nil, criticalError
 	}
 
-	objects, err := GetCustomResourceObjectList(client, config, &common.NamespaceQuery{}, dataselect.DefaultDataSelect, name)
+	objects, err := GetCustomResourceObjectList(client, config, &common.NamespaceQuery{}, dataselect.DefaultDataSelect, name,
+		metav1.ListOptions{})
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
@@ -27,8 +28,9 @@ import (
 )
 
 // GetCustomResourceDefinitionList returns all the custom resource definitions in the cluster.
-func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*types.CustomResourceDefinitionList, error) {
-	channel := common.GetCustomResourceDefinitionChannelV1beta1(client, 1)
+func GetCustomResourceDefinitionList(client apiextensionsclientset.Interface, dsQuery *dataselect.DataSelectQuery,
+	listOptions metav1.ListOptions) (*types.CustomResourceDefinitionList, error) {
+	channel := common.GetCustomResourceDefinitionChannelV1beta1(client, listOptions, 1)
 	crdList := <-channel.List
 	err := <-channel.Error
 
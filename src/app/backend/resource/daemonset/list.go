@@ -23,6 +23,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -48,10 +49,10 @@ type DaemonSet struct {
 
 // GetDaemonSetList returns a list of all Daemon Set in the cluster.
 func GetDaemonSetList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
-	metricClient metricapi.MetricClient) (*DaemonSetList, error) {
+	metricClient metricapi.MetricClient, listOptions metaV1.ListOptions) (*DaemonSetList, error) {
 	channels := &common.ResourceChannels{
-		DaemonSetList: common.GetDaemonSetListChannel(client, nsQuery, 1),
-		ServiceList:   common.GetServiceListChannel(client, nsQuery, 1),
+		DaemonSetList: common.GetDaemonSetListChannel(client, nsQuery, listOptions, 1),
+		ServiceList:   common.GetServiceListChannel(client, nsQuery, api.ListEverything, 1),
 		PodList:       common.GetPodListChannel(client, nsQuery, 1),
 		EventList:     common.GetEventListChannel(client, nsQuery, 1),
 	}
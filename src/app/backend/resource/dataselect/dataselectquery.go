@@ -142,6 +142,20 @@ func NewSortQuery(sortByListRaw []string) *SortQuery {
 	}
 }
 
+// NewOrderedSortQuery takes list of properties and returns SortQuery object that sorts by all of them in the same
+// order. For example: ["parameter1", "parameter2"], false - means that the data should be sorted by parameter1
+// (descending) and later - for results that return equal under parameter 1 sort - by parameter2 (descending)
+func NewOrderedSortQuery(properties []string, ascending bool) *SortQuery {
+	sortByList := []SortBy{}
+	for _, property := range properties {
+		sortByList = append(sortByList, SortBy{Property: PropertyName(property), Ascending: ascending})
+	}
+
+	return &SortQuery{
+		SortByList: sortByList,
+	}
+}
+
 // NewFilterQuery takes raw filter options list and returns FilterQuery object. For example:
 // ["parameter1", "value1", "parameter2", "value2"] - means that the data should be filtered by
 // parameter1 equals value1 and parameter2 equals value2
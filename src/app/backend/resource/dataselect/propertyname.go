@@ -14,6 +14,8 @@
 
 package dataselect
 
+import "github.com/kubernetes/dashboard/src/app/backend/api"
+
 // PropertyName is used to get the value of certain property of data cell.
 // For example if we want to get the namespace of certain Deployment we can use DeploymentCell.GetProperty(NamespaceProperty)
 type PropertyName string
@@ -25,4 +27,28 @@ const (
 	NamespaceProperty         = "namespace"
 	StatusProperty            = "status"
 	TypeProperty              = "type"
+	ActiveProperty            = "active"
+	LastTimestampProperty     = "lastTimestamp"
 )
+
+// SortableProperties is a list of properties that data can be sorted by. Order of cells that do not provide the
+// property is kept.
+var SortableProperties = []PropertyName{NameProperty, CreationTimestampProperty, NamespaceProperty, StatusProperty,
+	TypeProperty, ActiveProperty, LastTimestampProperty}
+
+// Properties provided by cells of all resource kinds.
+var commonSortableProperties = []PropertyName{NameProperty, CreationTimestampProperty, NamespaceProperty}
+
+// Properties provided only by cells of given resource kinds in addition to the common ones.
+var kindSortableProperties = map[string][]PropertyName{
+	api.ResourceKindEvent:                 {LastTimestampProperty},
+	api.ResourceKindCronJob:               {ActiveProperty},
+	api.ResourceKindService:               {TypeProperty},
+	api.ResourceKindPersistentVolume:      {StatusProperty},
+	api.ResourceKindPersistentVolumeClaim: {StatusProperty},
+}
+
+// GetKindSortableProperties returns properties that lists of resources of given kind can be sorted by.
+func GetKindSortableProperties(kind string) []PropertyName {
+	return append(append([]PropertyName{}, commonSortableProperties...), kindSortableProperties[kind]...)
+}
@@ -35,6 +35,8 @@ import (
 	client "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/discoverycache"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
@@ -118,6 +120,9 @@ type AppDeploymentFromFileResponse struct {
 
 	// Error after create resource
 	Error string `json:"error"`
+
+	// Objects returned by the apiserver for dry-run request, they are not persisted
+	Objects []unstructured.Unstructured `json:"objects,omitempty"`
 }
 
 // PortMapping is a specification of port mapping for an application deployment.
@@ -160,6 +165,14 @@ type Protocols struct {
 // client. App deployment consists of a deployment and an optional service. Both of them
 // share common labels.
 func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
+	if len(spec.Namespace) == 0 {
+		namespace, err := getDefaultNamespace(client)
+		if err != nil {
+			return err
+		}
+		spec.Namespace = namespace
+	}
+
 	log.Printf("Deploying %s application into %s namespace", spec.Name, spec.Namespace)
 
 	annotations := map[string]string{}
@@ -289,6 +302,19 @@ func generateName(base string) string {
 	return fmt.Sprintf("%s%s", base, rand.String(randomLength))
 }
 
+// Returns namespace given by --default-create-namespace argument. Error is returned if the namespace does not exist.
+func getDefaultNamespace(client client.Interface) (string, error) {
+	namespace := args.Holder.GetDefaultCreateNamespace()
+	if _, err := client.CoreV1().Namespaces().Get(context.TODO(), namespace, metaV1.GetOptions{}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return "", errors.NewBadRequest(fmt.Sprintf("Default namespace %s does not exist", namespace))
+		}
+		return "", err
+	}
+
+	return namespace, nil
+}
+
 // Converts array of labels to map[string]string
 func getLabelsMap(labels []Label) map[string]string {
 	result := make(map[string]string)
@@ -300,69 +326,141 @@ func getLabelsMap(labels []Label) map[string]string {
 	return result
 }
 
-// DeployAppFromFile deploys an app based on the given yaml or json file.
-func DeployAppFromFile(cfg *rest.Config, spec *AppDeploymentFromFileSpec) (bool, error) {
+// DeployAppFromFile deploys an app based on the given yaml or json file. Dry run option is passed to the apiserver
+// and objects returned by it are returned. Cluster-scoped objects are rejected if they are not allowed. Namespaced
+// objects that specify no namespace are created in the default namespace.
+func DeployAppFromFile(cfg *rest.Config, spec *AppDeploymentFromFileSpec, dryRun []string, allowClusterScoped bool,
+	checkNamespace func(namespace string) error) ([]unstructured.Unstructured, bool, error) {
 	reader := strings.NewReader(spec.Content)
 	log.Printf("Namespace for deploy from file: %s\n", spec.Namespace)
 	d := yaml.NewYAMLOrJSONDecoder(reader, 4096)
+	objects := make([]unstructured.Unstructured, 0)
+	resolver := &objectResolver{cfg: cfg, namespace: spec.Namespace, allowClusterScoped: allowClusterScoped,
+		checkNamespace: checkNamespace}
 	for {
 		data := &unstructured.Unstructured{}
 		if err := d.Decode(data); err != nil {
 			if err == io.EOF {
-				return true, nil
+				return objects, true, nil
 			}
-			return false, err
+			return nil, false, err
 		}
 
-		version := data.GetAPIVersion()
-		kind := data.GetKind()
-
-		gv, err := schema.ParseGroupVersion(version)
+		groupVersionResource, namespace, err := resolver.resolve(data)
 		if err != nil {
-			gv = schema.GroupVersion{Version: version}
+			return nil, false, err
 		}
 
-		discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+		dynamicClient, err := dynamic.NewForConfig(cfg)
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
-		apiResourceList, err := discoveryClient.ServerResourcesForGroupVersion(version)
-		if err != nil {
-			return false, err
-		}
-		apiResources := apiResourceList.APIResources
-		var resource *metaV1.APIResource
-		for _, apiResource := range apiResources {
-			if apiResource.Kind == kind && !strings.Contains(apiResource.Name, "/") {
-				resource = &apiResource
-				break
-			}
-		}
-		if resource == nil {
-			return false, fmt.Errorf("unknown resource kind: %s", kind)
+		var created *unstructured.Unstructured
+		createOptions := metaV1.CreateOptions{DryRun: dryRun}
+		if len(namespace) > 0 {
+			created, err = dynamicClient.Resource(groupVersionResource).Namespace(namespace).Create(context.TODO(), data, createOptions)
+		} else {
+			created, err = dynamicClient.Resource(groupVersionResource).Create(context.TODO(), data, createOptions)
 		}
 
-		dynamicClient, err := dynamic.NewForConfig(cfg)
 		if err != nil {
-			return false, err
+			return nil, false, errors.LocalizeError(err)
 		}
+		objects = append(objects, *created)
+	}
+}
 
-		groupVersionResource := schema.GroupVersionResource{Group: gv.Group, Version: gv.Version, Resource: resource.Name}
-		namespace := spec.Namespace
+// objectResolver resolves API resources and namespaces of objects from file.
+type objectResolver struct {
+	cfg *rest.Config
+	// Namespace selected for the deployment, objects keep their own if it is empty or all namespaces are selected.
+	namespace          string
+	allowClusterScoped bool
+	// Returns an error if objects must not be created in given namespace. Namespace objects are checked as well.
+	checkNamespace func(namespace string) error
+	// Namespace used for namespaced objects without any, it is fetched once when needed.
+	defaultNamespace string
+}
+
+// Returns API resource of given object and namespace it should be created in. Namespace is empty for cluster-scoped
+// objects.
+func (self *objectResolver) resolve(data *unstructured.Unstructured) (schema.GroupVersionResource, string, error) {
+	version := data.GetAPIVersion()
+	kind := data.GetKind()
+
+	gv, err := schema.ParseGroupVersion(version)
+	if err != nil {
+		gv = schema.GroupVersion{Version: version}
+	}
 
-		if strings.Compare(spec.Namespace, "_all") == 0 {
-			namespace = data.GetNamespace()
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(self.cfg)
+	if err != nil {
+		return schema.GroupVersionResource{}, "", err
+	}
+
+	apiResourceList, err := discoverycache.Wrap(discoveryClient).ServerResourcesForGroupVersion(version)
+	if err != nil {
+		return schema.GroupVersionResource{}, "", err
+	}
+	apiResources := apiResourceList.APIResources
+	var resource *metaV1.APIResource
+	for _, apiResource := range apiResources {
+		if apiResource.Kind == kind && !strings.Contains(apiResource.Name, "/") {
+			resource = &apiResource
+			break
 		}
+	}
+	if resource == nil {
+		return schema.GroupVersionResource{}, "", fmt.Errorf("unknown resource kind: %s", kind)
+	}
 
-		if resource.Namespaced {
-			_, err = dynamicClient.Resource(groupVersionResource).Namespace(namespace).Create(context.TODO(), data, metaV1.CreateOptions{})
-		} else {
-			_, err = dynamicClient.Resource(groupVersionResource).Create(context.TODO(), data, metaV1.CreateOptions{})
+	groupVersionResource := schema.GroupVersionResource{Group: gv.Group, Version: gv.Version, Resource: resource.Name}
+	if !resource.Namespaced {
+		if !self.allowClusterScoped {
+			return schema.GroupVersionResource{}, "", errors.NewForbidden(
+				fmt.Sprintf("Creation of cluster-scoped %s is disabled", kind))
 		}
 
-		if err != nil {
-			return false, errors.LocalizeError(err)
+		if len(gv.Group) == 0 && resource.Name == "namespaces" {
+			if err := self.check(data.GetName()); err != nil {
+				return schema.GroupVersionResource{}, "", err
+			}
 		}
+
+		return groupVersionResource, "", nil
 	}
+
+	namespace := self.namespace
+	if len(namespace) == 0 || strings.Compare(self.namespace, "_all") == 0 {
+		namespace = data.GetNamespace()
+	}
+
+	if len(namespace) == 0 {
+		if len(self.defaultNamespace) == 0 {
+			k8sClient, err := client.NewForConfig(self.cfg)
+			if err != nil {
+				return schema.GroupVersionResource{}, "", err
+			}
+
+			if self.defaultNamespace, err = getDefaultNamespace(k8sClient); err != nil {
+				return schema.GroupVersionResource{}, "", err
+			}
+		}
+		namespace = self.defaultNamespace
+	}
+
+	if err := self.check(namespace); err != nil {
+		return schema.GroupVersionResource{}, "", err
+	}
+
+	return groupVersionResource, namespace, nil
+}
+
+func (self *objectResolver) check(namespace string) error {
+	if self.checkNamespace == nil {
+		return nil
+	}
+
+	return self.checkNamespace(namespace)
 }
@@ -23,8 +23,11 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 )
 
 func TestDeployApp(t *testing.T) {
@@ -88,6 +91,41 @@ func TestDeployApp(t *testing.T) {
 	}
 }
 
+func TestDeployAppDefaultNamespace(t *testing.T) {
+	defer args.GetHolderBuilder().SetDefaultCreateNamespace("")
+	args.GetHolderBuilder().SetDefaultCreateNamespace("team-namespace")
+
+	cases := []struct {
+		info              string
+		namespace         string
+		existing          []runtime.Object
+		expectedNamespace string
+		expectedErr       bool
+	}{
+		{"Should use default namespace if none is given", "",
+			[]runtime.Object{&api.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "team-namespace"}}}, "team-namespace",
+			false},
+		{"Should prefer namespace of the spec", "foo-namespace",
+			[]runtime.Object{&api.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "team-namespace"}}}, "foo-namespace",
+			false},
+		{"Should reject default namespace that does not exist", "", []runtime.Object{}, "", true},
+	}
+
+	for _, c := range cases {
+		spec := &AppDeploymentSpec{Namespace: c.namespace, Name: "foo-name"}
+		testClient := fake.NewSimpleClientset(c.existing...)
+
+		err := DeployApp(spec, testClient)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if err == nil && spec.Namespace != c.expectedNamespace {
+			t.Errorf("Test Case: %s. Expected namespace %s, but got %s.", c.info, c.expectedNamespace, spec.Namespace)
+		}
+	}
+}
+
 func TestDeployAppContainerCommands(t *testing.T) {
 	command := "foo-command"
 	commandArgs := "foo-command-args"
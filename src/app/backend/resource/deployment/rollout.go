@@ -17,7 +17,6 @@ package deployment
 import (
 	"context"
 	"errors"
-	"time"
 
 	v1 "k8s.io/api/apps/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,8 +26,6 @@ import (
 const (
 	// FirstRevision is a first revision number
 	FirstRevision = "1"
-	// RestartedAtAnnotationKey is an annotation key for rollout restart
-	RestartedAtAnnotationKey = "kubectl.kubernetes.io/restartedAt"
 	// RevisionAnnotationKey is an annotation key for rollout targeted or resulted revision
 	RevisionAnnotationKey = "deployment.kubernetes.io/revision"
 )
@@ -103,26 +100,6 @@ func ResumeDeployment(client client.Interface, namespace, name string) (*v1.Depl
 	return nil, errors.New("The deployment is already resumed.")
 }
 
-// RestartDeployment restarts a deployment in the manner of `kubectl rollout restart`.
-func RestartDeployment(client client.Interface, namespace, name string) (*RolloutSpec, error) {
-	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
-	if err != nil {
-		return nil, err
-	}
-
-	if deployment.Spec.Template.ObjectMeta.Annotations == nil {
-		deployment.Spec.Template.ObjectMeta.Annotations = map[string]string{}
-	}
-	deployment.Spec.Template.ObjectMeta.Annotations[RestartedAtAnnotationKey] = time.Now().Format(time.RFC3339)
-	res, err := client.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metaV1.UpdateOptions{})
-	if err != nil {
-		return nil, err
-	}
-	return &RolloutSpec{
-		Revision: res.Annotations[RevisionAnnotationKey],
-	}, nil
-}
-
 // GetReplicaSetFromDeployment return all replicaSet which is belong to the deployment
 func GetReplicaSetFromDeployment(client client.Interface, namespace, name string) ([]v1.ReplicaSet, error) {
 	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
@@ -0,0 +1,235 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+// Field validation directives supported by the apiserver.
+const (
+	fieldValidationStrict = "Strict"
+	fieldValidationIgnore = "Ignore"
+)
+
+// Messages used by the apiserver to report fields that are not known to the schema of the object.
+var unknownFieldPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`unknown field "([^"]+)"`),
+	regexp.MustCompile(`\.?([^\s:,]+): field not declared in schema`),
+}
+
+// AppDeploymentFromFileValidity describes validity of objects from file checked by the apiserver without persisting
+// them.
+type AppDeploymentFromFileValidity struct {
+	// True when none of the objects has errors. Warnings do not make objects invalid.
+	Valid bool `json:"valid"`
+
+	// Errors that prevent objects from being created.
+	Errors []ValidationIssue `json:"errors"`
+
+	// Unknown fields that would be dropped on creation and other warnings returned by the apiserver.
+	Warnings []ValidationIssue `json:"warnings"`
+}
+
+// ValidationIssue describes a single problem found in an object from file.
+type ValidationIssue struct {
+	// Kind and name of the object, i.e. "Deployment/nginx". Empty if file could not be parsed.
+	Object string `json:"object"`
+
+	// Path of the field, i.e. "spec.replicas". Empty if the issue is not related to any field.
+	Path string `json:"path"`
+
+	// Message describing the issue.
+	Message string `json:"message"`
+}
+
+// ValidateAppFromFile sends objects from file to the apiserver as dry-run requests with strict field validation and
+// returns found issues. Apiserver versions that do not support field validation do not report unknown fields.
+func ValidateAppFromFile(cfg *rest.Config, spec *AppDeploymentFromFileSpec, allowClusterScoped bool,
+	checkNamespace func(namespace string) error) (*AppDeploymentFromFileValidity, error) {
+	result := &AppDeploymentFromFileValidity{Errors: make([]ValidationIssue, 0), Warnings: make([]ValidationIssue, 0)}
+	resolver := &objectResolver{cfg: cfg, namespace: spec.Namespace, allowClusterScoped: allowClusterScoped,
+		checkNamespace: checkNamespace}
+	d := yaml.NewYAMLOrJSONDecoder(strings.NewReader(spec.Content), 4096)
+	for {
+		data := &unstructured.Unstructured{}
+		if err := d.Decode(data); err != nil {
+			if err != io.EOF {
+				result.Errors = append(result.Errors, ValidationIssue{Message: err.Error()})
+			}
+			break
+		}
+
+		object := fmt.Sprintf("%s/%s", data.GetKind(), data.GetName())
+		if len(data.GetName()) == 0 {
+			object = fmt.Sprintf("%s/%s", data.GetKind(), data.GetGenerateName())
+		}
+
+		groupVersionResource, namespace, err := resolver.resolve(data)
+		if k8serrors.IsUnauthorized(err) {
+			return nil, err
+		}
+
+		if err == nil {
+			err = validateObject(cfg, groupVersionResource, namespace, data, object, result)
+		}
+
+		if err != nil {
+			result.Errors = append(result.Errors, ValidationIssue{Object: object, Message: err.Error()})
+		}
+	}
+
+	result.Valid = len(result.Errors) == 0
+	return result, nil
+}
+
+// Creates object as a dry-run and adds issues reported by the apiserver to the result. Strict field validation stops
+// at unknown fields, so object is validated once again ignoring them to find remaining errors. Returns error if
+// request could not be sent.
+func validateObject(cfg *rest.Config, resource schema.GroupVersionResource, namespace string,
+	data *unstructured.Unstructured, object string, result *AppDeploymentFromFileValidity) error {
+	warnings, err := dryRunCreate(cfg, resource, namespace, data, fieldValidationStrict)
+	if err != nil {
+		if _, ok := err.(k8serrors.APIStatus); !ok {
+			return err
+		}
+	}
+
+	unknownFields := getUnknownFields(err)
+	if len(unknownFields) > 0 {
+		for _, field := range unknownFields {
+			result.Warnings = append(result.Warnings, ValidationIssue{Object: object, Path: field,
+				Message: "unknown field"})
+		}
+
+		var ignoredWarnings []string
+		ignoredWarnings, err = dryRunCreate(cfg, resource, namespace, data, fieldValidationIgnore)
+		warnings = append(warnings, ignoredWarnings...)
+		if err != nil {
+			if _, ok := err.(k8serrors.APIStatus); !ok {
+				return err
+			}
+		}
+	}
+
+	for _, warning := range warnings {
+		result.Warnings = append(result.Warnings, ValidationIssue{Object: object, Message: warning})
+	}
+
+	result.Errors = append(result.Errors, getValidationErrors(err, object)...)
+	return nil
+}
+
+// Returns paths of unknown fields reported in given error or nil if it is not a strict field validation error.
+func getUnknownFields(err error) []string {
+	status, ok := err.(k8serrors.APIStatus)
+	if !ok || status.Status().Reason != metaV1.StatusReasonBadRequest {
+		return nil
+	}
+
+	var fields []string
+	for _, pattern := range unknownFieldPatterns {
+		for _, match := range pattern.FindAllStringSubmatch(status.Status().Message, -1) {
+			fields = append(fields, match[1])
+		}
+	}
+
+	return fields
+}
+
+// Returns errors of particular fields reported in given error. Error without details is returned as a single issue.
+func getValidationErrors(err error, object string) []ValidationIssue {
+	if err == nil {
+		return nil
+	}
+
+	status := err.(k8serrors.APIStatus).Status()
+	if status.Details == nil || len(status.Details.Causes) == 0 {
+		return []ValidationIssue{{Object: object, Message: status.Message}}
+	}
+
+	issues := make([]ValidationIssue, 0, len(status.Details.Causes))
+	for _, cause := range status.Details.Causes {
+		issues = append(issues, ValidationIssue{Object: object, Path: cause.Field, Message: cause.Message})
+	}
+
+	return issues
+}
+
+// Sends create request of given object with dry-run and field validation directive. Dynamic client does not support
+// field validation, so request is built directly. Returns warnings sent by the apiserver.
+func dryRunCreate(cfg *rest.Config, resource schema.GroupVersionResource, namespace string,
+	data *unstructured.Unstructured, fieldValidation string) ([]string, error) {
+	recorder := &warningRecorder{}
+	config := dynamic.ConfigFor(cfg)
+	config.WarningHandler = recorder
+	restClient, err := rest.UnversionedRESTClientFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := data.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	path := []string{"/apis", resource.Group, resource.Version}
+	if len(resource.Group) == 0 {
+		path = []string{"/api", resource.Version}
+	}
+
+	if len(namespace) > 0 {
+		path = append(path, "namespaces", namespace)
+	}
+
+	err = restClient.Post().
+		AbsPath(append(path, resource.Resource)...).
+		Param("dryRun", metaV1.DryRunAll).
+		Param("fieldValidation", fieldValidation).
+		Body(body).
+		Do(context.TODO()).
+		Error()
+	return recorder.warnings, err
+}
+
+// warningRecorder keeps warnings sent by the apiserver in response headers.
+type warningRecorder struct {
+	mux      sync.Mutex
+	warnings []string
+}
+
+// HandleWarningHeader implements rest.WarningHandler interface.
+func (self *warningRecorder) HandleWarningHeader(code int, agent string, text string) {
+	if code != 299 || len(text) == 0 {
+		return
+	}
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+	self.warnings = append(self.warnings, text)
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// Starts fake apiserver that serves discovery of core group and answers dry-run requests of pods. Pods with unknown
+// fields are rejected by strict field validation and pods without containers are invalid.
+func startFakeValidationServer(t *testing.T) *httptest.Server {
+	resources := metaV1.APIResourceList{GroupVersion: "v1", APIResources: []metaV1.APIResource{
+		{Name: "pods", Kind: "Pod", Namespaced: true},
+		{Name: "namespaces", Kind: "Namespace", Namespaced: false},
+	}}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1" {
+			json.NewEncoder(w).Encode(resources)
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Query().Get("dryRun") != metaV1.DryRunAll {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		pod := map[string]map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&pod)
+		status := metaV1.Status{TypeMeta: metaV1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+			Status: metaV1.StatusFailure}
+		if _, exists := pod["spec"]["foo"]; exists && r.URL.Query().Get("fieldValidation") == "Strict" {
+			status.Reason, status.Code = metaV1.StatusReasonBadRequest, http.StatusBadRequest
+			status.Message = `strict decoding error: unknown field "spec.foo"`
+		} else if _, exists := pod["spec"]["containers"]; !exists {
+			status.Reason, status.Code = metaV1.StatusReasonInvalid, http.StatusUnprocessableEntity
+			status.Message = `Pod "test" is invalid: spec.containers: Required value`
+			status.Details = &metaV1.StatusDetails{Causes: []metaV1.StatusCause{
+				{Type: metaV1.CauseTypeFieldValueRequired, Field: "spec.containers", Message: "Required value"},
+			}}
+		} else {
+			w.Header().Set("Warning", `299 - "spec.priority: deprecated"`)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(pod)
+			return
+		}
+
+		w.WriteHeader(int(status.Code))
+		json.NewEncoder(w).Encode(status)
+	}))
+}
+
+func TestValidateAppFromFile(t *testing.T) {
+	server := startFakeValidationServer(t)
+	defer server.Close()
+
+	cases := []struct {
+		info     string
+		content  string
+		expected *AppDeploymentFromFileValidity
+	}{
+		{
+			"Should report warnings of valid object",
+			`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "test"}, "spec": {"containers": []}}`,
+			&AppDeploymentFromFileValidity{Valid: true, Errors: []ValidationIssue{},
+				Warnings: []ValidationIssue{{Object: "Pod/test", Message: "spec.priority: deprecated"}}},
+		},
+		{
+			"Should report unknown fields as warnings and remaining errors",
+			`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "test"}, "spec": {"foo": "bar"}}`,
+			&AppDeploymentFromFileValidity{
+				Errors:   []ValidationIssue{{Object: "Pod/test", Path: "spec.containers", Message: "Required value"}},
+				Warnings: []ValidationIssue{{Object: "Pod/test", Path: "spec.foo", Message: "unknown field"}},
+			},
+		},
+		{
+			"Should report errors of all objects",
+			"apiVersion: v1\nkind: Foo\nmetadata:\n  name: foo\n---\napiVersion: v1\nkind: Namespace\n" +
+				"metadata:\n  name: bar\n",
+			&AppDeploymentFromFileValidity{Errors: []ValidationIssue{
+				{Object: "Foo/foo", Message: "unknown resource kind: Foo"},
+				{Object: "Namespace/bar", Message: "Creation of cluster-scoped Namespace is disabled"},
+			}, Warnings: []ValidationIssue{}},
+		},
+		{
+			"Should report file that can not be parsed",
+			"apiVersion: v1\nkind: [",
+			&AppDeploymentFromFileValidity{Errors: []ValidationIssue{
+				{Message: "error converting YAML to JSON: yaml: line 2: did not find expected node content"},
+			}, Warnings: []ValidationIssue{}},
+		},
+	}
+
+	for _, c := range cases {
+		spec := &AppDeploymentFromFileSpec{Namespace: "default", Content: c.content}
+		actual, err := ValidateAppFromFile(&rest.Config{Host: server.URL}, spec, false, nil)
+		if err != nil {
+			t.Errorf("Test Case: %s. Unexpected error: %s", c.info, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func TestValidateAppFromFileNamespaceNotAllowed(t *testing.T) {
+	server := startFakeValidationServer(t)
+	defer server.Close()
+
+	checkNamespace := func(namespace string) error {
+		if namespace != "default" {
+			return fmt.Errorf("Access to namespace %s is not allowed", namespace)
+		}
+		return nil
+	}
+	spec := &AppDeploymentFromFileSpec{Namespace: "_all", Content: `{"apiVersion": "v1", "kind": "Pod", ` +
+		`"metadata": {"name": "test", "namespace": "kube-system"}, "spec": {"containers": []}}`}
+	expected := &AppDeploymentFromFileValidity{Errors: []ValidationIssue{
+		{Object: "Pod/test", Message: "Access to namespace kube-system is not allowed"},
+	}, Warnings: []ValidationIssue{}}
+
+	actual, err := ValidateAppFromFile(&rest.Config{Host: server.URL}, spec, true, checkNamespace)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %#v, but got %#v.", expected, actual)
+	}
+}
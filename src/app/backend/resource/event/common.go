@@ -24,9 +24,6 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -38,23 +35,16 @@ var EmptyEventList = &common.EventList{
 	},
 }
 
-// GetEvents gets events associated to resource with given name.
-func GetEvents(client kubernetes.Interface, namespace, resourceName string) ([]v1.Event, error) {
-	fieldSelector, err := fields.ParseSelector("involvedObject.name" + "=" + resourceName)
-
+// GetEvents gets events associated to resource with given name that match selectors of the list options.
+func GetEvents(client kubernetes.Interface, namespace, resourceName string, listOptions metaV1.ListOptions) (
+	[]v1.Event, error) {
+	options, err := withInvolvedObject(fields.OneTermEqualSelector("involvedObject.name", resourceName), listOptions)
 	if err != nil {
 		return nil, err
 	}
 
 	channels := &common.ResourceChannels{
-		EventList: common.GetEventListChannelWithOptions(
-			client,
-			common.NewSameNamespaceQuery(namespace),
-			metaV1.ListOptions{
-				LabelSelector: labels.Everything().String(),
-				FieldSelector: fieldSelector.String(),
-			},
-			1),
+		EventList: common.GetEventListChannelWithOptions(client, common.NewSameNamespaceQuery(namespace), options, 1),
 	}
 
 	eventList := <-channels.EventList.List
@@ -120,22 +110,30 @@ func GetPodEvents(client kubernetes.Interface, namespace, podName string) ([]v1.
 }
 
 // GetNodeEvents gets events associated to node with given name.
-func GetNodeEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, nodeName string) (*common.EventList, error) {
+func GetNodeEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, nodeName string,
+	listOptions metaV1.ListOptions) (*common.EventList, error) {
 	eventList := common.EventList{
 		Events: make([]common.Event, 0),
 	}
 
-	scheme := runtime.NewScheme()
-	groupVersion := schema.GroupVersion{Group: "", Version: "v1"}
-	scheme.AddKnownTypes(groupVersion, &v1.Node{})
-
 	mc := client.CoreV1().Nodes()
 	node, err := mc.Get(context.TODO(), nodeName, metaV1.GetOptions{})
 	if err != nil {
 		return &eventList, err
 	}
 
-	events, err := client.CoreV1().Events(v1.NamespaceAll).Search(scheme, node)
+	// Same selector as the one used by events search of the client.
+	options, err := withInvolvedObject(fields.Set{
+		"involvedObject.kind":      "Node",
+		"involvedObject.name":      node.Name,
+		"involvedObject.namespace": node.Namespace,
+		"involvedObject.uid":       string(node.UID),
+	}.AsSelector(), listOptions)
+	if err != nil {
+		return &eventList, err
+	}
+
+	events, err := client.CoreV1().Events(v1.NamespaceAll).List(context.TODO(), options)
 	_, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return &eventList, criticalError
@@ -146,11 +144,27 @@ func GetNodeEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQu
 }
 
 // GetNamespaceEvents gets events associated to a namespace with given name.
-func GetNamespaceEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace string) (common.EventList, error) {
-	events, _ := client.CoreV1().Events(namespace).List(context.TODO(), api.ListEverything)
+func GetNamespaceEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace string,
+	listOptions metaV1.ListOptions) (common.EventList, error) {
+	events, _ := client.CoreV1().Events(namespace).List(context.TODO(), listOptions)
 	return CreateEventList(FillEventsType(events.Items), dsQuery), nil
 }
 
+// Returns list options that select events of the involved object and match field selector of the options too.
+func withInvolvedObject(selector fields.Selector, listOptions metaV1.ListOptions) (metaV1.ListOptions, error) {
+	fieldSelector, err := fields.ParseSelector(listOptions.FieldSelector)
+	if err != nil {
+		return listOptions, errors.NewBadRequest(err.Error())
+	}
+
+	if !fieldSelector.Empty() {
+		selector = fields.AndSelectors(selector, fieldSelector)
+	}
+
+	listOptions.FieldSelector = selector.String()
+	return listOptions, nil
+}
+
 // FillEventsType is based on event Reason fills event Type in order to allow correct filtering by Type.
 func FillEventsType(events []v1.Event) []v1.Event {
 	for i := range events {
@@ -187,9 +201,9 @@ func ToEvent(event v1.Event) common.Event {
 }
 
 // GetResourceEvents gets events associated to specified resource.
-func GetResourceEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
-	*common.EventList, error) {
-	resourceEvents, err := GetEvents(client, namespace, name)
+func GetResourceEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string,
+	listOptions metaV1.ListOptions) (*common.EventList, error) {
+	resourceEvents, err := GetEvents(client, namespace, name, listOptions)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return EmptyEventList, err
@@ -228,6 +242,8 @@ func (self EventCell) GetProperty(name dataselect.PropertyName) dataselect.Compa
 		return dataselect.StdComparableTime(self.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(self.ObjectMeta.Namespace)
+	case dataselect.LastTimestampProperty:
+		return dataselect.StdComparableTime(self.LastTimestamp.Time)
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
@@ -24,6 +24,7 @@ import (
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -54,7 +55,7 @@ func TestGetEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList)
 
-		actual, _ := GetEvents(fakeClient, c.namespace, c.name)
+		actual, _ := GetEvents(fakeClient, c.namespace, c.name, api.ListEverything)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -77,6 +78,41 @@ func TestGetEvents(t *testing.T) {
 	}
 }
 
+func TestWithInvolvedObject(t *testing.T) {
+	cases := []struct {
+		info     string
+		options  metaV1.ListOptions
+		expected metaV1.ListOptions
+	}{
+		{
+			"Should select events of the object",
+			api.ListEverything,
+			metaV1.ListOptions{FieldSelector: "involvedObject.name=foo"},
+		},
+		{
+			"Should keep selectors of the request",
+			metaV1.ListOptions{LabelSelector: "app=web", FieldSelector: "type=Warning"},
+			metaV1.ListOptions{LabelSelector: "app=web", FieldSelector: "involvedObject.name=foo,type=Warning"},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := withInvolvedObject(fields.OneTermEqualSelector("involvedObject.name", "foo"), c.options)
+		if err != nil {
+			t.Errorf("Test Case: %s. Unexpected error: %s.", c.info, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+
+	if _, err := withInvolvedObject(fields.Everything(), metaV1.ListOptions{FieldSelector: "type"}); err == nil {
+		t.Errorf("Expected error of invalid field selector.")
+	}
+}
+
 func TestToEventList(t *testing.T) {
 	cases := []struct {
 		events    []v1.Event
@@ -158,7 +194,7 @@ func TestGetResourceEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.replicaSet, c.podList)
 
-		actual, _ := GetResourceEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
+		actual, _ := GetResourceEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name, api.ListEverything)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
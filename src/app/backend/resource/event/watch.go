@@ -0,0 +1,121 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Watch event in the format returned by the apiserver, with the object converted to the Event model.
+type watchEvent struct {
+	Type   watch.EventType `json:"type"`
+	Object interface{}     `json:"object"`
+}
+
+// eventStream is a stream of watch events that stops the underlying watch when it is closed.
+type eventStream struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+// Close stops the watch and unblocks the goroutine that writes its events.
+func (self *eventStream) Close() error {
+	self.cancel()
+	return self.PipeReader.Close()
+}
+
+// WatchResourceEvents watches events of the resource with given UID. Up to historyLimit most recent events are
+// replayed as ADDED events before the live ones. Returned stream contains JSON watch events in the format of the
+// apiserver and has to be closed to stop the watch. Empty namespace watches events in all namespaces.
+func WatchResourceEvents(client kubernetes.Interface, namespace string, uid types.UID, historyLimit int) (
+	io.ReadCloser, error) {
+	options := metaV1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.uid", string(uid)).String(),
+	}
+	list, err := client.CoreV1().Events(namespace).List(context.TODO(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	options.ResourceVersion = list.ResourceVersion
+	watcher, err := client.CoreV1().Events(namespace).Watch(ctx, options)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		defer watcher.Stop()
+		encoder := json.NewEncoder(writer)
+		for _, e := range recentEvents(FillEventsType(list.Items), historyLimit) {
+			if err := encoder.Encode(watchEvent{Type: watch.Added, Object: ToEvent(e)}); err != nil {
+				return
+			}
+		}
+
+		for e := range watcher.ResultChan() {
+			event := watchEvent{Type: e.Type, Object: e.Object}
+			if object, ok := e.Object.(*v1.Event); ok {
+				event.Object = ToEvent(FillEventsType([]v1.Event{*object})[0])
+			}
+
+			if err := encoder.Encode(event); err != nil {
+				return
+			}
+		}
+		writer.Close()
+	}()
+
+	return &eventStream{PipeReader: reader, cancel: cancel}, nil
+}
+
+// Returns up to limit most recently seen events, ordered from the oldest one.
+func recentEvents(events []v1.Event, limit int) []v1.Event {
+	sort.SliceStable(events, func(i, j int) bool {
+		return lastSeen(events[i]).Before(lastSeen(events[j]))
+	})
+
+	if len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+
+	return events
+}
+
+// Returns time when event was seen for the last time. Events reported by the events.k8s.io API set only event time.
+func lastSeen(event v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+
+	return event.CreationTimestamp.Time
+}
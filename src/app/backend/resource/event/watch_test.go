@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRecentEvents(t *testing.T) {
+	now := time.Now()
+	newEvent := func(name string, lastTimestamp, eventTime time.Time) v1.Event {
+		return v1.Event{
+			ObjectMeta:    metaV1.ObjectMeta{Name: name, CreationTimestamp: metaV1.NewTime(now.Add(-time.Hour))},
+			LastTimestamp: metaV1.NewTime(lastTimestamp),
+			EventTime:     metaV1.NewMicroTime(eventTime),
+		}
+	}
+	events := []v1.Event{
+		newEvent("ev-3", now, time.Time{}),
+		newEvent("ev-1", time.Time{}, time.Time{}),
+		newEvent("ev-2", time.Time{}, now.Add(-time.Minute)),
+	}
+
+	cases := []struct {
+		limit    int
+		expected []string
+	}{
+		{0, []string{}},
+		{2, []string{"ev-2", "ev-3"}},
+		{5, []string{"ev-1", "ev-2", "ev-3"}},
+	}
+
+	for _, c := range cases {
+		actual := []string{}
+		for _, e := range recentEvents(append([]v1.Event{}, events...), c.limit) {
+			actual = append(actual, e.Name)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("recentEvents(%d) == %v, expected %v", c.limit, actual, c.expected)
+		}
+	}
+}
+
+func TestWatchResourceEvents(t *testing.T) {
+	now := time.Now()
+	client := fake.NewSimpleClientset(
+		&v1.Event{
+			ObjectMeta:    metaV1.ObjectMeta{Name: "ev-1", Namespace: "ns-1"},
+			LastTimestamp: metaV1.NewTime(now.Add(-time.Minute)),
+		},
+		&v1.Event{
+			ObjectMeta:    metaV1.ObjectMeta{Name: "ev-2", Namespace: "ns-1"},
+			LastTimestamp: metaV1.NewTime(now),
+			Reason:        "Started",
+		},
+	)
+
+	stream, err := WatchResourceEvents(client, "ns-1", "uid-1", 1)
+	if err != nil {
+		t.Fatalf("WatchResourceEvents() returned error: %s", err)
+	}
+	defer stream.Close()
+
+	_, err = client.CoreV1().Events("ns-1").Create(context.TODO(),
+		&v1.Event{ObjectMeta: metaV1.ObjectMeta{Name: "ev-3", Namespace: "ns-1"}, Reason: "Failed"},
+		metaV1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		eventType string
+		name      string
+		kind      string
+	}{
+		{"ADDED", "ev-2", v1.EventTypeNormal},
+		{"ADDED", "ev-3", v1.EventTypeWarning},
+	}
+
+	scanner := bufio.NewScanner(stream)
+	for _, e := range expected {
+		if !scanner.Scan() {
+			t.Fatalf("Expected %s event of %s, but stream ended: %v", e.eventType, e.name, scanner.Err())
+		}
+
+		var actual struct {
+			Type   string `json:"type"`
+			Object struct {
+				ObjectMeta struct {
+					Name string `json:"name"`
+				} `json:"objectMeta"`
+				Type string `json:"type"`
+			} `json:"object"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &actual); err != nil {
+			t.Fatal(err)
+		}
+
+		if actual.Type != e.eventType || actual.Object.ObjectMeta.Name != e.name || actual.Object.Type != e.kind {
+			t.Errorf("Expected %s event of %s with type %s, but got %s", e.eventType, e.name, e.kind, scanner.Text())
+		}
+	}
+}
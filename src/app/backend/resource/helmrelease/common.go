@@ -0,0 +1,204 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+// Label selector matching secrets and config maps used by Helm 3 to store releases.
+const storageLabelSelector = "owner=helm"
+
+// Key of the secret or config map data that contains encoded release.
+const storageReleaseKey = "release"
+
+// Header of the gzip compressed data.
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// Release is a single revision of the Helm release.
+type Release struct {
+	Name         string      `json:"name"`
+	Namespace    string      `json:"namespace"`
+	Chart        string      `json:"chart"`
+	ChartVersion string      `json:"chartVersion"`
+	AppVersion   string      `json:"appVersion"`
+	Status       string      `json:"status"`
+	Revision     int         `json:"revision"`
+	Updated      metaV1.Time `json:"updated"`
+	Description  string      `json:"description"`
+}
+
+// storedRelease is the part of the release stored by Helm that is used by dashboard.
+type storedRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"`
+	Info      struct {
+		FirstDeployed metaV1.Time `json:"first_deployed"`
+		LastDeployed  metaV1.Time `json:"last_deployed"`
+		Description   string      `json:"description"`
+		Status        string      `json:"status"`
+		Notes         string      `json:"notes"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name"`
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+	Config   map[string]interface{} `json:"config"`
+	Manifest string                 `json:"manifest"`
+}
+
+func (self *storedRelease) toRelease() Release {
+	return Release{
+		Name:         self.Name,
+		Namespace:    self.Namespace,
+		Chart:        self.Chart.Metadata.Name,
+		ChartVersion: self.Chart.Metadata.Version,
+		AppVersion:   self.Chart.Metadata.AppVersion,
+		Status:       self.Info.Status,
+		Revision:     self.Version,
+		Updated:      self.Info.LastDeployed,
+		Description:  self.Info.Description,
+	}
+}
+
+// Decodes release the way Helm stores it, which is base64 encoded and usually gzip compressed JSON.
+func decodeRelease(data []byte) (*storedRelease, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(decoded, gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		if decoded, err = ioutil.ReadAll(reader); err != nil {
+			return nil, err
+		}
+	}
+
+	release := new(storedRelease)
+	if err := json.Unmarshal(decoded, release); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// Returns all revisions of releases stored in the secrets and config maps of given namespaces. Releases that can not be
+// decoded and errors of the config map storage, which is rarely used, are returned as non-critical errors.
+func getStoredReleases(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	selector string) ([]*storedRelease, []error, error) {
+	options := metaV1.ListOptions{LabelSelector: selector}
+	secrets, err := client.CoreV1().Secrets(nsQuery.ToRequestParam()).List(context.TODO(), options)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, nonCriticalErrors, criticalError
+	}
+
+	configMaps, err := client.CoreV1().ConfigMaps(nsQuery.ToRequestParam()).List(context.TODO(), options)
+	if err != nil {
+		nonCriticalErrors = errors.MergeErrors(nonCriticalErrors, []error{errors.LocalizeError(err)})
+		configMaps = &v1.ConfigMapList{}
+	}
+
+	releases := make([]*storedRelease, 0)
+	add := func(meta metaV1.ObjectMeta, data []byte) {
+		if !nsQuery.Matches(meta.Namespace) {
+			return
+		}
+
+		release, err := decodeRelease(data)
+		if err != nil {
+			nonCriticalErrors = errors.MergeErrors(nonCriticalErrors,
+				[]error{fmt.Errorf("could not decode Helm release stored in %s/%s: %s", meta.Namespace, meta.Name, err)})
+			return
+		}
+
+		if len(release.Namespace) == 0 {
+			release.Namespace = meta.Namespace
+		}
+		releases = append(releases, release)
+	}
+
+	if secrets != nil {
+		for _, secret := range secrets.Items {
+			add(secret.ObjectMeta, secret.Data[storageReleaseKey])
+		}
+	}
+
+	for _, configMap := range configMaps.Items {
+		add(configMap.ObjectMeta, []byte(configMap.Data[storageReleaseKey]))
+	}
+
+	return releases, nonCriticalErrors, nil
+}
+
+// The code below allows to perform complex data section on []Release
+
+type ReleaseCell Release
+
+func (self ReleaseCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(self.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(self.Updated.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(self.Namespace)
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableString(self.Status)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []Release) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ReleaseCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []Release {
+	std := make([]Release, len(cells))
+	for i := range std {
+		std[i] = Release(cells[i].(ReleaseCell))
+	}
+	return std
+}
@@ -0,0 +1,98 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+)
+
+// ReleaseDetail contains the latest revision of the Helm release with its notes, values and rendered manifest.
+type ReleaseDetail struct {
+	Release       `json:",inline"`
+	FirstDeployed metaV1.Time            `json:"firstDeployed"`
+	Notes         string                 `json:"notes"`
+	Values        map[string]interface{} `json:"values"`
+	Manifest      string                 `json:"manifest"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ReleaseHistory contains all revisions of the Helm release sorted from the newest one.
+type ReleaseHistory struct {
+	Items []Release `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetReleaseDetail returns the latest revision of the Helm release with given name.
+func GetReleaseDetail(client kubernetes.Interface, namespace, name string) (*ReleaseDetail, error) {
+	log.Printf("Getting details of Helm release %s in %s namespace", name, namespace)
+	revisions, nonCriticalErrors, err := getReleaseRevisions(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := revisions[0]
+	return &ReleaseDetail{
+		Release:       latest.toRelease(),
+		FirstDeployed: latest.Info.FirstDeployed,
+		Notes:         latest.Info.Notes,
+		Values:        latest.Config,
+		Manifest:      latest.Manifest,
+		Errors:        nonCriticalErrors,
+	}, nil
+}
+
+// GetReleaseHistory returns all revisions of the Helm release with given name.
+func GetReleaseHistory(client kubernetes.Interface, namespace, name string) (*ReleaseHistory, error) {
+	log.Printf("Getting history of Helm release %s in %s namespace", name, namespace)
+	revisions, nonCriticalErrors, err := getReleaseRevisions(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &ReleaseHistory{Items: make([]Release, 0, len(revisions)), Errors: nonCriticalErrors}
+	for _, revision := range revisions {
+		history.Items = append(history.Items, revision.toRelease())
+	}
+
+	return history, nil
+}
+
+// Returns revisions of the Helm release sorted from the newest one. Returns not found error if release does not exist.
+func getReleaseRevisions(client kubernetes.Interface, namespace, name string) ([]*storedRelease, []error, error) {
+	selector := fmt.Sprintf("%s,name=%s", storageLabelSelector, name)
+	revisions, nonCriticalErrors, err := getStoredReleases(client, common.NewSameNamespaceQuery(namespace), selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(revisions) == 0 {
+		return nil, nil, errors.NewNotFound(fmt.Sprintf("Helm release %s not found in %s namespace", name, namespace))
+	}
+
+	sort.SliceStable(revisions, func(i, j int) bool { return revisions[i].Version > revisions[j].Version })
+	return revisions, nonCriticalErrors, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+func TestGetReleaseDetail(t *testing.T) {
+	client := fake.NewSimpleClientset(releaseSecret(t, "web", "default", 1, "superseded"),
+		releaseSecret(t, "web", "default", 2, "deployed"), releaseSecret(t, "api", "default", 3, "deployed"))
+
+	actual, err := GetReleaseDetail(client, "default", "web")
+	if err != nil {
+		t.Fatalf("GetReleaseDetail() returned error: %s", err)
+	}
+
+	expected := &ReleaseDetail{
+		Release: Release{
+			Name:         "web",
+			Namespace:    "default",
+			Chart:        "nginx",
+			ChartVersion: "9.3.2",
+			AppVersion:   "1.21.0",
+			Status:       "deployed",
+			Revision:     2,
+			Updated:      metaV1.NewTime(time.Date(2021, 6, 2, 10, 0, 0, 123456789, time.UTC).Local()),
+			Description:  "Install complete",
+		},
+		FirstDeployed: metaV1.NewTime(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC).Local()),
+		Notes:         "Thanks",
+		Values:        map[string]interface{}{"replicaCount": float64(2)},
+		Manifest:      "kind: Deployment",
+		Errors:        []error{},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetReleaseDetail() == \n%#v\nexpected \n%#v", actual, expected)
+	}
+}
+
+func TestGetReleaseHistory(t *testing.T) {
+	client := fake.NewSimpleClientset(releaseSecret(t, "web", "default", 2, "deployed"),
+		releaseSecret(t, "web", "default", 1, "superseded"), releaseSecret(t, "web", "default", 3, "failed"))
+
+	actual, err := GetReleaseHistory(client, "default", "web")
+	if err != nil {
+		t.Fatalf("GetReleaseHistory() returned error: %s", err)
+	}
+
+	revisions := make([]int, 0)
+	for _, release := range actual.Items {
+		revisions = append(revisions, release.Revision)
+	}
+
+	if expected := []int{3, 2, 1}; !reflect.DeepEqual(revisions, expected) {
+		t.Errorf("GetReleaseHistory() returned revisions %v, expected %v", revisions, expected)
+	}
+}
+
+func TestGetReleaseDetailNotFound(t *testing.T) {
+	client := fake.NewSimpleClientset(releaseSecret(t, "web", "other", 1, "deployed"))
+
+	_, err := GetReleaseDetail(client, "default", "web")
+	if !errors.IsNotFoundError(err) {
+		t.Errorf("Expected not found error, but got %v", err)
+	}
+}
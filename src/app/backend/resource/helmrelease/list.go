@@ -0,0 +1,76 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"log"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+// ReleaseList contains a list of Helm releases in the cluster.
+type ReleaseList struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Unordered list of the latest revisions of releases.
+	Items []Release `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetReleaseList returns the latest revision of every Helm release in given namespaces.
+func GetReleaseList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*ReleaseList, error) {
+	log.Printf("Getting list of Helm releases in the namespace %s", nsQuery.ToRequestParam())
+	stored, nonCriticalErrors, err := getStoredReleases(client, nsQuery, storageLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]*storedRelease)
+	order := make([]string, 0)
+	for _, release := range stored {
+		key := release.Namespace + "/" + release.Name
+		current, exists := latest[key]
+		if !exists {
+			order = append(order, key)
+		}
+
+		if !exists || release.Version > current.Version {
+			latest[key] = release
+		}
+	}
+
+	releases := make([]Release, 0, len(order))
+	for _, key := range order {
+		releases = append(releases, latest[key].toRelease())
+	}
+
+	return toReleaseList(releases, nonCriticalErrors, dsQuery), nil
+}
+
+func toReleaseList(releases []Release, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ReleaseList {
+	releaseCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(releases), dsQuery)
+	return &ReleaseList{
+		ListMeta: api.ListMeta{TotalItems: filteredTotal},
+		Items:    fromCells(releaseCells),
+		Errors:   nonCriticalErrors,
+	}
+}
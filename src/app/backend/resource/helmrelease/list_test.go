@@ -0,0 +1,131 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+// Encodes release the way Helm stores it.
+func encodeRelease(t *testing.T, name, namespace string, version int, status string) []byte {
+	data := fmt.Sprintf(`{"name":%q,"namespace":%q,"version":%d,`+
+		`"info":{"first_deployed":"2021-06-01T10:00:00Z","last_deployed":"2021-06-0%dT10:00:00.123456789Z",`+
+		`"status":%q,"description":"Install complete","notes":"Thanks"},`+
+		`"chart":{"metadata":{"name":"nginx","version":"9.3.%d","appVersion":"1.21.0"}},`+
+		`"config":{"replicaCount":2},"manifest":"kind: Deployment"}`,
+		name, namespace, version, version, status, version)
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes()))
+}
+
+func releaseSecret(t *testing.T, name, namespace string, version int, status string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, version),
+			Namespace: namespace,
+			Labels:    map[string]string{"owner": "helm", "name": name, "status": status},
+		},
+		Type: "helm.sh/release.v1",
+		Data: map[string][]byte{storageReleaseKey: encodeRelease(t, name, namespace, version, status)},
+	}
+}
+
+func TestGetReleaseList(t *testing.T) {
+	objects := []runtime.Object{
+		releaseSecret(t, "web", "default", 1, "superseded"),
+		releaseSecret(t, "web", "default", 2, "deployed"),
+		releaseSecret(t, "db", "other", 1, "failed"),
+		&v1.ConfigMap{
+			ObjectMeta: metaV1.ObjectMeta{Name: "cache.v1", Namespace: "default",
+				Labels: map[string]string{"owner": "helm", "name": "cache"}},
+			Data: map[string]string{storageReleaseKey: string(encodeRelease(t, "cache", "default", 1, "deployed"))},
+		},
+		&v1.Secret{ObjectMeta: metaV1.ObjectMeta{Name: "plain", Namespace: "default"}},
+	}
+
+	cases := []struct {
+		namespace     *common.NamespaceQuery
+		expectedNames []string
+		expectedRev   map[string]int
+	}{
+		{common.NewNamespaceQuery(nil), []string{"cache", "db", "web"}, map[string]int{"web": 2, "db": 1, "cache": 1}},
+		{common.NewSameNamespaceQuery("default"), []string{"cache", "web"}, map[string]int{"web": 2, "cache": 1}},
+	}
+
+	for _, c := range cases {
+		client := fake.NewSimpleClientset(objects...)
+		dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination,
+			dataselect.NewSortQuery([]string{"a", dataselect.NameProperty}), dataselect.NoFilter, dataselect.NoMetrics)
+		actual, err := GetReleaseList(client, c.namespace, dsQuery)
+		if err != nil {
+			t.Fatalf("GetReleaseList() returned error: %s", err)
+		}
+
+		names := make([]string, 0)
+		for _, release := range actual.Items {
+			names = append(names, release.Name)
+			if release.Revision != c.expectedRev[release.Name] {
+				t.Errorf("Expected revision %d of release %s, but got %d", c.expectedRev[release.Name], release.Name,
+					release.Revision)
+			}
+		}
+
+		if !reflect.DeepEqual(names, c.expectedNames) {
+			t.Errorf("GetReleaseList(%s) returned releases %v, expected %v", c.namespace.ToRequestParam(), names,
+				c.expectedNames)
+		}
+
+		if actual.ListMeta != (api.ListMeta{TotalItems: len(c.expectedNames)}) {
+			t.Errorf("Expected %d total items, but got %d", len(c.expectedNames), actual.ListMeta.TotalItems)
+		}
+	}
+}
+
+func TestGetReleaseListInvalidRelease(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "broken", Namespace: "default", Labels: map[string]string{"owner": "helm"}},
+		Data:       map[string][]byte{storageReleaseKey: []byte("not a release")},
+	})
+
+	actual, err := GetReleaseList(client, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetReleaseList() returned error: %s", err)
+	}
+
+	if len(actual.Items) != 0 || len(actual.Errors) != 1 {
+		t.Errorf("Expected no releases and 1 error, but got %d releases and errors %v", len(actual.Items),
+			actual.Errors)
+	}
+}
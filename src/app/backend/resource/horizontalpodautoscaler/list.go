@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	autoscaling "k8s.io/api/autoscaling/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sClient "k8s.io/client-go/kubernetes"
 )
 
@@ -46,8 +47,9 @@ type HorizontalPodAutoscaler struct {
 	TargetCPUUtilizationPercentage  *int32         `json:"targetCPUUtilizationPercentage"`
 }
 
-func GetHorizontalPodAutoscalerList(client k8sClient.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*HorizontalPodAutoscalerList, error) {
-	channel := common.GetHorizontalPodAutoscalerListChannel(client, nsQuery, 1)
+func GetHorizontalPodAutoscalerList(client k8sClient.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
+	listOptions metaV1.ListOptions) (*HorizontalPodAutoscalerList, error) {
+	channel := common.GetHorizontalPodAutoscalerListChannel(client, nsQuery, listOptions, 1)
 	hpaList := <-channel.List
 	err := <-channel.Error
 
@@ -61,7 +63,7 @@ func GetHorizontalPodAutoscalerList(client k8sClient.Interface, nsQuery *common.
 
 func GetHorizontalPodAutoscalerListForResource(client k8sClient.Interface, namespace, kind, name string) (*HorizontalPodAutoscalerList, error) {
 	nsQuery := common.NewSameNamespaceQuery(namespace)
-	channel := common.GetHorizontalPodAutoscalerListChannel(client, nsQuery, 1)
+	channel := common.GetHorizontalPodAutoscalerListChannel(client, nsQuery, api.ListEverything, 1)
 	hpaList := <-channel.List
 	err := <-channel.Error
 
@@ -142,7 +142,8 @@ func TestGetHorizontalPodAutoscalerList(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.hpaList)
 
-		actual, _ := GetHorizontalPodAutoscalerList(fakeClient, &common.NamespaceQuery{}, dataselect.DefaultDataSelect)
+		actual, _ := GetHorizontalPodAutoscalerList(fakeClient, &common.NamespaceQuery{}, dataselect.DefaultDataSelect,
+			api.ListEverything)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -18,14 +18,15 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 )
 
 // GetJobEvents gets events associated to job.
-func GetJobEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
-	*common.EventList, error) {
+func GetJobEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string,
+	listOptions metaV1.ListOptions) (*common.EventList, error) {
 
-	jobEvents, err := event.GetEvents(client, namespace, name)
+	jobEvents, err := event.GetEvents(client, namespace, name, listOptions)
 	if err != nil {
 		return event.EmptyEventList, err
 	}
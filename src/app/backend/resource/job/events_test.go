@@ -64,7 +64,7 @@ func TestGetJobEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.job, c.podList)
 
-		actual, _ := GetJobEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
+		actual, _ := GetJobEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name, api.ListEverything)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
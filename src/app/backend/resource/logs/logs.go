@@ -100,6 +100,9 @@ type LogInfo struct {
 
 	// Some log lines in the middle of the log file could not be loaded, because the log file is too large.
 	Truncated bool `json:"truncated"`
+
+	// Older log lines that were not loaded can be loaded by requesting more lines from the end of the log file.
+	MoreAvailable bool `json:"moreAvailable"`
 }
 
 // Selection of a slice of logs.
@@ -117,6 +120,8 @@ type Selection struct {
 	// The log file is loaded either from the beginning or from the end. This matters only if the log file is too
 	// large to be handled and must be truncated (to avoid oom)
 	LogFilePosition string `json:"logFilePosition"`
+	// Number of lines loaded from the end of the log file. Default limit is used if it is not set.
+	TailLines int `json:"tailLines,omitempty"`
 }
 
 // LogLineId uniquely identifies a line in logs - immune to log addition/deletion.
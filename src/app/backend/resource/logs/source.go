@@ -42,6 +42,7 @@ func getLogSourcesFromPod(k8sClient kubernetes.Interface, ns, resourceName strin
 		ContainerNames:     common.GetContainerNames(&pod.Spec),
 		InitContainerNames: common.GetInitContainerNames(&pod.Spec),
 		PodNames:           []string{resourceName},
+		DefaultContainer:   common.GetDefaultContainerName(pod),
 	}, nil
 }
 
@@ -56,5 +57,14 @@ func getLogSourcesFromController(k8sClient kubernetes.Interface, ns, resourceNam
 	if err != nil {
 		return controller.LogSources{}, err
 	}
-	return rc.GetLogSources(allPods.Items), nil
+
+	sources := rc.GetLogSources(allPods.Items)
+	for i := range allPods.Items {
+		if len(sources.PodNames) > 0 && allPods.Items[i].Name == sources.PodNames[0] {
+			sources.DefaultContainer = common.GetDefaultContainerName(&allPods.Items[i])
+			break
+		}
+	}
+
+	return sources, nil
 }
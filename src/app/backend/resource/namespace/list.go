@@ -23,6 +23,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -60,17 +61,25 @@ func GetNamespaceListFromChannels(channels *common.ResourceChannels, dsQuery *da
 	return toNamespaceList(namespaces.Items, nonCriticalErrors, dsQuery), nil
 }
 
-// GetNamespaceList returns a list of all namespaces in the cluster.
-func GetNamespaceList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*NamespaceList, error) {
+// GetNamespaceList returns a list of namespaces in the cluster that match given namespace query.
+func GetNamespaceList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery, listOptions metaV1.ListOptions) (*NamespaceList, error) {
 	log.Println("Getting list of namespaces")
-	namespaces, err := client.CoreV1().Namespaces().List(context.TODO(), api.ListEverything)
+	namespaces, err := client.CoreV1().Namespaces().List(context.TODO(), listOptions)
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	return toNamespaceList(namespaces.Items, nonCriticalErrors, dsQuery), nil
+	var items []v1.Namespace
+	for _, namespace := range namespaces.Items {
+		if nsQuery.Matches(namespace.Name) {
+			items = append(items, namespace)
+		}
+	}
+
+	return toNamespaceList(items, nonCriticalErrors, dsQuery), nil
 }
 
 func toNamespaceList(namespaces []v1.Namespace, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *NamespaceList {
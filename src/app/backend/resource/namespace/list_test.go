@@ -19,9 +19,11 @@ import (
 	"testing"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGetNamespaceList(t *testing.T) {
@@ -62,3 +64,36 @@ func TestGetNamespaceList(t *testing.T) {
 		}
 	}
 }
+
+func TestGetNamespaceListWithNamespaceQuery(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "foo"}},
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "bar"}},
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "baz"}},
+	)
+
+	cases := []struct {
+		nsQuery  *common.NamespaceQuery
+		expected []string
+	}{
+		{common.NewNamespaceQuery(nil), []string{"bar", "baz", "foo"}},
+		{common.NewNamespaceQuery([]string{"foo", "baz"}), []string{"baz", "foo"}},
+		{common.NewNamespaceQuery([]string{"other"}), []string{}},
+	}
+
+	for _, c := range cases {
+		actual, err := GetNamespaceList(client, c.nsQuery, dataselect.NoDataSelect, api.ListEverything)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names := []string{}
+		for _, namespace := range actual.Namespaces {
+			names = append(names, namespace.ObjectMeta.Name)
+		}
+
+		if !reflect.DeepEqual(names, c.expected) {
+			t.Errorf("GetNamespaceList(%#v) returns %v, expected %v", c.nsQuery, names, c.expected)
+		}
+	}
+}
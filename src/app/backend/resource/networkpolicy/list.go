@@ -18,6 +18,7 @@ import (
 	"context"
 
 	v1 "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	client "k8s.io/client-go/kubernetes"
 
@@ -42,9 +43,9 @@ type NetworkPolicyList struct {
 
 // GetNetworkPolicyList lists network policies from given namespace using given data select query.
 func GetNetworkPolicyList(client client.Interface, namespace *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery) (*NetworkPolicyList, error) {
+	dsQuery *dataselect.DataSelectQuery, listOptions metaV1.ListOptions) (*NetworkPolicyList, error) {
 	saList, err := client.NetworkingV1().NetworkPolicies(namespace.ToRequestParam()).List(context.TODO(),
-		api.ListEverything)
+		listOptions)
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
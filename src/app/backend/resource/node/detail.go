@@ -123,9 +123,10 @@ type NodeDetail struct {
 	Errors []error `json:"errors"`
 }
 
-// GetNodeDetail gets node details.
+// GetNodeDetail gets node details. Pod list of the node is limited to pods matching given namespace query, allocated
+// resources are computed from all pods.
 func GetNodeDetail(client k8sClient.Interface, metricClient metricapi.MetricClient, name string,
-	dsQuery *dataselect.DataSelectQuery) (*NodeDetail, error) {
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*NodeDetail, error) {
 	log.Printf("Getting details of %s node", name)
 
 	node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metaV1.GetOptions{})
@@ -145,13 +146,13 @@ func GetNodeDetail(client k8sClient.Interface, metricClient metricapi.MetricClie
 		return nil, criticalError
 	}
 
-	podList, err := GetNodePods(client, metricClient, dsQuery, name)
+	podList, err := GetNodePods(client, metricClient, dsQuery, name, nsQuery)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	eventList, err := event.GetNodeEvents(client, dsQuery, node.Name)
+	eventList, err := event.GetNodeEvents(client, dsQuery, node.Name, api.ListEverything)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
@@ -289,9 +290,9 @@ func maxResourceList(list, new v1.ResourceList) {
 	}
 }
 
-// GetNodePods return pods list in given named node
+// GetNodePods return pods list in given named node, limited to pods matching given namespace query
 func GetNodePods(client k8sClient.Interface, metricClient metricapi.MetricClient,
-	dsQuery *dataselect.DataSelectQuery, name string) (*pod.PodList, error) {
+	dsQuery *dataselect.DataSelectQuery, name string, nsQuery *common.NamespaceQuery) (*pod.PodList, error) {
 	podList := pod.PodList{
 		Pods:              []pod.Pod{},
 		CumulativeMetrics: []metricapi.Metric{},
@@ -308,14 +309,21 @@ func GetNodePods(client k8sClient.Interface, metricClient metricapi.MetricClient
 		return &podList, criticalError
 	}
 
-	events, err := event.GetPodsEvents(client, v1.NamespaceAll, pods.Items)
+	items := make([]v1.Pod, 0, len(pods.Items))
+	for _, item := range pods.Items {
+		if nsQuery.Matches(item.Namespace) {
+			items = append(items, item)
+		}
+	}
+
+	events, err := event.GetPodsEvents(client, v1.NamespaceAll, items)
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
 		return &podList, criticalError
 	}
 
 	nonCriticalErrors = append(nonCriticalErrors, podNonCriticalErrors...)
-	podList = pod.ToPodList(pods.Items, events, nonCriticalErrors, dsQuery, metricClient)
+	podList = pod.ToPodList(items, events, nonCriticalErrors, dsQuery, metricClient)
 	return &podList, nil
 }
 
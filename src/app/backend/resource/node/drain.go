@@ -0,0 +1,230 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// Statuses of the pods evicted while the node is drained.
+const (
+	DrainPodPending  = "Pending"
+	DrainPodEvicting = "Evicting"
+	DrainPodBlocked  = "Blocked"
+	DrainPodEvicted  = "Evicted"
+	DrainPodFailed   = "Failed"
+)
+
+// Annotation set by kubelet on mirror pods of static pods. Such pods can not be evicted through the apiserver.
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// Time between checks of the evicted pods. Evictions blocked by pod disruption budgets are retried as well.
+var drainPollInterval = 2 * time.Second
+
+// DrainPodStatus is a status of the pod evicted while the node is drained.
+type DrainPodStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	// Message explains why eviction of the pod is blocked, i.e. by a pod disruption budget.
+	Message string `json:"message,omitempty"`
+}
+
+// DrainProgress is sent to the client every time status of any of the evicted pods changes.
+type DrainProgress struct {
+	Pods []DrainPodStatus `json:"pods"`
+	// Done is true once all pods are evicted or the drain failed.
+	Done bool `json:"done"`
+	// Error is set if the drain failed, i.e. because it timed out.
+	Error string `json:"error,omitempty"`
+}
+
+// DrainOptions bound the drain of the node.
+type DrainOptions struct {
+	// GracePeriodSeconds of the evicted pods. Pods use their own termination grace period if it is negative.
+	GracePeriodSeconds int64
+	// Timeout after which the drain fails. Drain is not bounded if it is 0.
+	Timeout time.Duration
+	// CheckNamespace returns an error if pods from given namespace must not be evicted. Node is not cordoned and no
+	// pod is evicted in such case. All namespaces are allowed if it is nil.
+	CheckNamespace func(namespace string) error
+}
+
+// CordonNode marks the node as unschedulable, or schedulable again if unschedulable is false.
+func CordonNode(client client.Interface, name string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := client.CoreV1().Nodes().Patch(context.TODO(), name, types.StrategicMergePatchType, []byte(patch),
+		metaV1.PatchOptions{})
+	return err
+}
+
+// DrainNode cordons the node and evicts its pods with the Eviction API, so that pod disruption budgets are
+// respected. Pods managed by daemon sets and mirror pods are skipped. Progress is reported after every change of the
+// pod statuses, the last report has Done set. Evictions blocked by pod disruption budgets are retried until the
+// timeout, the drain fails right away if an eviction is rejected for any other reason.
+func DrainNode(ctx context.Context, client client.Interface, name string, opts DrainOptions,
+	report func(DrainProgress)) error {
+	pods, err := client.CoreV1().Pods(v1.NamespaceAll).List(ctx, metaV1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	drained := evictablePods(pods.Items)
+	if opts.CheckNamespace != nil {
+		for _, pod := range drained {
+			if err := opts.CheckNamespace(pod.Namespace); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := CordonNode(client, name, true); err != nil {
+		return err
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	progress := DrainProgress{Pods: make([]DrainPodStatus, len(drained))}
+	for i, pod := range drained {
+		progress.Pods[i] = DrainPodStatus{Namespace: pod.Namespace, Name: pod.Name, Status: DrainPodPending}
+	}
+	report(progress)
+
+	for {
+		changed := false
+		for i, pod := range drained {
+			status := &progress.Pods[i]
+			if status.Status == DrainPodEvicted {
+				continue
+			}
+
+			if status.Status != DrainPodEvicting {
+				evictChanged, err := evictPod(ctx, client, pod, opts.GracePeriodSeconds, status)
+				if err != nil {
+					progress.Done = true
+					progress.Error = fmt.Sprintf("Drain of node %s failed: %s", name, err)
+					report(progress)
+					return nil
+				}
+				changed = evictChanged || changed
+			}
+
+			if status.Status == DrainPodEvicting && isPodGone(ctx, client, pod) {
+				status.Status = DrainPodEvicted
+				changed = true
+			}
+		}
+
+		progress.Done = allEvicted(progress.Pods)
+		if changed || progress.Done {
+			report(progress)
+		}
+
+		if progress.Done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			progress.Done = true
+			progress.Error = fmt.Sprintf("Drain of node %s did not finish in time: %s", name, ctx.Err())
+			report(progress)
+			return nil
+		case <-time.After(drainPollInterval):
+		}
+	}
+}
+
+// Evicts the pod and updates its status. Returns true if the status changed. Error is returned if the eviction was
+// rejected for other reason than a pod disruption budget, i.e. because the user is not allowed to evict the pod.
+func evictPod(ctx context.Context, client client.Interface, pod v1.Pod, gracePeriodSeconds int64,
+	status *DrainPodStatus) (bool, error) {
+	eviction := &policy.Eviction{ObjectMeta: metaV1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name}}
+	if gracePeriodSeconds >= 0 {
+		eviction.DeleteOptions = &metaV1.DeleteOptions{GracePeriodSeconds: &gracePeriodSeconds}
+	}
+
+	previous := *status
+	err := client.PolicyV1beta1().Evictions(pod.Namespace).Evict(ctx, eviction)
+	switch {
+	case err == nil:
+		status.Status, status.Message = DrainPodEvicting, ""
+	case k8serrors.IsNotFound(err):
+		status.Status, status.Message = DrainPodEvicted, ""
+	case k8serrors.IsTooManyRequests(err):
+		// Eviction is rejected with 429 if it would violate a pod disruption budget, it is retried later.
+		status.Status, status.Message = DrainPodBlocked, err.Error()
+	default:
+		status.Status, status.Message = DrainPodFailed, err.Error()
+		return true, err
+	}
+
+	return previous != *status, nil
+}
+
+// Checks if the pod was deleted. Pod recreated with the same name, i.e. by a stateful set, has a different UID.
+func isPodGone(ctx context.Context, client client.Interface, pod v1.Pod) bool {
+	current, err := client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metaV1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return true
+	}
+
+	return err == nil && current.UID != pod.UID
+}
+
+// Returns pods that have to be evicted, daemon sets would recreate their pods on the node right away and mirror pods
+// are managed by the kubelet.
+func evictablePods(pods []v1.Pod) []v1.Pod {
+	result := make([]v1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if _, exists := pod.Annotations[mirrorPodAnnotation]; exists {
+			continue
+		}
+
+		if controller := metaV1.GetControllerOf(&pod); controller != nil && controller.Kind == "DaemonSet" {
+			continue
+		}
+
+		result = append(result, pod)
+	}
+
+	return result
+}
+
+func allEvicted(pods []DrainPodStatus) bool {
+	for _, pod := range pods {
+		if pod.Status != DrainPodEvicted {
+			return false
+		}
+	}
+
+	return true
+}
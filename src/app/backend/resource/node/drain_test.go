@@ -0,0 +1,187 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+)
+
+func newDrainedPod(name string, annotations map[string]string, owners ...metaV1.OwnerReference) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations,
+			OwnerReferences: owners},
+		Spec: v1.PodSpec{NodeName: "test-node"},
+	}
+}
+
+// Returns client that deletes evicted pods. Eviction of pods listed in blocked is rejected given number of times, as
+// if it violated pod disruption budget.
+func newDrainClient(blocked map[string]int, objects ...runtime.Object) *fake.Clientset {
+	client := fake.NewSimpleClientset(objects...)
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+
+		eviction := action.(core.CreateAction).GetObject().(*policy.Eviction)
+		if blocked[eviction.Name] > 0 {
+			blocked[eviction.Name]--
+			return true, nil, k8serrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 1)
+		}
+
+		gvr := v1.SchemeGroupVersion.WithResource("pods")
+		return true, nil, client.Tracker().Delete(gvr, eviction.Namespace, eviction.Name)
+	})
+	return client
+}
+
+func TestDrainNode(t *testing.T) {
+	drainPollInterval = time.Millisecond
+	controller := true
+	daemonSet := metaV1.OwnerReference{Kind: "DaemonSet", Name: "agent", Controller: &controller}
+	client := newDrainClient(map[string]int{"blocked": 2},
+		&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "test-node"}},
+		newDrainedPod("app", nil),
+		newDrainedPod("blocked", nil),
+		newDrainedPod("agent", nil, daemonSet),
+		newDrainedPod("static", map[string]string{mirrorPodAnnotation: "hash"}))
+
+	reports := []DrainProgress{}
+	err := DrainNode(context.TODO(), client, "test-node", DrainOptions{GracePeriodSeconds: -1, Timeout: time.Minute},
+		func(progress DrainProgress) {
+			progress.Pods = append([]DrainPodStatus{}, progress.Pods...)
+			reports = append(reports, progress)
+		})
+	if err != nil {
+		t.Fatalf("Expected node to be drained, but got error: %s", err)
+	}
+
+	node, _ := client.CoreV1().Nodes().Get(context.TODO(), "test-node", metaV1.GetOptions{})
+	if !node.Spec.Unschedulable {
+		t.Error("Expected node to be cordoned")
+	}
+
+	first, last := reports[0], reports[len(reports)-1]
+	if len(first.Pods) != 2 || first.Pods[0].Status != DrainPodPending {
+		t.Errorf("Expected first report with 2 pending pods, but got %#v", first)
+	}
+
+	if !last.Done || len(last.Error) > 0 || !allEvicted(last.Pods) {
+		t.Errorf("Expected last report with all pods evicted, but got %#v", last)
+	}
+
+	blockedReported := false
+	for _, report := range reports {
+		for _, pod := range report.Pods {
+			blockedReported = blockedReported || (pod.Name == "blocked" && pod.Status == DrainPodBlocked &&
+				len(pod.Message) > 0)
+		}
+	}
+	if !blockedReported {
+		t.Error("Expected eviction blocked by disruption budget to be reported")
+	}
+
+	for _, name := range []string{"agent", "static"} {
+		if _, err := client.CoreV1().Pods("default").Get(context.TODO(), name, metaV1.GetOptions{}); err != nil {
+			t.Errorf("Expected pod %s not to be evicted, but got error: %s", name, err)
+		}
+	}
+}
+
+func TestDrainNodeTimeout(t *testing.T) {
+	drainPollInterval = time.Millisecond
+	client := newDrainClient(map[string]int{"blocked": 1000000},
+		&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "test-node"}}, newDrainedPod("blocked", nil))
+
+	var last DrainProgress
+	err := DrainNode(context.TODO(), client, "test-node", DrainOptions{GracePeriodSeconds: 30,
+		Timeout: 20 * time.Millisecond}, func(progress DrainProgress) { last = progress })
+	if err != nil {
+		t.Fatalf("Expected timeout to be reported in progress, but got error: %s", err)
+	}
+
+	if !last.Done || len(last.Error) == 0 || last.Pods[0].Status != DrainPodBlocked {
+		t.Errorf("Expected last report with timeout error and blocked pod, but got %#v", last)
+	}
+}
+
+func TestDrainNodeEvictionError(t *testing.T) {
+	drainPollInterval = time.Millisecond
+	client := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "test-node"}},
+		newDrainedPod("app", nil))
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(v1.Resource("pods"), "app", nil)
+	})
+
+	var last DrainProgress
+	err := DrainNode(context.TODO(), client, "test-node", DrainOptions{GracePeriodSeconds: 30},
+		func(progress DrainProgress) { last = progress })
+	if err != nil {
+		t.Fatalf("Expected eviction error to be reported in progress, but got error: %s", err)
+	}
+
+	if !last.Done || len(last.Error) == 0 || last.Pods[0].Status != DrainPodFailed {
+		t.Errorf("Expected last report with eviction error and failed pod, but got %#v", last)
+	}
+}
+
+func TestDrainNodeNamespaceNotAllowed(t *testing.T) {
+	client := newDrainClient(nil, &v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "test-node"}},
+		newDrainedPod("app", nil))
+	checkNamespace := func(namespace string) error {
+		return k8serrors.NewForbidden(v1.Resource("namespaces"), namespace, nil)
+	}
+
+	err := DrainNode(context.TODO(), client, "test-node", DrainOptions{CheckNamespace: checkNamespace},
+		func(progress DrainProgress) { t.Errorf("Expected no progress to be reported, but got %#v", progress) })
+	if !k8serrors.IsForbidden(err) {
+		t.Errorf("Expected forbidden error, but got %v", err)
+	}
+
+	node, _ := client.CoreV1().Nodes().Get(context.TODO(), "test-node", metaV1.GetOptions{})
+	if node.Spec.Unschedulable {
+		t.Error("Expected node not to be cordoned")
+	}
+
+	if _, err := client.CoreV1().Pods("default").Get(context.TODO(), "app", metaV1.GetOptions{}); err != nil {
+		t.Errorf("Expected pod not to be evicted, but got error: %s", err)
+	}
+}
+
+func TestCordonNode(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "test-node"}})
+
+	for _, unschedulable := range []bool{true, false} {
+		if err := CordonNode(client, "test-node", unschedulable); err != nil {
+			t.Fatalf("Expected node to be patched, but got error: %s", err)
+		}
+
+		node, _ := client.CoreV1().Nodes().Get(context.TODO(), "test-node", metaV1.GetOptions{})
+		if node.Spec.Unschedulable != unschedulable {
+			t.Errorf("CordonNode() with unschedulable %t left node with unschedulable %t", unschedulable,
+				node.Spec.Unschedulable)
+		}
+	}
+}
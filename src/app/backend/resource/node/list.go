@@ -19,6 +19,7 @@ import (
 	"log"
 
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
@@ -47,8 +48,9 @@ type Node struct {
 }
 
 // GetNodeList returns a list of all Nodes in the cluster.
-func GetNodeList(client client.Interface, dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*NodeList, error) {
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), api.ListEverything)
+func GetNodeList(client client.Interface, dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient,
+	listOptions metaV1.ListOptions) (*NodeList, error) {
+	nodes, err := client.CoreV1().Nodes().List(context.TODO(), listOptions)
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
@@ -71,7 +71,7 @@ func TestGetNodeList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.node)
-		actual, _ := GetNodeList(fakeClient, dataselect.NoDataSelect, nil)
+		actual, _ := GetNodeList(fakeClient, dataselect.NoDataSelect, nil, api.ListEverything)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetNodeList() == \ngot: %#v, \nexpected %#v", actual, c.expected)
 		}
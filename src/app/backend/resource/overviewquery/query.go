@@ -0,0 +1,161 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overviewquery
+
+import (
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+// OverviewQueryResultList contains results of all queries of the overview page in the order they are defined.
+type OverviewQueryResultList struct {
+	Results []OverviewQueryResult `json:"results"`
+}
+
+// OverviewQueryResult is a result of a single query displayed on a card of the overview page.
+type OverviewQueryResult struct {
+	Query settingsApi.OverviewQuery `json:"query"`
+
+	// Number of objects that match the query.
+	Total int `json:"total"`
+
+	// Status of the objects. It is nil for kinds that do not have a status, i.e. config maps.
+	Status *common.ResourceStatus `json:"status,omitempty"`
+
+	// Error of the query, i.e. when user is not allowed to list the objects. Other queries are executed anyway.
+	Error string `json:"error,omitempty"`
+}
+
+// GetOverviewQueryResultList executes queries of the overview page. Queries rejected by check and failed queries are
+// reported in their results. Queries that do not select any namespace are limited to the namespaces matching given
+// namespace query.
+func GetOverviewQueryResultList(verber clientapi.ResourceVerber, overview settingsApi.Overview,
+	nsQuery *common.NamespaceQuery, check func(query settingsApi.OverviewQuery) error) *OverviewQueryResultList {
+	result := &OverviewQueryResultList{Results: make([]OverviewQueryResult, 0, len(overview.Queries))}
+	for _, query := range overview.Queries {
+		result.Results = append(result.Results, executeQuery(verber, query, nsQuery, check))
+	}
+
+	return result
+}
+
+func executeQuery(verber clientapi.ResourceVerber, query settingsApi.OverviewQuery, nsQuery *common.NamespaceQuery,
+	check func(query settingsApi.OverviewQuery) error) OverviewQueryResult {
+	result := OverviewQueryResult{Query: query}
+	if err := check(query); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	if _, err := labels.Parse(query.LabelSelector); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	namespace := query.Namespace
+	if len(namespace) > 0 {
+		nsQuery = common.NewSameNamespaceQuery(namespace)
+	} else if api.KindToAPIMapping[query.Kind].Namespaced {
+		namespace = nsQuery.ToRequestParam()
+	}
+
+	raw, err := verber.List(query.Kind, namespace, metaV1.ListOptions{LabelSelector: query.LabelSelector})
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	list := &unstructured.UnstructuredList{}
+	if err := list.UnmarshalJSON(raw.(*runtime.Unknown).Raw); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	items := filterItems(query.Kind, list.Items, nsQuery)
+	result.Total = len(items)
+	result.Status = getStatus(query.Kind, items)
+	return result
+}
+
+// Returns objects from the namespaces matching given namespace query. Namespaces themselves are matched by their
+// names, other cluster-scoped objects are not filtered.
+func filterItems(kind string, items []unstructured.Unstructured,
+	nsQuery *common.NamespaceQuery) []unstructured.Unstructured {
+	result := make([]unstructured.Unstructured, 0, len(items))
+	for _, item := range items {
+		switch {
+		case kind == api.ResourceKindNamespace && !nsQuery.Matches(item.GetName()):
+		case api.KindToAPIMapping[kind].Namespaced && !nsQuery.Matches(item.GetNamespace()):
+		default:
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// Returns status of the objects or nil if objects of given kind do not have a status.
+func getStatus(kind string, items []unstructured.Unstructured) *common.ResourceStatus {
+	var getItemStatus func(item *unstructured.Unstructured) string
+	switch kind {
+	case api.ResourceKindPod:
+		getItemStatus = getPodStatus
+	case api.ResourceKindDeployment, api.ResourceKindStatefulSet, api.ResourceKindReplicaSet,
+		api.ResourceKindReplicationController:
+		getItemStatus = getReplicatedStatus("status", "readyReplicas", "spec", "replicas")
+	case api.ResourceKindDaemonSet:
+		getItemStatus = getReplicatedStatus("status", "numberReady", "status", "desiredNumberScheduled")
+	case api.ResourceKindJob:
+		getItemStatus = getJobStatus
+	case api.ResourceKindPersistentVolumeClaim, api.ResourceKindPersistentVolume:
+		getItemStatus = getVolumeStatus
+	case api.ResourceKindNamespace:
+		getItemStatus = getNamespaceStatus
+	case api.ResourceKindNode:
+		getItemStatus = getNodeStatus
+	default:
+		return nil
+	}
+
+	status := &common.ResourceStatus{}
+	for i := range items {
+		if items[i].GetDeletionTimestamp() != nil {
+			status.Terminating++
+			continue
+		}
+
+		switch getItemStatus(&items[i]) {
+		case statusRunning:
+			status.Running++
+		case statusPending:
+			status.Pending++
+		case statusFailed:
+			status.Failed++
+		case statusSucceeded:
+			status.Succeeded++
+		default:
+			status.Unknown++
+		}
+	}
+
+	return status
+}
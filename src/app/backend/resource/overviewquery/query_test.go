@@ -0,0 +1,165 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overviewquery
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	settingsApi "github.com/kubernetes/dashboard/src/app/backend/settings/api"
+)
+
+func newObject(name string, labels map[string]interface{}, spec, status map[string]interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": name, "namespace": "default", "labels": labels},
+		"spec":     spec,
+		"status":   status,
+	}}
+}
+
+func TestGetStatus(t *testing.T) {
+	terminating := newObject("terminating", nil, nil, map[string]interface{}{"phase": "Running"})
+	now := metaV1.Now()
+	terminating.SetDeletionTimestamp(&now)
+
+	cases := []struct {
+		info     string
+		kind     string
+		items    []unstructured.Unstructured
+		expected *common.ResourceStatus
+	}{
+		{"Should count pods by their phase", "pod", []unstructured.Unstructured{
+			newObject("a", nil, nil, map[string]interface{}{"phase": "Running"}),
+			newObject("b", nil, nil, map[string]interface{}{"phase": "Pending"}),
+			newObject("c", nil, nil, map[string]interface{}{"phase": "Failed"}),
+			newObject("d", nil, nil, map[string]interface{}{}),
+			terminating,
+		}, &common.ResourceStatus{Running: 1, Pending: 1, Failed: 1, Unknown: 1, Terminating: 1}},
+		{"Should count deployments by ready replicas", "deployment", []unstructured.Unstructured{
+			newObject("a", nil, map[string]interface{}{"replicas": int64(2)},
+				map[string]interface{}{"readyReplicas": int64(2)}),
+			newObject("b", nil, map[string]interface{}{"replicas": int64(2)},
+				map[string]interface{}{"readyReplicas": int64(1)}),
+			newObject("c", nil, map[string]interface{}{}, map[string]interface{}{}),
+		}, &common.ResourceStatus{Running: 1, Pending: 2}},
+		{"Should count jobs by their conditions", "job", []unstructured.Unstructured{
+			newObject("a", nil, nil, map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Complete", "status": "True"}}}),
+			newObject("b", nil, nil, map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Failed", "status": "True"}}}),
+			newObject("c", nil, nil, map[string]interface{}{"active": int64(1)}),
+		}, &common.ResourceStatus{Running: 1, Failed: 1, Succeeded: 1}},
+		{"Should not count kinds without status", "configmap", []unstructured.Unstructured{
+			newObject("a", nil, nil, nil),
+		}, nil},
+	}
+
+	for _, c := range cases {
+		if actual := getStatus(c.kind, c.items); !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected status %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+}
+
+func newNamespacedObject(name, namespace string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": name, "namespace": namespace},
+	}}
+}
+
+func TestFilterItems(t *testing.T) {
+	items := []unstructured.Unstructured{
+		newNamespacedObject("foo", "foo"),
+		newNamespacedObject("bar", "bar"),
+		newNamespacedObject("baz", ""),
+	}
+
+	cases := []struct {
+		kind       string
+		namespaces []string
+		expected   []string
+	}{
+		{"pod", nil, []string{"foo", "bar", "baz"}},
+		{"pod", []string{"foo", "baz"}, []string{"foo"}},
+		{"namespace", []string{"foo", "baz"}, []string{"foo", "baz"}},
+		{"node", []string{"foo"}, []string{"foo", "bar", "baz"}},
+	}
+
+	for _, c := range cases {
+		names := []string{}
+		for _, item := range filterItems(c.kind, items, common.NewNamespaceQuery(c.namespaces)) {
+			names = append(names, item.GetName())
+		}
+
+		if !reflect.DeepEqual(names, c.expected) {
+			t.Errorf("Test Case: %s in %v. Expected %v, but got %v.", c.kind, c.namespaces, c.expected, names)
+		}
+	}
+}
+
+// queryVerber returns pods from two namespaces and records options of the lists.
+type queryVerber struct {
+	clientapi.ResourceVerber
+	lists []string
+}
+
+func (self *queryVerber) List(kind string, namespace string, opts metaV1.ListOptions) (runtime.Object, error) {
+	self.lists = append(self.lists, kind+"/"+namespace+"?"+opts.LabelSelector)
+	return &runtime.Unknown{Raw: []byte(`{"apiVersion":"v1","kind":"List","items":[
+		{"apiVersion":"v1","kind":"Pod","metadata":{"name":"a","namespace":"foo"},"status":{"phase":"Running"}},
+		{"apiVersion":"v1","kind":"Pod","metadata":{"name":"b","namespace":"bar"},"status":{"phase":"Running"}}]}`)},
+		nil
+}
+
+func TestGetOverviewQueryResultList(t *testing.T) {
+	overview := settingsApi.Overview{Queries: []settingsApi.OverviewQuery{
+		{Label: "Web", Kind: "pod", LabelSelector: "app=web"},
+		{Label: "Foo", Kind: "pod", Namespace: "foo"},
+		{Label: "Secrets", Kind: "secret"},
+	}}
+	check := func(query settingsApi.OverviewQuery) error {
+		if query.Kind == "secret" {
+			return errors.New("Resource secrets is not allowed")
+		}
+		return nil
+	}
+	verber := &queryVerber{}
+
+	actual := GetOverviewQueryResultList(verber, overview, common.NewNamespaceQuery([]string{"foo", "baz"}), check)
+
+	expectedLists := []string{"pod/?app=web", "pod/foo?"}
+	if !reflect.DeepEqual(verber.lists, expectedLists) {
+		t.Errorf("Expected lists %v, but got %v.", expectedLists, verber.lists)
+	}
+
+	expectedTotals := []int{1, 1, 0}
+	for i, result := range actual.Results {
+		if result.Total != expectedTotals[i] {
+			t.Errorf("Expected total %d of query %s, but got %d.", expectedTotals[i], result.Query.Label,
+				result.Total)
+		}
+	}
+
+	if actual.Results[2].Error != "Resource secrets is not allowed" {
+		t.Errorf("Expected rejected query to report error, but got %q.", actual.Results[2].Error)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overviewquery
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// States that the objects are counted by. They correspond to the fields of common.ResourceStatus.
+const (
+	statusRunning   = "running"
+	statusPending   = "pending"
+	statusFailed    = "failed"
+	statusSucceeded = "succeeded"
+	statusUnknown   = "unknown"
+)
+
+func getPodStatus(item *unstructured.Unstructured) string {
+	phase, _, _ := unstructured.NestedString(item.Object, "status", "phase")
+	switch phase {
+	case "Running":
+		return statusRunning
+	case "Pending":
+		return statusPending
+	case "Failed":
+		return statusFailed
+	case "Succeeded":
+		return statusSucceeded
+	}
+
+	return statusUnknown
+}
+
+// Returns function that compares number of ready replicas with the desired number. Object is running when all
+// replicas are ready and pending otherwise.
+func getReplicatedStatus(readyPath, readyField, desiredPath, desiredField string) func(
+	item *unstructured.Unstructured) string {
+	return func(item *unstructured.Unstructured) string {
+		ready, _, _ := unstructured.NestedInt64(item.Object, readyPath, readyField)
+		desired, found, _ := unstructured.NestedInt64(item.Object, desiredPath, desiredField)
+		if !found && desiredPath == "spec" {
+			// Number of replicas defaults to 1.
+			desired = 1
+		}
+
+		if ready >= desired {
+			return statusRunning
+		}
+
+		return statusPending
+	}
+}
+
+func getJobStatus(item *unstructured.Unstructured) string {
+	switch {
+	case hasCondition(item, "Complete", "True"):
+		return statusSucceeded
+	case hasCondition(item, "Failed", "True"):
+		return statusFailed
+	}
+
+	if active, _, _ := unstructured.NestedInt64(item.Object, "status", "active"); active > 0 {
+		return statusRunning
+	}
+
+	return statusPending
+}
+
+func getVolumeStatus(item *unstructured.Unstructured) string {
+	phase, _, _ := unstructured.NestedString(item.Object, "status", "phase")
+	switch phase {
+	case "Bound", "Available":
+		return statusRunning
+	case "Pending", "Released":
+		return statusPending
+	case "Lost", "Failed":
+		return statusFailed
+	}
+
+	return statusUnknown
+}
+
+func getNamespaceStatus(item *unstructured.Unstructured) string {
+	phase, _, _ := unstructured.NestedString(item.Object, "status", "phase")
+	if phase == "Active" {
+		return statusRunning
+	}
+
+	return statusUnknown
+}
+
+func getNodeStatus(item *unstructured.Unstructured) string {
+	switch {
+	case hasCondition(item, "Ready", "True"):
+		return statusRunning
+	case hasCondition(item, "Ready", "False"):
+		return statusFailed
+	}
+
+	return statusUnknown
+}
+
+func hasCondition(item *unstructured.Unstructured, conditionType, status string) bool {
+	conditions, _, _ := unstructured.NestedSlice(item.Object, "status", "conditions")
+	for _, condition := range conditions {
+		condition, ok := condition.(map[string]interface{})
+		if ok && condition["type"] == conditionType && condition["status"] == status {
+			return true
+		}
+	}
+
+	return false
+}
@@ -39,7 +39,7 @@ func GetStorageClassPersistentVolumes(client client.Interface, storageClassName
 
 	channels := &common.ResourceChannels{
 		PersistentVolumeList: common.GetPersistentVolumeListChannel(
-			client, 1),
+			client, metaV1.ListOptions{}, 1),
 	}
 
 	persistentVolumeList := <-channels.PersistentVolumeList.List
@@ -86,6 +86,8 @@ func (self PersistentVolumeCell) GetProperty(name dataselect.PropertyName) datas
 		return dataselect.StdComparableTime(self.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(self.ObjectMeta.Namespace)
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableString(self.Status.Phase)
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
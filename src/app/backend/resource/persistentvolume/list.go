@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -48,10 +49,11 @@ type PersistentVolume struct {
 }
 
 // GetPersistentVolumeList returns a list of all Persistent Volumes in the cluster.
-func GetPersistentVolumeList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*PersistentVolumeList, error) {
+func GetPersistentVolumeList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery,
+	listOptions metaV1.ListOptions) (*PersistentVolumeList, error) {
 	log.Print("Getting list persistent volumes")
 	channels := &common.ResourceChannels{
-		PersistentVolumeList: common.GetPersistentVolumeListChannel(client, 1),
+		PersistentVolumeList: common.GetPersistentVolumeListChannel(client, listOptions, 1),
 	}
 
 	return GetPersistentVolumeListFromChannels(channels, dsQuery)
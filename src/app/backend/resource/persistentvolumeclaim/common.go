@@ -53,7 +53,7 @@ func GetPodPersistentVolumeClaims(client client.Interface, namespace string, pod
 	if len(claimNames) > 0 {
 		channels := &common.ResourceChannels{
 			PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(
-				client, common.NewSameNamespaceQuery(namespace), 1),
+				client, common.NewSameNamespaceQuery(namespace), metaV1.ListOptions{}, 1),
 		}
 
 		persistentVolumeClaimList := <-channels.PersistentVolumeClaimList.List
@@ -95,6 +95,8 @@ func (self PersistentVolumeClaimCell) GetProperty(name dataselect.PropertyName)
 		return dataselect.StdComparableTime(self.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(self.ObjectMeta.Namespace)
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableString(self.Status.Phase)
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
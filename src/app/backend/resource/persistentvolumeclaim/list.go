@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -49,11 +50,11 @@ type PersistentVolumeClaim struct {
 
 // GetPersistentVolumeClaimList returns a list of all Persistent Volume Claims in the cluster.
 func GetPersistentVolumeClaimList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery) (*PersistentVolumeClaimList, error) {
+	dsQuery *dataselect.DataSelectQuery, listOptions metaV1.ListOptions) (*PersistentVolumeClaimList, error) {
 
 	log.Print("Getting list persistent volumes claims")
 	channels := &common.ResourceChannels{
-		PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(client, nsQuery, 1),
+		PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(client, nsQuery, listOptions, 1),
 	}
 
 	return GetPersistentVolumeClaimListFromChannels(channels, nsQuery, dsQuery)
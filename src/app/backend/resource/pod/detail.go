@@ -132,7 +132,7 @@ func GetPodDetail(client kubernetes.Interface, metricClient metricapi.MetricClie
 	log.Printf("Getting details of %s pod in %s namespace", name, namespace)
 
 	channels := &common.ResourceChannels{
-		ConfigMapList: common.GetConfigMapListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		ConfigMapList: common.GetConfigMapListChannel(client, common.NewSameNamespaceQuery(namespace), api.ListEverything, 1),
 		SecretList:    common.GetSecretListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
 	}
 
@@ -165,7 +165,7 @@ func GetPodDetail(client kubernetes.Interface, metricClient metricapi.MetricClie
 		return nil, criticalError
 	}
 
-	eventList, err := GetEventsForPod(client, dataselect.DefaultDataSelect, pod.Namespace, pod.Name)
+	eventList, err := GetEventsForPod(client, dataselect.DefaultDataSelect, pod.Namespace, pod.Name, api.ListEverything)
 	nonCriticalErrors, criticalError = errorHandler.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
 		return nil, criticalError
@@ -18,11 +18,12 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 )
 
 // GetEventsForPod gets events that are associated with this pod.
 func GetEventsForPod(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace,
-	podName string) (*common.EventList, error) {
-	return event.GetResourceEvents(client, dsQuery, namespace, podName)
+	podName string, listOptions metaV1.ListOptions) (*common.EventList, error) {
+	return event.GetResourceEvents(client, dsQuery, namespace, podName, listOptions)
 }
@@ -68,7 +68,7 @@ func TestGetPodEvents(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.podList, c.eventList)
 
-		actual, _ := GetEventsForPod(fakeClient, dataselect.NoDataSelect, c.namespace, c.podName)
+		actual, _ := GetEventsForPod(fakeClient, dataselect.NoDataSelect, c.namespace, c.podName, api.ListEverything)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetEventsForPods == \ngot %#v, \nexpected %#v", actual,
@@ -82,13 +82,14 @@ var EmptyPodList = &PodList{
 	},
 }
 
-// GetPodList returns a list of all Pods in the cluster.
+// GetPodList returns a list of all Pods in the cluster. If list options set a limit, only a chunk of the list is
+// returned together with the token of the next chunk.
 func GetPodList(client k8sClient.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
+	dsQuery *dataselect.DataSelectQuery, listOptions metaV1.ListOptions) (*PodList, error) {
 	log.Print("Getting list of all pods in the cluster")
 
 	channels := &common.ResourceChannels{
-		PodList:   common.GetPodListChannelWithOptions(client, nsQuery, metaV1.ListOptions{}, 1),
+		PodList:   common.GetPodListChannelWithOptions(client, nsQuery, listOptions, 1),
 		EventList: common.GetEventListChannel(client, nsQuery, 1),
 	}
 
@@ -116,6 +117,7 @@ func GetPodListFromChannels(channels *common.ResourceChannels, dsQuery *datasele
 
 	podList := ToPodList(pods.Items, eventList.Items, nonCriticalErrors, dsQuery, metricClient)
 	podList.Status = getStatus(pods, eventList.Items)
+	podList.ListMeta.Continue = pods.Continue
 	return &podList, nil
 }
 
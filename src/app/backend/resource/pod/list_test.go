@@ -110,6 +110,18 @@ func TestGetPodListFromChannels(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			v1.PodList{ListMeta: metav1.ListMeta{Continue: "next-chunk"}},
+			nil,
+			&v1.PodList{},
+			&pod.PodList{
+				ListMeta:          api.ListMeta{Continue: "next-chunk"},
+				Pods:              []pod.Pod{},
+				CumulativeMetrics: make([]metricapi.Metric, 0),
+				Errors:            []error{},
+			},
+			nil,
+		},
 	}
 
 	for _, c := range cases {
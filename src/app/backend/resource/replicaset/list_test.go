@@ -300,7 +300,7 @@ func TestGetReplicaSetList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.rsList)
-		actual, _ := GetReplicaSetList(fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil)
+		actual, _ := GetReplicaSetList(fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil, api.ListEverything)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
@@ -17,6 +17,7 @@ package replicaset
 import (
 	"context"
 
+	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
@@ -35,7 +36,7 @@ func GetReplicaSetServices(client client.Interface, dsQuery *dataselect.DataSele
 	}
 
 	channels := &common.ResourceChannels{
-		ServiceList: common.GetServiceListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		ServiceList: common.GetServiceListChannel(client, common.NewSameNamespaceQuery(namespace), api.ListEverything, 1),
 	}
 
 	services := <-channels.ServiceList.List
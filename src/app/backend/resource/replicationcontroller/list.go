@@ -24,6 +24,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 )
 
@@ -60,11 +61,12 @@ type ReplicationController struct {
 
 // GetReplicationControllerList returns a list of all Replication Controllers in the cluster.
 func GetReplicationControllerList(client client.Interface, nsQuery *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*ReplicationControllerList, error) {
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient,
+	listOptions metaV1.ListOptions) (*ReplicationControllerList, error) {
 	log.Print("Getting list of all replication controllers in the cluster")
 
 	channels := &common.ResourceChannels{
-		ReplicationControllerList: common.GetReplicationControllerListChannel(client, nsQuery, 1),
+		ReplicationControllerList: common.GetReplicationControllerListChannel(client, nsQuery, listOptions, 1),
 		PodList:                   common.GetPodListChannel(client, nsQuery, 1),
 		EventList:                 common.GetEventListChannel(client, nsQuery, 1),
 	}
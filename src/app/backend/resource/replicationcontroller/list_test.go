@@ -269,7 +269,8 @@ func TestGetReplicationControllerList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.rcList)
-		actual, _ := GetReplicationControllerList(fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil)
+		actual, _ := GetReplicationControllerList(fakeClient, &common.NamespaceQuery{}, dataselect.NoDataSelect, nil,
+			api.ListEverything)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
@@ -17,6 +17,7 @@ package replicationcontroller
 import (
 	"context"
 
+	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
@@ -36,7 +37,7 @@ func GetReplicationControllerServices(client client.Interface, dsQuery *datasele
 	}
 
 	channels := &common.ResourceChannels{
-		ServiceList: common.GetServiceListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+		ServiceList: common.GetServiceListChannel(client, common.NewSameNamespaceQuery(namespace), api.ListEverything, 1),
 	}
 
 	services := <-channels.ServiceList.List
@@ -0,0 +1,129 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// UsageWarningThreshold is the utilization of the resource, in percents, above which it is flagged as near the limit.
+const UsageWarningThreshold = 90
+
+// ResourceQuotaUsage is the usage of a single resource in the namespace.
+type ResourceQuotaUsage struct {
+	// Name of the resource, i.e. "cpu" or "requests.memory".
+	Name v1.ResourceName `json:"name"`
+
+	// Quota is the name of the most utilized resource quota that limits the resource.
+	Quota string `json:"quota"`
+
+	Used      string `json:"used"`
+	Hard      string `json:"hard"`
+	Remaining string `json:"remaining"`
+
+	// Percentage of the hard limit that is used. It can exceed 100 if the limit was lowered below current usage.
+	Percentage float64 `json:"percentage"`
+
+	// NearLimit is set when Percentage is over UsageWarningThreshold.
+	NearLimit bool `json:"nearLimit"`
+}
+
+// ResourceQuotaUsageList is the usage of all resources limited by resource quotas in the namespace.
+type ResourceQuotaUsageList struct {
+	ListMeta api.ListMeta         `json:"listMeta"`
+	Items    []ResourceQuotaUsage `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetResourceQuotaUsage aggregates status of all resource quotas in the namespace. Namespace without resource quotas
+// returns empty list.
+func GetResourceQuotaUsage(client kubernetes.Interface, namespace string) (*ResourceQuotaUsageList, error) {
+	log.Printf("Getting resource quota usage in %s namespace", namespace)
+	quotas, err := client.CoreV1().ResourceQuotas(namespace).List(context.TODO(), api.ListEverything)
+
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var items []v1.ResourceQuota
+	if quotas != nil {
+		items = quotas.Items
+	}
+
+	return toResourceQuotaUsageList(items, nonCriticalErrors), nil
+}
+
+func toResourceQuotaUsageList(quotas []v1.ResourceQuota, nonCriticalErrors []error) *ResourceQuotaUsageList {
+	usages := map[v1.ResourceName]ResourceQuotaUsage{}
+	for _, quota := range quotas {
+		for name, hard := range quota.Status.Hard {
+			usage := toResourceQuotaUsage(quota.Name, name, hard, quota.Status.Used[name])
+
+			// Quotas are enforced independently, so the one with the highest utilization is the effective limit.
+			if current, exists := usages[name]; !exists || usage.Percentage > current.Percentage {
+				usages[name] = usage
+			}
+		}
+	}
+
+	result := &ResourceQuotaUsageList{
+		ListMeta: api.ListMeta{TotalItems: len(usages)},
+		Items:    make([]ResourceQuotaUsage, 0, len(usages)),
+		Errors:   nonCriticalErrors,
+	}
+	for _, usage := range usages {
+		result.Items = append(result.Items, usage)
+	}
+	sort.Slice(result.Items, func(i, j int) bool { return result.Items[i].Name < result.Items[j].Name })
+
+	return result
+}
+
+func toResourceQuotaUsage(quota string, name v1.ResourceName, hard, used resource.Quantity) ResourceQuotaUsage {
+	remaining := hard.DeepCopy()
+	remaining.Sub(used)
+	if remaining.Sign() < 0 {
+		remaining = resource.Quantity{Format: hard.Format}
+	}
+
+	percentage := float64(0)
+	if hard.Sign() > 0 {
+		percentage = used.AsApproximateFloat64() / hard.AsApproximateFloat64() * 100
+	} else if used.Sign() > 0 {
+		percentage = 100
+	}
+
+	return ResourceQuotaUsage{
+		Name:       name,
+		Quota:      quota,
+		Used:       used.String(),
+		Hard:       hard.String(),
+		Remaining:  remaining.String(),
+		Percentage: percentage,
+		NearLimit:  percentage > UsageWarningThreshold,
+	}
+}
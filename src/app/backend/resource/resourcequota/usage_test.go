@@ -0,0 +1,102 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+)
+
+func newQuota(name string, hard, used v1.ResourceList) *v1.ResourceQuota {
+	return &v1.ResourceQuota{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "ns-1"},
+		Status:     v1.ResourceQuotaStatus{Hard: hard, Used: used},
+	}
+}
+
+func TestGetResourceQuotaUsage(t *testing.T) {
+	cases := []struct {
+		info     string
+		quotas   []*v1.ResourceQuota
+		expected *ResourceQuotaUsageList
+	}{
+		{
+			"Should return empty list when there are no quotas",
+			[]*v1.ResourceQuota{},
+			&ResourceQuotaUsageList{ListMeta: api.ListMeta{TotalItems: 0}, Items: []ResourceQuotaUsage{},
+				Errors: []error{}},
+		},
+		{
+			"Should report the most utilized quota of every resource",
+			[]*v1.ResourceQuota{
+				newQuota("quota-1",
+					v1.ResourceList{v1.ResourcePods: resource.MustParse("10"), v1.ResourceCPU: resource.MustParse("2")},
+					v1.ResourceList{v1.ResourcePods: resource.MustParse("5"), v1.ResourceCPU: resource.MustParse("1900m")}),
+				newQuota("quota-2",
+					v1.ResourceList{v1.ResourcePods: resource.MustParse("4")},
+					v1.ResourceList{v1.ResourcePods: resource.MustParse("3")}),
+			},
+			&ResourceQuotaUsageList{ListMeta: api.ListMeta{TotalItems: 2}, Items: []ResourceQuotaUsage{
+				{Name: v1.ResourceCPU, Quota: "quota-1", Used: "1900m", Hard: "2", Remaining: "100m", Percentage: 95,
+					NearLimit: true},
+				{Name: v1.ResourcePods, Quota: "quota-2", Used: "3", Hard: "4", Remaining: "1", Percentage: 75},
+			}, Errors: []error{}},
+		},
+		{
+			"Should not report negative remaining amount",
+			[]*v1.ResourceQuota{
+				newQuota("quota-1", v1.ResourceList{v1.ResourceServices: resource.MustParse("2")},
+					v1.ResourceList{v1.ResourceServices: resource.MustParse("3")}),
+			},
+			&ResourceQuotaUsageList{ListMeta: api.ListMeta{TotalItems: 1}, Items: []ResourceQuotaUsage{
+				{Name: v1.ResourceServices, Quota: "quota-1", Used: "3", Hard: "2", Remaining: "0", Percentage: 150,
+					NearLimit: true},
+			}, Errors: []error{}},
+		},
+		{
+			"Should treat missing usage as zero",
+			[]*v1.ResourceQuota{
+				newQuota("quota-1", v1.ResourceList{v1.ResourceSecrets: resource.MustParse("0")}, nil),
+			},
+			&ResourceQuotaUsageList{ListMeta: api.ListMeta{TotalItems: 1}, Items: []ResourceQuotaUsage{
+				{Name: v1.ResourceSecrets, Quota: "quota-1", Used: "0", Hard: "0", Remaining: "0"},
+			}, Errors: []error{}},
+		},
+	}
+
+	for _, c := range cases {
+		client := fake.NewSimpleClientset()
+		for _, quota := range c.quotas {
+			client.Tracker().Add(quota)
+		}
+
+		actual, err := GetResourceQuotaUsage(client, "ns-1")
+		if err != nil {
+			t.Errorf("Test Case: %s. Unexpected error: %s", c.info, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Test Case: %s. Expected:\n%#v\nbut got:\n%#v", c.info, c.expected, actual)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	rbac "k8s.io/api/rbac/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -42,10 +43,11 @@ type Role struct {
 }
 
 // GetRoleList returns a list of all Roles in the cluster.
-func GetRoleList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*RoleList, error) {
+func GetRoleList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
+	listOptions metaV1.ListOptions) (*RoleList, error) {
 	log.Print("Getting list of all roles in the cluster")
 	channels := &common.ResourceChannels{
-		RoleList: common.GetRoleListChannel(client, nsQuery, 1),
+		RoleList: common.GetRoleListChannel(client, nsQuery, listOptions, 1),
 	}
 
 	return GetRoleListFromChannels(channels, dsQuery)
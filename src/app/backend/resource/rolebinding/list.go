@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	rbac "k8s.io/api/rbac/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -42,10 +43,11 @@ type RoleBinding struct {
 }
 
 // GetRoleBindingList returns a list of all RoleBindings in the cluster.
-func GetRoleBindingList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*RoleBindingList, error) {
+func GetRoleBindingList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
+	listOptions metaV1.ListOptions) (*RoleBindingList, error) {
 	log.Print("Getting list of all roleBindings in the cluster")
 	channels := &common.ResourceChannels{
-		RoleBindingList: common.GetRoleBindingListChannel(client, nsQuery, 1),
+		RoleBindingList: common.GetRoleBindingListChannel(client, nsQuery, listOptions, 1),
 	}
 
 	return GetRoleBindingListFromChannels(channels, dsQuery)
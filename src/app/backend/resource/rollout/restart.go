@@ -0,0 +1,84 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// RestartedAtAnnotationKey is an annotation key of the pod template set by rollout restart.
+const RestartedAtAnnotationKey = "kubectl.kubernetes.io/restartedAt"
+
+// Kinds of workloads that can be restarted.
+var restartableKinds = []string{api.ResourceKindDeployment, api.ResourceKindStatefulSet, api.ResourceKindDaemonSet}
+
+// RestartWorkload restarts deployment, stateful set or daemon set in the manner of `kubectl rollout restart`. Pod
+// template is patched with the current time in restartedAt annotation, so pods are replaced by the controller the
+// same way as after any other change of the template. Returns the updated object.
+func RestartWorkload(client kubernetes.Interface, kind, namespace, name string) (runtime.Object, error) {
+	patch, err := restartPatch(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case api.ResourceKindDeployment:
+		deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if deployment.Spec.Paused {
+			return nil, errors.NewBadRequest(fmt.Sprintf("Can not restart paused deployment %s, resume it first", name))
+		}
+
+		return client.AppsV1().Deployments(namespace).Patch(context.TODO(), name, types.StrategicMergePatchType, patch,
+			metaV1.PatchOptions{})
+	case api.ResourceKindStatefulSet:
+		return client.AppsV1().StatefulSets(namespace).Patch(context.TODO(), name, types.StrategicMergePatchType, patch,
+			metaV1.PatchOptions{})
+	case api.ResourceKindDaemonSet:
+		return client.AppsV1().DaemonSets(namespace).Patch(context.TODO(), name, types.StrategicMergePatchType, patch,
+			metaV1.PatchOptions{})
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("Kind %s can not be restarted, supported kinds are: %s", kind,
+			strings.Join(restartableKinds, ", ")))
+	}
+}
+
+// Returns patch of the pod template annotations with the restart time, the same as created by kubectl.
+func restartPatch(now time.Time) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{RestartedAtAnnotationKey: now.Format(time.RFC3339)},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRestartWorkload(t *testing.T) {
+	objectMeta := metaV1.ObjectMeta{Name: "web", Namespace: "default"}
+	template := v1.PodTemplateSpec{ObjectMeta: metaV1.ObjectMeta{Annotations: map[string]string{"team": "web"}}}
+
+	cases := []struct {
+		info          string
+		kind          string
+		objects       []runtime.Object
+		expectedError bool
+	}{
+		{"Should restart deployment", "deployment",
+			[]runtime.Object{&apps.Deployment{ObjectMeta: objectMeta, Spec: apps.DeploymentSpec{Template: template}}},
+			false},
+		{"Should restart stateful set", "statefulset",
+			[]runtime.Object{&apps.StatefulSet{ObjectMeta: objectMeta, Spec: apps.StatefulSetSpec{Template: template}}},
+			false},
+		{"Should restart daemon set", "daemonset",
+			[]runtime.Object{&apps.DaemonSet{ObjectMeta: objectMeta, Spec: apps.DaemonSetSpec{Template: template}}},
+			false},
+		{"Should not restart paused deployment", "deployment",
+			[]runtime.Object{&apps.Deployment{ObjectMeta: objectMeta, Spec: apps.DeploymentSpec{Paused: true}}}, true},
+		{"Should not restart unsupported kind", "replicaset",
+			[]runtime.Object{&apps.ReplicaSet{ObjectMeta: objectMeta}}, true},
+		{"Should return error of missing workload", "statefulset", []runtime.Object{}, true},
+	}
+
+	for _, c := range cases {
+		result, err := RestartWorkload(fake.NewSimpleClientset(c.objects...), c.kind, "default", "web")
+		if (err != nil) != c.expectedError {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedError, err)
+			continue
+		}
+
+		if err != nil {
+			if c.kind != "statefulset" && !k8sErrors.IsBadRequest(err) {
+				t.Errorf("Test Case: %s. Expected bad request error, but got %v.", c.info, err)
+			}
+			continue
+		}
+
+		var annotations map[string]string
+		switch workload := result.(type) {
+		case *apps.Deployment:
+			annotations = workload.Spec.Template.Annotations
+		case *apps.StatefulSet:
+			annotations = workload.Spec.Template.Annotations
+		case *apps.DaemonSet:
+			annotations = workload.Spec.Template.Annotations
+		}
+
+		if len(annotations[RestartedAtAnnotationKey]) == 0 || annotations["team"] != "web" {
+			t.Errorf("Test Case: %s. Expected restartedAt annotation next to existing ones, but got %v.", c.info,
+				annotations)
+		}
+	}
+}
@@ -84,9 +84,9 @@ type SecretList struct {
 
 // GetSecretList returns all secrets in the given namespace.
 func GetSecretList(client kubernetes.Interface, namespace *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery) (*SecretList, error) {
+	dsQuery *dataselect.DataSelectQuery, listOptions metaV1.ListOptions) (*SecretList, error) {
 	log.Printf("Getting list of secrets in %s namespace\n", namespace)
-	secretList, err := client.CoreV1().Secrets(namespace.ToRequestParam()).List(context.TODO(), api.ListEverything)
+	secretList, err := client.CoreV1().Secrets(namespace.ToRequestParam()).List(context.TODO(), listOptions)
 
 	nonCriticalErrors, criticalError := errors.HandleError(err)
 	if criticalError != nil {
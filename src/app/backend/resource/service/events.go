@@ -21,18 +21,19 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 )
 
 // GetServiceEvents returns model events for a service with the given name in the given namespace.
-func GetServiceEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
-	*common.EventList, error) {
+func GetServiceEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string,
+	listOptions metaV1.ListOptions) (*common.EventList, error) {
 	eventList := common.EventList{
 		Events:   make([]common.Event, 0),
 		ListMeta: api.ListMeta{TotalItems: 0},
 	}
 
-	serviceEvents, err := event.GetEvents(client, namespace, name)
+	serviceEvents, err := event.GetEvents(client, namespace, name, listOptions)
 	if err != nil {
 		return &eventList, err
 	}
@@ -61,7 +61,7 @@ func TestServiceEvents(t *testing.T) {
 
 		fakeClient := fake.NewSimpleClientset(c.eventList, c.service)
 
-		actual, _ := GetServiceEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name)
+		actual, _ := GetServiceEvents(fakeClient, dataselect.NoDataSelect, c.namespace, c.name, api.ListEverything)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetServiceEvents(client,%#v, %#v) == \ngot: %#v, \nexpected %#v",
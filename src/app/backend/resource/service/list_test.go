@@ -65,7 +65,7 @@ func TestGetServiceList(t *testing.T) {
 
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.serviceList)
-		actual, _ := GetServiceList(fakeClient, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+		actual, _ := GetServiceList(fakeClient, common.NewNamespaceQuery(nil), dataselect.NoDataSelect, api.ListEverything)
 		actions := fakeClient.Actions()
 
 		if len(actions) != len(c.expectedActions) {
@@ -87,6 +87,27 @@ func TestGetServiceList(t *testing.T) {
 	}
 }
 
+func TestGetServiceListWithSelector(t *testing.T) {
+	services := &v1.ServiceList{Items: []v1.Service{
+		{ObjectMeta: metaV1.ObjectMeta{Name: "web-1", Namespace: "ns-1", Labels: map[string]string{"app": "web"}}},
+		{ObjectMeta: metaV1.ObjectMeta{Name: "web-2", Namespace: "ns-1", Labels: map[string]string{"app": "web"}}},
+		{ObjectMeta: metaV1.ObjectMeta{Name: "db", Namespace: "ns-1", Labels: map[string]string{"app": "db"}}},
+	}}
+	firstPageByName := dataselect.NewDataSelectQuery(dataselect.NewPaginationQuery(1, 0),
+		dataselect.NewSortQuery([]string{"d", dataselect.NameProperty}), dataselect.NoFilter, dataselect.NoMetrics)
+
+	fakeClient := fake.NewSimpleClientset(services)
+	actual, err := GetServiceList(fakeClient, common.NewNamespaceQuery(nil), firstPageByName,
+		metaV1.ListOptions{LabelSelector: "app=web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual.ListMeta.TotalItems != 2 || len(actual.Services) != 1 || actual.Services[0].ObjectMeta.Name != "web-2" {
+		t.Errorf("Expected first page of 2 services matching the selector, but got %#v", actual)
+	}
+}
+
 func TestToServiceDetail(t *testing.T) {
 	cases := []struct {
 		service      *v1.Service
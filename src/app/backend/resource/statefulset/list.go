@@ -25,6 +25,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -51,11 +52,12 @@ type StatefulSet struct {
 
 // GetStatefulSetList returns a list of all Stateful Sets in the cluster.
 func GetStatefulSetList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
-	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient) (*StatefulSetList, error) {
+	dsQuery *dataselect.DataSelectQuery, metricClient metricapi.MetricClient,
+	listOptions metaV1.ListOptions) (*StatefulSetList, error) {
 	log.Print("Getting list of all pet sets in the cluster")
 
 	channels := &common.ResourceChannels{
-		StatefulSetList: common.GetStatefulSetListChannel(client, nsQuery, 1),
+		StatefulSetList: common.GetStatefulSetListChannel(client, nsQuery, listOptions, 1),
 		PodList:         common.GetPodListChannel(client, nsQuery, 1),
 		EventList:       common.GetEventListChannel(client, nsQuery, 1),
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	storage "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -43,12 +44,12 @@ type StorageClass struct {
 }
 
 // GetStorageClassList returns a list of all storage class objects in the cluster.
-func GetStorageClassList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (
-	*StorageClassList, error) {
+func GetStorageClassList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery,
+	listOptions metaV1.ListOptions) (*StorageClassList, error) {
 	log.Print("Getting list of storage classes in the cluster")
 
 	channels := &common.ResourceChannels{
-		StorageClassList: common.GetStorageClassListChannel(client, 1),
+		StorageClassList: common.GetStorageClassListChannel(client, listOptions, 1),
 	}
 
 	return GetStorageClassListFromChannels(channels, dsQuery)
@@ -61,7 +61,7 @@ func TestGetStorageClassList(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.storageClassList)
 
-		actual, _ := GetStorageClassList(fakeClient, dataselect.NoDataSelect)
+		actual, _ := GetStorageClassList(fakeClient, dataselect.NoDataSelect, api.ListEverything)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
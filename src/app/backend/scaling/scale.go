@@ -16,9 +16,11 @@ package scaling
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	apps "k8s.io/api/apps/v1"
+	autoscalingV1 "k8s.io/api/autoscaling/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
@@ -28,12 +30,17 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/scale"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
 )
 
 // ReplicaCounts provide the desired and actual number of replicas.
 type ReplicaCounts struct {
 	DesiredReplicas int32 `json:"desiredReplicas"`
 	ActualReplicas  int32 `json:"actualReplicas"`
+	// ResourceVersion of the scaled resource. It can be passed back when scaling the resource to make sure that it
+	// was not changed in the meantime.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
 }
 
 // GetReplicaCounts returns a populated ReplicaCounts object with desired and actual number of replicas.
@@ -49,43 +56,56 @@ func GetReplicaCounts(cfg *rest.Config, kind, namespace, name string) (*ReplicaC
 		return nil, err
 	}
 
-	return &ReplicaCounts{
-		ActualReplicas:  res.Status.Replicas,
-		DesiredReplicas: res.Spec.Replicas,
-	}, nil
+	return toReplicaCounts(res), nil
 }
 
-// ScaleResource scales the provided resource using the client scale method in the case of Deployment,
-// ReplicaSet, Replication Controller. In the case of a job we are using the jobs resource update
-// method since the client scale method does not provide one for the job.
-func ScaleResource(cfg *rest.Config, kind, namespace, name, count string) (*ReplicaCounts, error) {
-	sc, err := getScaleGetter(cfg)
+// ScaleResource scales the provided resource, i.e. Deployment, StatefulSet or ReplicaSet, using its scale
+// subresource. If resource version is given, update fails with 409 Conflict when the resource was changed since
+// then, so that concurrent changes are not overwritten.
+func ScaleResource(cfg *rest.Config, kind, namespace, name, count, resourceVersion string) (*ReplicaCounts, error) {
+	c, err := strconv.Atoi(count)
 	if err != nil {
-		return nil, err
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid replica count %q", count))
 	}
 
-	gr := getGroupResource(kind)
-	res, err := sc.Scales(namespace).Get(context.TODO(), gr, name, metaV1.GetOptions{})
+	if c < 0 {
+		return nil, errors.NewBadRequest("replica count can not be negative")
+	}
+
+	sc, err := getScaleGetter(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	c, err := strconv.Atoi(count)
+	return scaleResource(sc, getGroupResource(kind), namespace, name, int32(c), resourceVersion)
+}
+
+func scaleResource(sc scale.ScalesGetter, gr schema.GroupResource, namespace, name string, replicas int32,
+	resourceVersion string) (*ReplicaCounts, error) {
+	res, err := sc.Scales(namespace).Get(context.TODO(), gr, name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	res.Spec.Replicas = int32(c)
+	if len(resourceVersion) > 0 {
+		res.ResourceVersion = resourceVersion
+	}
+	res.Spec.Replicas = replicas
 
 	res, err = sc.Scales(namespace).Update(context.TODO(), gr, res, metaV1.UpdateOptions{})
 	if err != nil {
 		return nil, err
 	}
 
+	return toReplicaCounts(res), nil
+}
+
+func toReplicaCounts(res *autoscalingV1.Scale) *ReplicaCounts {
 	return &ReplicaCounts{
 		ActualReplicas:  res.Status.Replicas,
 		DesiredReplicas: res.Spec.Replicas,
-	}, nil
+		ResourceVersion: res.ResourceVersion,
+	}
 }
 
 func getScaleGetter(cfg *rest.Config) (scale.ScalesGetter, error) {
@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaling
+
+import (
+	"net/http"
+	"testing"
+
+	autoscalingV1 "k8s.io/api/autoscaling/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/scale/fake"
+	core "k8s.io/client-go/testing"
+)
+
+// Returns scale client of a deployment with 3 replicas and resource version 2. Update fails with conflict if it was
+// made with other resource version.
+func newFakeScaleClient() *fake.FakeScaleClient {
+	client := &fake.FakeScaleClient{}
+	client.AddReactor("get", "deployments", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &autoscalingV1.Scale{
+			ObjectMeta: metaV1.ObjectMeta{Name: "test", Namespace: "default", ResourceVersion: "2"},
+			Spec:       autoscalingV1.ScaleSpec{Replicas: 3},
+			Status:     autoscalingV1.ScaleStatus{Replicas: 3},
+		}, nil
+	})
+	client.AddReactor("update", "deployments", func(action core.Action) (bool, runtime.Object, error) {
+		obj := action.(core.UpdateAction).GetObject().(*autoscalingV1.Scale)
+		if obj.ResourceVersion != "2" {
+			return true, nil, k8serrors.NewConflict(action.GetResource().GroupResource(), obj.Name, nil)
+		}
+
+		obj.ResourceVersion = "3"
+		return true, obj, nil
+	})
+	return client
+}
+
+func TestScaleResource(t *testing.T) {
+	cases := []struct {
+		info            string
+		resourceVersion string
+		expectedCode    int32
+	}{
+		{"Should scale resource without resource version", "", 0},
+		{"Should scale resource with current resource version", "2", 0},
+		{"Should fail with conflict if resource was changed", "1", http.StatusConflict},
+	}
+
+	for _, c := range cases {
+		result, err := scaleResource(newFakeScaleClient(), getGroupResource("deployments"), "default", "test", 5,
+			c.resourceVersion)
+
+		if c.expectedCode == 0 {
+			if err != nil {
+				t.Errorf("Test Case: %s. Expected resource to be scaled, but got error: %s", c.info, err)
+				continue
+			}
+
+			if result.DesiredReplicas != 5 || result.ResourceVersion != "3" {
+				t.Errorf("Test Case: %s. Expected 5 desired replicas in resource version 3, but got %#v", c.info,
+					result)
+			}
+			continue
+		}
+
+		status, ok := err.(k8serrors.APIStatus)
+		if !ok || status.Status().Code != c.expectedCode {
+			t.Errorf("Test Case: %s. Expected error with status %d, but got %v", c.info, c.expectedCode, err)
+		}
+	}
+}
+
+func TestScaleResourceInvalidCount(t *testing.T) {
+	for _, count := range []string{"-1", "many"} {
+		_, err := ScaleResource(nil, "deployment", "default", "test", count, "")
+		if !k8serrors.IsBadRequest(err) {
+			t.Errorf("ScaleResource() with count %q returned %v, expected bad request error", count, err)
+		}
+	}
+}
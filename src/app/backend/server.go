@@ -0,0 +1,163 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Maximum wait time between attempts to connect to the apiserver at startup.
+const maxConnectBackoff = 30 * time.Second
+
+// connectionCounter keeps track of connections opened to dashboard servers, so that number of connections that
+// are still open can be reported when server shutdown times out.
+type connectionCounter struct {
+	open int64
+}
+
+// Track can be used as http.Server ConnState callback.
+func (self *connectionCounter) Track(conn net.Conn, state http.ConnState) {
+	switch state {
+	case http.StateNew:
+		atomic.AddInt64(&self.open, 1)
+	case http.StateClosed, http.StateHijacked:
+		atomic.AddInt64(&self.open, -1)
+	}
+}
+
+// Open returns number of currently open connections.
+func (self *connectionCounter) Open() int64 {
+	return atomic.LoadInt64(&self.open)
+}
+
+// serverAddress returns address in host:port format on which server listens. IPv6 addresses are enclosed in square
+// brackets, i.e. '[::1]:8443', so that they can be told apart from the port.
+func serverAddress(ip net.IP, port int) string {
+	return net.JoinHostPort(ip.String(), strconv.Itoa(port))
+}
+
+// listenUnixSocket listens on the unix socket with given path and sets its file permissions. Socket file left over
+// by the previous run is removed first. Socket file is removed again when the listener is closed during shutdown.
+func listenUnixSocket(path string, mode os.FileMode) (net.Listener, error) {
+	if info, err := os.Stat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s already exists and is not a socket", path)
+		}
+
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, mode); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// disableHTTP2 limits protocols negotiated with ALPN by the TLS server to HTTP/1.1. Non-nil TLSNextProto map stops
+// net/http from enabling HTTP/2 on its own.
+func disableHTTP2(server *http.Server) {
+	server.TLSConfig.NextProtos = []string{"http/1.1"}
+	server.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+}
+
+// connectToAPIServer sends the initial request to the apiserver. Failed request is retried up to given number of
+// times. Wait time between attempts starts with given backoff and is doubled after every attempt, up to
+// maxConnectBackoff.
+func connectToAPIServer(client kubernetes.Interface, retries int, backoff time.Duration) (*version.Info, error) {
+	for attempt := 0; ; attempt++ {
+		versionInfo, err := client.Discovery().ServerVersion()
+		if err == nil || attempt >= retries {
+			return versionInfo, err
+		}
+
+		log.Printf("Initial request to the apiserver failed (attempt %d of %d), retrying in %s: %s", attempt+1,
+			retries+1, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
+	}
+}
+
+// serve runs given function that starts the server in the background. Server closed during shutdown is not
+// treated as an error.
+func serve(listenAndServe func() error) {
+	go func() {
+		if err := listenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+}
+
+// waitForShutdown blocks until SIGTERM or SIGINT is received and then gracefully shuts down all given servers.
+// Servers stop accepting new connections right away, while open ones can finish their requests until timeout
+// passes. After that remaining connections are forcibly closed.
+func waitForShutdown(servers []*http.Server, connections *connectionCounter, timeout time.Duration) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	sig := <-signals
+	log.Printf("Received %s signal, shutting down with %s timeout", sig, timeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	for _, server := range servers {
+		go func(server *http.Server) {
+			if err := server.Shutdown(ctx); err != nil {
+				log.Printf("Error while shutting down server %s: %s", server.Addr, err)
+			}
+			done <- struct{}{}
+		}(server)
+	}
+
+	for range servers {
+		<-done
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		log.Printf("Shutdown timeout exceeded, forcibly closing %d connections that are still open", connections.Open())
+		for _, server := range servers {
+			server.Close()
+		}
+		return
+	}
+
+	log.Print("All connections drained, shutdown completed")
+}
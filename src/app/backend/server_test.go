@@ -0,0 +1,158 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/elliptic"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes/dashboard/src/app/backend/cert/ecdsa"
+)
+
+func TestServerAddress(t *testing.T) {
+	cases := []struct {
+		ip       net.IP
+		port     int
+		expected string
+	}{
+		{net.IPv4(0, 0, 0, 0), 8443, "0.0.0.0:8443"},
+		{net.IPv4(127, 0, 0, 1), 9090, "127.0.0.1:9090"},
+		{net.IPv6unspecified, 8443, "[::]:8443"},
+		{net.IPv6loopback, 9090, "[::1]:9090"},
+		{net.ParseIP("fd00::10"), 8443, "[fd00::10]:8443"},
+	}
+
+	for _, c := range cases {
+		if actual := serverAddress(c.ip, c.port); actual != c.expected {
+			t.Errorf("serverAddress(%s, %d) == %s, expected %s", c.ip, c.port, actual, c.expected)
+		}
+	}
+}
+
+func TestServerAddressListen(t *testing.T) {
+	cases := []net.IP{net.IPv6unspecified, net.IPv6loopback}
+
+	for _, ip := range cases {
+		listener, err := net.Listen("tcp", serverAddress(ip, 0))
+		if err != nil {
+			t.Errorf("Could not listen on %s: %s", ip, err)
+			continue
+		}
+
+		if addr := listener.Addr().(*net.TCPAddr); !addr.IP.Equal(ip) || addr.Port == 0 {
+			t.Errorf("Expected listener on %s, but got %s", ip, addr)
+		}
+		listener.Close()
+	}
+}
+
+func TestConnectToAPIServer(t *testing.T) {
+	cases := []struct {
+		failures         int32
+		retries          int
+		expectedErr      bool
+		expectedAttempts int32
+	}{
+		{0, 0, false, 1},
+		{2, 3, false, 3},
+		{2, 1, true, 2},
+	}
+
+	for _, c := range cases {
+		attempts := int32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) <= c.failures {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"major":"1","minor":"21","gitVersion":"v1.21.2"}`))
+		}))
+
+		client, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		versionInfo, err := connectToAPIServer(client, c.retries, 0)
+		server.Close()
+
+		if (err != nil) != c.expectedErr {
+			t.Errorf("connectToAPIServer() with %d failures and %d retries returned error %v, expected error: %t",
+				c.failures, c.retries, err, c.expectedErr)
+		}
+
+		if err == nil && versionInfo.GitVersion != "v1.21.2" {
+			t.Errorf("connectToAPIServer() returned version %s, expected v1.21.2", versionInfo.GitVersion)
+		}
+
+		if attempts != c.expectedAttempts {
+			t.Errorf("connectToAPIServer() with %d failures and %d retries made %d attempts, expected %d",
+				c.failures, c.retries, attempts, c.expectedAttempts)
+		}
+	}
+}
+
+func TestDisableHTTP2(t *testing.T) {
+	creator := ecdsa.NewECDSACreator("tls.key", "tls.crt", elliptic.P256(), nil)
+	key := creator.GenerateKey()
+	certificate := tls.Certificate{Certificate: [][]byte{creator.GenerateCertificate(key)}, PrivateKey: key}
+
+	cases := []struct {
+		disableHTTP2     bool
+		expectedProtocol string
+	}{
+		{false, "h2"},
+		{true, "http/1.1"},
+	}
+
+	for _, c := range cases {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		server := &http.Server{
+			Handler:   http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+			TLSConfig: &tls.Config{Certificates: []tls.Certificate{certificate}},
+		}
+		if c.disableHTTP2 {
+			disableHTTP2(server)
+		}
+		go server.ServeTLS(listener, "", "")
+
+		conn, err := tls.Dial("tcp", listener.Addr().String(),
+			&tls.Config{InsecureSkipVerify: true, NextProtos: []string{"h2", "http/1.1"}})
+		if err != nil {
+			t.Fatalf("Could not connect to the server: %s", err)
+		}
+
+		if protocol := conn.ConnectionState().NegotiatedProtocol; protocol != c.expectedProtocol {
+			t.Errorf("With HTTP/2 disabled: %t negotiated protocol %s, expected %s", c.disableHTTP2, protocol,
+				c.expectedProtocol)
+		}
+		conn.Close()
+		server.Close()
+	}
+}
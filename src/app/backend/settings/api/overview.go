@@ -0,0 +1,127 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+)
+
+// Limits of the overview definition, so that the overview page can be loaded in reasonable time.
+const (
+	MaxOverviewQueries     = 50
+	MaxOverviewLabelLength = 100
+)
+
+// OverviewQuery is a query of the overview page. Objects of the kind are counted by their status and displayed on a
+// card with the label.
+type OverviewQuery struct {
+	Label string `json:"label"`
+	Kind  string `json:"kind"`
+	// Namespace of the objects. Objects of namespaced kinds are counted in all namespaces if it is empty.
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector of the objects, i.e. 'app=web,tier!=cache'. All objects are counted if it is empty.
+	LabelSelector string `json:"labelSelector,omitempty"`
+}
+
+// Overview is a definition of the overview page.
+type Overview struct {
+	Queries []OverviewQuery `json:"queries"`
+}
+
+// Marshal overview into JSON object.
+func (o Overview) Marshal() string {
+	bytes, _ := json.Marshal(o)
+	return string(bytes)
+}
+
+// UnmarshalOverview unmarshal overview from JSON string into object.
+func UnmarshalOverview(data string) (*Overview, error) {
+	o := new(Overview)
+	err := json.Unmarshal([]byte(data), o)
+	return o, err
+}
+
+// ValidateOverview returns errors of the overview definition fields. Returns empty list if definition is valid.
+func ValidateOverview(o *Overview) field.ErrorList {
+	errs := field.ErrorList{}
+	queriesPath := field.NewPath("queries")
+	if len(o.Queries) > MaxOverviewQueries {
+		errs = append(errs, field.TooMany(queriesPath, len(o.Queries), MaxOverviewQueries))
+	}
+
+	labels := map[string]bool{}
+	for i, query := range o.Queries {
+		path := queriesPath.Index(i)
+		switch {
+		case len(query.Label) == 0:
+			errs = append(errs, field.Required(path.Child("label"), ""))
+		case len(query.Label) > MaxOverviewLabelLength:
+			errs = append(errs, field.TooLong(path.Child("label"), query.Label, MaxOverviewLabelLength))
+		case labels[query.Label]:
+			errs = append(errs, field.Duplicate(path.Child("label"), query.Label))
+		}
+		labels[query.Label] = true
+
+		errs = append(errs, validateOverviewQuery(&query, path)...)
+	}
+
+	return errs
+}
+
+func validateOverviewQuery(query *OverviewQuery, path *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	mapping, supported := api.KindToAPIMapping[query.Kind]
+	switch {
+	case len(query.Kind) == 0:
+		errs = append(errs, field.Required(path.Child("kind"), ""))
+	case !supported:
+		errs = append(errs, field.NotSupported(path.Child("kind"), query.Kind, supportedKinds()))
+	}
+
+	if len(query.Namespace) > 0 {
+		if supported && !mapping.Namespaced {
+			errs = append(errs, field.Invalid(path.Child("namespace"), query.Namespace,
+				fmt.Sprintf("%s is not namespaced", query.Kind)))
+		}
+
+		for _, msg := range validation.IsDNS1123Label(query.Namespace) {
+			errs = append(errs, field.Invalid(path.Child("namespace"), query.Namespace, msg))
+		}
+	}
+
+	if _, err := labels.Parse(query.LabelSelector); err != nil {
+		errs = append(errs, field.Invalid(path.Child("labelSelector"), query.LabelSelector, err.Error()))
+	}
+
+	return errs
+}
+
+func supportedKinds() []string {
+	kinds := make([]string, 0, len(api.KindToAPIMapping))
+	for kind := range api.KindToAPIMapping {
+		kinds = append(kinds, kind)
+	}
+
+	sort.Strings(kinds)
+	return kinds
+}
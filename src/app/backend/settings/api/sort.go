@@ -0,0 +1,106 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+// Orders of the default sort. Keep them in sync with the order query parameter of lists.
+var defaultSortOrders = []string{"asc", "desc"}
+
+// DefaultSort is the order of resource list used when the list is requested without 'sortBy' parameter.
+type DefaultSort struct {
+	// SortBy is a list of properties, i.e. ['lastTimestamp', 'name'].
+	SortBy []string `json:"sortBy"`
+	// Order is either 'asc' or 'desc'. Lists are sorted in ascending order if it is empty.
+	Order string `json:"order,omitempty"`
+}
+
+// MarshalDefaultSorts marshal default sorts by resource kind into JSON object.
+func MarshalDefaultSorts(s map[string]DefaultSort) string {
+	bytes, _ := json.Marshal(s)
+	return string(bytes)
+}
+
+// UnmarshalDefaultSorts unmarshal default sorts by resource kind into object.
+func UnmarshalDefaultSorts(data string) (map[string]DefaultSort, error) {
+	sorts := map[string]DefaultSort{}
+	err := json.Unmarshal([]byte(data), &sorts)
+	return sorts, err
+}
+
+// ValidateDefaultSorts returns errors of the default sorts by resource kind. Kinds have to be supported and sorted
+// only by properties that their lists provide. Returns empty list if default sorts are valid.
+func ValidateDefaultSorts(sorts map[string]DefaultSort) field.ErrorList {
+	kinds := make([]string, 0, len(sorts))
+	for kind := range sorts {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	errs := field.ErrorList{}
+	for _, kind := range kinds {
+		path := field.NewPath(kind)
+		if _, supported := api.KindToAPIMapping[kind]; !supported {
+			errs = append(errs, field.NotSupported(path, kind, supportedKinds()))
+			continue
+		}
+
+		errs = append(errs, validateDefaultSort(sorts[kind], dataselect.GetKindSortableProperties(kind), path)...)
+	}
+
+	return errs
+}
+
+func validateDefaultSort(s DefaultSort, properties []dataselect.PropertyName, path *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if len(s.SortBy) == 0 {
+		errs = append(errs, field.Required(path.Child("sortBy"), ""))
+	}
+
+	supported := make([]string, len(properties))
+	for i, property := range properties {
+		supported[i] = string(property)
+	}
+
+	for i, property := range s.SortBy {
+		if !containsString(supported, property) {
+			errs = append(errs, field.NotSupported(path.Child("sortBy").Index(i), property, supported))
+		}
+	}
+
+	if len(s.Order) > 0 && !containsString(defaultSortOrders, s.Order) {
+		errs = append(errs, field.NotSupported(path.Child("order"), s.Order, defaultSortOrders))
+	}
+
+	return errs
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
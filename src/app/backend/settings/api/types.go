@@ -16,10 +16,13 @@ package api
 
 import (
 	"encoding/json"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
 )
 
 const (
@@ -38,6 +41,21 @@ const (
 	// PinnedResourcesKey is a settings map key which maps to current pinned resources.
 	PinnedResourcesKey = "_pinnedCRD"
 
+	// AuthenticationModesKey is a settings map key which maps to comma separated list of authentication modes that
+	// override modes passed with --authentication-mode.
+	AuthenticationModesKey = "_authenticationModes"
+
+	// CustomColumnsKey is a settings map key which maps to JSON object with lists of custom columns of resource
+	// lists by resource kind.
+	CustomColumnsKey = "_customColumns"
+
+	// OverviewKey is a settings map key which maps to JSON object with the definition of the overview page.
+	OverviewKey = "_overview"
+
+	// DefaultSortKey is a settings map key which maps to JSON object with default sort of resource lists by resource
+	// kind.
+	DefaultSortKey = "_defaultSort"
+
 	// ConcurrentSettingsChangeError occurs during settings save if settings were modified concurrently.
 	// Keep it in sync with CONCURRENT_CHANGE_ERROR constant from the frontend.
 	ConcurrentSettingsChangeError = "settings changed since last reload"
@@ -47,6 +65,9 @@ const (
 
 	// ResourceAlreadyPinnedError occurs while pinning a new resource, if it has been pinned before.
 	ResourceAlreadyPinnedError = "resource already pinned"
+
+	// SettingsReadOnlyError occurs while changing settings, if dashboard runs with --settings-read-only flag.
+	SettingsReadOnlyError = "settings are read-only and can only be changed in the settings config map"
 )
 
 // SettingsManager is used for user settings management.
@@ -61,6 +82,25 @@ type SettingsManager interface {
 	SavePinnedResource(client kubernetes.Interface, r *PinnedResource) error
 	// DeletePinnedResource removes a pinned resource from config map.
 	DeletePinnedResource(client kubernetes.Interface, r *PinnedResource) error
+	// GetAuthenticationModes gets authentication modes from config map. Returns nil if they are not set and modes
+	// passed with --authentication-mode should be used.
+	GetAuthenticationModes(client kubernetes.Interface) []string
+	// GetCustomColumns gets custom columns of resource lists by resource kind from config map.
+	GetCustomColumns(client kubernetes.Interface) map[string][]CustomColumn
+	// GetOverview gets definition of the overview page from config map.
+	GetOverview(client kubernetes.Interface) Overview
+	// SaveOverview validates and saves provided definition of the overview page in config map.
+	SaveOverview(client kubernetes.Interface, o *Overview) error
+	// GetDefaultSorts gets default sort of resource lists by resource kind from config map.
+	GetDefaultSorts(client kubernetes.Interface) map[string]DefaultSort
+	// SaveDefaultSorts validates and saves provided default sort of resource lists by resource kind in config map.
+	SaveDefaultSorts(client kubernetes.Interface, s map[string]DefaultSort) error
+	// EnableDefaultSortSync makes CachedDefaultSorts serve default sorts of the config map kept up to date by given
+	// synchronizer.
+	EnableDefaultSortSync(synchronizer syncApi.Synchronizer)
+	// CachedDefaultSorts gets default sorts loaded by the synchronizer or saved by SaveDefaultSorts without reading
+	// config map, so it can be used on every list request.
+	CachedDefaultSorts() map[string]DefaultSort
 }
 
 // PinnedResource represents a pinned resource.
@@ -89,6 +129,33 @@ func UnmarshalPinnedResources(data string) (*[]PinnedResource, error) {
 	return p, err
 }
 
+// UnmarshalAuthenticationModes parses comma separated list of authentication modes. Surrounding whitespace and empty
+// entries are skipped.
+func UnmarshalAuthenticationModes(data string) []string {
+	modes := []string{}
+	for _, mode := range strings.Split(data, ",") {
+		if mode = strings.TrimSpace(mode); len(mode) > 0 {
+			modes = append(modes, mode)
+		}
+	}
+
+	return modes
+}
+
+// CustomColumn is a column of resource list with values extracted from the objects with JSONPath expression, i.e.
+// '{.metadata.labels.team}'.
+type CustomColumn struct {
+	Name     string `json:"name"`
+	JSONPath string `json:"jsonPath"`
+}
+
+// UnmarshalCustomColumns unmarshal custom columns by resource kind into object.
+func UnmarshalCustomColumns(data string) (map[string][]CustomColumn, error) {
+	columns := map[string][]CustomColumn{}
+	err := json.Unmarshal([]byte(data), &columns)
+	return columns, err
+}
+
 // Settings is a single instance of settings without context.
 type Settings struct {
 	ClusterName                      string   `json:"clusterName"`
@@ -18,6 +18,9 @@ import (
 	"net/http"
 
 	restful "github.com/emicklei/go-restful/v3"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
@@ -29,6 +32,8 @@ import (
 type SettingsHandler struct {
 	manager       api.SettingsManager
 	clientManager clientapi.ClientManager
+	// Returns an error if objects of the overview query can not be accessed with the current arguments.
+	checkOverviewQuery func(query api.OverviewQuery) error
 }
 
 // Install creates new endpoints for settings management.
@@ -46,6 +51,26 @@ func (self *SettingsHandler) Install(ws *restful.WebService) {
 			Reads(api.Settings{}).
 			Writes(api.Settings{}))
 
+	ws.Route(
+		ws.GET("/settings/overview").
+			To(self.handleSettingsGetOverview).
+			Writes(api.Overview{}))
+	ws.Route(
+		ws.PUT("/settings/overview").
+			To(self.handleSettingsSaveOverview).
+			Reads(api.Overview{}).
+			Writes(api.Overview{}))
+
+	ws.Route(
+		ws.GET("/settings/defaultsort").
+			To(self.handleSettingsGetDefaultSorts).
+			Writes(map[string]api.DefaultSort{}))
+	ws.Route(
+		ws.PUT("/settings/defaultsort").
+			To(self.handleSettingsSaveDefaultSorts).
+			Reads(map[string]api.DefaultSort{}).
+			Writes(map[string]api.DefaultSort{}))
+
 	ws.Route(
 		ws.GET("/settings/pinner").
 			To(self.handleSettingsGetPinned))
@@ -70,7 +95,7 @@ func (self *SettingsHandler) handleSettingsGlobalCanI(request *restful.Request,
 	}
 
 	canI := self.clientManager.CanI(request, clientapi.ToSelfSubjectAccessReview(
-		args.Holder.GetNamespace(),
+		Namespace(),
 		api.SettingsConfigMapName,
 		api.ConfigMapKindName,
 		verb,
@@ -80,6 +105,10 @@ func (self *SettingsHandler) handleSettingsGlobalCanI(request *restful.Request,
 		canI = true
 	}
 
+	if args.Holder.GetSettingsReadOnly() && verb != http.MethodGet {
+		canI = false
+	}
+
 	response.WriteHeaderAndEntity(http.StatusOK, clientapi.CanIResponse{Allowed: canI})
 }
 
@@ -109,6 +138,96 @@ func (self *SettingsHandler) handleSettingsGlobalSave(request *restful.Request,
 	response.WriteHeaderAndEntity(http.StatusCreated, settings)
 }
 
+func (self *SettingsHandler) handleSettingsGetOverview(request *restful.Request, response *restful.Response) {
+	client := self.clientManager.InsecureClient()
+	result := self.manager.GetOverview(client)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Saves definition of the overview page. Invalid definitions and queries of objects that can not be accessed are
+// rejected with the status that lists errors of the fields in its details, so that they can be shown next to the
+// fields.
+func (self *SettingsHandler) handleSettingsSaveOverview(request *restful.Request, response *restful.Response) {
+	overview := new(api.Overview)
+	if err := request.ReadEntity(overview); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if errs := self.checkOverview(overview); len(errs) > 0 {
+		handleSettingsValidationError(response,
+			k8sErrors.NewInvalid(schema.GroupKind{Kind: "Overview"}, api.OverviewKey, errs))
+		return
+	}
+
+	client, err := self.clientManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := self.manager.SaveOverview(client, overview); err != nil {
+		handleSettingsValidationError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, overview)
+}
+
+// Returns errors of the overview queries rejected by the check.
+func (self *SettingsHandler) checkOverview(overview *api.Overview) field.ErrorList {
+	errs := field.ErrorList{}
+	if self.checkOverviewQuery == nil {
+		return errs
+	}
+
+	for i, query := range overview.Queries {
+		if err := self.checkOverviewQuery(query); err != nil {
+			errs = append(errs, field.Forbidden(field.NewPath("queries").Index(i), err.Error()))
+		}
+	}
+
+	return errs
+}
+
+func (self *SettingsHandler) handleSettingsGetDefaultSorts(request *restful.Request, response *restful.Response) {
+	client := self.clientManager.InsecureClient()
+	result := self.manager.GetDefaultSorts(client)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Saves default sort of resource lists. Sorts by properties that lists of the kind do not provide are rejected the
+// same way as invalid definitions of the overview page.
+func (self *SettingsHandler) handleSettingsSaveDefaultSorts(request *restful.Request, response *restful.Response) {
+	sorts := map[string]api.DefaultSort{}
+	if err := request.ReadEntity(&sorts); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	client, err := self.clientManager.Client(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := self.manager.SaveDefaultSorts(client, sorts); err != nil {
+		handleSettingsValidationError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, sorts)
+}
+
+// Writes status with errors of the fields if settings are invalid, so they can be shown next to the fields.
+func handleSettingsValidationError(response *restful.Response, err error) {
+	if statusError, ok := err.(*k8sErrors.StatusError); ok && statusError.Status().Details != nil &&
+		len(statusError.Status().Details.Causes) > 0 {
+		response.WriteHeaderAndEntity(int(statusError.Status().Code), statusError.Status())
+		return
+	}
+
+	errors.HandleInternalError(response, err)
+}
+
 func (self *SettingsHandler) handleSettingsGetPinned(request *restful.Request, response *restful.Response) {
 	client := self.clientManager.InsecureClient()
 	result := self.manager.GetPinnedResources(client)
@@ -155,7 +274,8 @@ func (self *SettingsHandler) handleSettingsDeletePinned(request *restful.Request
 	response.WriteHeader(http.StatusNoContent)
 }
 
-// NewSettingsHandler creates SettingsHandler.
-func NewSettingsHandler(manager api.SettingsManager, clientManager clientapi.ClientManager) SettingsHandler {
-	return SettingsHandler{manager: manager, clientManager: clientManager}
+// NewSettingsHandler creates SettingsHandler. Overview queries rejected by given check can not be saved.
+func NewSettingsHandler(manager api.SettingsManager, clientManager clientapi.ClientManager,
+	checkOverviewQuery func(query api.OverviewQuery) error) SettingsHandler {
+	return SettingsHandler{manager: manager, clientManager: clientManager, checkOverviewQuery: checkOverviewQuery}
 }
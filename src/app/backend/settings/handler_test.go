@@ -15,13 +15,16 @@
 package settings
 
 import (
+	"errors"
 	"testing"
 
 	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/kubernetes/dashboard/src/app/backend/settings/api"
 )
 
 func TestIntegrationHandler_Install(t *testing.T) {
-	iHandler := NewSettingsHandler(NewSettingsManager(), nil)
+	iHandler := NewSettingsHandler(NewSettingsManager(), nil, nil)
 	ws := new(restful.WebService)
 	iHandler.Install(ws)
 
@@ -29,3 +32,22 @@ func TestIntegrationHandler_Install(t *testing.T) {
 		t.Error("Failed to install routes.")
 	}
 }
+
+func TestSettingsHandlerCheckOverview(t *testing.T) {
+	check := func(query api.OverviewQuery) error {
+		if query.Kind == "secret" {
+			return errors.New("Resource secrets is not allowed")
+		}
+		return nil
+	}
+	handler := NewSettingsHandler(NewSettingsManager(), nil, check)
+	overview := &api.Overview{Queries: []api.OverviewQuery{
+		{Label: "Pods", Kind: "pod"},
+		{Label: "Secrets", Kind: "secret"},
+	}}
+
+	errs := handler.checkOverview(overview)
+	if len(errs) != 1 || errs[0].Field != "queries[1]" || errs[0].Detail != "Resource secrets is not allowed" {
+		t.Errorf("Expected forbidden error of the second query, but got %v", errs)
+	}
+}
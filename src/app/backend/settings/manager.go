@@ -22,20 +22,33 @@ import (
 	"sync"
 
 	v1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/settings/api"
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
 )
 
 // SettingsManager is a structure containing all settings manager members.
 type SettingsManager struct {
-	settings        map[string]api.Settings
-	pinnedResources []api.PinnedResource
-	rawSettings     map[string]string
-	mux             sync.Mutex
+	settings            map[string]api.Settings
+	pinnedResources     []api.PinnedResource
+	authenticationModes []string
+	customColumns       map[string][]api.CustomColumn
+	overview            api.Overview
+	defaultSorts        map[string]api.DefaultSort
+	rawSettings         map[string]string
+	mux                 sync.Mutex
+
+	// Default sorts parsed from the config map kept up to date by the synchronizer, used by every list request.
+	cachedDefaultSorts map[string]api.DefaultSort
+	cacheMux           sync.RWMutex
 }
 
 // NewSettingsManager creates new settings manager.
@@ -48,11 +61,13 @@ func NewSettingsManager() api.SettingsManager {
 
 // load config map data into settings manager and return true if new settings are different.
 func (sm *SettingsManager) load(client kubernetes.Interface) (configMap *v1.ConfigMap, isDifferent bool) {
-	configMap, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).
+	configMap, err := client.CoreV1().ConfigMaps(Namespace()).
 		Get(context.TODO(), api.SettingsConfigMapName, metav1.GetOptions{})
 	if err != nil {
 		log.Printf("Cannot find settings config map: %s", err.Error())
-		sm.restoreConfigMap(client)
+		if !args.Holder.GetSettingsReadOnly() {
+			sm.restoreConfigMap(client)
+		}
 		return
 	}
 
@@ -64,9 +79,36 @@ func (sm *SettingsManager) load(client kubernetes.Interface) (configMap *v1.Conf
 		defer sm.mux.Unlock()
 		sm.rawSettings = configMap.Data
 		sm.settings = make(map[string]api.Settings)
+		sm.authenticationModes = nil
+		sm.customColumns = nil
+		sm.overview = api.Overview{}
+		sm.defaultSorts = nil
 
 		for key, value := range sm.rawSettings {
-			if key == api.PinnedResourcesKey {
+			if key == api.AuthenticationModesKey {
+				sm.authenticationModes = api.UnmarshalAuthenticationModes(value)
+			} else if key == api.CustomColumnsKey {
+				c, err := api.UnmarshalCustomColumns(value)
+				if err != nil {
+					log.Printf("Cannot unmarshal settings key %s with %s value: %s", key, value, err.Error())
+				} else {
+					sm.customColumns = c
+				}
+			} else if key == api.OverviewKey {
+				o, err := api.UnmarshalOverview(value)
+				if err != nil {
+					log.Printf("Cannot unmarshal settings key %s with %s value: %s", key, value, err.Error())
+				} else {
+					sm.overview = *o
+				}
+			} else if key == api.DefaultSortKey {
+				d, err := api.UnmarshalDefaultSorts(value)
+				if err != nil {
+					log.Printf("Cannot unmarshal settings key %s with %s value: %s", key, value, err.Error())
+				} else {
+					sm.defaultSorts = d
+				}
+			} else if key == api.PinnedResourcesKey {
 				p, err := api.UnmarshalPinnedResources(value)
 				if err != nil {
 					log.Printf("Cannot unmarshal settings key %s with %s value: %s", key, value, err.Error())
@@ -89,8 +131,8 @@ func (sm *SettingsManager) load(client kubernetes.Interface) (configMap *v1.Conf
 
 // restoreConfigMap restores settings config map using default global settings.
 func (sm *SettingsManager) restoreConfigMap(client kubernetes.Interface) {
-	restoredConfigMap, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).
-		Create(context.TODO(), api.GetDefaultSettingsConfigMap(args.Holder.GetNamespace()), metav1.CreateOptions{})
+	restoredConfigMap, err := client.CoreV1().ConfigMaps(Namespace()).
+		Create(context.TODO(), api.GetDefaultSettingsConfigMap(Namespace()), metav1.CreateOptions{})
 	if err != nil {
 		log.Printf("Cannot restore settings config map: %s", err.Error())
 	} else {
@@ -100,6 +142,16 @@ func (sm *SettingsManager) restoreConfigMap(client kubernetes.Interface) {
 	}
 }
 
+// Namespace returns namespace of the settings config map. Dashboard namespace is used if --settings-namespace is not
+// set.
+func Namespace() string {
+	if namespace := args.Holder.GetSettingsNamespace(); len(namespace) > 0 {
+		return namespace
+	}
+
+	return args.Holder.GetNamespace()
+}
+
 // GetGlobalSettings implements SettingsManager interface. Check it for more information.
 func (sm *SettingsManager) GetGlobalSettings(client kubernetes.Interface) api.Settings {
 	cm, _ := sm.load(client)
@@ -117,6 +169,10 @@ func (sm *SettingsManager) GetGlobalSettings(client kubernetes.Interface) api.Se
 
 // GetGlobalSettings implements SettingsManager interface. Check it for more information.
 func (sm *SettingsManager) SaveGlobalSettings(client kubernetes.Interface, s *api.Settings) error {
+	if args.Holder.GetSettingsReadOnly() {
+		return errors.NewForbidden(api.SettingsReadOnlyError)
+	}
+
 	cm, isDiff := sm.load(client)
 	if isDiff {
 		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
@@ -129,10 +185,159 @@ func (sm *SettingsManager) SaveGlobalSettings(client kubernetes.Interface, s *ap
 
 	defer sm.load(client)
 	cm.Data[api.GlobalSettingsKey] = s.Marshal()
-	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	_, err := client.CoreV1().ConfigMaps(Namespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	return err
+}
+
+// GetAuthenticationModes implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) GetAuthenticationModes(client kubernetes.Interface) []string {
+	cm, _ := sm.load(client)
+	if cm == nil {
+		return nil
+	}
+
+	return sm.authenticationModes
+}
+
+// GetCustomColumns implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) GetCustomColumns(client kubernetes.Interface) map[string][]api.CustomColumn {
+	cm, _ := sm.load(client)
+	if cm == nil {
+		return nil
+	}
+
+	return sm.customColumns
+}
+
+// GetOverview implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) GetOverview(client kubernetes.Interface) api.Overview {
+	cm, _ := sm.load(client)
+	if cm == nil || sm.overview.Queries == nil {
+		return api.Overview{Queries: []api.OverviewQuery{}}
+	}
+
+	return sm.overview
+}
+
+// SaveOverview implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) SaveOverview(client kubernetes.Interface, o *api.Overview) error {
+	if args.Holder.GetSettingsReadOnly() {
+		return errors.NewForbidden(api.SettingsReadOnlyError)
+	}
+
+	if errs := api.ValidateOverview(o); len(errs) > 0 {
+		return k8sErrors.NewInvalid(schema.GroupKind{Kind: "Overview"}, api.OverviewKey, errs)
+	}
+
+	cm, isDiff := sm.load(client)
+	if isDiff {
+		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
+	}
+
+	// Data can be nil if the configMap exists but does not have any data
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+
+	defer sm.load(client)
+	cm.Data[api.OverviewKey] = o.Marshal()
+	_, err := client.CoreV1().ConfigMaps(Namespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
 	return err
 }
 
+// GetDefaultSorts implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) GetDefaultSorts(client kubernetes.Interface) map[string]api.DefaultSort {
+	cm, _ := sm.load(client)
+	if cm == nil || sm.defaultSorts == nil {
+		return map[string]api.DefaultSort{}
+	}
+
+	return sm.defaultSorts
+}
+
+// SaveDefaultSorts implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) SaveDefaultSorts(client kubernetes.Interface, s map[string]api.DefaultSort) error {
+	if args.Holder.GetSettingsReadOnly() {
+		return errors.NewForbidden(api.SettingsReadOnlyError)
+	}
+
+	if errs := api.ValidateDefaultSorts(s); len(errs) > 0 {
+		return k8sErrors.NewInvalid(schema.GroupKind{Kind: "DefaultSort"}, api.DefaultSortKey, errs)
+	}
+
+	cm, isDiff := sm.load(client)
+	if isDiff {
+		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
+	}
+
+	// Data can be nil if the configMap exists but does not have any data
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+
+	defer sm.load(client)
+	cm.Data[api.DefaultSortKey] = api.MarshalDefaultSorts(s)
+	_, err := client.CoreV1().ConfigMaps(Namespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	sm.setCachedDefaultSorts(s)
+	return nil
+}
+
+// EnableDefaultSortSync implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) EnableDefaultSortSync(synchronizer syncApi.Synchronizer) {
+	sm.loadCachedDefaultSorts(synchronizer.Get())
+
+	synchronizer.RegisterActionHandler(sm.loadCachedDefaultSorts, watch.Added, watch.Modified)
+	synchronizer.RegisterActionHandler(func(runtime.Object) {
+		sm.loadCachedDefaultSorts(nil)
+	}, watch.Deleted)
+}
+
+// CachedDefaultSorts implements SettingsManager interface. Check it for more information.
+func (sm *SettingsManager) CachedDefaultSorts() map[string]api.DefaultSort {
+	sm.cacheMux.RLock()
+	defer sm.cacheMux.RUnlock()
+
+	if sm.cachedDefaultSorts == nil {
+		return map[string]api.DefaultSort{}
+	}
+
+	return sm.cachedDefaultSorts
+}
+
+// Parses default sorts of the config map. Previous default sorts are kept if they can not be parsed, so that a typo
+// in the config map does not reset them.
+func (sm *SettingsManager) loadCachedDefaultSorts(obj runtime.Object) {
+	configMap, ok := obj.(*v1.ConfigMap)
+	if !ok || configMap == nil {
+		sm.setCachedDefaultSorts(nil)
+		return
+	}
+
+	value, exists := configMap.Data[api.DefaultSortKey]
+	if !exists {
+		sm.setCachedDefaultSorts(nil)
+		return
+	}
+
+	sorts, err := api.UnmarshalDefaultSorts(value)
+	if err != nil {
+		log.Printf("Cannot unmarshal settings key %s with %s value: %s", api.DefaultSortKey, value, err.Error())
+		return
+	}
+
+	sm.setCachedDefaultSorts(sorts)
+}
+
+func (sm *SettingsManager) setCachedDefaultSorts(sorts map[string]api.DefaultSort) {
+	sm.cacheMux.Lock()
+	defer sm.cacheMux.Unlock()
+	sm.cachedDefaultSorts = sorts
+}
+
 func (sm *SettingsManager) GetPinnedResources(client kubernetes.Interface) (r []api.PinnedResource) {
 	cm, _ := sm.load(client)
 	if cm == nil {
@@ -143,6 +348,10 @@ func (sm *SettingsManager) GetPinnedResources(client kubernetes.Interface) (r []
 }
 
 func (sm *SettingsManager) SavePinnedResource(client kubernetes.Interface, r *api.PinnedResource) error {
+	if args.Holder.GetSettingsReadOnly() {
+		return errors.NewForbidden(api.SettingsReadOnlyError)
+	}
+
 	cm, isDiff := sm.load(client)
 	if isDiff {
 		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
@@ -167,11 +376,15 @@ func (sm *SettingsManager) SavePinnedResource(client kubernetes.Interface, r *ap
 	defer sm.load(client)
 	sm.pinnedResources = append(sm.pinnedResources, *r)
 	cm.Data[api.PinnedResourcesKey] = api.MarshalPinnedResources(sm.pinnedResources)
-	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	_, err := client.CoreV1().ConfigMaps(Namespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
 	return err
 }
 
 func (sm *SettingsManager) DeletePinnedResource(client kubernetes.Interface, r *api.PinnedResource) error {
+	if args.Holder.GetSettingsReadOnly() {
+		return errors.NewForbidden(api.SettingsReadOnlyError)
+	}
+
 	cm, isDiff := sm.load(client)
 	if isDiff {
 		return errors.NewInvalid(api.ConcurrentSettingsChangeError)
@@ -196,6 +409,6 @@ func (sm *SettingsManager) DeletePinnedResource(client kubernetes.Interface, r *
 	defer sm.load(client)
 	sm.pinnedResources = append(sm.pinnedResources[:index], sm.pinnedResources[index+1:]...)
 	cm.Data[api.PinnedResourcesKey] = api.MarshalPinnedResources(sm.pinnedResources)
-	_, err := client.CoreV1().ConfigMaps(args.Holder.GetNamespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	_, err := client.CoreV1().ConfigMaps(Namespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
 	return err
 }
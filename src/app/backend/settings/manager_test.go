@@ -15,11 +15,22 @@
 package settings
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
-	"github.com/kubernetes/dashboard/src/app/backend/settings/api"
+	v1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/settings/api"
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
 )
 
 func TestNewSettingsManager(t *testing.T) {
@@ -63,3 +74,263 @@ func TestSettingsManager_SaveGlobalSettings(t *testing.T) {
 			err.Error())
 	}
 }
+
+func TestSettingsManager_SettingsNamespace(t *testing.T) {
+	defer func() {
+		args.GetHolderBuilder().SetNamespace("").SetSettingsNamespace("")
+	}()
+	args.GetHolderBuilder().SetNamespace("kubernetes-dashboard").SetSettingsNamespace("gitops")
+
+	sm := NewSettingsManager()
+	client := fake.NewSimpleClientset()
+	sm.GetGlobalSettings(client)
+
+	if _, err := client.CoreV1().ConfigMaps("gitops").Get(context.TODO(), api.SettingsConfigMapName,
+		metav1.GetOptions{}); err != nil {
+		t.Errorf("it should restore settings config map in the settings namespace instead of failing with \"%s\" error",
+			err.Error())
+	}
+}
+
+func TestSettingsManager_ReadOnly(t *testing.T) {
+	defer args.GetHolderBuilder().SetSettingsReadOnly(false)
+	args.GetHolderBuilder().SetSettingsReadOnly(true)
+
+	sm := NewSettingsManager()
+	client := fake.NewSimpleClientset()
+	if gs := sm.GetGlobalSettings(client); !reflect.DeepEqual(api.GetDefaultSettings(), gs) {
+		t.Errorf("it should return default settings \"%v\" instead of \"%v\"", api.GetDefaultSettings(), gs)
+	}
+
+	if list, _ := client.CoreV1().ConfigMaps("").List(context.TODO(), metav1.ListOptions{}); len(list.Items) > 0 {
+		t.Error("it should not restore settings config map in read-only mode")
+	}
+
+	defaults := api.GetDefaultSettings()
+	if err := sm.SaveGlobalSettings(client, &defaults); !errors.IsForbiddenError(err) {
+		t.Errorf("it should reject saving settings in read-only mode instead of returning \"%v\" error", err)
+	}
+
+	if err := sm.SavePinnedResource(client, &api.PinnedResource{Kind: "crd", Name: "foo"}); !errors.IsForbiddenError(err) {
+		t.Errorf("it should reject pinning resources in read-only mode instead of returning \"%v\" error", err)
+	}
+}
+
+func TestSettingsManager_GetAuthenticationModes(t *testing.T) {
+	sm := NewSettingsManager()
+	client := fake.NewSimpleClientset(api.GetDefaultSettingsConfigMap(""))
+
+	if modes := sm.GetAuthenticationModes(client); modes != nil {
+		t.Errorf("it should return nil if authentication modes are not set instead of \"%v\"", modes)
+	}
+
+	configMap := api.GetDefaultSettingsConfigMap("")
+	configMap.Data[api.AuthenticationModesKey] = " token, basic ,"
+	if _, err := client.CoreV1().ConfigMaps("").Update(context.TODO(), configMap, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"token", "basic"}
+	if modes := sm.GetAuthenticationModes(client); !reflect.DeepEqual(modes, expected) {
+		t.Errorf("it should return authentication modes \"%v\" instead of \"%v\"", expected, modes)
+	}
+
+	if settings := sm.(*SettingsManager).settings; len(settings) != 1 {
+		t.Errorf("it should not treat authentication modes as settings, got \"%v\"", settings)
+	}
+}
+
+func TestSettingsManager_GetCustomColumns(t *testing.T) {
+	sm := NewSettingsManager()
+	configMap := api.GetDefaultSettingsConfigMap("")
+	configMap.Data[api.CustomColumnsKey] = `{"pod":[{"name":"Team","jsonPath":"{.metadata.labels.team}"}]}`
+	client := fake.NewSimpleClientset(configMap)
+
+	expected := map[string][]api.CustomColumn{"pod": {{Name: "Team", JSONPath: "{.metadata.labels.team}"}}}
+	if columns := sm.GetCustomColumns(client); !reflect.DeepEqual(columns, expected) {
+		t.Errorf("it should return custom columns \"%v\" instead of \"%v\"", expected, columns)
+	}
+
+	if settings := sm.(*SettingsManager).settings; len(settings) != 1 {
+		t.Errorf("it should not treat custom columns as settings, got \"%v\"", settings)
+	}
+}
+
+func TestSettingsManager_SaveOverview(t *testing.T) {
+	sm := NewSettingsManager()
+	client := fake.NewSimpleClientset(api.GetDefaultSettingsConfigMap(""))
+
+	if overview := sm.GetOverview(client); overview.Queries == nil || len(overview.Queries) > 0 {
+		t.Errorf("it should return empty overview if it is not set instead of \"%v\"", overview)
+	}
+
+	overview := &api.Overview{Queries: []api.OverviewQuery{
+		{Label: "Web pods", Kind: "pod", Namespace: "default", LabelSelector: "app=web"},
+		{Label: "Nodes", Kind: "node"},
+	}}
+	if err := sm.SaveOverview(client, overview); err != nil {
+		t.Fatalf("it should save valid overview instead of returning \"%v\" error", err)
+	}
+
+	if saved := sm.GetOverview(client); !reflect.DeepEqual(saved, *overview) {
+		t.Errorf("it should return saved overview \"%v\" instead of \"%v\"", *overview, saved)
+	}
+
+	if settings := sm.(*SettingsManager).settings; len(settings) != 1 {
+		t.Errorf("it should not treat overview as settings, got \"%v\"", settings)
+	}
+}
+
+func TestSettingsManager_SaveOverviewInvalid(t *testing.T) {
+	sm := NewSettingsManager()
+	client := fake.NewSimpleClientset(api.GetDefaultSettingsConfigMap(""))
+
+	overview := &api.Overview{Queries: []api.OverviewQuery{
+		{Label: "Pods", Kind: "pod", LabelSelector: "app in (web"},
+		{Label: "Pods", Kind: "node", Namespace: "default"},
+		{Kind: "unknown", Namespace: "Invalid_Namespace"},
+	}}
+	err := sm.SaveOverview(client, overview)
+	statusError, ok := err.(*k8sErrors.StatusError)
+	if !ok || !k8sErrors.IsInvalid(err) {
+		t.Fatalf("it should reject invalid overview with invalid status error instead of \"%v\"", err)
+	}
+
+	fields := []string{}
+	for _, cause := range statusError.Status().Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+
+	expected := []string{"queries[0].labelSelector", "queries[1].label", "queries[1].namespace", "queries[2].label",
+		"queries[2].kind", "queries[2].namespace"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("it should report errors of fields \"%v\" instead of \"%v\"", expected, fields)
+	}
+
+	if saved := sm.GetOverview(client); len(saved.Queries) > 0 {
+		t.Errorf("it should not save invalid overview, got \"%v\"", saved)
+	}
+}
+
+func TestSettingsManager_SaveDefaultSorts(t *testing.T) {
+	sm := NewSettingsManager()
+	client := fake.NewSimpleClientset(api.GetDefaultSettingsConfigMap(""))
+
+	if sorts := sm.GetDefaultSorts(client); sorts == nil || len(sorts) > 0 {
+		t.Errorf("it should return empty default sorts if they are not set instead of \"%v\"", sorts)
+	}
+
+	sorts := map[string]api.DefaultSort{
+		"pod":   {SortBy: []string{"creationTimestamp"}, Order: "desc"},
+		"event": {SortBy: []string{"lastTimestamp", "name"}},
+	}
+	if err := sm.SaveDefaultSorts(client, sorts); err != nil {
+		t.Fatalf("it should save valid default sorts instead of returning \"%v\" error", err)
+	}
+
+	if saved := sm.GetDefaultSorts(client); !reflect.DeepEqual(saved, sorts) {
+		t.Errorf("it should return saved default sorts \"%v\" instead of \"%v\"", sorts, saved)
+	}
+
+	if cached := sm.CachedDefaultSorts(); !reflect.DeepEqual(cached, sorts) {
+		t.Errorf("it should cache saved default sorts \"%v\" instead of \"%v\"", sorts, cached)
+	}
+
+	if settings := sm.(*SettingsManager).settings; len(settings) != 1 {
+		t.Errorf("it should not treat default sorts as settings, got \"%v\"", settings)
+	}
+}
+
+func TestSettingsManager_SaveDefaultSortsInvalid(t *testing.T) {
+	sm := NewSettingsManager()
+	client := fake.NewSimpleClientset(api.GetDefaultSettingsConfigMap(""))
+
+	sorts := map[string]api.DefaultSort{
+		"pod":     {SortBy: []string{"lastTimestamp"}, Order: "up"},
+		"service": {SortBy: []string{"type"}},
+		"node":    {},
+		"unknown": {SortBy: []string{"name"}},
+	}
+	err := sm.SaveDefaultSorts(client, sorts)
+	statusError, ok := err.(*k8sErrors.StatusError)
+	if !ok || !k8sErrors.IsInvalid(err) {
+		t.Fatalf("it should reject invalid default sorts with invalid status error instead of \"%v\"", err)
+	}
+
+	fields := []string{}
+	for _, cause := range statusError.Status().Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+
+	expected := []string{"node.sortBy", "pod.sortBy[0]", "pod.order", "unknown"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("it should report errors of fields \"%v\" instead of \"%v\"", expected, fields)
+	}
+
+	if saved := sm.GetDefaultSorts(client); len(saved) > 0 {
+		t.Errorf("it should not save invalid default sorts, got \"%v\"", saved)
+	}
+}
+
+// fakeSynchronizer returns given config map and records registered action handlers.
+type fakeSynchronizer struct {
+	syncApi.Synchronizer
+	configMap *v1.ConfigMap
+	handlers  map[watch.EventType][]syncApi.ActionHandlerFunction
+}
+
+func (self *fakeSynchronizer) Get() runtime.Object {
+	return self.configMap
+}
+
+func (self *fakeSynchronizer) RegisterActionHandler(handler syncApi.ActionHandlerFunction, events ...watch.EventType) {
+	for _, event := range events {
+		self.handlers[event] = append(self.handlers[event], handler)
+	}
+}
+
+func (self *fakeSynchronizer) emit(event watch.EventType, obj runtime.Object) {
+	for _, handler := range self.handlers[event] {
+		handler(obj)
+	}
+}
+
+func TestSettingsManager_CachedDefaultSorts(t *testing.T) {
+	configMap := api.GetDefaultSettingsConfigMap("")
+	configMap.Data[api.DefaultSortKey] = `{"pod":{"sortBy":["name"]}}`
+	client := fake.NewSimpleClientset(configMap.DeepCopy())
+	synchronizer := &fakeSynchronizer{configMap: configMap,
+		handlers: map[watch.EventType][]syncApi.ActionHandlerFunction{}}
+	sm := NewSettingsManager()
+	sm.EnableDefaultSortSync(synchronizer)
+
+	if sorts := sm.CachedDefaultSorts(); !reflect.DeepEqual(sorts, map[string]api.DefaultSort{
+		"pod": {SortBy: []string{"name"}}}) {
+		t.Errorf("it should load default sorts of the synchronized config map instead of \"%v\"", sorts)
+	}
+
+	client.PrependReactor("get", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		t.Error("it should not read config map to get cached default sorts")
+		return false, nil, nil
+	})
+	modified := configMap.DeepCopy()
+	modified.Data[api.DefaultSortKey] = `{"event":{"sortBy":["lastTimestamp"],"order":"desc"}}`
+	synchronizer.emit(watch.Modified, modified)
+
+	if sorts := sm.CachedDefaultSorts(); len(sorts) != 1 || sorts["event"].Order != "desc" {
+		t.Errorf("it should reload default sorts when config map changes instead of \"%v\"", sorts)
+	}
+
+	invalid := configMap.DeepCopy()
+	invalid.Data[api.DefaultSortKey] = `{`
+	synchronizer.emit(watch.Modified, invalid)
+
+	if sorts := sm.CachedDefaultSorts(); len(sorts) != 1 {
+		t.Errorf("it should keep default sorts if config map can not be parsed instead of \"%v\"", sorts)
+	}
+
+	synchronizer.emit(watch.Deleted, nil)
+	if sorts := sm.CachedDefaultSorts(); sorts == nil || len(sorts) > 0 {
+		t.Errorf("it should return empty default sorts if config map was deleted instead of \"%v\"", sorts)
+	}
+}
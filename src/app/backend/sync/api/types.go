@@ -55,11 +55,13 @@ type Synchronizer interface {
 type SynchronizerManager interface {
 	// Secret created single secret synchronizer based on name and namespace information.
 	Secret(namespace, name string) Synchronizer
+	// ConfigMap creates single config map synchronizer based on name and namespace information.
+	ConfigMap(namespace, name string) Synchronizer
 }
 
 // Poller interface is responsible for periodically polling specific resource.
 type Poller interface {
-	// Poll polls specific resource every 'interval' time. Watch interface is returned in order to use it
-	// in the same way as regular watch on resource.
+	// Poll polls specific resource every 'interval' time or only once if interval is 0. Watch interface is returned
+	// in order to use it in the same way as regular watch on resource.
 	Poll(interval time.Duration) watch.Interface
 }
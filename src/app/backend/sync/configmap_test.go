@@ -0,0 +1,85 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func getConfigMapEvent(name, namespace string, eventType watch.EventType) watch.Event {
+	return watch.Event{
+		Type:   eventType,
+		Object: &v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: namespace}},
+	}
+}
+
+func TestConfigMapSynchronizer_Start(t *testing.T) {
+	fWatch := &fakeWatch{events: make(chan watch.Event)}
+	fClient := fake.NewSimpleClientset()
+
+	configMapSync := NewSynchronizerManager(fClient).ConfigMap("test-ns", "test-config-map")
+	configMapSync.SetPoller(&fakePoller{watch: fWatch})
+	configMapSync.Start()
+
+	if configMap := configMapSync.Get(); configMap != nil {
+		t.Fatal("configMapSync.Start(): Expected config map to be nil")
+	}
+
+	// Emit config map that should be synced and available through Get() method
+	fWatch.emitEvent(getConfigMapEvent("test-config-map", "test-ns", watch.Added))
+	if !validateSyncedObject(configMapSync, 2*time.Second, expectNotNil) {
+		t.Fatal("configMapSync.Start(): Expected config map not to be nil")
+	}
+
+	fWatch.emitEvent(getConfigMapEvent("test-config-map", "test-ns", watch.Deleted))
+	if !validateSyncedObject(configMapSync, 2*time.Second, expectNil) {
+		t.Fatal("configMapSync.Start(): Expected config map to be nil after delete event")
+	}
+}
+
+func TestConfigMapSynchronizer_RegisterActionHandler(t *testing.T) {
+	fWatch := &fakeWatch{events: make(chan watch.Event)}
+	handled := make(chan watch.EventType, 1)
+
+	configMapSync := NewSynchronizerManager(fake.NewSimpleClientset()).ConfigMap("test-ns", "test-config-map")
+	configMapSync.SetPoller(&fakePoller{watch: fWatch})
+	configMapSync.RegisterActionHandler(func(obj runtime.Object) {
+		handled <- watch.Modified
+	}, watch.Modified)
+	configMapSync.Start()
+
+	fWatch.emitEvent(getConfigMapEvent("test-config-map", "test-ns", watch.Modified))
+	select {
+	case <-handled:
+	case <-time.After(2 * time.Second):
+		t.Fatal("configMapSync.RegisterActionHandler(): Expected action handler to be called")
+	}
+}
+
+func TestConfigMapSynchronizer_Name(t *testing.T) {
+	ns, name := "test-ns", "test-config-map"
+	configMapSync := NewSynchronizerManager(fake.NewSimpleClientset()).ConfigMap(ns, name)
+	if configMapSync.Name() != name+"-"+ns {
+		t.Fatalf("configMapSync.Name(): Expected synchronizer name to equal name-namespace but got %s",
+			configMapSync.Name())
+	}
+}
@@ -15,11 +15,18 @@
 package sync
 
 import (
+	"time"
+
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 )
 
+// Secrets are resynchronized less often than config maps, as config maps are often edited by hand and changes should
+// be visible quickly.
+const secretSyncPeriodMultiplier = 10
+
 // Implements SynchronizerManager interface.
 type synchronizerManager struct {
 	client kubernetes.Interface
@@ -31,6 +38,18 @@ func (self *synchronizerManager) Secret(namespace, name string) syncApi.Synchron
 		namespace:      namespace,
 		name:           name,
 		client:         self.client,
+		syncPeriod:     secretSyncPeriodMultiplier * configMapSyncPeriod(),
+		actionHandlers: make(map[watch.EventType][]syncApi.ActionHandlerFunction),
+	}
+}
+
+// ConfigMap implements synchronizer manager. See SynchronizerManager interface for more information.
+func (self *synchronizerManager) ConfigMap(namespace, name string) syncApi.Synchronizer {
+	return &configMapSynchronizer{
+		namespace:      namespace,
+		name:           name,
+		client:         self.client,
+		syncPeriod:     configMapSyncPeriod(),
 		actionHandlers: make(map[watch.EventType][]syncApi.ActionHandlerFunction),
 	}
 }
@@ -39,3 +58,8 @@ func (self *synchronizerManager) Secret(namespace, name string) syncApi.Synchron
 func NewSynchronizerManager(client kubernetes.Interface) syncApi.SynchronizerManager {
 	return &synchronizerManager{client: client}
 }
+
+// Returns time interval between which config maps should be resynchronized. Zero disables periodic resync.
+func configMapSyncPeriod() time.Duration {
+	return time.Duration(args.Holder.GetResyncPeriod()) * time.Second
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poll
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	syncapi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
+)
+
+// ConfigMapPoller implements Poller interface. See Poller for more information.
+type ConfigMapPoller struct {
+	name      string
+	namespace string
+	client    kubernetes.Interface
+	watcher   *PollWatcher
+}
+
+// Poll new config map every 'interval' time and send it to watcher channel. If interval is 0 it
+// is only polled once. See Poller for more information.
+func (self *ConfigMapPoller) Poll(interval time.Duration) watch.Interface {
+	if interval == 0 {
+		go func() {
+			if self.watcher.IsStopped() {
+				return
+			}
+
+			self.watcher.eventChan <- self.getConfigMapEvent()
+		}()
+
+		return self.watcher
+	}
+
+	stopCh := make(chan struct{})
+	go wait.Until(func() {
+		if self.watcher.IsStopped() {
+			close(stopCh)
+			return
+		}
+
+		self.watcher.eventChan <- self.getConfigMapEvent()
+	}, interval, stopCh)
+
+	return self.watcher
+}
+
+// Gets config map from API server and transforms it to watch.Event object.
+func (self *ConfigMapPoller) getConfigMapEvent() (event watch.Event) {
+	configMap, err := self.client.CoreV1().ConfigMaps(self.namespace).Get(context.TODO(), self.name, metav1.GetOptions{})
+	event = watch.Event{
+		Object: configMap,
+		Type:   watch.Added,
+	}
+
+	if err != nil {
+		event.Type = watch.Error
+	}
+
+	// In case it was never created we can still mark it as deleted and let config map be recreated.
+	if errors.IsNotFoundError(err) {
+		event.Type = watch.Deleted
+	}
+
+	return
+}
+
+// NewConfigMapPoller returns instance of Poller interface.
+func NewConfigMapPoller(name, namespace string, client kubernetes.Interface) syncapi.Poller {
+	return &ConfigMapPoller{
+		name:      name,
+		namespace: namespace,
+		client:    client,
+		watcher:   NewPollWatcher(),
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poll_test
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/sync/poll"
+)
+
+func TestConfigMapPoller_Poll(t *testing.T) {
+	name, namespace := "test-config-map", "test-ns"
+	client := fake.NewSimpleClientset(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
+	poller := poll.NewConfigMapPoller(name, namespace, client)
+
+	watcher := poller.Poll(1 * time.Second)
+	select {
+	case ev := <-watcher.ResultChan():
+		if ev.Type != watch.Added {
+			t.Fatalf("Expected %s event, but got %s.", watch.Added, ev.Type)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timeout while waiting for watcher data.")
+	}
+}
+
+func TestConfigMapPoller_PollOnce(t *testing.T) {
+	name, namespace := "test-config-map", "test-ns"
+	client := fake.NewSimpleClientset(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
+	poller := poll.NewConfigMapPoller(name, namespace, client)
+
+	watcher := poller.Poll(0)
+	select {
+	case ev := <-watcher.ResultChan():
+		if ev.Type != watch.Added {
+			t.Fatalf("Expected %s event, but got %s.", watch.Added, ev.Type)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timeout while waiting for watcher data.")
+	}
+
+	select {
+	case ev := <-watcher.ResultChan():
+		t.Fatalf("Expected config map to be polled only once, but got %s event.", ev.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
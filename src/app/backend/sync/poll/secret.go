@@ -35,10 +35,22 @@ type SecretPoller struct {
 	watcher   *PollWatcher
 }
 
-// Poll new secret every 'interval' time and send it to watcher channel. See Poller for more information.
+// Poll new secret every 'interval' time and send it to watcher channel. If interval is 0 it
+// is only polled once. See Poller for more information.
 func (self *SecretPoller) Poll(interval time.Duration) watch.Interface {
-	stopCh := make(chan struct{})
+	if interval == 0 {
+		go func() {
+			if self.watcher.IsStopped() {
+				return
+			}
+
+			self.watcher.eventChan <- self.getSecretEvent()
+		}()
 
+		return self.watcher
+	}
+
+	stopCh := make(chan struct{})
 	go wait.Until(func() {
 		if self.watcher.IsStopped() {
 			close(stopCh)
@@ -33,9 +33,6 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/sync/poll"
 )
 
-// Time interval between which secret should be resynchronized.
-const secretSyncPeriod = 5 * time.Minute
-
 // Implements Synchronizer interface. See Synchronizer for more information.
 type secretSynchronizer struct {
 	namespace string
@@ -46,6 +43,7 @@ type secretSynchronizer struct {
 	actionHandlers map[watch.EventType][]syncApi.ActionHandlerFunction
 	errChan        chan error
 	poller         syncApi.Poller
+	syncPeriod     time.Duration
 
 	mux sync.Mutex
 }
@@ -182,7 +180,7 @@ func (self *secretSynchronizer) watch(namespace, name string) (watch.Interface,
 		self.poller = poll.NewSecretPoller(name, namespace, self.client)
 	}
 
-	return self.poller.Poll(secretSyncPeriod), nil
+	return self.poller.Poll(self.syncPeriod), nil
 }
 
 func (self *secretSynchronizer) handleEvent(event watch.Event) error {
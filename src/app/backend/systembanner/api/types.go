@@ -14,6 +14,16 @@
 
 package api
 
+const (
+	// GlobalBannerConfigMapName is the name of the config map in dashboard namespace that holds the global banner.
+	GlobalBannerConfigMapName = "kubernetes-dashboard-global-banner"
+
+	// Keys of the global banner config map.
+	GlobalBannerMessageKey  = "message"
+	GlobalBannerSeverityKey = "severity"
+	GlobalBannerVisibleKey  = "visible"
+)
+
 // SystemBannerManager is used for user system banner management.
 type SystemBannerManager interface {
 	// Get system banner.
@@ -15,12 +15,21 @@
 package systembanner
 
 import (
+	"log"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	syncApi "github.com/kubernetes/dashboard/src/app/backend/sync/api"
 	"github.com/kubernetes/dashboard/src/app/backend/systembanner/api"
 )
 
 // SystemBannerManager is a structure containing all system banner manager members.
 type SystemBannerManager struct {
 	systemBanner api.SystemBanner
+	global       *globalBanner
 }
 
 // NewSystemBannerManager creates new settings manager.
@@ -33,7 +42,64 @@ func NewSystemBannerManager(message, severity string) SystemBannerManager {
 	}
 }
 
+// EnableGlobalBanner makes manager serve global banner read from the config map kept up to date by given
+// synchronizer. Global banner takes precedence over the system banner set with flags while it is visible.
+func (sbm *SystemBannerManager) EnableGlobalBanner(synchronizer syncApi.Synchronizer) {
+	sbm.global = &globalBanner{}
+	sbm.global.load(synchronizer.Get())
+
+	synchronizer.RegisterActionHandler(sbm.global.load, watch.Added, watch.Modified)
+	synchronizer.RegisterActionHandler(func(runtime.Object) {
+		sbm.global.load(nil)
+	}, watch.Deleted)
+}
+
 // Get implements SystemBannerManager interface. Check it for more information.
 func (sbm *SystemBannerManager) Get() api.SystemBanner {
+	if sbm.global != nil {
+		if banner := sbm.global.get(); banner != nil {
+			return *banner
+		}
+	}
+
 	return sbm.systemBanner
 }
+
+// globalBanner holds banner read from the global banner config map. It is nil if config map does not exist, banner
+// has no message or it is hidden.
+type globalBanner struct {
+	mux    sync.RWMutex
+	banner *api.SystemBanner
+}
+
+func (self *globalBanner) get() *api.SystemBanner {
+	self.mux.RLock()
+	defer self.mux.RUnlock()
+	return self.banner
+}
+
+func (self *globalBanner) load(obj runtime.Object) {
+	var banner *api.SystemBanner
+	if configMap, ok := obj.(*v1.ConfigMap); ok && configMap != nil {
+		message := configMap.Data[api.GlobalBannerMessageKey]
+		if len(message) > 0 && configMap.Data[api.GlobalBannerVisibleKey] != "false" {
+			banner = &api.SystemBanner{
+				Message:  message,
+				Severity: api.GetSeverity(configMap.Data[api.GlobalBannerSeverityKey]),
+			}
+		}
+	}
+
+	self.mux.Lock()
+	defer self.mux.Unlock()
+
+	// Config map is periodically resynchronized, log only actual changes.
+	if (banner == nil) != (self.banner == nil) || (banner != nil && *banner != *self.banner) {
+		if banner == nil {
+			log.Print("Global banner has been cleared")
+		} else {
+			log.Printf("Global banner has been set with severity %s", banner.Severity)
+		}
+	}
+	self.banner = banner
+}
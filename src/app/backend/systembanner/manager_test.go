@@ -0,0 +1,77 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package systembanner
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes/dashboard/src/app/backend/sync"
+	"github.com/kubernetes/dashboard/src/app/backend/systembanner/api"
+)
+
+func TestSystemBannerManager_EnableGlobalBanner(t *testing.T) {
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{Name: api.GlobalBannerConfigMapName, Namespace: "kube-system"},
+		Data:       map[string]string{"message": "Maintenance at 10:00", "severity": "WARNING"},
+	}
+	client := fake.NewSimpleClientset(configMap)
+	synchronizer := sync.NewSynchronizerManager(client).ConfigMap("kube-system", api.GlobalBannerConfigMapName)
+
+	manager := NewSystemBannerManager("Hello world!", "INFO")
+	manager.EnableGlobalBanner(synchronizer)
+	expected := api.SystemBanner{Message: "Maintenance at 10:00", Severity: api.SystemBannerSeverityWarning}
+	if actual := manager.Get(); actual != expected {
+		t.Errorf("EnableGlobalBanner() loads %#v, expected %#v", actual, expected)
+	}
+
+	systemBanner := api.SystemBanner{Message: "Hello world!", Severity: api.SystemBannerSeverityInfo}
+	cases := []struct {
+		info      string
+		configMap *v1.ConfigMap
+		expected  api.SystemBanner
+	}{
+		{
+			"Should reload banner when config map changes",
+			&v1.ConfigMap{Data: map[string]string{"message": "Upgrade in progress", "severity": "ERROR"}},
+			api.SystemBanner{Message: "Upgrade in progress", Severity: api.SystemBannerSeverityError},
+		},
+		{
+			"Should fall back to system banner when global banner is hidden",
+			&v1.ConfigMap{Data: map[string]string{"message": "Upgrade in progress", "visible": "false"}},
+			systemBanner,
+		},
+		{
+			"Should fall back to system banner when message is cleared",
+			&v1.ConfigMap{Data: map[string]string{"severity": "ERROR"}},
+			systemBanner,
+		},
+		{
+			"Should fall back to system banner when config map does not exist",
+			nil,
+			systemBanner,
+		},
+	}
+
+	for _, c := range cases {
+		manager.global.load(c.configMap)
+		if actual := manager.Get(); actual != c.expected {
+			t.Errorf("Test Case: %s. Expected banner %#v, but got %#v.", c.info, c.expected, actual)
+		}
+	}
+}
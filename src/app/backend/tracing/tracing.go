@@ -0,0 +1,119 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing exports OpenTelemetry spans of API requests and requests sent to the API server.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// ServiceName is the name of the service that spans are reported for.
+const ServiceName = "kubernetes-dashboard"
+
+// enabled is true once the tracer provider is initialized.
+var enabled = false
+
+// Init configures global tracer provider that exports spans to the OTLP/HTTP endpoint, i.e.
+// 'http://otel-collector:4318'. Share of traces given by the sample rate is sampled unless sampling decision is
+// propagated by the caller. Returns function that flushes spans and stops the exporter. Tracing stays disabled if
+// endpoint is empty.
+func Init(endpoint string, sampleRate float64, version string) (func(context.Context) error, error) {
+	if len(endpoint) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if sampleRate < 0 || sampleRate > 1 {
+		return nil, fmt.Errorf("--otel-sample-rate has to be between 0 and 1, got %v", sampleRate)
+	}
+
+	options, err := exporterOptions(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRate))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(ServiceName), semconv.ServiceVersionKey.String(version))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{},
+		propagation.Baggage{}))
+	enabled = true
+
+	return provider.Shutdown, nil
+}
+
+// Enabled returns true if tracing was initialized with non-empty endpoint.
+func Enabled() bool {
+	return enabled
+}
+
+// Handler starts span for every request served by given handler. Context of the trace sent by the caller in the
+// traceparent header is continued. Handler is returned unchanged if tracing is disabled.
+func Handler(handler http.Handler, operation string) http.Handler {
+	if !enabled {
+		return handler
+	}
+
+	return otelhttp.NewHandler(handler, operation)
+}
+
+// Transport starts child span of the span from the request context for every request sent through given round
+// tripper and propagates trace context to the server. Round tripper is returned unchanged if tracing is disabled.
+func Transport(rt http.RoundTripper) http.RoundTripper {
+	if !enabled {
+		return rt
+	}
+
+	return otelhttp.NewTransport(rt)
+}
+
+// Returns options of the exporter sending spans to the endpoint given as http or https URL.
+func exporterOptions(endpoint string) ([]otlptracehttp.Option, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || len(endpointURL.Host) == 0 || (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") {
+		return nil, fmt.Errorf("--otel-endpoint has to be http or https URL, i.e. 'http://otel-collector:4318', got '%s'",
+			endpoint)
+	}
+
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpointURL.Host)}
+	if endpointURL.Scheme == "http" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+
+	if len(endpointURL.Path) > 0 && endpointURL.Path != "/" {
+		options = append(options, otlptracehttp.WithURLPath(endpointURL.Path))
+	}
+
+	return options, nil
+}
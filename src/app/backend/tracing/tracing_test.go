@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestInitDisabled(t *testing.T) {
+	shutdown, err := Init("", 2, "v1")
+	if err != nil {
+		t.Fatalf("Expected no error when endpoint is empty, but got %s", err)
+	}
+
+	if Enabled() {
+		t.Error("Expected tracing to be disabled when endpoint is empty")
+	}
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("Expected no-op shutdown, but got %s", err)
+	}
+
+	if Transport(http.DefaultTransport) != http.DefaultTransport {
+		t.Error("Expected transport to be returned unchanged when tracing is disabled")
+	}
+}
+
+func TestInitInvalidSampleRate(t *testing.T) {
+	for _, rate := range []float64{-0.1, 1.5} {
+		if _, err := Init("http://otel-collector:4318", rate, "v1"); err == nil {
+			t.Errorf("Test Case: sample rate %v. Expected error, but got nil", rate)
+		}
+	}
+}
+
+func TestExporterOptions(t *testing.T) {
+	cases := []struct {
+		info            string
+		endpoint        string
+		expectedErr     bool
+		expectedOptions int
+	}{
+		{"Should use insecure connection for http URL", "http://otel-collector:4318", false, 2},
+		{"Should use secure connection for https URL", "https://otel-collector:4318", false, 1},
+		{"Should use path of the URL", "http://otel-collector:4318/custom/v1/traces", false, 3},
+		{"Should ignore root path", "http://otel-collector:4318/", false, 2},
+		{"Should reject endpoint without scheme", "otel-collector:4318", true, 0},
+		{"Should reject grpc scheme", "grpc://otel-collector:4317", true, 0},
+	}
+
+	for _, c := range cases {
+		options, err := exporterOptions(c.endpoint)
+		if (err != nil) != c.expectedErr {
+			t.Errorf("Test Case: %s. Expected error: %t, but got %v.", c.info, c.expectedErr, err)
+		}
+
+		if len(options) != c.expectedOptions {
+			t.Errorf("Test Case: %s. Expected %d options, but got %d.", c.info, c.expectedOptions, len(options))
+		}
+	}
+}
@@ -25,7 +25,8 @@ import (
 type LoginStatus struct {
 	// True when token header indicating logged in user is found in request.
 	TokenPresent bool `json:"tokenPresent"`
-	// True when authorization header indicating logged in user is found in request.
+	// True when authorization header or header set by the trusted authenticating proxy indicating logged in user is
+	// found in request. Always true if dashboard uses token from --token-file, so login page is not shown.
 	HeaderPresent bool `json:"headerPresent"`
 	// True if dashboard is configured to use HTTPS connection. It is required for secure
 	// data exchange during login operation.
@@ -48,9 +49,12 @@ func ValidateLoginStatus(request *restful.Request) *LoginStatus {
 		httpsMode = true
 	}
 
+	headerPresent := len(authHeader) > 0 || len(client.ProxyAuthToken(request.Request)) > 0 ||
+		len(args.Holder.GetTokenFile()) > 0
+
 	loginStatus := &LoginStatus{
 		TokenPresent:         len(tokenHeader) > 0,
-		HeaderPresent:        len(authHeader) > 0,
+		HeaderPresent:        headerPresent,
 		ImpersonationPresent: len(impersonationHeader) > 0,
 		HTTPSMode:            httpsMode,
 	}
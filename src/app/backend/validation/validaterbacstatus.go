@@ -21,6 +21,8 @@ import (
 	auth "k8s.io/api/authorization/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes/dashboard/src/app/backend/discoverycache"
 )
 
 // RbacStatus describes status of RBAC in the cluster.
@@ -32,7 +34,7 @@ type RbacStatus struct {
 // ValidateRbacStatus validates if RBAC is enabled in the cluster.
 // Supported version of RBAC api is: 'rbac.authorization.k8s.io/v1beta1'
 func ValidateRbacStatus(client kubernetes.Interface) (*RbacStatus, error) {
-	groupList, err := client.Discovery().ServerGroups()
+	groupList, err := discoverycache.Wrap(client.Discovery()).ServerGroups()
 	if err != nil {
 		return nil, fmt.Errorf("Couldn't get available api versions from server: %v", err)
 	}
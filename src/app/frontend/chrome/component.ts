@@ -13,12 +13,17 @@
 // limitations under the License.
 
 import {DOCUMENT} from '@angular/common';
-import {HttpClient} from '@angular/common/http';
-import {Component, Inject, OnInit} from '@angular/core';
+import {HttpClient, HttpHeaders} from '@angular/common/http';
+import {Component, Inject, OnDestroy, OnInit} from '@angular/core';
 import {Router} from '@angular/router';
+import {IConfig} from '@api/root.ui';
+import {Subject, timer} from 'rxjs';
+import {switchMap, takeUntil} from 'rxjs/operators';
 
 import {AssetsService} from '../common/services/global/assets';
 import {GlobalSettingsService} from '../common/services/global/globalsettings';
+import {SessionWarningService} from '../common/services/global/sessionwarning';
+import {CONFIG_DI_TOKEN} from '../index.config';
 
 class SystemBanner {
   message: string;
@@ -30,9 +35,12 @@ class SystemBanner {
   templateUrl: './template.html',
   styleUrls: ['./style.scss'],
 })
-export class ChromeComponent implements OnInit {
+export class ChromeComponent implements OnInit, OnDestroy {
   private static readonly systemBannerEndpoint = 'api/v1/systembanner';
+  // Global banner can be changed at runtime, so it is refreshed periodically.
+  private static readonly systemBannerRefreshInterval = 30000;
   private systemBanner_: SystemBanner;
+  private readonly unsubscribe_ = new Subject<void>();
   loading = false;
 
   constructor(
@@ -40,18 +48,29 @@ export class ChromeComponent implements OnInit {
     private readonly http_: HttpClient,
     private readonly router_: Router,
     @Inject(DOCUMENT) private readonly document_: Document,
-    private readonly globalSettings_: GlobalSettingsService
+    private readonly globalSettings_: GlobalSettingsService,
+    public sessionWarning: SessionWarningService,
+    @Inject(CONFIG_DI_TOKEN) private readonly config_: IConfig
   ) {}
 
   ngOnInit(): void {
-    this.http_
-      .get<SystemBanner>(ChromeComponent.systemBannerEndpoint)
-      .toPromise()
-      .then(sb => {
+    // Banner refresh is not a user activity.
+    const headers = new HttpHeaders().set(this.config_.backgroundRequestHeaderName, 'true');
+    timer(0, ChromeComponent.systemBannerRefreshInterval)
+      .pipe(switchMap(() => this.http_.get<SystemBanner>(ChromeComponent.systemBannerEndpoint, {headers})))
+      .pipe(takeUntil(this.unsubscribe_))
+      .subscribe(sb => {
         this.systemBanner_ = sb;
       });
 
     this.registerVisibilityChangeHandler_();
+    this.sessionWarning.init();
+  }
+
+  ngOnDestroy(): void {
+    this.sessionWarning.destroy();
+    this.unsubscribe_.next();
+    this.unsubscribe_.complete();
   }
 
   getWorkloadsStateName(): string {
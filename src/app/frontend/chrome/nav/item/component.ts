@@ -13,10 +13,12 @@
 // limitations under the License.
 
 import {animate, keyframes, state, style, transition, trigger} from '@angular/animations';
-import {Component, EventEmitter, HostListener, Input, OnDestroy, OnInit} from '@angular/core';
+import {Component, EventEmitter, HostBinding, HostListener, Input, OnDestroy, OnInit} from '@angular/core';
 import {Subject} from 'rxjs';
 import {debounceTime, takeUntil, tap} from 'rxjs/operators';
 
+import {ConfigService} from '../../../common/services/global/config';
+
 enum NamespacedIndicatorState {
   Enter = 'mouseenter',
   Leave = 'mouseleave',
@@ -63,6 +65,14 @@ export class NavItemComponent implements OnInit, OnDestroy {
   private debounceTime_ = 500;
   private unsubscribe_ = new Subject<void>();
 
+  constructor(private readonly config_: ConfigService) {}
+
+  // Items of resource kinds that are not allowed by the backend are hidden.
+  @HostBinding('hidden')
+  get hidden(): boolean {
+    return !this.config_.isResourceKindAllowed(this.state.replace(/^\//, ''));
+  }
+
   get indicator(): string {
     return this.animationState === NamespacedIndicatorState.Leave ? 'N' : 'Namespaced';
   }
@@ -15,10 +15,11 @@
 import {NgModule} from '@angular/core';
 import {RouterModule, Routes} from '@angular/router';
 import {AuthGuard} from '@common/services/guard/auth';
+import {DefaultViewGuard} from '@common/services/guard/defaultview';
 import {ChromeComponent} from './component';
 
 const routes: Routes = [
-  {path: '', redirectTo: '/workloads', pathMatch: 'full'},
+  {path: '', pathMatch: 'full', canActivate: [DefaultViewGuard], children: []},
   {
     path: '',
     component: ChromeComponent,
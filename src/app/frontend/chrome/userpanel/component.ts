@@ -15,6 +15,7 @@
 import {Component, OnInit} from '@angular/core';
 import {LoginStatus} from '@api/root.api';
 import {AuthService} from '../../common/services/global/authentication';
+import {ConfigService} from '../../common/services/global/config';
 
 @Component({
   selector: 'kd-user-panel',
@@ -28,7 +29,7 @@ export class UserPanelComponent implements OnInit {
   loginStatus: LoginStatus;
   isLoginStatusInitialized = false;
 
-  constructor(private readonly authService_: AuthService) {}
+  constructor(private readonly authService_: AuthService, private readonly config_: ConfigService) {}
 
   ngOnInit(): void {
     this.authService_.getLoginStatus().subscribe(status => {
@@ -45,6 +46,16 @@ export class UserPanelComponent implements OnInit {
     return this.loginStatus && !this.loginStatus.headerPresent && this.loginStatus.tokenPresent;
   }
 
+  isAnonymous(): boolean {
+    return (
+      this.loginStatus &&
+      !this.loginStatus.headerPresent &&
+      !this.loginStatus.tokenPresent &&
+      this.authService_.isLoginPageEnabled() &&
+      this.config_.isAnonymousAccessEnabled()
+    );
+  }
+
   isAuthEnabled(): boolean {
     return this.loginStatus ? this.loginStatus.httpsMode : false;
   }
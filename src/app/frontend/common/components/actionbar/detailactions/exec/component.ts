@@ -12,18 +12,27 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import {Component, Input} from '@angular/core';
+import {Component, Input, OnChanges} from '@angular/core';
 import {ObjectMeta} from '@api/root.api';
+import {Observable} from 'rxjs';
+import {FeatureService} from '../../../../services/global/features';
 import {KdStateService} from '../../../../services/global/state';
 
 @Component({
   selector: 'kd-actionbar-detail-exec',
   templateUrl: './template.html',
 })
-export class ActionbarDetailExecComponent {
+export class ActionbarDetailExecComponent implements OnChanges {
   @Input() objectMeta: ObjectMeta;
+  enabled: Observable<boolean>;
 
-  constructor(private readonly kdState_: KdStateService) {}
+  constructor(private readonly kdState_: KdStateService, private readonly features_: FeatureService) {}
+
+  ngOnChanges(): void {
+    if (this.objectMeta) {
+      this.enabled = this.features_.isEnabled('podExec', this.objectMeta.namespace);
+    }
+  }
 
   getHref(): string {
     return this.kdState_.href('shell', this.objectMeta.name, this.objectMeta.namespace);
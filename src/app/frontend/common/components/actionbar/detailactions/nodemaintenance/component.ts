@@ -0,0 +1,54 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {Component, Input, OnInit} from '@angular/core';
+import {ObjectMeta, TypeMeta} from '@api/root.api';
+import {combineLatest, Observable} from 'rxjs';
+import {map} from 'rxjs/operators';
+
+import {FeatureService} from '../../../../services/global/features';
+import {VerberService} from '../../../../services/global/verber';
+
+@Component({
+  selector: 'kd-actionbar-detail-node-maintenance',
+  templateUrl: './template.html',
+})
+export class ActionbarDetailNodeMaintenanceComponent implements OnInit {
+  @Input() objectMeta: ObjectMeta;
+  @Input() typeMeta: TypeMeta;
+  @Input() displayName: string;
+  cordonEnabled: Observable<boolean>;
+  drainEnabled: Observable<boolean>;
+
+  constructor(private readonly verber_: VerberService, private readonly features_: FeatureService) {}
+
+  ngOnInit(): void {
+    this.cordonEnabled = this.features_.isEnabled('nodeMaintenance');
+    this.drainEnabled = combineLatest([this.cordonEnabled, this.features_.isEnabled('podEviction')]).pipe(
+      map(([cordon, evict]) => cordon && evict)
+    );
+  }
+
+  onCordon(): void {
+    this.verber_.setNodeUnschedulable(this.objectMeta, true);
+  }
+
+  onUncordon(): void {
+    this.verber_.setNodeUnschedulable(this.objectMeta, false);
+  }
+
+  onDrain(): void {
+    this.verber_.showDrainDialog(this.displayName, this.typeMeta, this.objectMeta);
+  }
+}
@@ -16,6 +16,7 @@ import {DefaultActionbar} from './default/component';
 import {LogsDefaultActionbar} from './logsdefault/component';
 import {LogsExecDefaultActionbar} from './logsexecdefault/component';
 import {LogsScaleDefaultActionbar} from './logsscaledefault/component';
+import {NodeDefaultActionbar} from './nodedefault/component';
 import {ScaleDefaultActionbar} from './scaledefault/component';
 import {TriggerDefaultActionbar} from './triggerdefault/component';
 import {PinDefaultActionbar} from './pindefault/component';
@@ -44,6 +45,12 @@ export const LOGS_SCALE_DEFAULT_ACTIONBAR = {
   outlet: 'actionbar',
 };
 
+export const NODE_DEFAULT_ACTIONBAR = {
+  path: '',
+  component: NodeDefaultActionbar,
+  outlet: 'actionbar',
+};
+
 export const PIN_DEFAULT_ACTIONBAR = {
   path: '',
   component: PinDefaultActionbar,
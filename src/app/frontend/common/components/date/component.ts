@@ -16,6 +16,9 @@ import {ChangeDetectionStrategy, ChangeDetectorRef, Component, Input, OnChanges,
 import {Subject, timer} from 'rxjs';
 import {switchMap, takeUntil} from 'rxjs/operators';
 
+import {ConfigService} from '../../services/global/config';
+import {LocalSettingsService} from '../../services/global/localsettings';
+
 /**
  * Display a date
  *
@@ -65,7 +68,21 @@ export class DateComponent implements OnChanges, OnDestroy {
   ];
   private unsubscribe_ = new Subject<void>();
 
-  constructor(private readonly cdr_: ChangeDetectorRef) {}
+  constructor(
+    private readonly cdr_: ChangeDetectorRef,
+    private readonly settings_: LocalSettingsService,
+    private readonly config_: ConfigService
+  ) {}
+
+  /**
+   * Returns offset of the timezone that the date is displayed in, i.e. '+0200'. The timezone can be overridden in
+   * local settings, otherwise the one configured with '--default-timezone' flag is used. Offset is computed for the
+   * date, so that daylight saving time is respected.
+   */
+  get timezoneOffset(): string {
+    const timezone = this.settings_.get().timezone || this.config_.getDefaultTimezone();
+    return timezone ? getTimezoneOffset(new Date(this.date), timezone) : undefined;
+  }
 
   ngOnChanges() {
     if (this.relative_) {
@@ -125,3 +142,27 @@ export class DateComponent implements OnChanges, OnDestroy {
     this.intervalChanged_.next();
   }
 }
+
+/**
+ * Returns offset of the IANA timezone at the given date in the format accepted by the date pipe, i.e. '-0430'.
+ * Returns undefined if the timezone is not supported by the browser, so that the local timezone is used.
+ */
+export function getTimezoneOffset(date: Date, timezone: string): string {
+  let minutes: number;
+  try {
+    const inTimezone = new Date(date.toLocaleString('en-US', {timeZone: timezone}));
+    const inUTC = new Date(date.toLocaleString('en-US', {timeZone: 'UTC'}));
+    minutes = Math.round((inTimezone.getTime() - inUTC.getTime()) / 60000);
+  } catch (_) {
+    return undefined;
+  }
+
+  if (isNaN(minutes)) {
+    return undefined;
+  }
+
+  const sign = minutes < 0 ? '-' : '+';
+  const abs = Math.abs(minutes);
+  const pad = (value: number) => String(value).padStart(2, '0');
+  return `${sign}${pad(Math.floor(abs / 60))}${pad(abs % 60)}`;
+}
@@ -33,7 +33,7 @@ const loggableResources: string[] = [
 const pinnableResources: string[] = [Resource.crdFull];
 const executableResources: string[] = [Resource.pod];
 const triggerableResources: string[] = [Resource.cronJob];
-const restartableResources: string[] = [Resource.deployment];
+const restartableResources: string[] = [Resource.deployment, Resource.statefulSet, Resource.daemonSet];
 
 @Component({
   selector: 'kd-resource-context-menu',
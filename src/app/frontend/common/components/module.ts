@@ -24,6 +24,7 @@ import {ActionbarDetailDeleteComponent} from './actionbar/detailactions/delete/c
 import {ActionbarDetailEditComponent} from './actionbar/detailactions/edit/component';
 import {ActionbarDetailExecComponent} from './actionbar/detailactions/exec/component';
 import {ActionbarDetailLogsComponent} from './actionbar/detailactions/logs/component';
+import {ActionbarDetailNodeMaintenanceComponent} from './actionbar/detailactions/nodemaintenance/component';
 import {ActionbarDetailPinComponent} from './actionbar/detailactions/pin/component';
 import {ActionbarDetailScaleComponent} from './actionbar/detailactions/scale/component';
 import {ActionbarDetailTriggerComponent} from './actionbar/detailactions/trigger/component';
@@ -31,6 +32,7 @@ import {DefaultActionbar} from './actionbars/default/component';
 import {LogsDefaultActionbar} from './actionbars/logsdefault/component';
 import {LogsExecDefaultActionbar} from './actionbars/logsexecdefault/component';
 import {LogsScaleDefaultActionbar} from './actionbars/logsscaledefault/component';
+import {NodeDefaultActionbar} from './actionbars/nodedefault/component';
 import {PinDefaultActionbar} from './actionbars/pindefault/component';
 import {ScaleDefaultActionbar} from './actionbars/scaledefault/component';
 import {TriggerDefaultActionbar} from './actionbars/triggerdefault/component';
@@ -66,6 +68,7 @@ import {ProbeComponent} from './probe/component';
 import {PropertyComponent} from './property/component';
 import {ProxyComponent} from './proxy/component';
 import {ResourceQuotaListComponent} from './quotas/component';
+import {ResourceQuotaUsageComponent} from './quotausage/component';
 import {ClusterRoleListComponent} from './resourcelist/clusterrole/component';
 import {ClusterRoleBindingListComponent} from './resourcelist/clusterrolebinding/component';
 import {ConfigMapListComponent} from './resourcelist/configmap/component';
@@ -115,6 +118,7 @@ const components = [
   ActionbarDetailPinComponent,
   ActionbarComponent,
   ActionbarDetailTriggerComponent,
+  ActionbarDetailNodeMaintenanceComponent,
   BreadcrumbsComponent,
   CardComponent,
   CardListFilterComponent,
@@ -156,6 +160,7 @@ const components = [
   MenuComponent,
   NamespaceListComponent,
   NodeListComponent,
+  NodeDefaultActionbar,
   NamespaceSelectorComponent,
   NamespaceChangeDialog,
   ObjectMetaComponent,
@@ -170,6 +175,7 @@ const components = [
   PolicyRuleListComponent,
   PinDefaultActionbar,
   ResourceQuotaListComponent,
+  ResourceQuotaUsageComponent,
   ResourceLimitListComponent,
   ReplicaSetListComponent,
   ReplicationControllerListComponent,
@@ -0,0 +1,35 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {Component, Input} from '@angular/core';
+import {ResourceQuotaUsage} from 'typings/root.api';
+
+@Component({
+  selector: 'kd-resource-quota-usage',
+  templateUrl: './template.html',
+  styleUrls: ['./style.scss'],
+})
+export class ResourceQuotaUsageComponent {
+  @Input() initialized: boolean;
+  @Input() usages: ResourceQuotaUsage[];
+
+  // Progress bar of the usage over the hard limit is displayed as full.
+  getValue(usage: ResourceQuotaUsage): number {
+    return Math.min(usage.percentage, 100);
+  }
+
+  trackByResourceQuotaUsage(_: number, item: ResourceQuotaUsage): string {
+    return item.name;
+  }
+}
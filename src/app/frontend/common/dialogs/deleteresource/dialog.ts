@@ -15,6 +15,7 @@
 import {Component, Inject} from '@angular/core';
 import {MAT_DIALOG_DATA, MatDialogRef} from '@angular/material/dialog';
 import {ResourceMeta} from '../../services/global/actionbar';
+import {ConfigService} from '../../services/global/config';
 
 @Component({
   selector: 'kd-delete-resource-dialog',
@@ -23,9 +24,17 @@ import {ResourceMeta} from '../../services/global/actionbar';
 export class DeleteResourceDialog {
   constructor(
     public dialogRef: MatDialogRef<DeleteResourceDialog>,
-    @Inject(MAT_DIALOG_DATA) public data: ResourceMeta
+    @Inject(MAT_DIALOG_DATA) public data: ResourceMeta,
+    private readonly config_: ConfigService
   ) {}
 
+  /**
+   * Returns value of the kubectl '--cascade' flag equivalent to the propagation policy of the delete request.
+   */
+  get cascade(): string {
+    return this.config_.getDefaultDeletePropagation().toLowerCase();
+  }
+
   onNoClick(): void {
     this.dialogRef.close();
   }
@@ -20,6 +20,7 @@ import {saveAs} from 'file-saver';
 import {Subject, Subscription} from 'rxjs';
 import {takeUntil} from 'rxjs/operators';
 
+import {LocalSettingsService} from '../../services/global/localsettings';
 import {LogService} from '../../services/global/logs';
 
 export interface LogsDownloadDialogMeta {
@@ -42,16 +43,25 @@ export class LogsDownloadDialog implements OnDestroy {
   private _unsubscribe = new Subject<void>();
 
   private get _logOptions(): LogOptions {
-    return {
+    const options: LogOptions = {
       previous: this.logService.getPrevious(),
       timestamps: this.logService.getShowTimestamp(),
     };
+
+    // Server converts timestamps to the default timezone unless user overrides it
+    const timezone = this.settings_.get().timezone;
+    if (timezone) {
+      options.timezone = timezone;
+    }
+
+    return options;
   }
 
   constructor(
     private readonly _dialogRef: MatDialogRef<LogsDownloadDialog>,
     @Inject(MAT_DIALOG_DATA) public data: LogsDownloadDialogMeta,
     private readonly logService: LogService,
+    private readonly settings_: LocalSettingsService,
     private readonly http_: HttpClient
   ) {
     const logUrl = `api/v1/log/file/${data.namespace}/${data.pod}/${data.container}`;
@@ -0,0 +1,84 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {HttpClient, HttpDownloadProgressEvent, HttpEvent, HttpEventType} from '@angular/common/http';
+import {Component, Inject, OnDestroy} from '@angular/core';
+import {MAT_DIALOG_DATA, MatDialogRef} from '@angular/material/dialog';
+import {DrainProgress} from '@api/root.api';
+import {Subscription} from 'rxjs';
+
+import {ResourceMeta} from '../../services/global/actionbar';
+
+@Component({
+  selector: 'kd-drain-node-dialog',
+  templateUrl: 'template.html',
+})
+export class DrainNodeDialog implements OnDestroy {
+  progress: DrainProgress;
+  error = '';
+  private subscription_: Subscription;
+
+  constructor(
+    public dialogRef: MatDialogRef<DrainNodeDialog>,
+    @Inject(MAT_DIALOG_DATA) public data: ResourceMeta,
+    private readonly http_: HttpClient
+  ) {}
+
+  get isStarted(): boolean {
+    return !!this.subscription_;
+  }
+
+  get isDone(): boolean {
+    return !!this.error || (this.progress && this.progress.done);
+  }
+
+  drain(): void {
+    // Progress is streamed as one JSON document per line, the last complete line describes the current state.
+    this.subscription_ = this.http_
+      .request('POST', `api/v1/node/${this.data.objectMeta.name}/drain`, {
+        observe: 'events',
+        reportProgress: true,
+        responseType: 'text',
+      })
+      .subscribe(
+        (event: HttpEvent<string>) => {
+          if (event.type === HttpEventType.DownloadProgress) {
+            this.onProgress_((event as HttpDownloadProgressEvent).partialText);
+          } else if (event.type === HttpEventType.Response) {
+            this.onProgress_(event.body);
+          }
+        },
+        err => (this.error = err.error || 'Could not drain the node.')
+      );
+  }
+
+  ngOnDestroy(): void {
+    if (this.subscription_) {
+      this.subscription_.unsubscribe();
+    }
+  }
+
+  private onProgress_(text: string): void {
+    const lines = (text || '').split('\n').filter(line => line.trim().length > 0);
+    if (lines.length === 0) {
+      return;
+    }
+
+    try {
+      this.progress = JSON.parse(lines[lines.length - 1]);
+    } catch (e) {
+      // Last line is not complete yet. It will be parsed with the next progress event.
+    }
+  }
+}
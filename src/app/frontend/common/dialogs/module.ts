@@ -20,6 +20,7 @@ import {ComponentsModule} from '../components/module';
 import {AlertDialog} from './alert/dialog';
 import {DeleteResourceDialog} from './deleteresource/dialog';
 import {LogsDownloadDialog} from './download/dialog';
+import {DrainNodeDialog} from './drainnode/dialog';
 import {EditResourceDialog} from './editresource/dialog';
 import {RestartResourceDialog} from './restartresource/dialog';
 import {ScaleResourceDialog} from './scaleresource/dialog';
@@ -31,6 +32,7 @@ import {TriggerResourceDialog} from './triggerresource/dialog';
     AlertDialog,
     EditResourceDialog,
     DeleteResourceDialog,
+    DrainNodeDialog,
     LogsDownloadDialog,
     RestartResourceDialog,
     ScaleResourceDialog,
@@ -40,6 +42,7 @@ import {TriggerResourceDialog} from './triggerresource/dialog';
     AlertDialog,
     EditResourceDialog,
     DeleteResourceDialog,
+    DrainNodeDialog,
     LogsDownloadDialog,
     RestartResourceDialog,
     ScaleResourceDialog,
@@ -49,6 +52,7 @@ import {TriggerResourceDialog} from './triggerresource/dialog';
     AlertDialog,
     EditResourceDialog,
     DeleteResourceDialog,
+    DrainNodeDialog,
     LogsDownloadDialog,
     RestartResourceDialog,
     ScaleResourceDialog,
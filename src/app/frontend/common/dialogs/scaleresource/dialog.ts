@@ -26,6 +26,8 @@ import {ResourceMeta} from '../../services/global/actionbar';
 export class ScaleResourceDialog implements OnInit {
   actual = 0;
   desired = 0;
+  // Version of the resource when replica counts were loaded. Scaling fails if the resource was changed since then.
+  resourceVersion = '';
 
   constructor(
     public dialogRef: MatDialogRef<ScaleResourceDialog>,
@@ -45,6 +47,7 @@ export class ScaleResourceDialog implements OnInit {
       .then(rc => {
         this.actual = rc.actualReplicas;
         this.desired = rc.desiredReplicas;
+        this.resourceVersion = rc.resourceVersion || '';
       });
   }
 
@@ -19,6 +19,7 @@ import {KdError as KdApiError} from '@api/root.shared';
 export enum ApiError {
   tokenExpired = 'MSG_TOKEN_EXPIRED_ERROR',
   encryptionKeyChanged = 'MSG_ENCRYPTION_KEY_CHANGED',
+  sessionIdle = 'MSG_SESSION_IDLE_ERROR',
 }
 
 export enum ErrorStatus {
@@ -37,6 +38,7 @@ export enum ErrorCode {
 const localizedErrors: {[key: string]: string} = {
   MSG_TOKEN_EXPIRED_ERROR: 'You have been logged out because your token has expired.',
   MSG_ENCRYPTION_KEY_CHANGED: 'You have been logged out because your token is invalid.',
+  MSG_SESSION_IDLE_ERROR: 'You have been logged out because your session was inactive for too long.',
   MSG_ACCESS_DENIED: 'Access denied.',
   MSG_DASHBOARD_EXCLUSIVE_RESOURCE_ERROR: 'Trying to access/modify dashboard exclusive resource.',
   MSG_LOGIN_UNAUTHORIZED_ERROR: 'Invalid credentials provided',
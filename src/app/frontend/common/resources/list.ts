@@ -15,6 +15,7 @@
 import {DataSource} from '@angular/cdk/collections';
 import {HttpParams} from '@angular/common/http';
 import {
+  AfterViewInit,
   ChangeDetectorRef,
   Directive,
   EventEmitter,
@@ -26,10 +27,10 @@ import {
   ViewChild,
 } from '@angular/core';
 import {MatPaginator} from '@angular/material/paginator';
-import {MatSort} from '@angular/material/sort';
+import {MatSort, SortDirection} from '@angular/material/sort';
 import {MatTableDataSource} from '@angular/material/table';
 import {Router} from '@angular/router';
-import {Event as KdEvent, Resource, ResourceList} from '@api/root.api';
+import {DefaultSort, Event as KdEvent, Resource, ResourceList} from '@api/root.api';
 import {ActionColumn, ActionColumnDef, ColumnWhenCallback, ColumnWhenCondition, OnListChangeEvent} from '@api/root.ui';
 import {isObservable, merge, Observable, ObservableInput, Subject} from 'rxjs';
 import {startWith, switchMap, takeUntil, tap} from 'rxjs/operators';
@@ -44,7 +45,9 @@ import {ParamsService} from '../services/global/params';
 import {KdStateService} from '../services/global/state';
 
 @Directive()
-export abstract class ResourceListBase<T extends ResourceList, R extends Resource> implements OnInit, OnDestroy {
+export abstract class ResourceListBase<T extends ResourceList, R extends Resource>
+  implements OnInit, AfterViewInit, OnDestroy
+{
   // Base properties
   private readonly actionColumns_: Array<ActionColumnDef<ActionColumn>> = [];
   private readonly data_ = new MatTableDataSource<R>();
@@ -53,6 +56,7 @@ export abstract class ResourceListBase<T extends ResourceList, R extends Resourc
   private loaded_ = false;
   private readonly dynamicColumns_: ColumnWhenCondition[] = [];
   private paramsService_: ParamsService;
+  private defaultSort_: DefaultSort;
   private router_: Router;
   protected readonly unsubscribe_ = new Subject<void>();
   protected readonly kdState_: KdStateService;
@@ -198,6 +202,10 @@ export abstract class ResourceListBase<T extends ResourceList, R extends Resourc
     } as ActionColumnDef<ActionColumn>);
   }
 
+  ngAfterViewInit(): void {
+    this.applyDefaultSort_();
+  }
+
   protected registerDynamicColumn(col: string, afterCol: string, whenCallback: ColumnWhenCallback): void {
     this.dynamicColumns_.push({
       col,
@@ -304,7 +312,52 @@ export abstract class ResourceListBase<T extends ResourceList, R extends Resourc
     return !!this.filter_().get('filterBy');
   }
 
+  // Sorts the list in the default order configured for its kind in settings. Templates set the initial sort of the
+  // table, so the default is applied after the view is initialized.
+  private applyDefaultSort_(): void {
+    const defaultSort = this.settingsService_.getDefaultSort(this.id.replace(/List$/, '').toLowerCase());
+    if (!this.matSort_ || !defaultSort || defaultSort.sortBy.length === 0) {
+      return;
+    }
+
+    const active = this.mapToColumnName_(defaultSort.sortBy[0]);
+    let ascending = defaultSort.order !== 'desc';
+    if (active === 'created') {
+      ascending = !ascending;
+    }
+
+    const direction: SortDirection = ascending ? 'asc' : 'desc';
+    this.defaultSort_ = defaultSort;
+    this.matSort_.active = active;
+    this.matSort_.direction = direction;
+    this.matSort_.sortChange.emit({active, direction});
+  }
+
+  // Returns all properties of the default sort while the list is sorted by its first property, so the default order
+  // is kept until the user sorts by another column.
+  private getDefaultSortBy_(): string {
+    const sortBy = this.defaultSort_.sortBy;
+    if (this.matSort_.active !== this.mapToColumnName_(sortBy[0])) {
+      return '';
+    }
+
+    let ascending = this.matSort_.direction !== 'desc';
+    if (this.matSort_.active === 'created') {
+      ascending = !ascending;
+    }
+
+    if (ascending !== (this.defaultSort_.order !== 'desc')) {
+      return '';
+    }
+
+    return sortBy.map(property => `${ascending ? 'a' : 'd'},${property}`).join(',');
+  }
+
   private getSortBy_(): string {
+    if (this.defaultSort_ && this.getDefaultSortBy_()) {
+      return this.getDefaultSortBy_();
+    }
+
     // Default values.
     let ascending = true;
     let active = 'created';
@@ -328,6 +381,10 @@ export abstract class ResourceListBase<T extends ResourceList, R extends Resourc
     return sortByColumnName === 'created' ? 'creationTimestamp' : sortByColumnName;
   }
 
+  private mapToColumnName_(property: string): string {
+    return property === 'creationTimestamp' ? 'created' : property;
+  }
+
   private onListChange_(data: T): void {
     const emitValue = {
       id: this.id,
@@ -16,7 +16,12 @@ import {HttpClient, HttpErrorResponse} from '@angular/common/http';
 import {Inject, Injectable} from '@angular/core';
 import {MatDialog} from '@angular/material/dialog';
 import {Router} from '@angular/router';
-import {AppDeploymentContentResponse, AppDeploymentContentSpec, AppDeploymentSpec} from '@api/root.api';
+import {
+  AppDeploymentContentResponse,
+  AppDeploymentContentSpec,
+  AppDeploymentContentValidity,
+  AppDeploymentSpec,
+} from '@api/root.api';
 import {IConfig} from '@api/root.ui';
 import {CONFIG_DI_TOKEN} from '../../../index.config';
 import {AlertDialog, AlertDialogConfig} from '../../dialogs/alert/dialog';
@@ -96,6 +101,20 @@ export class CreateService {
     return response;
   }
 
+  /**
+   * Validates content with the apiserver without creating any resources. Unknown fields are reported as warnings.
+   */
+  validateContent(content: string): Promise<AppDeploymentContentValidity> {
+    const spec: AppDeploymentContentSpec = {
+      name: '',
+      namespace: this.namespace_.current(),
+      content,
+      validate: true,
+    };
+
+    return this.http_.post<AppDeploymentContentValidity>('api/v1/appdeployment/validate/file', spec).toPromise();
+  }
+
   async deploy(spec: AppDeploymentSpec): Promise<AppDeploymentContentResponse> {
     let response: AppDeploymentContentResponse;
     let error: HttpErrorResponse;
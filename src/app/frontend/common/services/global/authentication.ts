@@ -13,7 +13,7 @@
 // limitations under the License.
 
 import {HttpClient, HttpHeaders} from '@angular/common/http';
-import {Inject, Injectable} from '@angular/core';
+import {EventEmitter, Inject, Injectable} from '@angular/core';
 import {Router} from '@angular/router';
 import {IConfig} from '@api/root.ui';
 import {CookieService} from 'ngx-cookie-service';
@@ -25,17 +25,22 @@ import {CONFIG_DI_TOKEN} from '../../../index.config';
 
 import {K8SError} from '../../errors/errors';
 
+import {ConfigService} from './config';
 import {CsrfTokenService} from './csrftoken';
 import {KdStateService} from './state';
 
 @Injectable()
 export class AuthService {
+  // Emits every time a new token is stored, i.e. after login or token refresh.
+  onTokenUpdate = new EventEmitter<void>();
+
   constructor(
     private readonly cookies_: CookieService,
     private readonly router_: Router,
     private readonly http_: HttpClient,
     private readonly csrfTokenService_: CsrfTokenService,
     private readonly stateService_: KdStateService,
+    private readonly appConfig_: ConfigService,
     @Inject(CONFIG_DI_TOKEN) private readonly config_: IConfig
   ) {
     this.init_();
@@ -54,13 +59,33 @@ export class AuthService {
       return;
     }
 
+    const sameSite = this.appConfig_.getCookieSameSite();
     if (this.isCurrentProtocolSecure_()) {
-      this.cookies_.set(this.config_.authTokenCookieName, token, null, null, null, true, 'Strict');
+      this.cookies_.set(
+        this.config_.authTokenCookieName,
+        token,
+        null,
+        null,
+        this.appConfig_.getCookieDomain() || null,
+        this.appConfig_.isCookieSecure(),
+        sameSite
+      );
+      this.onTokenUpdate.emit();
       return;
     }
 
+    // Browsers reject cookies with SameSite=None attribute that are not secure, so it is relaxed on plain HTTP.
     if (this.isCurrentDomainSecure_()) {
-      this.cookies_.set(this.config_.authTokenCookieName, token, null, null, location.hostname, false, 'Strict');
+      this.cookies_.set(
+        this.config_.authTokenCookieName,
+        token,
+        null,
+        null,
+        location.hostname,
+        false,
+        sameSite === 'None' ? 'Lax' : sameSite
+      );
+      this.onTokenUpdate.emit();
     }
   }
 
@@ -77,7 +102,7 @@ export class AuthService {
   }
 
   removeAuthCookies(): void {
-    this.cookies_.delete(this.config_.authTokenCookieName);
+    this.cookies_.delete(this.config_.authTokenCookieName, null, this.appConfig_.getCookieDomain() || null);
     this.cookies_.delete(this.config_.skipLoginPageCookieName);
   }
 
@@ -112,11 +137,11 @@ export class AuthService {
 
   /**
    * Sends a token refresh request to the backend. In case user is not logged in
-   * with token nothing will happen.
+   * with token or token refresh is disabled nothing will happen.
    */
   refreshToken(): void {
     const token = this.getTokenCookie_();
-    if (token.length === 0) return;
+    if (token.length === 0 || !this.appConfig_.isTokenRefreshEnabled()) return;
 
     this.csrfTokenService_
       .getTokenForAction('token')
@@ -144,7 +169,12 @@ export class AuthService {
 
   /** Checks if user is authenticated. */
   isAuthenticated(loginStatus: LoginStatus): boolean {
-    return loginStatus.headerPresent || loginStatus.tokenPresent || !this.isLoginPageEnabled();
+    return (
+      loginStatus.headerPresent ||
+      loginStatus.tokenPresent ||
+      !this.isLoginPageEnabled() ||
+      this.appConfig_.isAnonymousAccessEnabled()
+    );
   }
 
   /**
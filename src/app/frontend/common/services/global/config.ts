@@ -14,13 +14,32 @@
 
 import {HttpClient} from '@angular/common/http';
 import {Injectable} from '@angular/core';
+import {Params} from '@angular/router';
 import {AppConfig} from '@api/root.api';
 import {VersionInfo} from '@api/root.ui';
-import {Observable} from 'rxjs';
+import {Observable, ReplaySubject} from 'rxjs';
 import {version} from '@environments/version';
+import {Resource} from '../resource/endpoint';
+
+// View users land on if it is not configured with '--default-view' flag passed to dashboard.
+const DEFAULT_VIEW = 'workloads';
+
+// Title of the browser tab if it is not configured with '--app-title' flag passed to dashboard.
+const DEFAULT_APP_TITLE = 'Kubernetes Dashboard';
+
+// Favicon of the browser tab if it is not configured with '--favicon-url' flag passed to dashboard.
+const DEFAULT_FAVICON_URL = 'assets/images/kubernetes-logo.png';
+
+// Prefix of the REST API if it is not configured with '--api-prefix' flag passed to dashboard.
+const DEFAULT_API_PREFIX = 'api/v1';
+
+// SameSite attribute of the token cookie if it is not configured with '--cookie-samesite' flag passed to dashboard.
+const DEFAULT_COOKIE_SAME_SITE = 'Lax';
 
 @Injectable()
 export class ConfigService {
+  onConfigLoad = new ReplaySubject<void>();
+
   private readonly configPath_ = 'config';
   private config_: AppConfig;
   private initTime_: number;
@@ -32,6 +51,7 @@ export class ConfigService {
       // Set init time when response from the backend will arrive.
       this.config_ = config;
       this.initTime_ = new Date().getTime();
+      this.onConfigLoad.next();
     });
   }
 
@@ -47,6 +67,151 @@ export class ConfigService {
     return new Date();
   }
 
+  /**
+   * Checks if given resource kind can be accessed. Kinds can be restricted with '--allowed-resources' flag passed to
+   * dashboard. Values that are not resource kinds are always allowed.
+   */
+  isResourceKindAllowed(kind: string): boolean {
+    if (!this.config_ || !this.config_.allowedResourceKinds) {
+      return true;
+    }
+
+    const isKind = Object.values(Resource).includes(kind as Resource);
+    return !isKind || this.config_.allowedResourceKinds.includes(kind);
+  }
+
+  /**
+   * Checks if settings can be changed. Dashboard started with '--settings-read-only' flag rejects all changes.
+   */
+  isSettingsReadOnly(): boolean {
+    return !!this.config_ && !!this.config_.settingsReadOnly;
+  }
+
+  /**
+   * Returns product name displayed on the login page. It can be configured with '--login-title' flag passed to
+   * dashboard. Empty if default branding should be used.
+   */
+  getLoginTitle(): string {
+    return this.config_ && this.config_.loginTitle ? this.config_.loginTitle : '';
+  }
+
+  /**
+   * Returns URL of the logo displayed on the login page. It can be configured with '--login-logo-url' flag passed
+   * to dashboard. Empty if no logo should be displayed.
+   */
+  getLoginLogoUrl(): string {
+    return this.config_ && this.config_.loginLogoUrl ? this.config_.loginLogoUrl : '';
+  }
+
+  /**
+   * Returns title of the browser tab. It can be configured with '--app-title' flag passed to dashboard.
+   */
+  getAppTitle(): string {
+    return this.config_ && this.config_.appTitle ? this.config_.appTitle : DEFAULT_APP_TITLE;
+  }
+
+  /**
+   * Returns URL of the favicon. It can be configured with '--favicon-url' flag passed to dashboard.
+   */
+  getFaviconUrl(): string {
+    return this.config_ && this.config_.faviconUrl ? this.config_.faviconUrl : DEFAULT_FAVICON_URL;
+  }
+
+  /**
+   * Returns SameSite attribute of the cookie that carries the JWE token. It can be configured with
+   * '--cookie-samesite' flag passed to dashboard.
+   */
+  getCookieSameSite(): 'Lax' | 'Strict' | 'None' {
+    return this.config_ && this.config_.cookieSameSite ? this.config_.cookieSameSite : DEFAULT_COOKIE_SAME_SITE;
+  }
+
+  /**
+   * Checks if the cookie that carries the JWE token should be sent only over HTTPS. It can be disabled with
+   * '--cookie-secure=false' flag passed to dashboard.
+   */
+  isCookieSecure(): boolean {
+    return !this.config_ || this.config_.cookieSecure !== false;
+  }
+
+  /**
+   * Returns Domain attribute of the cookie that carries the JWE token. It can be configured with '--cookie-domain'
+   * flag passed to dashboard. Empty if the cookie should be restricted to the current host.
+   */
+  getCookieDomain(): string {
+    return this.config_ && this.config_.cookieDomain ? this.config_.cookieDomain : '';
+  }
+
+  /**
+   * Returns namespace pre-filled in the create form. It can be configured with '--default-create-namespace' flag
+   * passed to dashboard. Empty if it is not known.
+   */
+  getDefaultCreateNamespace(): string {
+    return this.config_ && this.config_.defaultCreateNamespace ? this.config_.defaultCreateNamespace : '';
+  }
+
+  /**
+   * Returns propagation policy of delete requests. It can be configured with '--default-delete-propagation' flag
+   * passed to dashboard. Empty if it is not known.
+   */
+  getDefaultDeletePropagation(): string {
+    return this.config_ && this.config_.defaultDeletePropagation ? this.config_.defaultDeletePropagation : '';
+  }
+
+  /**
+   * Returns IANA name of the timezone that timestamps are displayed in by default. It can be configured with
+   * '--default-timezone' flag passed to dashboard. Empty if it is not known.
+   */
+  getDefaultTimezone(): string {
+    return this.config_ && this.config_.defaultTimezone ? this.config_.defaultTimezone : '';
+  }
+
+  /**
+   * Returns true if allowed resources can be viewed without logging in. It can be enabled with '--anonymous-access'
+   * flag passed to dashboard.
+   */
+  isAnonymousAccessEnabled(): boolean {
+    return this.config_ ? !!this.config_.anonymousAccess : false;
+  }
+
+  /**
+   * Returns time in seconds before the session expires when user is warned. It can be configured with
+   * '--session-warning-lead-time' flag passed to dashboard. 0 if warnings are disabled.
+   */
+  getSessionWarningLeadTime(): number {
+    return this.config_ && this.config_.sessionWarningLeadTime ? this.config_.sessionWarningLeadTime : 0;
+  }
+
+  /**
+   * Checks if tokens can be refreshed. Refresh can be disabled with '--enable-token-refresh=false' flag passed to
+   * dashboard.
+   */
+  isTokenRefreshEnabled(): boolean {
+    return !this.config_ || !this.config_.tokenRefreshDisabled;
+  }
+
+  /**
+   * Returns prefix of the REST API without leading slash, i.e. 'api/v1'. It can be configured with '--api-prefix'
+   * flag passed to dashboard.
+   */
+  getApiPrefix(): string {
+    return this.config_ && this.config_.apiPrefix ? this.config_.apiPrefix.replace(/^\//, '') : DEFAULT_API_PREFIX;
+  }
+
+  /**
+   * Returns view that users land on after opening dashboard or logging in. It can be configured with '--default-view'
+   * flag passed to dashboard. Config loaded at boot is used if no other config is given.
+   */
+  getDefaultView(config = this.config_): string {
+    return config && config.defaultView ? config.defaultView : DEFAULT_VIEW;
+  }
+
+  /**
+   * Returns query params of the default view, i.e. namespace selected with '--default-view' flag.
+   */
+  getDefaultViewQueryParams(config = this.config_): Params {
+    return config && config.defaultViewNamespace ? {namespace: config.defaultViewNamespace} : {};
+  }
+
   getVersionInfo(): VersionInfo {
     return version;
   }
@@ -0,0 +1,39 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {HttpClient} from '@angular/common/http';
+import {Injectable} from '@angular/core';
+import {FeatureFlags} from '@api/root.api';
+import {Observable, of} from 'rxjs';
+import {catchError, map} from 'rxjs/operators';
+
+@Injectable()
+export class FeatureService {
+  private readonly endpoint_ = 'api/v1/me/features';
+
+  constructor(private readonly http_: HttpClient) {}
+
+  /**
+   * Checks if given feature is enabled for the current user. Features are evaluated by the backend based on user
+   * permissions in given namespace, or in all namespaces if it is not set. They are disabled if the flags could not be
+   * loaded.
+   */
+  isEnabled(feature: string, namespace?: string): Observable<boolean> {
+    const params: {[param: string]: string} = namespace ? {namespace} : {};
+    return this.http_.get<FeatureFlags>(this.endpoint_, {params}).pipe(
+      map(flags => !!flags.features && !!flags.features[feature]),
+      catchError(() => of(false))
+    );
+  }
+}
@@ -14,7 +14,7 @@
 
 import {HttpClient, HttpHeaders} from '@angular/common/http';
 import {EventEmitter, Injectable} from '@angular/core';
-import {GlobalSettings} from '@api/root.api';
+import {DefaultSort, GlobalSettings} from '@api/root.api';
 import {onSettingsFailCallback, onSettingsLoadCallback} from '@api/root.ui';
 import _ from 'lodash';
 import {Observable, of, ReplaySubject, Subject} from 'rxjs';
@@ -28,6 +28,7 @@ export class GlobalSettingsService {
   onPageVisibilityChange = new EventEmitter<boolean>();
 
   private readonly endpoint_ = 'api/v1/settings/global';
+  private readonly defaultSortEndpoint_ = 'api/v1/settings/defaultsort';
   private settings_: GlobalSettings = {
     itemsPerPage: 10,
     clusterName: '',
@@ -38,6 +39,7 @@ export class GlobalSettingsService {
     defaultNamespace: 'default',
     namespaceFallbackList: ['default'],
   };
+  private defaultSorts_: {[kind: string]: DefaultSort} = {};
   private unsubscribe_ = new Subject<void>();
   private isInitialized_ = false;
   private isPageVisible_ = true;
@@ -58,6 +60,14 @@ export class GlobalSettingsService {
   }
 
   load(onLoad?: onSettingsLoadCallback, onFail?: onSettingsFailCallback): void {
+    this.http_
+      .get<{[kind: string]: DefaultSort}>(this.defaultSortEndpoint_)
+      .toPromise()
+      .then(
+        sorts => (this.defaultSorts_ = sorts),
+        _ => (this.defaultSorts_ = {})
+      );
+
     this.http_
       .get<GlobalSettings>(this.endpoint_)
       .toPromise()
@@ -121,6 +131,10 @@ export class GlobalSettingsService {
     return this.settings_.defaultNamespace;
   }
 
+  getDefaultSort(kind: string): DefaultSort | undefined {
+    return this.defaultSorts_[kind];
+  }
+
   getNamespaceFallbackList(): string[] {
     return _.isArray(this.settings_.namespaceFallbackList)
       ? this.settings_.namespaceFallbackList
@@ -13,7 +13,7 @@
 // limitations under the License.
 
 import {Injectable, Injector} from '@angular/core';
-import {Navigation, NavigationEnd, Router} from '@angular/router';
+import {Navigation, NavigationEnd, Params, Router} from '@angular/router';
 import {filter, pairwise} from 'rxjs/operators';
 
 @Injectable()
@@ -45,13 +45,18 @@ export class HistoryService {
   }
 
   /**
-   * Goes back to previous state or to the provided defaultState if none set.
+   * Goes back to previous state or to the provided defaultState if none set. Current query params are preserved
+   * unless queryParams of the default state are provided.
    */
-  goToPreviousState(defaultState: string): Promise<boolean> {
+  goToPreviousState(defaultState: string, queryParams?: Params): Promise<boolean> {
     if (this.previousStateUrl_ && this.previousStateUrl_ !== this.currentStateUrl_) {
       return this.router_.navigateByUrl(this.previousStateUrl_);
     }
 
+    if (queryParams && Object.keys(queryParams).length > 0) {
+      return this.router_.navigate([defaultState], {queryParams});
+    }
+
     return this.router_.navigate([defaultState], {queryParamsHandling: 'preserve'});
   }
 }
@@ -13,24 +13,37 @@
 // limitations under the License.
 
 import {HttpEvent, HttpHandler, HttpInterceptor, HttpRequest} from '@angular/common/http';
-import {Inject, Injectable} from '@angular/core';
+import {Inject, Injectable, Injector} from '@angular/core';
 import {IConfig} from '@api/root.ui';
 import {CookieService} from 'ngx-cookie-service';
 import {Observable} from 'rxjs';
 import {CONFIG_DI_TOKEN} from '../../../index.config';
 
+import {ConfigService} from './config';
+
+// Prefix of the REST API used in requests made by the frontend. It is replaced with the one configured with
+// '--api-prefix' flag passed to dashboard.
+const API_PREFIX = 'api/v1';
+
 @Injectable()
 export class AuthInterceptor implements HttpInterceptor {
   constructor(
     private readonly cookies_: CookieService,
+    private readonly injector_: Injector,
     @Inject(CONFIG_DI_TOKEN) private readonly appConfig_: IConfig
   ) {}
 
   intercept(req: HttpRequest<any>, next: HttpHandler): Observable<HttpEvent<any>> {
+    // Config service is resolved lazily, as it depends on the HTTP client that depends on this interceptor.
+    const apiPrefix = this.injector_.get(ConfigService).getApiPrefix();
+    if (req.url.startsWith(`${API_PREFIX}/`) && apiPrefix !== API_PREFIX) {
+      req = req.clone({url: apiPrefix + req.url.substring(API_PREFIX.length)});
+    }
+
     const authCookie = this.cookies_.get(this.appConfig_.authTokenCookieName);
-    // Filter requests made to our backend starting with 'api/v1' and append request header
+    // Filter requests made to our backend starting with the API prefix and append request header
     // with token stored in a cookie.
-    if (req.url.startsWith('api/v1') && authCookie.length) {
+    if (req.url.startsWith(apiPrefix) && authCookie.length) {
       const authReq = req.clone({
         headers: req.headers.set(this.appConfig_.authTokenHeaderName, authCookie),
       });
@@ -43,6 +43,15 @@ export class LocalSettingsService {
     this.updateCookie_();
   }
 
+  /**
+   * Overrides the timezone that timestamps are displayed in. Empty timezone means that the timezone configured with
+   * '--default-timezone' flag is used.
+   */
+  handleTimezoneChange(timezone: string): void {
+    this.settings_.timezone = timezone;
+    this.updateCookie_();
+  }
+
   updateCookie_(): void {
     localStorage.setItem(this._settingsKey, JSON.stringify(this.settings_));
   }
@@ -12,10 +12,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import {HttpClient, HttpParams} from '@angular/common/http';
-import {Injectable} from '@angular/core';
+import {HttpClient, HttpHeaders, HttpParams} from '@angular/common/http';
+import {Inject, Injectable} from '@angular/core';
+import {IConfig} from '@api/root.ui';
 import {Observable} from 'rxjs';
 
+import {CONFIG_DI_TOKEN} from '../../../index.config';
+
 @Injectable()
 export class LogService {
   previous_ = false;
@@ -25,10 +28,19 @@ export class LogService {
   following_ = false;
   autoRefresh_ = false;
 
-  constructor(private readonly http_: HttpClient) {}
+  constructor(private readonly http_: HttpClient, @Inject(CONFIG_DI_TOKEN) private readonly config_: IConfig) {}
+
+  /**
+   * Requests made by the auto refresh are marked as background requests, so they are not counted as user activity by
+   * the backend.
+   */
+  getResource<T>(uri: string, params?: HttpParams, background = false): Observable<T> {
+    let headers = new HttpHeaders();
+    if (background) {
+      headers = headers.set(this.config_.backgroundRequestHeaderName, 'true');
+    }
 
-  getResource<T>(uri: string, params?: HttpParams): Observable<T> {
-    return this.http_.get<T>(`api/v1/log/${uri}`, {params});
+    return this.http_.get<T>(`api/v1/log/${uri}`, {params, headers});
   }
 
   setFollowing(status: boolean): void {
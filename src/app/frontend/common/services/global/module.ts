@@ -22,6 +22,7 @@ import {AuthService} from './authentication';
 import {AuthorizerService} from './authorizer';
 import {ConfigService} from './config';
 import {CsrfTokenService} from './csrftoken';
+import {FeatureService} from './features';
 import {GlobalSettingsService} from './globalsettings';
 import {HistoryService} from './history';
 import {AuthInterceptor} from './interceptor';
@@ -30,6 +31,7 @@ import {LogService} from './logs';
 import {NamespaceService} from './namespace';
 import {NotificationsService} from './notifications';
 import {ParamsService} from './params';
+import {SessionWarningService} from './sessionwarning';
 import {KdStateService} from './state';
 import {ThemeService} from './theme';
 import {TitleService} from './title';
@@ -50,6 +52,7 @@ import {PinnerService} from './pinner';
     TitleService,
     AuthService,
     CsrfTokenService,
+    FeatureService,
     NotificationsService,
     ThemeService,
     KdStateService,
@@ -60,6 +63,7 @@ import {PinnerService} from './pinner';
     HistoryService,
     LogService,
     ParamsService,
+    SessionWarningService,
     LocalConfigLoaderService,
     {
       provide: APP_INITIALIZER,
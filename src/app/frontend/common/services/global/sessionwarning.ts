@@ -0,0 +1,145 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {HttpClient, HttpHeaders} from '@angular/common/http';
+import {Inject, Injectable, NgZone} from '@angular/core';
+import {IConfig} from '@api/root.ui';
+import {SessionWarningMessage, SessionWarningResponse, SJSMessageEvent} from '@api/root.api';
+import {interval, Subject, Subscription} from 'rxjs';
+import {first, takeUntil} from 'rxjs/operators';
+
+import {CONFIG_DI_TOKEN} from '../../../index.config';
+
+import {AuthService} from './authentication';
+import {ConfigService} from './config';
+
+declare let SockJS: any;
+
+/**
+ * Receives warnings about expiration of the session from the backend and counts down the remaining time, so that user
+ * can extend the session before it expires. Warnings are enabled with '--session-warning-lead-time' flag passed to
+ * dashboard.
+ */
+@Injectable()
+export class SessionWarningService {
+  private static readonly endpoint_ = 'api/v1/session/warning';
+  // Remaining time in seconds or null if session does not expire soon.
+  expiresIn: number = null;
+  reason = '';
+
+  private conn_: any;
+  private countdown_: Subscription;
+  private readonly unsubscribe_ = new Subject<void>();
+
+  constructor(
+    private readonly http_: HttpClient,
+    private readonly ngZone_: NgZone,
+    private readonly auth_: AuthService,
+    private readonly config_: ConfigService,
+    @Inject(CONFIG_DI_TOKEN) private readonly appConfig_: IConfig
+  ) {}
+
+  init(): void {
+    this.config_.onConfigLoad.pipe(first()).subscribe(() => {
+      if (this.config_.getSessionWarningLeadTime() === 0) {
+        return;
+      }
+
+      // Warnings are bound to credentials of the session, so channel is reopened for every new token.
+      this.auth_.onTokenUpdate.pipe(takeUntil(this.unsubscribe_)).subscribe(() => this.connect_());
+      this.connect_();
+    });
+  }
+
+  destroy(): void {
+    this.unsubscribe_.next();
+    this.close_();
+  }
+
+  isWarningVisible(): boolean {
+    return this.expiresIn !== null;
+  }
+
+  /**
+   * Extends the session by refreshing the token. Refresh request is an user activity, so it resets the idle timeout
+   * as well.
+   */
+  extend(): void {
+    this.stopCountdown_();
+    this.auth_.refreshToken();
+  }
+
+  private connect_(): void {
+    this.close_();
+
+    // Opening the channel is not a user activity.
+    const headers = new HttpHeaders().set(this.appConfig_.backgroundRequestHeaderName, 'true');
+    this.http_
+      .get<SessionWarningResponse>(SessionWarningService.endpoint_, {headers})
+      .pipe(takeUntil(this.unsubscribe_))
+      .subscribe(
+        response => {
+          const conn = new SockJS(`api/session?${response.id}`);
+          conn.onopen = () => conn.send(JSON.stringify({op: 'bind', sessionId: response.id}));
+          conn.onmessage = (evt: SJSMessageEvent) => this.ngZone_.run(() => this.onMessage_(JSON.parse(evt.data)));
+          this.conn_ = conn;
+        },
+        // Session of the user without credentials does not expire.
+        () => {}
+      );
+  }
+
+  private close_(): void {
+    this.stopCountdown_();
+    if (this.conn_) {
+      this.conn_.close();
+      this.conn_ = null;
+    }
+  }
+
+  private onMessage_(msg: SessionWarningMessage): void {
+    switch (msg.op) {
+      case 'warning':
+        this.startCountdown_(msg.expiresIn, msg.reason);
+        break;
+      case 'extended':
+        this.stopCountdown_();
+        break;
+      case 'expired':
+        this.close_();
+        this.auth_.logout();
+        break;
+      default:
+    }
+  }
+
+  private startCountdown_(expiresIn: number, reason: string): void {
+    this.stopCountdown_();
+    this.expiresIn = expiresIn;
+    this.reason = reason;
+    this.countdown_ = interval(1000).subscribe(() => {
+      if (this.expiresIn > 0) {
+        this.expiresIn--;
+      }
+    });
+  }
+
+  private stopCountdown_(): void {
+    if (this.countdown_) {
+      this.countdown_.unsubscribe();
+      this.countdown_ = null;
+    }
+    this.expiresIn = null;
+  }
+}
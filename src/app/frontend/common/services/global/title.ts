@@ -12,32 +12,43 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import {Injectable} from '@angular/core';
+import {DOCUMENT} from '@angular/common';
+import {Inject, Injectable} from '@angular/core';
 import {Title} from '@angular/platform-browser';
+import {first} from 'rxjs/operators';
 
+import {ConfigService} from './config';
 import {GlobalSettingsService} from './globalsettings';
 
 @Injectable()
 export class TitleService {
   clusterName = '';
 
-  constructor(private readonly title_: Title, private readonly settings_: GlobalSettingsService) {}
+  constructor(
+    private readonly title_: Title,
+    private readonly settings_: GlobalSettingsService,
+    private readonly config_: ConfigService,
+    @Inject(DOCUMENT) private readonly document_: Document
+  ) {}
 
   update(): void {
-    this.settings_.load(
-      () => {
-        this.clusterName = this.settings_.getClusterName();
-        this.apply_();
-      },
-      () => {
-        this.clusterName = '';
-        this.apply_();
-      }
-    );
+    this.config_.onConfigLoad.pipe(first()).subscribe(() => {
+      this.applyFavicon_();
+      this.settings_.load(
+        () => {
+          this.clusterName = this.settings_.getClusterName();
+          this.apply_();
+        },
+        () => {
+          this.clusterName = '';
+          this.apply_();
+        }
+      );
+    });
   }
 
   private apply_(): void {
-    let title = 'Kubernetes Dashboard';
+    let title = this.config_.getAppTitle();
 
     if (this.clusterName && this.clusterName.length > 0) {
       title = `${this.clusterName} - ` + title;
@@ -45,4 +56,12 @@ export class TitleService {
 
     this.title_.setTitle(title);
   }
+
+  private applyFavicon_(): void {
+    const favicon = this.document_.querySelector<HTMLLinkElement>('link[rel="icon"]');
+    if (favicon) {
+      favicon.removeAttribute('type');
+      favicon.href = this.config_.getFaviconUrl();
+    }
+  }
 }
@@ -20,6 +20,7 @@ import {filter, switchMap} from 'rxjs/operators';
 
 import {AlertDialog, AlertDialogConfig} from '../../dialogs/alert/dialog';
 import {DeleteResourceDialog} from '../../dialogs/deleteresource/dialog';
+import {DrainNodeDialog} from '../../dialogs/drainnode/dialog';
 import {EditResourceDialog} from '../../dialogs/editresource/dialog';
 import {RestartResourceDialog} from '../../dialogs/restartresource/dialog';
 import {ScaleResourceDialog} from '../../dialogs/scaleresource/dialog';
@@ -35,6 +36,7 @@ export class VerberService {
   onScale = new EventEmitter<boolean>();
   onTrigger = new EventEmitter<boolean>();
   onRestart = new EventEmitter<boolean>();
+  onNodeMaintenance = new EventEmitter<boolean>();
 
   constructor(private readonly dialog_: MatDialog, private readonly http_: HttpClient) {}
 
@@ -85,8 +87,8 @@ export class VerberService {
 
   showScaleDialog(displayName: string, typeMeta: TypeMeta, objectMeta: ObjectMeta): void {
     const dialogConfig = this.getDialogConfig_(displayName, typeMeta, objectMeta);
-    this.dialog_
-      .open(ScaleResourceDialog, dialogConfig)
+    const dialogRef = this.dialog_.open(ScaleResourceDialog, dialogConfig);
+    dialogRef
       .afterClosed()
       .pipe(filter(result => Number.isInteger(result)))
       .pipe(
@@ -94,11 +96,29 @@ export class VerberService {
           const url = `api/v1/scale/${typeMeta.kind}${objectMeta.namespace ? `/${objectMeta.namespace}` : ''}/${
             objectMeta.name
           }/`;
+          const params: {[param: string]: string} = {scaleBy: `${result}`};
+          if (dialogRef.componentInstance.resourceVersion) {
+            params.resourceVersion = dialogRef.componentInstance.resourceVersion;
+          }
 
-          return this.http_.put(url, {scaleBy: result}, {params: {scaleBy: result}});
+          return this.http_.put(url, {scaleBy: result}, {params});
         })
       )
-      .subscribe(_ => this.onScale.emit(true), this.handleErrorResponse_.bind(this));
+      .subscribe(
+        _ => this.onScale.emit(true),
+        (err: HttpErrorResponse) => {
+          if (err && err.status === 409) {
+            // Resource was changed while the dialog was open. Refresh it, so that scaling can be retried.
+            this.onScale.emit(true);
+            err = new HttpErrorResponse({
+              status: err.status,
+              statusText: err.statusText,
+              error: `${displayName} was changed in the meantime. Review its current state and scale it again.`,
+            });
+          }
+          this.handleErrorResponse_(err);
+        }
+      );
   }
 
   showTriggerDialog(displayName: string, typeMeta: TypeMeta, objectMeta: ObjectMeta): void {
@@ -116,6 +136,22 @@ export class VerberService {
       .subscribe(_ => this.onTrigger.emit(true), this.handleErrorResponse_.bind(this));
   }
 
+  setNodeUnschedulable(objectMeta: ObjectMeta, unschedulable: boolean): void {
+    const url = `api/v1/node/${objectMeta.name}/${unschedulable ? 'cordon' : 'uncordon'}`;
+    this.http_
+      .put(url, {}, {responseType: 'text'})
+      .subscribe(_ => this.onNodeMaintenance.emit(true), this.handleErrorResponse_.bind(this));
+  }
+
+  showDrainDialog(displayName: string, typeMeta: TypeMeta, objectMeta: ObjectMeta): void {
+    const dialogConfig = this.getDialogConfig_(displayName, typeMeta, objectMeta);
+    this.dialog_
+      .open(DrainNodeDialog, dialogConfig)
+      .afterClosed()
+      .pipe(filter(started => started))
+      .subscribe(_ => this.onNodeMaintenance.emit(true));
+  }
+
   getDialogConfig_(displayName: string, typeMeta: TypeMeta, objectMeta: ObjectMeta): MatDialogConfig<ResourceMeta> {
     return {width: '900px', data: {displayName, typeMeta, objectMeta}};
   }
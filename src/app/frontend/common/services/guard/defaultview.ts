@@ -0,0 +1,42 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {Injectable} from '@angular/core';
+import {CanActivate, Router, UrlTree} from '@angular/router';
+import {AppConfig} from '@api/root.api';
+import {Observable, of} from 'rxjs';
+import {catchError, first, map} from 'rxjs/operators';
+import {ConfigService} from '../global/config';
+
+/**
+ * Redirects to the default view configured with '--default-view' flag passed to dashboard.
+ */
+@Injectable()
+export class DefaultViewGuard implements CanActivate {
+  constructor(private readonly config_: ConfigService, private readonly router_: Router) {}
+
+  canActivate(): Observable<UrlTree> {
+    return this.config_
+      .getAppConfig()
+      .pipe(first())
+      .pipe(map((config: AppConfig) => this.defaultViewUrl_(config)))
+      .pipe(catchError(_ => of(this.defaultViewUrl_())));
+  }
+
+  private defaultViewUrl_(config?: AppConfig): UrlTree {
+    return this.router_.createUrlTree([this.config_.getDefaultView(config)], {
+      queryParams: this.config_.getDefaultViewQueryParams(config),
+    });
+  }
+}
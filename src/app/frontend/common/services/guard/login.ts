@@ -18,10 +18,15 @@ import {LoginStatus} from '@api/root.api';
 import {Observable, of} from 'rxjs';
 import {catchError, first, switchMap} from 'rxjs/operators';
 import {AuthService} from '../global/authentication';
+import {ConfigService} from '../global/config';
 
 @Injectable()
 export class LoginGuard implements CanActivate {
-  constructor(private readonly authService_: AuthService, private readonly router_: Router) {}
+  constructor(
+    private readonly authService_: AuthService,
+    private readonly router_: Router,
+    private readonly config_: ConfigService
+  ) {}
 
   canActivate(): Observable<boolean | UrlTree> {
     return this.authService_
@@ -30,12 +35,18 @@ export class LoginGuard implements CanActivate {
       .pipe(
         switchMap((loginStatus: LoginStatus) => {
           if (!this.authService_.isAuthenticationEnabled(loginStatus)) {
-            return this.router_.navigate(['workloads']);
+            return this.navigateToDefaultView_();
           }
 
           return of(true);
         })
       )
-      .pipe(catchError(_ => this.router_.navigate(['workloads'])));
+      .pipe(catchError(_ => this.navigateToDefaultView_()));
+  }
+
+  private navigateToDefaultView_(): Promise<boolean> {
+    return this.router_.navigate([this.config_.getDefaultView()], {
+      queryParams: this.config_.getDefaultViewQueryParams(),
+    });
   }
 }
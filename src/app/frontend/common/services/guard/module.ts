@@ -14,10 +14,11 @@
 
 import {NgModule} from '@angular/core';
 import {AuthGuard} from './auth';
+import {DefaultViewGuard} from './defaultview';
 import {LoginGuard} from './login';
 import {SearchGuard} from './search';
 
 @NgModule({
-  providers: [AuthGuard, SearchGuard, LoginGuard],
+  providers: [AuthGuard, SearchGuard, LoginGuard, DefaultViewGuard],
 })
 export class GuardsModule {}
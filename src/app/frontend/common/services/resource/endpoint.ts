@@ -45,6 +45,7 @@ export enum Resource {
   service = 'service',
   serviceAccount = 'serviceaccount',
   networkPolicy = 'networkpolicy',
+  resourceQuota = 'resourcequota',
   event = 'event',
   container = 'container',
   plugin = 'plugin',
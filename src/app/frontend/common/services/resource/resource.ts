@@ -12,23 +12,38 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import {HttpClient, HttpParams} from '@angular/common/http';
-import {Injectable} from '@angular/core';
+import {HttpClient, HttpHeaders, HttpParams} from '@angular/common/http';
+import {Inject, Injectable} from '@angular/core';
+import {IConfig} from '@api/root.ui';
 import {timer} from 'rxjs';
 import {Observable} from 'rxjs';
-import {publishReplay, refCount, switchMap, switchMapTo} from 'rxjs/operators';
+import {publishReplay, refCount, switchMap} from 'rxjs/operators';
+import {CONFIG_DI_TOKEN} from '../../../index.config';
 
 import {ResourceBase} from '../../resources/resource';
 import {GlobalSettingsService} from '../global/globalsettings';
 import {NamespaceService} from '../global/namespace';
 
+/**
+ * Only the first request is made on behalf of the user, following requests are made by the auto refresh and are
+ * marked as background requests so they are not counted as user activity by the backend.
+ */
+function requestHeaders(config: IConfig, tick: number): HttpHeaders {
+  const headers = new HttpHeaders();
+  return tick > 0 ? headers.set(config.backgroundRequestHeaderName, 'true') : headers;
+}
+
 @Injectable()
 export class ResourceService<T> extends ResourceBase<T> {
   /**
    * We need to provide HttpClient here since the base is not annotated with
    * @Injectable
    */
-  constructor(readonly http: HttpClient, private readonly settings_: GlobalSettingsService) {
+  constructor(
+    readonly http: HttpClient,
+    private readonly settings_: GlobalSettingsService,
+    @Inject(CONFIG_DI_TOKEN) private readonly config_: IConfig
+  ) {
     super(http);
   }
 
@@ -45,7 +60,7 @@ export class ResourceService<T> extends ResourceBase<T> {
           return timer(0, interval);
         })
       )
-      .pipe(switchMapTo(this.http_.get<T>(endpoint, {params})))
+      .pipe(switchMap(tick => this.http_.get<T>(endpoint, {params, headers: requestHeaders(this.config_, tick)})))
       .pipe(publishReplay(1))
       .pipe(refCount());
   }
@@ -56,7 +71,8 @@ export class NamespacedResourceService<T> extends ResourceBase<T> {
   constructor(
     readonly http: HttpClient,
     private readonly namespace_: NamespaceService,
-    private readonly settings_: GlobalSettingsService
+    private readonly settings_: GlobalSettingsService,
+    @Inject(CONFIG_DI_TOKEN) private readonly config_: IConfig
   ) {
     super(http);
   }
@@ -85,7 +101,7 @@ export class NamespacedResourceService<T> extends ResourceBase<T> {
           return timer(0, interval);
         })
       )
-      .pipe(switchMapTo(this.http_.get<T>(endpoint, {params})))
+      .pipe(switchMap(tick => this.http_.get<T>(endpoint, {params, headers: requestHeaders(this.config_, tick)})))
       .pipe(publishReplay(1))
       .pipe(refCount());
   }
@@ -29,6 +29,7 @@ import {
 import {take} from 'rxjs/operators';
 
 import {CreateService} from '../../../common/services/create/service';
+import {ConfigService} from '../../../common/services/global/config';
 import {HistoryService} from '../../../common/services/global/history';
 import {NamespaceService} from '../../../common/services/global/namespace';
 
@@ -65,7 +66,8 @@ export class CreateFromFormComponent implements OnInit {
     private readonly http_: HttpClient,
     private readonly route_: ActivatedRoute,
     private readonly fb_: FormBuilder,
-    private readonly dialog_: MatDialog
+    private readonly dialog_: MatDialog,
+    private readonly config_: ConfigService
   ) {}
 
   ngOnInit(): void {
@@ -99,8 +101,8 @@ export class CreateFromFormComponent implements OnInit {
       this.namespaces = result.namespaces.map((namespace: Namespace) => namespace.objectMeta.name);
       this.namespace.patchValue(
         !this.namespace_.areMultipleNamespacesSelected()
-          ? this.route_.snapshot.params.namespace || this.namespaces[0]
-          : this.namespaces[0]
+          ? this.route_.snapshot.params.namespace || this.getDefaultNamespace_()
+          : this.getDefaultNamespace_()
       );
     });
     this.http_
@@ -295,4 +297,12 @@ export class CreateFromFormComponent implements OnInit {
     };
     this.create_.deploy(spec);
   }
+
+  /**
+   * Returns namespace configured with '--default-create-namespace' flag if it exists, otherwise first namespace.
+   */
+  private getDefaultNamespace_(): string {
+    const defaultNamespace = this.config_.getDefaultCreateNamespace();
+    return this.namespaces.includes(defaultNamespace) ? defaultNamespace : this.namespaces[0];
+  }
 }
@@ -13,6 +13,7 @@
 // limitations under the License.
 
 import {Component} from '@angular/core';
+import {AppDeploymentContentValidity} from '@api/root.api';
 
 import {CreateService} from '../../../common/services/create/service';
 import {HistoryService} from '../../../common/services/global/history';
@@ -25,6 +26,8 @@ import {NamespaceService} from '../../../common/services/global/namespace';
 })
 export class CreateFromInputComponent {
   inputData = '';
+  validity: AppDeploymentContentValidity;
+  isValidationInProgress = false;
 
   constructor(
     private readonly namespace_: NamespaceService,
@@ -40,6 +43,19 @@ export class CreateFromInputComponent {
     this.create_.createContent(this.inputData);
   }
 
+  async validate(): Promise<void> {
+    this.isValidationInProgress = true;
+    try {
+      this.validity = await this.create_.validateContent(this.inputData);
+    } finally {
+      this.isValidationInProgress = false;
+    }
+  }
+
+  isValidateDisabled(): boolean {
+    return this.isCreateDisabled() || this.isValidationInProgress;
+  }
+
   cancel(): void {
     this.history_.goToPreviousState('overview');
   }
@@ -49,7 +49,7 @@ export class GlobalErrorHandler implements ErrorHandler {
 
   private handleHTTPError_(error: HttpErrorResponse): void {
     this.ngZone_.run(() => {
-      if (KdError.isError(error, ApiError.tokenExpired, ApiError.encryptionKeyChanged)) {
+      if (KdError.isError(error, ApiError.tokenExpired, ApiError.encryptionKeyChanged, ApiError.sessionIdle)) {
         this.auth_.removeAuthCookies();
         this.router_.navigate(['login'], {
           state: {error: AsKdError(error)} as StateError,
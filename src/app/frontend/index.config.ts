@@ -57,6 +57,7 @@ export const CONFIG: IConfig = {
   authTokenCookieName: 'jweToken',
   authTokenHeaderName: 'jweToken',
   csrfHeaderName: 'X-CSRF-TOKEN',
+  backgroundRequestHeaderName: 'X-Dashboard-Background',
   skipLoginPageCookieName: 'skipLoginPage',
   defaultNamespace: 'default',
   authModeCookieName: 'authMode',
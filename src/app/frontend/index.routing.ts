@@ -13,11 +13,12 @@
 // limitations under the License.
 
 import {Routes} from '@angular/router';
+import {DefaultViewGuard} from '@common/services/guard/defaultview';
 import {LoginGuard} from '@common/services/guard/login';
 import {LoginComponent} from './login/component';
 
 export const routes: Routes = [
   {path: 'login', component: LoginComponent, canActivate: [LoginGuard]},
-  {path: '', redirectTo: '/workloads', pathMatch: 'full'},
-  {path: '**', redirectTo: '/workloads'},
+  {path: '', pathMatch: 'full', canActivate: [DefaultViewGuard], children: []},
+  {path: '**', canActivate: [DefaultViewGuard], children: []},
 ];
@@ -13,23 +13,37 @@
 // limitations under the License.
 
 import {HttpClient, HttpErrorResponse} from '@angular/common/http';
-import {Component, Inject, NgZone, OnInit} from '@angular/core';
+import {Component, Inject, NgZone, OnDestroy, OnInit} from '@angular/core';
 import {ActivatedRoute, Router} from '@angular/router';
-import {AuthenticationMode, EnabledAuthenticationModes, LoginSkippableResponse, LoginSpec} from '@api/root.api';
+import {
+  AuthenticationMode,
+  EnabledAuthenticationModes,
+  LoginReadOnlyResponse,
+  LoginSkippableResponse,
+  LoginSpec,
+} from '@api/root.api';
 import {KdError} from '@api/root.shared';
 import {IConfig, KdFile, StateError} from '@api/root.ui';
 import {AsKdError, K8SError} from '@common/errors/errors';
 import {AuthService} from '@common/services/global/authentication';
+import {ConfigService} from '@common/services/global/config';
 import {HistoryService} from '@common/services/global/history';
 import {PluginsConfigService} from '@common/services/global/plugin';
 import {CookieService} from 'ngx-cookie-service';
-import {map} from 'rxjs/operators';
+import {EMPTY, interval, Subject} from 'rxjs';
+import {catchError, map, startWith, switchMap, takeUntil} from 'rxjs/operators';
 import {CONFIG_DI_TOKEN} from '../index.config';
 
+// Authentication modes can be changed in the settings config map while dashboard is running, so they are periodically
+// reloaded.
+const AUTHENTICATION_MODES_REFRESH_INTERVAL = 30000;
+
 enum LoginModes {
   Kubeconfig = 'kubeconfig',
   Basic = 'basic',
   Token = 'token',
+  OIDC = 'oidc',
+  X509 = 'x509',
 }
 
 @Component({
@@ -37,17 +51,21 @@ enum LoginModes {
   templateUrl: './template.html',
   styleUrls: ['./style.scss'],
 })
-export class LoginComponent implements OnInit {
+export class LoginComponent implements OnInit, OnDestroy {
   loginModes = LoginModes;
   selectedAuthenticationMode = '';
   errors: KdError[] = [];
 
   private enabledAuthenticationModes_: AuthenticationMode[] = [];
   private isLoginSkippable_ = false;
+  private isReadOnly_ = false;
   private kubeconfig_: string;
   private token_: string;
   private username_: string;
   private password_: string;
+  private clientCertificate_: string;
+  private clientKey_: string;
+  private readonly unsubscribe_ = new Subject<void>();
 
   constructor(
     private readonly authService_: AuthService,
@@ -58,6 +76,7 @@ export class LoginComponent implements OnInit {
     private readonly route_: ActivatedRoute,
     private readonly pluginConfigService_: PluginsConfigService,
     private readonly historyService_: HistoryService,
+    private readonly config_: ConfigService,
     @Inject(CONFIG_DI_TOKEN) private readonly CONFIG: IConfig
   ) {}
 
@@ -65,15 +84,13 @@ export class LoginComponent implements OnInit {
     this.selectedAuthenticationMode =
       this.selectedAuthenticationMode || this.cookies_.get(this.CONFIG.authModeCookieName) || '';
 
-    this.http_
-      .get<EnabledAuthenticationModes>('api/v1/login/modes')
-      .subscribe((enabledModes: EnabledAuthenticationModes) => {
-        this.enabledAuthenticationModes_ = enabledModes.modes;
-        this.enabledAuthenticationModes_.push(LoginModes.Kubeconfig);
-        this.selectedAuthenticationMode = this.selectedAuthenticationMode
-          ? (this.selectedAuthenticationMode as LoginModes)
-          : (this.enabledAuthenticationModes_[0] as LoginModes);
-      });
+    interval(AUTHENTICATION_MODES_REFRESH_INTERVAL)
+      .pipe(
+        startWith(0),
+        switchMap(_ => this.http_.get<EnabledAuthenticationModes>('api/v1/login/modes').pipe(catchError(() => EMPTY))),
+        takeUntil(this.unsubscribe_)
+      )
+      .subscribe((enabledModes: EnabledAuthenticationModes) => this.onAuthenticationModesLoad_(enabledModes));
 
     this.http_
       .get<LoginSkippableResponse>('api/v1/login/skippable')
@@ -81,6 +98,12 @@ export class LoginComponent implements OnInit {
         this.isLoginSkippable_ = loginSkippableResponse.skippable;
       });
 
+    this.http_
+      .get<LoginReadOnlyResponse>('api/v1/login/readonly')
+      .subscribe((loginReadOnlyResponse: LoginReadOnlyResponse) => {
+        this.isReadOnly_ = loginReadOnlyResponse.readOnly;
+      });
+
     this.route_.paramMap.pipe(map(() => window.history.state)).subscribe((state: StateError) => {
       if (state.error) {
         this.errors = [state.error];
@@ -88,6 +111,11 @@ export class LoginComponent implements OnInit {
     });
   }
 
+  ngOnDestroy(): void {
+    this.unsubscribe_.next();
+    this.unsubscribe_.complete();
+  }
+
   getEnabledAuthenticationModes(): AuthenticationMode[] {
     return this.enabledAuthenticationModes_;
   }
@@ -97,6 +125,11 @@ export class LoginComponent implements OnInit {
       return;
     }
 
+    if (this.selectedAuthenticationMode === LoginModes.OIDC) {
+      this.loginWithSSO();
+      return;
+    }
+
     this.saveLastLoginMode_();
     this.authService_.login(this.getLoginSpec_()).subscribe(
       (errors: K8SError[]) => {
@@ -106,7 +139,7 @@ export class LoginComponent implements OnInit {
         }
 
         this.pluginConfigService_.refreshConfig();
-        this.ngZone_.run(_ => this.historyService_.goToPreviousState('workloads'));
+        this.ngZone_.run(_ => this.goToDefaultView_());
       },
       (err: HttpErrorResponse) => {
         this.errors = [AsKdError(err)];
@@ -114,15 +147,32 @@ export class LoginComponent implements OnInit {
     );
   }
 
+  loginWithSSO(): void {
+    this.saveLastLoginMode_();
+    window.location.href = 'api/v1/login/oidc';
+  }
+
   skip(): void {
     this.authService_.skipLoginPage(true);
-    this.historyService_.goToPreviousState('workloads');
+    this.goToDefaultView_();
   }
 
   isSkipButtonEnabled(): boolean {
     return this.isLoginSkippable_;
   }
 
+  isReadOnly(): boolean {
+    return this.isReadOnly_;
+  }
+
+  getTitle(): string {
+    return this.config_.getLoginTitle();
+  }
+
+  getLogoUrl(): string {
+    return this.config_.getLoginLogoUrl();
+  }
+
   isLoginEnabled(): boolean {
     return this.authService_.isLoginEnabled();
   }
@@ -146,6 +196,23 @@ export class LoginComponent implements OnInit {
     }
   }
 
+  onClientCertificateLoad(file: KdFile): void {
+    this.clientCertificate_ = file.content;
+  }
+
+  onClientKeyLoad(file: KdFile): void {
+    this.clientKey_ = file.content;
+  }
+
+  private onAuthenticationModesLoad_(enabledModes: EnabledAuthenticationModes): void {
+    this.enabledAuthenticationModes_ = enabledModes.modes;
+    this.enabledAuthenticationModes_.push(LoginModes.Kubeconfig);
+
+    // Selected mode could have been disabled since the last reload.
+    if (!this.enabledAuthenticationModes_.includes(this.selectedAuthenticationMode)) {
+      this.selectedAuthenticationMode = this.enabledAuthenticationModes_[0] as LoginModes;
+    }
+  }
   private hasEmptyToken_(): boolean {
     return this.selectedAuthenticationMode === LoginModes.Token && (!this.token_ || !this.token_.trim());
   }
@@ -177,8 +244,17 @@ export class LoginComponent implements OnInit {
           username: this.username_,
           password: this.password_,
         } as LoginSpec;
+      case LoginModes.X509:
+        return {
+          clientCertificate: this.clientCertificate_,
+          clientKey: this.clientKey_,
+        } as LoginSpec;
       default:
         return {} as LoginSpec;
     }
   }
+
+  private goToDefaultView_(): void {
+    this.historyService_.goToPreviousState(this.config_.getDefaultView(), this.config_.getDefaultViewQueryParams());
+  }
 }
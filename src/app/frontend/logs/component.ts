@@ -76,7 +76,8 @@ export class LogsComponent implements OnDestroy {
           this.logSources = data;
           this.pod = data.podNames[0]; // Pick first pod (cannot use resource name as it may
           // not be a pod).
-          this.container = containerName ? containerName : data.containerNames[0]; // Pick from URL or first.
+          // Pick from URL, the default container of the pod or the first one.
+          this.container = containerName || data.defaultContainer || data.containerNames[0];
           this.appendContainerParam_();
 
           return this.logService.getResource(`${namespace}/${this.pod}/${this.container}`);
@@ -136,16 +137,23 @@ export class LogsComponent implements OnDestroy {
   }
 
   /**
-   * Shifts view by maxLogSize lines to the past.
+   * Shifts view by maxLogSize lines to the past. If the oldest loaded lines are displayed, more lines are requested
+   * from the end of the log file.
    */
   loadOlder(): void {
+    let tailLines = this.currentSelection.tailLines;
+    if (this.podLogs.info.moreAvailable) {
+      tailLines += this.logsPerView;
+    }
+
     this.loadView_(
       this.currentSelection.logFilePosition,
       this.currentSelection.referencePoint.timestamp,
       this.currentSelection.referencePoint.lineNum,
       this.currentSelection.offsetFrom - this.logsPerView,
       this.currentSelection.offsetFrom,
-      this.scrollToBottom_.bind(this)
+      this.scrollToBottom_.bind(this),
+      tailLines
     );
   }
 
@@ -159,7 +167,8 @@ export class LogsComponent implements OnDestroy {
       this.currentSelection.referencePoint.lineNum,
       this.currentSelection.offsetTo,
       this.currentSelection.offsetTo + this.logsPerView,
-      this.scrollToTop_.bind(this)
+      this.scrollToTop_.bind(this),
+      this.currentSelection.tailLines
     );
   }
 
@@ -219,7 +228,7 @@ export class LogsComponent implements OnDestroy {
     this.podLogs = podLogs;
     this.currentSelection = podLogs.selection;
     this.logsSet = this.formatAllLogs_(podLogs.logs);
-    if (podLogs.info.truncated) {
+    if (podLogs.info.truncated && !podLogs.info.moreAvailable) {
       this.notifications_.push(i18n.MSG_LOGS_TRUNCATED_WARNING, NotificationSeverity.error);
     }
 
@@ -265,18 +274,24 @@ export class LogsComponent implements OnDestroy {
     referenceLinenum: number,
     offsetFrom: number,
     offsetTo: number,
-    onLoad?: Function
+    onLoad?: Function,
+    tailLines?: number,
+    background = false
   ): void {
     const namespace = this.activatedRoute_.snapshot.params.resourceNamespace;
-    const params = new HttpParams()
+    let params = new HttpParams()
       .set('logFilePosition', logFilePosition)
       .set('referenceTimestamp', referenceTimestamp)
       .set('referenceLineNum', `${referenceLinenum}`)
       .set('offsetFrom', `${offsetFrom}`)
       .set('offsetTo', `${offsetTo}`)
       .set('previous', `${this.logService.getPrevious()}`);
+    // Backend loads --log-default-tail-lines newest lines if the number is not given.
+    if (tailLines > 0) {
+      params = params.set('tailLines', `${tailLines}`);
+    }
     this.logService
-      .getResource(`${namespace}/${this.pod}/${this.container}`, params)
+      .getResource(`${namespace}/${this.pod}/${this.container}`, params, background)
       .pipe(takeUntil(this.unsubscribe_))
       .subscribe((podLogs: LogDetails) => {
         this.updateUiModel_(podLogs);
@@ -304,13 +319,16 @@ export class LogsComponent implements OnDestroy {
         })
       )
       .pipe(takeUntil(this.refreshUnsubscribe_))
-      .subscribe(_ =>
+      .subscribe(tick =>
         this.loadView_(
           LogControl.LoadEnd,
           LogControl.TimestampNewest,
           0,
           this.maxLogSize,
-          this.maxLogSize + this.logsPerView
+          this.maxLogSize + this.logsPerView,
+          undefined,
+          undefined,
+          tick > 0
         )
       );
   }
@@ -12,8 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+import {HttpClient} from '@angular/common/http';
 import {Component, OnInit} from '@angular/core';
 import {Router} from '@angular/router';
+import {saveAs} from 'file-saver';
 import {Subject} from 'rxjs';
 import {takeUntil} from 'rxjs/operators';
 
@@ -31,7 +33,11 @@ export class ActionbarComponent implements OnInit {
 
   private unsubscribe_ = new Subject<void>();
 
-  constructor(private readonly actionbar_: ActionbarService, private readonly router_: Router) {}
+  constructor(
+    private readonly actionbar_: ActionbarService,
+    private readonly router_: Router,
+    private readonly http_: HttpClient
+  ) {}
 
   ngOnInit(): void {
     this.actionbar_.onInit.pipe(takeUntil(this.unsubscribe_)).subscribe((resourceMeta: ResourceMeta) => {
@@ -54,4 +60,13 @@ export class ActionbarComponent implements OnInit {
       queryParams: {[NAMESPACE_STATE_PARAM]: this.resourceMeta.objectMeta.name},
     });
   }
+
+  // Downloads manifests of all resources in the namespace. Secrets are not included.
+  onExport(): void {
+    const name = this.resourceMeta.objectMeta.name;
+    this.http_
+      .get(`api/v1/namespace/${name}/export`, {responseType: 'blob'})
+      .pipe(takeUntil(this.unsubscribe_))
+      .subscribe(bundle => saveAs(bundle, `${name}.yaml`));
+  }
 }
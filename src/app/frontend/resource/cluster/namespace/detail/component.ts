@@ -14,7 +14,7 @@
 
 import {Component, OnDestroy, OnInit} from '@angular/core';
 import {ActivatedRoute} from '@angular/router';
-import {NamespaceDetail} from '@api/root.api';
+import {NamespaceDetail, ResourceQuotaUsageList} from '@api/root.api';
 import {Subject} from 'rxjs';
 import {takeUntil} from 'rxjs/operators';
 
@@ -32,11 +32,14 @@ export class NamespaceDetailComponent implements OnInit, OnDestroy {
   private readonly unsubscribe_ = new Subject<void>();
 
   namespace: NamespaceDetail;
+  quotaUsage: ResourceQuotaUsageList;
   isInitialized = false;
+  isQuotaUsageInitialized = false;
   eventListEndpoint: string;
 
   constructor(
     private readonly namespace_: ResourceService<NamespaceDetail>,
+    private readonly quotaUsage_: ResourceService<ResourceQuotaUsageList>,
     private readonly actionbar_: ActionbarService,
     private readonly activatedRoute_: ActivatedRoute,
     private readonly notifications_: NotificationsService
@@ -56,6 +59,15 @@ export class NamespaceDetailComponent implements OnInit, OnDestroy {
         this.actionbar_.onInit.emit(new ResourceMeta('Namespace', d.objectMeta, d.typeMeta));
         this.isInitialized = true;
       });
+
+    this.quotaUsage_
+      .get(this.endpoint_.child(resourceName, Resource.resourceQuota))
+      .pipe(takeUntil(this.unsubscribe_))
+      .subscribe((d: ResourceQuotaUsageList) => {
+        this.quotaUsage = d;
+        this.notifications_.pushErrors(d.errors);
+        this.isQuotaUsageInitialized = true;
+      });
   }
 
   ngOnDestroy(): void {
@@ -14,7 +14,7 @@
 
 import {NgModule} from '@angular/core';
 import {Route, RouterModule} from '@angular/router';
-import {DEFAULT_ACTIONBAR} from '../../../common/components/actionbars/routing';
+import {NODE_DEFAULT_ACTIONBAR} from '../../../common/components/actionbars/routing';
 
 import {CLUSTER_ROUTE} from '../routing';
 
@@ -40,7 +40,7 @@ const NODE_DETAIL_ROUTE: Route = {
 };
 
 @NgModule({
-  imports: [RouterModule.forChild([NODE_LIST_ROUTE, NODE_DETAIL_ROUTE, DEFAULT_ACTIONBAR])],
+  imports: [RouterModule.forChild([NODE_LIST_ROUTE, NODE_DETAIL_ROUTE, NODE_DEFAULT_ACTIONBAR])],
   exports: [RouterModule],
 })
 export class NodeRoutingModule {}
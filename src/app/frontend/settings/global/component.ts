@@ -22,6 +22,7 @@ import {of, Subject} from 'rxjs';
 import {Observable} from 'rxjs/Observable';
 import {catchError, take, takeUntil, tap} from 'rxjs/operators';
 
+import {ConfigService} from '../../common/services/global/config';
 import {GlobalSettingsService} from '../../common/services/global/globalsettings';
 import {TitleService} from '../../common/services/global/title';
 import {ResourceService} from '../../common/services/resource/resource';
@@ -61,7 +62,8 @@ export class GlobalSettingsComponent implements OnInit, OnDestroy {
     private readonly namespaceService_: ResourceService<NamespaceList>,
     private readonly dialog_: MatDialog,
     private readonly title_: TitleService,
-    private readonly builder_: FormBuilder
+    private readonly builder_: FormBuilder,
+    private readonly config_: ConfigService
   ) {}
 
   private get externalSettings_(): GlobalSettings {
@@ -110,8 +112,12 @@ export class GlobalSettingsComponent implements OnInit, OnDestroy {
     this.load_();
   }
 
+  isReadOnly(): boolean {
+    return this.config_.isSettingsReadOnly();
+  }
+
   canSave(): boolean {
-    return !_.isEqual(this.settings, this.externalSettings_);
+    return !this.isReadOnly() && !_.isEqual(this.settings, this.externalSettings_);
   }
 
   save(): void {
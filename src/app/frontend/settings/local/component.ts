@@ -17,6 +17,7 @@ import {Component, Inject, OnInit, ViewChild} from '@angular/core';
 import {MatSelect} from '@angular/material/select';
 import {LocalSettings, Theme} from '@api/root.api';
 import {IConfig, LanguageConfig} from '@api/root.ui';
+import {ConfigService} from '@common/services/global/config';
 import {LocalSettingsService} from '@common/services/global/localsettings';
 import {ThemeService} from '@common/services/global/theme';
 import {environment} from '@environments/environment';
@@ -35,6 +36,9 @@ export class LocalSettingsComponent implements OnInit {
   themes: Theme[];
   selectedTheme: string;
   systemTheme: string;
+  selectedTimezone: string;
+  defaultTimezone: string;
+  browserTimezone: string;
 
   @ViewChild(MatSelect, {static: true}) private readonly select_: MatSelect;
 
@@ -42,6 +46,7 @@ export class LocalSettingsComponent implements OnInit {
     private readonly settings_: LocalSettingsService,
     private readonly theme_: ThemeService,
     private readonly cookies_: CookieService,
+    private readonly config_: ConfigService,
     @Inject(DOCUMENT) private readonly document_: Document,
     @Inject(CONFIG_DI_TOKEN) private readonly appConfig_: IConfig
   ) {}
@@ -55,6 +60,15 @@ export class LocalSettingsComponent implements OnInit {
     this.themes = this.theme_.themes;
     this.selectedTheme = this.theme_.theme;
     this.systemTheme = this.theme_.systemTheme;
+
+    this.selectedTimezone = this.settings.timezone || '';
+    this.defaultTimezone = this.config_.getDefaultTimezone() || 'UTC';
+    this.browserTimezone = Intl.DateTimeFormat().resolvedOptions().timeZone;
+  }
+
+  onTimezoneChange(): void {
+    this.settings.timezone = this.selectedTimezone;
+    this.settings_.handleTimezoneChange(this.settings.timezone);
   }
 
   onThemeChange(): void {
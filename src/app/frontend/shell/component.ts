@@ -78,7 +78,7 @@ export class ShellComponent implements AfterViewInit, OnDestroy {
       .subscribe(containerList => {
         this.containers = containerList.containers;
         if (this.containers.length > 0 && !this.selectedContainer) {
-          this.onPodContainerChange(this.containers[0]);
+          this.onPodContainerChange(containerList.defaultContainer || this.containers[0]);
         }
       });
   }
@@ -192,7 +192,7 @@ export class ShellComponent implements AfterViewInit, OnDestroy {
 
     const terminalSessionUrl = `${EndpointManager.utility(Utility.shell).shell(this.namespace_, this.podName)}/${
       this.selectedContainer
-    }`;
+    }?term=xterm-256color&rows=${this.term.rows}&cols=${this.term.cols}`;
     const {id} = await this.utility_.shell(terminalSessionUrl).toPromise();
 
     this.conn_ = new SockJS(`api/sockjs?${id}`);
@@ -246,6 +246,10 @@ export class ShellComponent implements AfterViewInit, OnDestroy {
     this.connecting_ = false;
     this.connectionClosed_ = true;
     this.matSnackBar_.open(_evt.reason, null, {duration: 3000});
+    // Reason stays in the terminal, so it is not left blank if the shell could not be started.
+    if (_evt.reason) {
+      this.term.write(`\r\n${_evt.reason}\r\n`);
+    }
 
     this.cdr_.markForCheck();
   }
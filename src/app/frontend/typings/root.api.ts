@@ -22,6 +22,7 @@ export interface TypeMeta {
 
 export interface ListMeta {
   totalItems: number;
+  continue?: string;
 }
 
 export interface ObjectMeta {
@@ -168,6 +169,7 @@ export interface PersistentVolumeList extends ResourceList {
 
 export interface PodContainerList {
   containers: string[];
+  defaultContainer: string;
 }
 
 export interface PodList extends ResourceList {
@@ -192,6 +194,10 @@ export interface ResourceQuotaDetailList extends ResourceList {
   items: ResourceQuotaDetail[];
 }
 
+export interface ResourceQuotaUsageList extends ResourceList {
+  items: ResourceQuotaUsage[];
+}
+
 export interface SecretList extends ResourceList {
   secrets: Secret[];
 }
@@ -716,6 +722,10 @@ export interface AuthResponse {
   errors: K8sError[];
 }
 
+export interface FeatureFlags {
+  features: {[name: string]: boolean};
+}
+
 export interface CanIResponse {
   allowed: boolean;
 }
@@ -746,6 +756,18 @@ export interface AppDeploymentContentResponse {
   name: string;
 }
 
+export interface AppDeploymentContentValidity {
+  valid: boolean;
+  errors: AppDeploymentContentIssue[];
+  warnings: AppDeploymentContentIssue[];
+}
+
+export interface AppDeploymentContentIssue {
+  object: string;
+  path: string;
+  message: string;
+}
+
 export interface AppDeploymentSpec {
   containerImage: string;
   containerCommand?: string;
@@ -770,6 +792,7 @@ export interface CsrfToken {
 
 export interface LocalSettings {
   theme: string;
+  timezone?: string;
 }
 
 export interface Theme {
@@ -780,6 +803,34 @@ export interface Theme {
 
 export interface AppConfig {
   serverTime: number;
+  allowedResourceKinds?: string[];
+  settingsReadOnly?: boolean;
+  defaultView: string;
+  defaultViewNamespace?: string;
+  loginTitle?: string;
+  loginLogoUrl?: string;
+  appTitle?: string;
+  faviconUrl?: string;
+  cookieSameSite?: 'Lax' | 'Strict' | 'None';
+  cookieSecure?: boolean;
+  cookieDomain?: string;
+  defaultCreateNamespace?: string;
+  sessionWarningLeadTime?: number;
+  tokenRefreshDisabled?: boolean;
+  defaultDeletePropagation?: 'Foreground' | 'Background' | 'Orphan';
+  defaultTimezone?: string;
+  anonymousAccess?: boolean;
+  apiPrefix?: string;
+}
+
+export interface SessionWarningResponse {
+  id: string;
+}
+
+export interface SessionWarningMessage {
+  op: 'warning' | 'extended' | 'expired';
+  reason?: 'token' | 'idle';
+  expiresIn?: number;
 }
 
 export interface StringMap {
@@ -835,6 +886,16 @@ export interface ResourceQuotaStatus {
   hard: string;
 }
 
+export interface ResourceQuotaUsage {
+  name: string;
+  quota: string;
+  used: string;
+  hard: string;
+  remaining: string;
+  percentage: number;
+  nearLimit: boolean;
+}
+
 export interface MetricResult {
   timestamp: string;
   value: number;
@@ -1070,6 +1131,19 @@ export interface NodeTaint {
   timeAdded: number;
 }
 
+export interface DrainPodStatus {
+  namespace: string;
+  name: string;
+  status: string;
+  message?: string;
+}
+
+export interface DrainProgress {
+  pods: DrainPodStatus[];
+  done: boolean;
+  error?: string;
+}
+
 export interface PortMapping {
   port: number | null;
   protocol: string;
@@ -1110,6 +1184,7 @@ export interface ReplicationControllerSpec {
 export interface ReplicaCounts {
   desiredReplicas: number;
   actualReplicas: number;
+  resourceVersion?: string;
 }
 
 export interface DeleteReplicationControllerSpec {
@@ -1135,6 +1210,7 @@ export interface LogSources {
   podNames: string[];
   containerNames: string[];
   initContainerNames: string[];
+  defaultContainer: string;
 }
 
 export interface LogDetails {
@@ -1150,6 +1226,7 @@ export interface LogInfo {
   fromDate: string;
   toDate: string;
   truncated: boolean;
+  moreAvailable: boolean;
 }
 
 export interface LogLine {
@@ -1169,6 +1246,7 @@ export interface LogSelection {
   referencePoint: LogLineReference;
   offsetFrom: number;
   offsetTo: number;
+  tailLines?: number;
 }
 
 export interface LogLineReference {
@@ -1179,6 +1257,7 @@ export interface LogLineReference {
 export type LogOptions = {
   previous: boolean;
   timestamps: boolean;
+  timezone?: string;
 };
 
 export interface Protocols {
@@ -1225,6 +1304,33 @@ export interface PinnedResource {
   namespaced: boolean;
 }
 
+export interface OverviewQuery {
+  label: string;
+  kind: string;
+  namespace?: string;
+  labelSelector?: string;
+}
+
+export interface Overview {
+  queries: OverviewQuery[];
+}
+
+export interface OverviewQueryResult {
+  query: OverviewQuery;
+  total: number;
+  status?: Status;
+  error?: string;
+}
+
+export interface DefaultSort {
+  sortBy: string[];
+  order?: string;
+}
+
+export interface OverviewQueryResultList {
+  results: OverviewQueryResult[];
+}
+
 export interface APIVersion {
   name: string;
 }
@@ -1234,6 +1340,8 @@ export interface LoginSpec {
   password: string;
   token: string;
   kubeConfig: string;
+  clientCertificate: string;
+  clientKey: string;
 }
 
 export interface AuthResponse {
@@ -1257,6 +1365,10 @@ export interface LoginSkippableResponse {
   skippable: boolean;
 }
 
+export interface LoginReadOnlyResponse {
+  readOnly: boolean;
+}
+
 export interface SystemBanner {
   message: string;
   severity: string;
@@ -143,6 +143,7 @@ export interface IConfig {
   skipLoginPageCookieName: string;
   csrfHeaderName: string;
   authTokenHeaderName: string;
+  backgroundRequestHeaderName: string;
   defaultNamespace: string;
   authModeCookieName: string;
   supportedLanguages: LanguageConfig[];